@@ -0,0 +1,236 @@
+// apikey 管理API key：创建、吊销和列出key
+//
+// 用法：
+//
+//	apikey create -name discord-bot -scopes calculate -quota 10000 -guilds '*'
+//	apikey revoke <id>
+//	apikey list
+//
+// key文件默认为AUTH_KEYS_FILE，可用-file指定；运行中的服务会自动重新加载key文件
+// 配置了数据库（DB_DRIVER）时list从数据库读取用量
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "create":
+		err = create(os.Args[2:])
+	case "revoke":
+		err = revoke(os.Args[2:])
+	case "list":
+		err = list(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "未知的命令: %s\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "错误:", err)
+		os.Exit(1)
+	}
+}
+
+// usage 打印用法
+func usage() {
+	fmt.Fprintf(os.Stderr, `用法: apikey <命令> [参数]
+
+命令:
+  create  创建API key，明文key只显示一次
+  revoke  吊销API key
+  list    列出API key及其用量
+
+权限范围: %s（calculate包含catalog，admin包含全部权限）
+使用 apikey <命令> -h 查看命令参数
+`, strings.Join(auth.Scopes(), "、"))
+}
+
+// newFlagSet 创建子命令的参数集，包含-file参数
+func newFlagSet(name string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	file := fs.String("file", config.LoadConfig().Auth.KeysFile, "API key文件")
+	return fs, file
+}
+
+// openStore 打开key文件，不定期写入用量
+func openStore(path string) (*auth.FileStore, error) {
+	return auth.NewFileStore(path, 0)
+}
+
+// usageStore 从数据库读取用量的key存储，关闭时同时关闭数据库
+type usageStore struct {
+	*auth.DBStore
+	db *store.DB
+}
+
+// Close 关闭key存储和数据库
+func (s usageStore) Close() error {
+	err := s.DBStore.Close()
+	if closeErr := s.db.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// openUsageStore 打开key文件，配置了数据库（DB_DRIVER）时从数据库读取用量，与服务保持一致
+func openUsageStore(path string) (auth.Store, error) {
+	keys, err := openStore(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := config.LoadConfig().Database
+	if cfg.Driver == store.DriverNone {
+		return keys, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	db, err := store.Open(ctx, store.ConfigOptions(cfg))
+	if err != nil {
+		keys.Close()
+		return nil, err
+	}
+	return usageStore{DBStore: auth.NewDBStore(keys, db.Usage(), 0), db: db}, nil
+}
+
+// create 创建API key
+func create(args []string) error {
+	fs, file := newFlagSet("create")
+	name := fs.String("name", "", "key名称，如使用方或用途")
+	scopes := fs.String("scopes", auth.ScopeCalculate, "权限范围，多个用逗号分隔")
+	quota := fs.Int("quota", 0, "每日请求数上限（UTC自然日），0表示不限")
+	guilds := fs.String("guilds", "", "可以修改服务器预设的服务器ID，多个用逗号分隔，*表示全部服务器（调用方需自行检查服务器管理权限）")
+	fs.Parse(args)
+
+	if *name == "" {
+		return errors.New("需要-name参数")
+	}
+
+	key, plain, err := auth.NewKey(*name, splitList(*scopes), *quota)
+	if err != nil {
+		return err
+	}
+	key.Guilds = splitList(*guilds)
+
+	store, err := openStore(*file)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	if err := store.Create(key); err != nil {
+		return err
+	}
+
+	fmt.Printf("已创建API key %s（%s），请妥善保存，之后无法再次查看：\n%s\n", key.ID, key.Name, plain)
+	return nil
+}
+
+// splitList 拆分逗号分隔的列表，忽略空项
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// revoke 吊销API key
+func revoke(args []string) error {
+	fs, file := newFlagSet("revoke")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "用法: apikey revoke [-file 文件] <id>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("需要key ID")
+	}
+
+	store, err := openStore(*file)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	key, err := store.Revoke(fs.Arg(0), time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("已吊销API key %s（%s）\n", key.ID, key.Name)
+	return nil
+}
+
+// list 列出API key及其用量，用量由服务定期写入，可能有延迟
+func list(args []string) error {
+	fs, file := newFlagSet("list")
+	fs.Parse(args)
+
+	store, err := openUsageStore(*file)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	keys, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		fmt.Println("没有API key")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\t名称\t权限\t服务器\t每日配额\t今日\t累计\t最后使用\t状态")
+	for _, key := range keys {
+		usage, err := store.Usage(key.ID)
+		if err != nil {
+			return err
+		}
+
+		quota := "不限"
+		if key.DailyQuota > 0 {
+			quota = fmt.Sprint(key.DailyQuota)
+		}
+		lastUsed := "-"
+		if usage.LastUsedAt != nil {
+			lastUsed = usage.LastUsedAt.Local().Format("2006-01-02 15:04")
+		}
+		guilds := "-"
+		if len(key.Guilds) > 0 {
+			guilds = strings.Join(key.Guilds, ",")
+		}
+		status := "有效"
+		if key.RevokedAt != nil {
+			status = "已吊销 " + key.RevokedAt.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			key.ID, key.Name, strings.Join(key.Scopes, ","), guilds, quota, usage.Today, usage.Total, lastUsed, status)
+	}
+	return w.Flush()
+}
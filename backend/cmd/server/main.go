@@ -9,19 +9,38 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/go-openapi/loads"
 	"github.com/jessevdk/go-flags"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/buildinfo"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations"
 )
 
 func main() {
+	cfg := config.LoadConfig()
+	logging.Setup(cfg.Log.Level, cfg.Log.Format)
+
+	// server migrate 管理数据库schema版本，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			fatal("数据库迁移失败", err)
+		}
+		return
+	}
+
 	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
 	if err != nil {
-		log.Fatalln(err)
+		fatal("加载API规范失败", err)
 	}
 
 	api := operations.NewOncehumanToolsAPI(swaggerSpec)
@@ -36,7 +55,7 @@ func main() {
 	for _, optsGroup := range api.CommandLineOptionsGroups {
 		_, err := parser.AddGroup(optsGroup.ShortDescription, optsGroup.LongDescription, optsGroup.Options)
 		if err != nil {
-			log.Fatalln(err)
+			fatal("添加命令行参数失败", err)
 		}
 	}
 
@@ -47,18 +66,54 @@ func main() {
 				code = 0
 			}
 		}
-		log.Fatalf("Error parsing flags: %v (code: %d)", err, code)
+		if code != 0 {
+			slog.Error("解析命令行参数失败", "error", err)
+		}
+		os.Exit(code)
+	}
+
+	// 停止服务时等待请求和任务结束的总时间不短于配置的停止延迟和宽限期
+	if timeout := cfg.Shutdown.DrainDelay + cfg.Shutdown.GracePeriod + restapi.ShutdownMargin; server.GracefulTimeout < timeout {
+		server.GracefulTimeout = timeout
+	}
+
+	models.SetPatchDir(cfg.Catalog.PatchesDir)
+	if err := models.ReloadCatalogs(); err != nil {
+		fatal("加载目录补丁失败", err)
 	}
 
 	server.ConfigureAPI()
+	go reloadCatalogsOnSignal()
 
 	// 启动前打印信息
-	log.Printf("Starting OnceHuman Tools API Server...")
-	log.Printf("API Version: 1.0.0")
-	log.Printf("Listening on %s:%d", server.Host, server.Port)
-	log.Printf("Swagger UI available at: http://%s:%d/docs", server.Host, server.Port)
+	info := buildinfo.Get()
+	slog.Info("Starting OnceHuman Tools API Server...",
+		"version", info.Version,
+		"commit", info.ShortCommit(),
+		"listen", fmt.Sprintf("%s:%d", server.Host, server.Port),
+		"swagger_ui", fmt.Sprintf("http://%s:%d/api/v1/docs", server.Host, server.Port),
+		"log_level", cfg.Log.Level)
 
 	if err := server.Serve(); err != nil {
-		log.Fatalln(err)
+		fatal("服务运行失败", err)
 	}
 }
+
+// reloadCatalogsOnSignal 收到SIGHUP时重新读取补丁目录并生成游戏版本目录，计算结果缓存随之清空
+// 补丁有错误时记录日志并继续使用当前的目录
+func reloadCatalogsOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		slog.Info("收到SIGHUP，重新加载目录")
+		if err := models.ReloadCatalogs(); err != nil {
+			slog.Error("重新加载目录失败，继续使用当前目录", "error", err)
+		}
+	}
+}
+
+// fatal 记录错误并退出
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
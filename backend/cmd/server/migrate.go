@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+// migrateUsage 打印migrate命令的用法
+func migrateUsage() {
+	fmt.Fprint(os.Stderr, `用法: server migrate <命令> [参数]
+
+命令:
+  up      应用未应用的迁移，-to指定目标版本，默认应用全部
+  down    回滚迁移，-to指定保留的版本，默认回滚最近的一个
+  status  列出迁移及其应用状态
+
+数据库由DB_DRIVER等环境变量指定
+`)
+}
+
+// runMigrate 执行migrate子命令
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		migrateUsage()
+		os.Exit(2)
+	}
+	if args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help" {
+		migrateUsage()
+		return nil
+	}
+
+	fs := flag.NewFlagSet("migrate "+args[0], flag.ExitOnError)
+	to := fs.Int("to", -1, "目标版本")
+	switch args[0] {
+	case "up", "down", "status":
+	default:
+		migrateUsage()
+		return fmt.Errorf("未知的命令: %s", args[0])
+	}
+	fs.Parse(args[1:])
+
+	if cfg.Database.Driver == store.DriverNone {
+		return errors.New("未启用数据库，请设置DB_DRIVER")
+	}
+
+	ctx := context.Background()
+	db, err := store.Open(ctx, store.ConfigOptions(cfg.Database))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	switch args[0] {
+	case "up":
+		target := *to
+		if target < 0 {
+			target = 0
+		}
+		applied, err := db.Migrate(ctx, target)
+		printMigrations("已应用", applied)
+		if err != nil {
+			return err
+		}
+	case "down":
+		target := *to
+		if target < 0 {
+			version, err := db.Version(ctx)
+			if err != nil {
+				return err
+			}
+			target = version - 1
+		}
+		rolledBack, err := db.Rollback(ctx, target)
+		printMigrations("已回滚", rolledBack)
+		if err != nil {
+			return err
+		}
+	case "status":
+		return printStatus(ctx, db)
+	}
+
+	version, err := db.Version(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("当前版本: %d\n", version)
+	return nil
+}
+
+// printMigrations 打印本次应用或回滚的迁移
+func printMigrations(action string, migrations []store.Migration) {
+	if len(migrations) == 0 {
+		fmt.Println("没有需要执行的迁移")
+		return
+	}
+	for _, m := range migrations {
+		fmt.Printf("%s %04d_%s\n", action, m.Version, m.Name)
+	}
+}
+
+// printStatus 列出迁移及其应用状态
+func printStatus(ctx context.Context, db *store.DB) error {
+	status, err := db.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "数据库: %s\n", db.Driver())
+	fmt.Fprintln(w, "版本\t名称\t应用时间")
+	for _, s := range status {
+		appliedAt := "未应用"
+		if s.AppliedAt != nil {
+			appliedAt = s.AppliedAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+	}
+	return w.Flush()
+}
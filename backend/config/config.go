@@ -3,13 +3,29 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config 应用配置
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Discord  DiscordConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	Discord   DiscordConfig
+	RateLimit RateLimitConfig
+	Redis     RedisConfig
+	CORS      CORSConfig
+	Security  SecurityConfig
+	Log       LogConfig
+	Metrics   MetricsConfig
+	Cache     CacheConfig
+	Jobs      JobsConfig
+	Auth      AuthConfig
+	Shutdown  ShutdownConfig
+	Tools     ToolsConfig
+	Plugins   PluginsConfig
+	Scripts   ScriptsConfig
+	Catalog   CatalogConfig
 }
 
 // ServerConfig 服务器配置
@@ -18,13 +34,18 @@ type ServerConfig struct {
 	Port int
 }
 
-// DatabaseConfig 数据库配置
+// DatabaseConfig 数据库配置，SQLite用于单机部署，PostgreSQL用于生产环境
 type DatabaseConfig struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	DBName   string
+	Driver       string // none、sqlite或postgres，none时不使用数据库，依赖数据库的功能不可用
+	Path         string // SQLite数据库文件
+	Host         string
+	Port         int
+	User         string
+	Password     string
+	DBName       string
+	SSLMode      string // PostgreSQL的sslmode
+	MaxOpenConns int    // PostgreSQL的最大连接数
+	AutoMigrate  bool   // 启动时是否应用未应用的迁移
 }
 
 // DiscordConfig Discord配置
@@ -33,6 +54,112 @@ type DiscordConfig struct {
 	BotPrefix string
 }
 
+// RateLimitConfig 限流配置，请求数为每个周期的令牌桶容量，0表示不限流
+type RateLimitConfig struct {
+	Requests          int           // 每个IP低开销接口的请求数
+	ExpensiveRequests int           // 每个IP高开销接口的请求数
+	KeyRequests       int           // 每个API key低开销接口的请求数
+	KeyExpensive      int           // 每个API key高开销接口的请求数
+	Period            time.Duration // 令牌桶补满的周期
+	APIKeys           []string      // 已知的API key
+	TrustedProxies    []string      // 可信代理的地址或CIDR
+	Store             string        // 令牌桶存储，memory或redis
+}
+
+// CORSConfig 跨域配置，AllowedOrigins为空时不允许跨域请求
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration // 预检请求的缓存时间
+}
+
+// SecurityConfig 安全响应头配置
+type SecurityConfig struct {
+	HSTSMaxAge            time.Duration // HTTPS响应的HSTS有效期，0表示不发送
+	HSTSIncludeSubdomains bool
+	ContentSecurityPolicy string // API响应的CSP
+	DocsSecurityPolicy    string // Swagger UI的CSP
+}
+
+// LogConfig 日志配置
+type LogConfig struct {
+	Level  string // debug、info、warn或error
+	Format string // text或json
+}
+
+// CacheConfig 计算结果缓存配置
+type CacheConfig struct {
+	Store      string        // memory、redis或none，redis时进程内缓存之外再使用Redis共享结果
+	MaxEntries int           // 进程内缓存的条目数上限
+	MaxBytes   int64         // 进程内缓存的总字节数上限
+	TTL        time.Duration // 结果的有效期
+}
+
+// JobsConfig 异步计算任务配置
+type JobsConfig struct {
+	Workers   int           // 并发执行的任务数
+	QueueSize int           // 排队任务数上限，队列满时拒绝提交
+	Timeout   time.Duration // 单个任务的执行时间上限
+	ResultTTL time.Duration // 任务结束后结果的保留时间
+}
+
+// AuthConfig API key认证配置
+type AuthConfig struct {
+	Required      bool          // 是否要求所有非公开接口携带API key，false时允许匿名访问
+	KeysFile      string        // API key文件，由apikey命令管理
+	FlushInterval time.Duration // 写入key用量的间隔
+}
+
+// ShutdownConfig 停止服务配置
+type ShutdownConfig struct {
+	DrainDelay  time.Duration // 收到信号后继续接受请求的时间，就绪检查已返回503，供负载均衡摘除实例
+	GracePeriod time.Duration // 等待进行中的请求和任务结束的时间，超过后取消剩余的计算
+}
+
+// ToolsConfig 工具功能开关，按工具ID启用默认关闭的工具或隐藏工具，同时出现时隐藏
+type ToolsConfig struct {
+	Enabled  []string
+	Disabled []string
+}
+
+// PluginsConfig 外部工具插件配置
+type PluginsConfig struct {
+	Dir           string        // 插件目录，目录中的可执行文件作为插件启动，目录不存在时没有插件
+	Timeout       time.Duration // 单次调用的时间上限，超时后结束插件进程
+	MemoryLimitMB int           // 插件进程的虚拟内存上限，0表示不限制，只在Linux上生效
+	RestartDelay  time.Duration // 插件进程退出后重新启动的初始等待时间
+}
+
+// CatalogConfig 游戏版本目录配置
+type CatalogConfig struct {
+	PatchesDir string // 补丁目录，*.json补丁追加在内置版本之后，收到SIGHUP时重新读取，目录不存在时只有内置版本
+}
+
+// ScriptsConfig Starlark脚本配置
+type ScriptsConfig struct {
+	Dir        string        // 脚本工具目录，目录中声明了TOOL的*.star脚本作为工具，目录不存在时没有脚本工具
+	MaxSteps   uint64        // 单次执行的步数上限，0表示不限制
+	Timeout    time.Duration // 单次执行的时间上限，0表示不限制
+	MaxAllocMB int           // 单次执行中字符串、列表等分配的内存上限，0表示不限制
+}
+
+// MetricsConfig 指标配置
+type MetricsConfig struct {
+	Enabled bool
+	Path    string // Prometheus抓取指标的路径，不在API前缀下，不受限流影响
+}
+
+// RedisConfig Redis配置
+type RedisConfig struct {
+	Host     string
+	Port     int
+	Password string
+	DB       int
+}
+
 // LoadConfig 加载配置
 func LoadConfig() *Config {
 	return &Config{
@@ -41,16 +168,103 @@ func LoadConfig() *Config {
 			Port: getEnvAsInt("SERVER_PORT", 8080),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnvAsInt("DB_PORT", 5432),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", ""),
-			DBName:   getEnv("DB_NAME", "oncehuman_tools"),
+			Driver:       getEnv("DB_DRIVER", "none"),
+			Path:         getEnv("DB_PATH", "data/oncehuman.db"),
+			Host:         getEnv("DB_HOST", "localhost"),
+			Port:         getEnvAsInt("DB_PORT", 5432),
+			User:         getEnv("DB_USER", "postgres"),
+			Password:     getEnv("DB_PASSWORD", ""),
+			DBName:       getEnv("DB_NAME", "oncehuman_tools"),
+			SSLMode:      getEnv("DB_SSLMODE", "disable"),
+			MaxOpenConns: getEnvAsInt("DB_MAX_OPEN_CONNS", 10),
+			AutoMigrate:  getEnvAsBool("DB_AUTO_MIGRATE", true),
 		},
 		Discord: DiscordConfig{
 			Token:     getEnv("DISCORD_TOKEN", ""),
 			BotPrefix: getEnv("DISCORD_PREFIX", "!oh"),
 		},
+		RateLimit: RateLimitConfig{
+			Requests:          getEnvAsInt("RATE_LIMIT", 100),
+			ExpensiveRequests: getEnvAsInt("RATE_LIMIT_EXPENSIVE", 10),
+			KeyRequests:       getEnvAsInt("RATE_LIMIT_API_KEY", 1000),
+			KeyExpensive:      getEnvAsInt("RATE_LIMIT_API_KEY_EXPENSIVE", 100),
+			Period:            getEnvAsDuration("RATE_LIMIT_PERIOD", time.Minute),
+			APIKeys:           getEnvAsList("RATE_LIMIT_API_KEYS", nil),
+			TrustedProxies:    getEnvAsList("RATE_LIMIT_TRUSTED_PROXIES", []string{"127.0.0.1", "::1"}),
+			Store:             getEnv("RATE_LIMIT_STORE", "memory"),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   getEnvAsList("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:9000"}),
+			AllowedMethods:   getEnvAsList("CORS_ALLOWED_METHODS", []string{"GET", "POST", "DELETE", "OPTIONS"}),
+			AllowedHeaders:   getEnvAsList("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Accept", "Accept-Language", "X-API-Key", "X-Request-ID", "If-None-Match"}),
+			ExposedHeaders:   getEnvAsList("CORS_EXPOSED_HEADERS", []string{"Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "X-Request-ID", "ETag", "Location"}),
+			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", false),
+			MaxAge:           getEnvAsDuration("CORS_MAX_AGE", 10*time.Minute),
+		},
+		Security: SecurityConfig{
+			HSTSMaxAge:            getEnvAsDuration("HSTS_MAX_AGE", 180*24*time.Hour),
+			HSTSIncludeSubdomains: getEnvAsBool("HSTS_INCLUDE_SUBDOMAINS", false),
+			ContentSecurityPolicy: getEnv("CONTENT_SECURITY_POLICY", "default-src 'none'; frame-ancestors 'none'"),
+			DocsSecurityPolicy: getEnv("DOCS_CONTENT_SECURITY_POLICY", "default-src 'self'; "+
+				"script-src 'self' 'unsafe-inline' https://unpkg.com; "+
+				"style-src 'self' 'unsafe-inline' https://unpkg.com; "+
+				"img-src 'self' data: https://unpkg.com; "+
+				"connect-src 'self'; frame-ancestors 'none'"),
+		},
+		Log: LogConfig{
+			Level:  getEnv("LOG_LEVEL", "info"),
+			Format: getEnv("LOG_FORMAT", "text"),
+		},
+		Cache: CacheConfig{
+			Store:      getEnv("CACHE_STORE", "memory"),
+			MaxEntries: getEnvAsInt("CACHE_MAX_ENTRIES", 10000),
+			MaxBytes:   int64(getEnvAsInt("CACHE_MAX_BYTES", 64<<20)),
+			TTL:        getEnvAsDuration("CACHE_TTL", time.Hour),
+		},
+		Jobs: JobsConfig{
+			Workers:   getEnvAsInt("JOBS_WORKERS", 2),
+			QueueSize: getEnvAsInt("JOBS_QUEUE_SIZE", 100),
+			Timeout:   getEnvAsDuration("JOBS_TIMEOUT", 10*time.Minute),
+			ResultTTL: getEnvAsDuration("JOBS_RESULT_TTL", time.Hour),
+		},
+		Auth: AuthConfig{
+			Required:      getEnvAsBool("AUTH_REQUIRED", false),
+			KeysFile:      getEnv("AUTH_KEYS_FILE", "data/api_keys.json"),
+			FlushInterval: getEnvAsDuration("AUTH_FLUSH_INTERVAL", 30*time.Second),
+		},
+		Shutdown: ShutdownConfig{
+			DrainDelay:  getEnvAsDuration("SHUTDOWN_DRAIN_DELAY", 0),
+			GracePeriod: getEnvAsDuration("SHUTDOWN_GRACE_PERIOD", 30*time.Second),
+		},
+		Tools: ToolsConfig{
+			Enabled:  getEnvAsList("TOOLS_ENABLED", nil),
+			Disabled: getEnvAsList("TOOLS_DISABLED", nil),
+		},
+		Plugins: PluginsConfig{
+			Dir:           getEnv("PLUGINS_DIR", "plugins"),
+			Timeout:       getEnvAsDuration("PLUGINS_TIMEOUT", 10*time.Second),
+			MemoryLimitMB: getEnvAsInt("PLUGINS_MEMORY_LIMIT_MB", 1024),
+			RestartDelay:  getEnvAsDuration("PLUGINS_RESTART_DELAY", time.Second),
+		},
+		Scripts: ScriptsConfig{
+			Dir:        getEnv("SCRIPTS_DIR", "scripts"),
+			MaxSteps:   uint64(getEnvAsInt("SCRIPTS_MAX_STEPS", 10000000)),
+			Timeout:    getEnvAsDuration("SCRIPTS_TIMEOUT", 5*time.Second),
+			MaxAllocMB: getEnvAsInt("SCRIPTS_MAX_ALLOC_MB", 256),
+		},
+		Catalog: CatalogConfig{
+			PatchesDir: getEnv("CATALOG_PATCHES_DIR", "data/patches"),
+		},
+		Metrics: MetricsConfig{
+			Enabled: getEnvAsBool("METRICS_ENABLED", true),
+			Path:    getEnv("METRICS_PATH", "/metrics"),
+		},
+		Redis: RedisConfig{
+			Host:     getEnv("REDIS_HOST", "localhost"),
+			Port:     getEnvAsInt("REDIS_PORT", 6379),
+			Password: getEnv("REDIS_PASSWORD", ""),
+			DB:       getEnvAsInt("REDIS_DB", 0),
+		},
 	}
 }
 
@@ -68,4 +282,36 @@ func getEnvAsInt(key string, defaultValue int) int {
 		}
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
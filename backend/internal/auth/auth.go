@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrKeyRequired 要求认证但请求未携带API key
+	ErrKeyRequired = errors.New("需要API key")
+	// ErrInvalidKey API key不存在或已吊销
+	ErrInvalidKey = errors.New("API key无效")
+	// ErrInsufficientScope API key没有接口要求的权限
+	ErrInsufficientScope = errors.New("API key权限不足")
+	// ErrQuotaExceeded API key当天的请求数已达上限
+	ErrQuotaExceeded = errors.New("API key今日配额已用完")
+)
+
+// Authenticator API key认证器
+type Authenticator struct {
+	store    Store
+	required bool
+}
+
+// NewAuthenticator 创建认证器，required为false时允许匿名访问
+func NewAuthenticator(store Store, required bool) *Authenticator {
+	return &Authenticator{
+		store:    store,
+		required: required,
+	}
+}
+
+// Authenticate 校验明文API key，key不存在或已吊销时返回ErrInvalidKey
+func (a *Authenticator) Authenticate(plain string) (*Key, error) {
+	key, ok, err := a.store.Lookup(HashKey(plain))
+	if err != nil {
+		return nil, err
+	}
+	if !ok || key.Revoked() {
+		return nil, ErrInvalidKey
+	}
+	return &key, nil
+}
+
+// Known 检查明文API key是否有效
+func (a *Authenticator) Known(plain string) bool {
+	if plain == "" {
+		return false
+	}
+	key, err := a.Authenticate(plain)
+	return err == nil && key != nil
+}
+
+// Authorize 检查key是否可以访问要求scope权限的operation接口并记录用量，key为nil表示匿名请求
+// scope为空的接口始终公开；admin接口始终需要admin权限的key，其他接口的匿名请求只在未要求认证时放行
+func (a *Authenticator) Authorize(key *Key, scope, operation string, now time.Time) error {
+	if key == nil {
+		if scope == ScopeAdmin || a.required && scope != "" {
+			return ErrKeyRequired
+		}
+		return nil
+	}
+	if scope != "" && !key.HasScope(scope) {
+		return ErrInsufficientScope
+	}
+
+	_, allowed, err := a.store.AddUsage(key.ID, operation, now, key.DailyQuota)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// Store 获取key存储
+func (a *Authenticator) Store() Store {
+	return a.store
+}
+
+// Close 关闭key存储
+func (a *Authenticator) Close() error {
+	return a.store.Close()
+}
+
+// QuotaResetAfter 获取每日配额重置前的剩余时间，配额按UTC自然日计算
+func QuotaResetAfter(now time.Time) time.Duration {
+	now = now.UTC()
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	return tomorrow.Sub(now)
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestAuthenticator(t *testing.T, required bool) *Authenticator {
+	t.Helper()
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "api_keys.json"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(store, required)
+	t.Cleanup(func() { a.Close() })
+	return a
+}
+
+func TestAuthorize(t *testing.T) {
+	catalog := &Key{ID: "k1", Scopes: []string{ScopeCatalog}}
+	calculate := &Key{ID: "k2", Scopes: []string{ScopeCalculate}}
+	admin := &Key{ID: "k3", Scopes: []string{ScopeAdmin}}
+
+	tests := []struct {
+		name     string
+		required bool
+		key      *Key
+		scope    string
+		want     error
+	}{
+		{"anonymous public", false, nil, "", nil},
+		{"anonymous public required", true, nil, "", nil},
+		{"anonymous calculate", false, nil, ScopeCalculate, nil},
+		{"anonymous calculate required", true, nil, ScopeCalculate, ErrKeyRequired},
+		{"anonymous admin", false, nil, ScopeAdmin, ErrKeyRequired},
+		{"anonymous admin required", true, nil, ScopeAdmin, ErrKeyRequired},
+		{"catalog key calculate", false, catalog, ScopeCalculate, ErrInsufficientScope},
+		{"calculate key catalog", true, calculate, ScopeCatalog, nil},
+		{"calculate key admin", false, calculate, ScopeAdmin, ErrInsufficientScope},
+		{"admin key admin", false, admin, ScopeAdmin, nil},
+		{"admin key calculate", true, admin, ScopeCalculate, nil},
+	}
+	for _, tt := range tests {
+		a := newTestAuthenticator(t, tt.required)
+		if err := a.Authorize(tt.key, tt.scope, "test", time.Now()); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Authorize() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestAuthorizeQuota(t *testing.T) {
+	a := newTestAuthenticator(t, false)
+	key := &Key{ID: "k1", Scopes: []string{ScopeCalculate}, DailyQuota: 2}
+	now := time.Date(2024, 7, 9, 23, 0, 0, 0, time.UTC)
+
+	for i, want := range []error{nil, nil, ErrQuotaExceeded} {
+		if err := a.Authorize(key, ScopeCalculate, "test", now); !errors.Is(err, want) {
+			t.Errorf("request %d: Authorize() = %v, want %v", i+1, err, want)
+		}
+	}
+	// 配额按UTC自然日重置
+	if err := a.Authorize(key, ScopeCalculate, "test", now.Add(2*time.Hour)); err != nil {
+		t.Errorf("next day: Authorize() = %v, want nil", err)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := newTestAuthenticator(t, false)
+	key, plain, err := NewKey("bot", []string{ScopeCalculate}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Store().Create(key); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := a.Authenticate(plain)
+	if err != nil || got.ID != key.ID {
+		t.Fatalf("Authenticate() = %v, %v, want key %s", got, err, key.ID)
+	}
+	if _, err := a.Authenticate(plain + "x"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Authenticate(wrong) = %v, want ErrInvalidKey", err)
+	}
+	if _, err := a.Store().Revoke(key.ID, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Authenticate(plain); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Authenticate(revoked) = %v, want ErrInvalidKey", err)
+	}
+}
+
+func TestNewKey(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		key, plain, err := NewKey("bot", []string{ScopeCatalog}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secret := strings.TrimPrefix(plain, keyPrefix)
+		if len(key.ID) != 8 || strings.Contains(secret, key.ID) {
+			t.Fatalf("key ID %q is derived from the secret %q", key.ID, plain)
+		}
+		if key.Hash != HashKey(plain) || strings.Contains(key.Hash, secret) {
+			t.Fatalf("hash %q does not match the key", key.Hash)
+		}
+	}
+
+	invalid := []struct {
+		scopes []string
+		quota  int
+	}{
+		{nil, 0},
+		{[]string{"root"}, 0},
+		{[]string{ScopeCatalog}, -1},
+	}
+	for _, tt := range invalid {
+		if _, _, err := NewKey("bot", tt.scopes, tt.quota); err == nil {
+			t.Errorf("NewKey(%v, %d) succeeded, want error", tt.scopes, tt.quota)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+// dbTimeout 读写用量的超时时间
+const dbTimeout = 5 * time.Second
+
+// usageKey 待写入用量的分组
+type usageKey struct {
+	day       string
+	subject   string
+	operation string
+}
+
+// DBStore key保存在JSON文件，用量按日期和接口保存在数据库的usage_stats表
+// 请求数在内存中计数，key首次使用时从数据库读取，新增的请求定期批量写入；
+// 多个实例共用数据库时，每个实例只在首次使用时读取其他实例的计数，配额为近似值
+type DBStore struct {
+	*FileStore
+	usage store.UsageRepository
+
+	mu      sync.Mutex
+	counts  map[string]Usage
+	pending map[usageKey]store.UsageStat
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewDBStore 创建数据库用量存储，keys只用于读写key，其用量文件不再写入
+// flushInterval大于0时定期写入用量，否则只在Flush和Close时写入
+func NewDBStore(keys *FileStore, usage store.UsageRepository, flushInterval time.Duration) *DBStore {
+	s := &DBStore{
+		FileStore: keys,
+		usage:     usage,
+		counts:    make(map[string]Usage),
+		pending:   make(map[usageKey]store.UsageStat),
+		done:      make(chan struct{}),
+	}
+	if flushInterval > 0 {
+		s.wg.Add(1)
+		go s.flushLoop(flushInterval)
+	}
+	return s
+}
+
+// Usage 获取key的用量，包含尚未写入数据库的请求
+func (s *DBStore) Usage(id string) (Usage, error) {
+	usage, err := s.load(id, time.Now())
+	if err != nil {
+		return Usage{}, err
+	}
+	return usage, nil
+}
+
+// AddUsage 记录一次对operation接口的请求
+func (s *DBStore) AddUsage(id, operation string, now time.Time, quota int) (Usage, bool, error) {
+	if _, err := s.load(id, now); err != nil {
+		return Usage{}, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := rollover(s.counts[id], now)
+	if quota > 0 && usage.Today >= quota {
+		return usage, false, nil
+	}
+
+	now = now.UTC()
+	usage.Today++
+	usage.Total++
+	usage.LastUsedAt = &now
+	s.counts[id] = usage
+
+	k := usageKey{day: usage.Day, subject: id, operation: operation}
+	stat := s.pending[k]
+	stat.Day, stat.Subject, stat.Operation = k.day, k.subject, k.operation
+	stat.Count++
+	stat.LastUsedAt = now
+	s.pending[k] = stat
+	return usage, true, nil
+}
+
+// Flush 写入未保存的用量，写入失败时保留到下次写入
+func (s *DBStore) Flush() error {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[usageKey]store.UsageStat)
+	s.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	stats := make([]store.UsageStat, 0, len(pending))
+	for _, stat := range pending {
+		stats = append(stats, stat)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+	if err := s.usage.Add(ctx, stats); err != nil {
+		s.mu.Lock()
+		for k, stat := range pending {
+			if newer, ok := s.pending[k]; ok {
+				stat.Count += newer.Count
+				stat.LastUsedAt = newer.LastUsedAt
+			}
+			s.pending[k] = stat
+		}
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Close 停止定期写入，保存用量并关闭key存储
+func (s *DBStore) Close() error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+		close(s.done)
+	}
+	s.wg.Wait()
+	if err := s.Flush(); err != nil {
+		s.FileStore.Close()
+		return err
+	}
+	return s.FileStore.Close()
+}
+
+// load 获取key的用量，内存中没有时从数据库读取；读取期间其他请求已加载时以内存中的为准
+func (s *DBStore) load(id string, now time.Time) (Usage, error) {
+	s.mu.Lock()
+	usage, ok := s.counts[id]
+	s.mu.Unlock()
+	if ok {
+		return rollover(usage, now), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+	defer cancel()
+	today := day(now)
+	summary, err := s.usage.Summary(ctx, id, today)
+	if err != nil {
+		return Usage{}, err
+	}
+	usage = Usage{Day: today, Today: int(summary.Today), Total: summary.Total, LastUsedAt: summary.LastUsedAt}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if loaded, ok := s.counts[id]; ok {
+		return rollover(loaded, now), nil
+	}
+	s.counts[id] = usage
+	return usage, nil
+}
+
+// flushLoop 定期写入用量
+func (s *DBStore) flushLoop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				slog.Warn("写入API key用量失败", "error", err)
+			}
+		}
+	}
+}
+
+// rollover 跨天后当天请求数从0开始计
+func rollover(usage Usage, now time.Time) Usage {
+	if today := day(now); usage.Day != today {
+		usage.Day = today
+		usage.Today = 0
+	}
+	return usage
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+func newTestDBStore(t *testing.T) (*DBStore, *store.DB) {
+	t.Helper()
+	dir := t.TempDir()
+	db, err := store.Open(context.Background(), store.Options{Driver: store.DriverSQLite, DSN: filepath.Join(dir, "test.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Migrate(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := NewFileStore(filepath.Join(dir, "api_keys.json"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewDBStore(keys, db.Usage(), 0), db
+}
+
+func TestDBStoreUsage(t *testing.T) {
+	s, db := newTestDBStore(t)
+	now := time.Date(2024, 7, 9, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		operation string
+		now       time.Time
+		allowed   bool
+		today     int
+	}{
+		{"listAffixes", now, true, 1},
+		{"calculateAffixProbability", now, true, 2},
+		{"listAffixes", now, false, 2},
+		{"listAffixes", now.Add(2 * time.Hour), true, 1},
+	}
+	for i, tt := range tests {
+		usage, allowed, err := s.AddUsage("k1", tt.operation, tt.now, 2)
+		if err != nil || allowed != tt.allowed || usage.Today != tt.today {
+			t.Errorf("request %d: AddUsage() = %+v, %v, %v, want today=%d allowed=%v", i+1, usage, allowed, err, tt.today, tt.allowed)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := db.Usage().List(context.Background(), "2024-07-09", "2024-07-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []store.UsageStat{
+		{Day: "2024-07-09", Subject: "k1", Operation: "calculateAffixProbability", Count: 1},
+		{Day: "2024-07-09", Subject: "k1", Operation: "listAffixes", Count: 1},
+		{Day: "2024-07-10", Subject: "k1", Operation: "listAffixes", Count: 1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("usage_stats = %+v, want %+v", stats, want)
+	}
+	for i := range want {
+		if stats[i].Day != want[i].Day || stats[i].Operation != want[i].Operation || stats[i].Count != want[i].Count {
+			t.Errorf("stat %d = %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+
+	// 重新打开时从数据库读取已有的用量，配额继续生效
+	keys, err := NewFileStore(filepath.Join(t.TempDir(), "api_keys.json"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened := NewDBStore(keys, db.Usage(), 0)
+	defer reopened.Close()
+	usage, allowed, err := reopened.AddUsage("k1", "listAffixes", now.Add(2*time.Hour), 2)
+	if err != nil || !allowed || usage.Today != 2 || usage.Total != 4 {
+		t.Errorf("AddUsage() after reopen = %+v, %v, %v, want today=2 total=4", usage, allowed, err)
+	}
+	if _, allowed, _ := reopened.AddUsage("k1", "listAffixes", now.Add(2*time.Hour), 2); allowed {
+		t.Error("quota not enforced after reopen")
+	}
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 权限范围，高级权限包含低级权限
+const (
+	ScopeCatalog   = "catalog"   // 只读目录：词条、稀有度、游戏版本和工具列表
+	ScopeCalculate = "calculate" // 概率计算和异步任务，包含catalog
+	ScopeAdmin     = "admin"     // 管理接口，包含全部权限
+)
+
+// scopeLevels 权限范围的级别
+var scopeLevels = map[string]int{
+	ScopeCatalog:   1,
+	ScopeCalculate: 2,
+	ScopeAdmin:     3,
+}
+
+// Scopes 获取所有权限范围
+func Scopes() []string {
+	return []string{ScopeCatalog, ScopeCalculate, ScopeAdmin}
+}
+
+// ValidScope 检查权限范围是否有效
+func ValidScope(scope string) bool {
+	_, ok := scopeLevels[scope]
+	return ok
+}
+
+// AllGuilds 允许修改所有服务器的预设，用于自行检查服务器管理权限的调用方（如机器人）
+const AllGuilds = "*"
+
+// keyPrefix API key的前缀，便于识别泄露的key
+const keyPrefix = "oh_"
+
+// Key API key记录，只保存key的摘要
+type Key struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Hash       string     `json:"hash"`
+	Scopes     []string   `json:"scopes"`
+	DailyQuota int        `json:"dailyQuota"`       // 每日请求数上限（UTC自然日），0表示不限
+	Guilds     []string   `json:"guilds,omitempty"` // 可以修改服务器预设的服务器ID，AllGuilds表示全部服务器
+	CreatedAt  time.Time  `json:"createdAt"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+// Usage API key的用量
+type Usage struct {
+	Day        string     `json:"day"`   // Today所属的日期（UTC），格式2006-01-02
+	Today      int        `json:"today"` // 当天的请求数
+	Total      int64      `json:"total"` // 累计请求数
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// HasScope 检查key是否拥有权限范围，高级权限包含低级权限
+func (k *Key) HasScope(scope string) bool {
+	required, ok := scopeLevels[scope]
+	if !ok {
+		return false
+	}
+	for _, s := range k.Scopes {
+		if scopeLevels[s] >= required {
+			return true
+		}
+	}
+	return false
+}
+
+// CanManageGuild 检查key能否修改服务器的预设，admin权限可以修改所有服务器
+func (k *Key) CanManageGuild(guild string) bool {
+	if k == nil || guild == "" {
+		return false
+	}
+	if k.HasScope(ScopeAdmin) {
+		return true
+	}
+	for _, g := range k.Guilds {
+		if g == AllGuilds || g == guild {
+			return true
+		}
+	}
+	return false
+}
+
+// Revoked 检查key是否已吊销
+func (k *Key) Revoked() bool {
+	return k.RevokedAt != nil
+}
+
+// NewKey 生成新的API key，返回记录和只显示一次的明文key
+func NewKey(name string, scopes []string, dailyQuota int) (Key, string, error) {
+	for _, scope := range scopes {
+		if !ValidScope(scope) {
+			return Key{}, "", fmt.Errorf("未知的权限范围: %s，可选: %s", scope, strings.Join(Scopes(), "、"))
+		}
+	}
+	if len(scopes) == 0 {
+		return Key{}, "", fmt.Errorf("至少需要一个权限范围")
+	}
+	if dailyQuota < 0 {
+		return Key{}, "", fmt.Errorf("每日配额不能为负数")
+	}
+
+	// ID公开显示在日志和管理接口中，与明文key使用不同的随机数
+	b := make([]byte, 24+4)
+	if _, err := rand.Read(b); err != nil {
+		return Key{}, "", fmt.Errorf("生成API key失败: %w", err)
+	}
+	plain := keyPrefix + hex.EncodeToString(b[:24])
+	key := Key{
+		ID:         hex.EncodeToString(b[24:]),
+		Name:       name,
+		Hash:       HashKey(plain),
+		Scopes:     scopes,
+		DailyQuota: dailyQuota,
+		CreatedAt:  time.Now().UTC(),
+	}
+	return key, plain, nil
+}
+
+// HashKey 计算API key的摘要，存储中只保存摘要
+func HashKey(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+// day 获取时间所属的UTC日期
+func day(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,335 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrKeyNotFound API key不存在
+var ErrKeyNotFound = errors.New("API key不存在")
+
+// Store API key存储
+type Store interface {
+	// Lookup 按摘要查找key，不存在时ok为false
+	Lookup(hash string) (key Key, ok bool, err error)
+	// List 获取所有key，按创建时间排序
+	List() ([]Key, error)
+	// Create 保存新的key
+	Create(key Key) error
+	// Revoke 吊销key，key不存在时返回ErrKeyNotFound
+	Revoke(id string, at time.Time) (Key, error)
+	// Usage 获取key的用量
+	Usage(id string) (Usage, error)
+	// AddUsage 记录一次对operation接口的请求，当天请求数超过quota时不计数且allowed为false，quota为0表示不限
+	AddUsage(id, operation string, now time.Time, quota int) (usage Usage, allowed bool, err error)
+	// Close 保存未写入的用量并关闭存储
+	Close() error
+}
+
+// reloadInterval 检查key文件是否变化的最小间隔
+const reloadInterval = 2 * time.Second
+
+// FileStore 基于本地JSON文件的key存储
+// key文件由管理命令写入，服务发现文件变化后重新加载；用量保存在同目录的.usage.json文件，由服务定期写入
+type FileStore struct {
+	path      string
+	usagePath string
+
+	mu        sync.Mutex
+	keys      []Key
+	byHash    map[string]int
+	modTime   time.Time
+	checkedAt time.Time
+	usage     map[string]Usage
+	dirty     bool
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewFileStore 创建文件存储，文件不存在时视为没有key
+// flushInterval大于0时定期写入用量，否则只在Close时写入
+func NewFileStore(path string, flushInterval time.Duration) (*FileStore, error) {
+	s := &FileStore{
+		path:      path,
+		usagePath: strings.TrimSuffix(path, filepath.Ext(path)) + ".usage.json",
+		usage:     make(map[string]Usage),
+		done:      make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if err := readJSON(s.usagePath, &s.usage); err != nil {
+		return nil, err
+	}
+
+	if flushInterval > 0 {
+		s.wg.Add(1)
+		go s.flushLoop(flushInterval)
+	}
+	return s, nil
+}
+
+// Lookup 按摘要查找key，key文件变化时先重新加载
+func (s *FileStore) Lookup(hash string) (Key, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.reload(); err != nil {
+		return Key{}, false, err
+	}
+	i, ok := s.byHash[hash]
+	if !ok {
+		return Key{}, false, nil
+	}
+	return s.keys[i], true, nil
+}
+
+// List 获取所有key
+func (s *FileStore) List() ([]Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	keys := make([]Key, len(s.keys))
+	copy(keys, s.keys)
+	return keys, nil
+}
+
+// Create 保存新的key，写入前重新读取文件，避免覆盖其他进程的修改
+func (s *FileStore) Create(key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	for _, k := range s.keys {
+		if k.ID == key.ID {
+			return fmt.Errorf("API key ID重复: %s", key.ID)
+		}
+	}
+	s.setKeys(append(s.keys, key))
+	return s.save()
+}
+
+// Revoke 吊销key，已吊销的key保持原吊销时间
+func (s *FileStore) Revoke(id string, at time.Time) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return Key{}, err
+	}
+	for i := range s.keys {
+		if s.keys[i].ID != id {
+			continue
+		}
+		if s.keys[i].RevokedAt == nil {
+			at = at.UTC()
+			s.keys[i].RevokedAt = &at
+			if err := s.save(); err != nil {
+				return Key{}, err
+			}
+		}
+		return s.keys[i], nil
+	}
+	return Key{}, ErrKeyNotFound
+}
+
+// Usage 获取key的用量，跨天后当天请求数按0计
+func (s *FileStore) Usage(id string) (Usage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := s.usage[id]
+	if usage.Day != day(time.Now()) {
+		usage.Today = 0
+	}
+	return usage, nil
+}
+
+// AddUsage 记录一次请求，文件中只保存每个key的合计，不区分接口
+func (s *FileStore) AddUsage(id, _ string, now time.Time, quota int) (Usage, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := s.usage[id]
+	if today := day(now); usage.Day != today {
+		usage.Day = today
+		usage.Today = 0
+	}
+	if quota > 0 && usage.Today >= quota {
+		return usage, false, nil
+	}
+
+	now = now.UTC()
+	usage.Today++
+	usage.Total++
+	usage.LastUsedAt = &now
+	s.usage[id] = usage
+	s.dirty = true
+	return usage, true, nil
+}
+
+// Flush 写入未保存的用量
+func (s *FileStore) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.dirty {
+		return nil
+	}
+	if err := writeJSON(s.usagePath, s.usage); err != nil {
+		return err
+	}
+	s.dirty = false
+	return nil
+}
+
+// Close 停止定期写入并保存用量
+func (s *FileStore) Close() error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+		close(s.done)
+	}
+	s.wg.Wait()
+	return s.Flush()
+}
+
+// flushLoop 定期写入用量
+func (s *FileStore) flushLoop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				slog.Warn("写入API key用量失败", "path", s.usagePath, "error", err)
+			}
+		}
+	}
+}
+
+// reload key文件的修改时间变化时重新加载，调用方需持有锁
+func (s *FileStore) reload() error {
+	now := time.Now()
+	if now.Sub(s.checkedAt) < reloadInterval {
+		return nil
+	}
+	s.checkedAt = now
+
+	info, err := os.Stat(s.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if len(s.keys) > 0 {
+			s.setKeys(nil)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("读取API key文件失败: %w", err)
+	case info.ModTime().Equal(s.modTime):
+		return nil
+	}
+	return s.load()
+}
+
+// load 读取key文件，调用方需持有锁
+func (s *FileStore) load() error {
+	var keys []Key
+	if err := readJSON(s.path, &keys); err != nil {
+		return err
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime = info.ModTime()
+	}
+	s.checkedAt = time.Now()
+	s.setKeys(keys)
+	return nil
+}
+
+// save 写入key文件，调用方需持有锁
+func (s *FileStore) save() error {
+	if err := writeJSON(s.path, s.keys); err != nil {
+		return err
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime = info.ModTime()
+	}
+	return nil
+}
+
+// setKeys 替换key列表并重建摘要索引，调用方需持有锁
+func (s *FileStore) setKeys(keys []Key) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	s.keys = keys
+	s.byHash = make(map[string]int, len(keys))
+	for i, k := range keys {
+		s.byHash[k.Hash] = i
+	}
+}
+
+// readJSON 读取JSON文件，文件不存在时保持value不变
+func readJSON(path string, value interface{}) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("读取文件失败: %w", err)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("解析文件%s失败: %w", path, err)
+	}
+	return nil
+}
+
+// writeJSON 先写入临时文件再重命名，避免读取方看到写了一半的文件，文件只允许所有者读写
+func writeJSON(path string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("创建目录失败: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("设置文件权限失败: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("保存文件失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// 构建时通过ldflags注入，如：
+// go build -ldflags "-X github.com/SpenserCai/OnceHumanTools/backend/internal/buildinfo.Version=v1.2.0"
+// 未注入时从Go模块和VCS信息中读取
+var (
+	Version   string
+	Commit    string
+	BuildTime string
+)
+
+// Info 构建信息
+type Info struct {
+	Version   string // 版本号，未知时为dev
+	Commit    string // 提交哈希
+	BuildTime string // 构建时间或提交时间，RFC 3339格式
+	Modified  bool   // 构建时工作区是否有未提交的修改
+	GoVersion string
+}
+
+var (
+	once sync.Once
+	info Info
+)
+
+// Get 获取构建信息，ldflags注入的值优先
+func Get() Info {
+	once.Do(func() {
+		info = read()
+	})
+	return info
+}
+
+// read 合并ldflags注入的值和debug.ReadBuildInfo中的模块和VCS信息
+func read() Info {
+	result := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		if result.Version == "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			result.Version = bi.Main.Version
+		}
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if result.Commit == "" {
+					result.Commit = setting.Value
+				}
+			case "vcs.time":
+				if result.BuildTime == "" {
+					result.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				result.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if result.Version == "" {
+		result.Version = "dev"
+	}
+	return result
+}
+
+// ShortCommit 获取提交哈希的前12位
+func (i Info) ShortCommit() string {
+	if len(i.Commit) > 12 {
+		return i.Commit[:12]
+	}
+	return i.Commit
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/metrics"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// Store 缓存存储，值为序列化后的字节
+type Store interface {
+	// Get 获取key对应的值，不存在或已过期时ok为false
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set 写入key对应的值，ttl后过期
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Purge 清空缓存
+	Purge(ctx context.Context) error
+	// Ping 检查存储是否可用
+	Ping(ctx context.Context) error
+}
+
+// Cache 计算结果缓存，先查进程内存储再查共享存储，值按JSON序列化
+// nil表示不使用缓存，所有方法都可以在nil上调用
+type Cache struct {
+	name   string
+	local  Store
+	shared Store
+	ttl    time.Duration
+}
+
+// New 创建缓存，name用于指标，shared为nil时只使用进程内存储
+func New(name string, local, shared Store, ttl time.Duration) *Cache {
+	return &Cache{
+		name:   name,
+		local:  local,
+		shared: shared,
+		ttl:    ttl,
+	}
+}
+
+// Get 获取key对应的值并反序列化到value，命中时返回true，共享存储命中时回填进程内存储
+func (c *Cache) Get(ctx context.Context, key string, value interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	data, ok := c.get(ctx, key)
+	if ok && json.Unmarshal(data, value) != nil {
+		ok = false
+	}
+
+	if ok {
+		metrics.CacheHit(c.name)
+		logging.AddAttrs(ctx, slog.String("cache", "hit"))
+	} else {
+		metrics.CacheMiss(c.name)
+		logging.AddAttrs(ctx, slog.String("cache", "miss"))
+	}
+	return ok
+}
+
+// get 依次查询进程内存储和共享存储，存储出错时按未命中处理
+func (c *Cache) get(ctx context.Context, key string) ([]byte, bool) {
+	if data, ok, err := c.local.Get(ctx, key); err == nil && ok {
+		return data, true
+	}
+	if c.shared == nil {
+		return nil, false
+	}
+
+	data, ok, err := c.shared.Get(ctx, key)
+	if err != nil {
+		logging.FromContext(ctx).Warn("读取共享缓存失败", "error", err)
+		return nil, false
+	}
+	if ok {
+		c.local.Set(ctx, key, data, c.ttl)
+	}
+	return data, ok
+}
+
+// Set 序列化value并写入所有存储，写入失败只记录日志
+func (c *Cache) Set(ctx context.Context, key string, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		logging.FromContext(ctx).Warn("序列化缓存值失败", "error", err)
+		return
+	}
+	c.local.Set(ctx, key, data, c.ttl)
+	if c.shared != nil {
+		if err := c.shared.Set(ctx, key, data, c.ttl); err != nil {
+			logging.FromContext(ctx).Warn("写入共享缓存失败", "error", err)
+		}
+	}
+}
+
+// Purge 清空所有存储
+func (c *Cache) Purge(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if err := c.local.Purge(ctx); err != nil {
+		return err
+	}
+	if c.shared != nil {
+		return c.shared.Purge(ctx)
+	}
+	return nil
+}
+
+// Ping 检查所有存储是否可用
+func (c *Cache) Ping(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if err := c.local.Ping(ctx); err != nil {
+		return err
+	}
+	if c.shared != nil {
+		return c.shared.Ping(ctx)
+	}
+	return nil
+}
+
+// Key 由计算名称、目录版本和规范化后的参数生成缓存键，参数按顺序格式化后取摘要
+func Key(calculator, gameVersion, revision string, params ...interface{}) string {
+	parts := make([]string, len(params))
+	for i, param := range params {
+		parts[i] = fmt.Sprintf("%v", param)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return calculator + ":" + gameVersion + ":" + revision + ":" + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		maxEntries int
+		maxBytes   int64
+		set        []string // 依次写入的key，值为key本身
+		touch      string   // 写入后读取的key，移到最近使用
+		next       string   // 读取后再写入的key
+		want       []string
+		evicted    []string
+	}{
+		{"entry limit", 2, 0, []string{"a", "b"}, "", "c", []string{"b", "c"}, []string{"a"}},
+		{"recently used kept", 2, 0, []string{"a", "b"}, "a", "c", []string{"a", "c"}, []string{"b"}},
+		{"byte limit", 0, 3, []string{"aa", "b"}, "", "cc", []string{"b", "cc"}, []string{"aa"}},
+		{"oversized value skipped", 0, 3, []string{"a"}, "", "dddd", []string{"a"}, []string{"dddd"}},
+		{"unlimited", 0, 0, []string{"a", "b"}, "", "c", []string{"a", "b", "c"}, nil},
+	}
+	for _, tt := range tests {
+		s := NewMemoryStore(tt.maxEntries, tt.maxBytes)
+		for _, key := range tt.set {
+			s.Set(ctx, key, []byte(key), 0)
+		}
+		if tt.touch != "" {
+			s.Get(ctx, tt.touch)
+		}
+		s.Set(ctx, tt.next, []byte(tt.next), 0)
+
+		for _, key := range tt.want {
+			if value, ok, _ := s.Get(ctx, key); !ok || string(value) != key {
+				t.Errorf("%s: Get(%s) = %q, %v, want hit", tt.name, key, value, ok)
+			}
+		}
+		for _, key := range tt.evicted {
+			if _, ok, _ := s.Get(ctx, key); ok {
+				t.Errorf("%s: Get(%s) hit, want evicted", tt.name, key)
+			}
+		}
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore(0, 0)
+	s.Set(ctx, "short", []byte("1"), time.Nanosecond)
+	s.Set(ctx, "forever", []byte("1"), 0)
+	time.Sleep(time.Millisecond)
+
+	if _, ok, _ := s.Get(ctx, "short"); ok {
+		t.Error("expired entry returned")
+	}
+	if _, ok, _ := s.Get(ctx, "forever"); !ok {
+		t.Error("entry without ttl expired")
+	}
+}
+
+// errorStore 总是失败的共享存储
+type errorStore struct{}
+
+func (errorStore) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("unavailable")
+}
+func (errorStore) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("unavailable")
+}
+func (errorStore) Purge(context.Context) error { return errors.New("unavailable") }
+func (errorStore) Ping(context.Context) error  { return errors.New("unavailable") }
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	type result struct{ Value int }
+
+	// 共享存储命中时回填进程内存储
+	local, shared := NewMemoryStore(0, 0), NewMemoryStore(0, 0)
+	New("test", NewMemoryStore(0, 0), shared, time.Minute).Set(ctx, "k", result{Value: 7})
+	c := New("test", local, shared, time.Minute)
+	var got result
+	if !c.Get(ctx, "k", &got) || got.Value != 7 {
+		t.Fatalf("Get = %+v, want shared hit", got)
+	}
+	if _, ok, _ := local.Get(ctx, "k"); !ok {
+		t.Error("shared hit not copied to the local store")
+	}
+
+	// 值无法反序列化时按未命中处理
+	local.Set(ctx, "bad", []byte("{"), 0)
+	if c.Get(ctx, "bad", &got) {
+		t.Error("Get returned a value that does not decode")
+	}
+
+	// 共享存储不可用时只使用进程内存储
+	c = New("test", NewMemoryStore(0, 0), errorStore{}, time.Minute)
+	c.Set(ctx, "k", result{Value: 1})
+	if !c.Get(ctx, "k", &got) || got.Value != 1 {
+		t.Error("local store not used when the shared store fails")
+	}
+	if c.Ping(ctx) == nil {
+		t.Error("Ping ignored the shared store error")
+	}
+
+	// nil缓存不缓存
+	var none *Cache
+	none.Set(ctx, "k", result{Value: 1})
+	if none.Get(ctx, "k", &got) || none.Purge(ctx) != nil || none.Ping(ctx) != nil {
+		t.Error("nil cache is not a no-op")
+	}
+}
+
+func TestKey(t *testing.T) {
+	base := Key("calc", "1.0", "r1", 4, "gold", []int{1, 2}, false)
+
+	tests := []struct {
+		name string
+		key  string
+		same bool
+	}{
+		{"same params", Key("calc", "1.0", "r1", 4, "gold", []int{1, 2}, false), true},
+		{"other calculator", Key("other", "1.0", "r1", 4, "gold", []int{1, 2}, false), false},
+		{"other version", Key("calc", "1.1", "r1", 4, "gold", []int{1, 2}, false), false},
+		{"other revision", Key("calc", "1.0", "r2", 4, "gold", []int{1, 2}, false), false},
+		{"other param", Key("calc", "1.0", "r1", 4, "gold", []int{1, 3}, false), false},
+		{"shifted params", Key("calc", "1.0", "r1", 4, "gold", []int{1, 2}, true), false},
+	}
+	for _, tt := range tests {
+		if (tt.key == base) != tt.same {
+			t.Errorf("%s: key = %s, base %s, want same=%v", tt.name, tt.key, base, tt.same)
+		}
+	}
+}
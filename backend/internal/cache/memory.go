@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore 进程内LRU存储，超过条目数或总字节数上限时淘汰最久未使用的条目
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxBytes   int64
+	bytes      int64
+	order      *list.List // 从最近使用到最久未使用
+	entries    map[string]*list.Element
+}
+
+// memoryEntry LRU条目
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryStore 创建进程内LRU存储，上限不大于0表示不限制
+func NewMemoryStore(maxEntries int, maxBytes int64) *MemoryStore {
+	return &MemoryStore{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get 获取key对应的值，命中时移到最近使用
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		s.remove(element)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set 写入key对应的值，单个值超过字节上限时不缓存
+func (s *MemoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	size := int64(len(value))
+	if s.maxBytes > 0 && size > s.maxBytes {
+		return nil
+	}
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.remove(element)
+	}
+	s.entries[key] = s.order.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	s.bytes += size
+
+	for s.overLimit() {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// Purge 清空所有条目
+func (s *MemoryStore) Purge(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.order.Init()
+	s.entries = make(map[string]*list.Element)
+	s.bytes = 0
+	return nil
+}
+
+// Ping 进程内存储始终可用
+func (s *MemoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+// overLimit 检查是否超过条目数或字节数上限
+func (s *MemoryStore) overLimit() bool {
+	if s.order.Len() == 0 {
+		return false
+	}
+	return (s.maxEntries > 0 && s.order.Len() > s.maxEntries) || (s.maxBytes > 0 && s.bytes > s.maxBytes)
+}
+
+// remove 删除条目
+func (s *MemoryStore) remove(element *list.Element) {
+	entry := s.order.Remove(element).(*memoryEntry)
+	delete(s.entries, entry.key)
+	s.bytes -= int64(len(entry.value))
+}
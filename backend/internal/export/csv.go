@@ -0,0 +1,35 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/go-openapi/runtime"
+)
+
+// CSVProducer 输出计算结果的主要表格，强化概率请求了概率表时为初始等级×目标等级的概率表
+func CSVProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		tables, err := sheets(data)
+		if err != nil {
+			return err
+		}
+
+		table := tables[0]
+		writer := csv.NewWriter(w)
+		if err := writer.Write(table.header); err != nil {
+			return err
+		}
+		for _, row := range table.rows {
+			record := make([]string, len(row))
+			for i, cell := range row {
+				record[i] = formatCell(cell, false)
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	})
+}
@@ -0,0 +1,283 @@
+// Package export 将计算结果导出为CSV、Markdown和JSON Lines，作为go-swagger的生产者注册
+package export
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/middleware/header"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// 导出格式的媒体类型
+const (
+	CSVMime       = "text/csv"
+	MarkdownMime  = "text/markdown"
+	JSONLinesMime = "application/x-ndjson"
+)
+
+// formats format查询参数对应的媒体类型
+var formats = map[string]string{
+	"json":     runtime.JSONMime,
+	"csv":      CSVMime,
+	"markdown": MarkdownMime,
+	"jsonl":    JSONLinesMime,
+}
+
+// offers 计算接口支持的媒体类型，顺序与接口定义的produces一致，首个为默认类型
+var offers = []string{runtime.JSONMime, CSVMime, MarkdownMime, JSONLinesMime}
+
+// FormatMiddleware 将format查询参数转换为Accept请求头，使生产者的选择只依赖内容协商
+// 响应按Accept变化，错误响应也按Accept选择ErrorResponse或ProblemDetails
+func FormatMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if mediaType, ok := formats[r.URL.Query().Get("format")]; ok {
+			r.Header.Set(runtime.HeaderAccept, mediaType)
+		} else {
+			preferJSON(r)
+		}
+		w.Header().Add("Vary", runtime.HeaderAccept)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// preferJSON 通配的Accept请求头优先匹配JSON
+// 路由的produces来自无序集合，通配时各格式权重相同，协商结果不确定
+func preferJSON(r *http.Request) {
+	specs := header.ParseAccept(r.Header, runtime.HeaderAccept)
+	if len(specs) == 0 {
+		r.Header.Set(runtime.HeaderAccept, runtime.JSONMime+", */*")
+		return
+	}
+
+	q := 0.0
+	for _, spec := range specs {
+		switch spec.Value {
+		case runtime.JSONMime:
+			return
+		case "*/*", "application/*":
+			q = math.Max(q, spec.Q)
+		}
+	}
+	if q > 0 {
+		r.Header.Add(runtime.HeaderAccept, runtime.JSONMime+";q="+strconv.FormatFloat(q, 'g', -1, 64))
+	}
+}
+
+// MediaType 按Accept请求头协商计算结果的媒体类型，与生产者的选择一致，用于区分不同格式的ETag
+func MediaType(r *http.Request) string {
+	return middleware.NegotiateContentType(r, offers, runtime.JSONMime)
+}
+
+// probability 概率单元格，CSV输出小数，Markdown输出百分比
+type probability float64
+
+// sheet 导出的表格，单元格为string、整数、float64或probability
+type sheet struct {
+	title  string
+	header []string
+	rows   [][]interface{}
+}
+
+// sheets 将计算结果转换为表格，首个表格为主要结果
+func sheets(data interface{}) ([]sheet, error) {
+	switch resp := data.(type) {
+	case *models.AffixProbabilityResponse:
+		return affixSheets(resp), nil
+	case *models.AffixValueProbabilityResponse:
+		return affixValueSheets(resp), nil
+	case *models.StrengthenProbabilityResponse:
+		return strengthenSheets(resp), nil
+	case *models.JobResult:
+		if len(resp.Items) > 0 {
+			return batchSheets(resp.Items)
+		}
+		if payload := jobPayload(resp); payload != nil {
+			return sheets(payload)
+		}
+	}
+	return nil, fmt.Errorf("export: unsupported payload %T", data)
+}
+
+// batchSheets 批量任务：每项的概率或失败原因，其后是各项结果的表格，标题带有该项的位置
+func batchSheets(items []*models.JobItemResult) ([]sheet, error) {
+	summary := sheet{title: "items", header: []string{"index", "type", "probability", "error"}}
+	var result []sheet
+	for _, item := range items {
+		row := []interface{}{swag.Int32Value(item.Index), swag.StringValue(item.Type), "", ""}
+		if item.Error != nil {
+			row[3] = swag.StringValue(item.Error.Message)
+		}
+
+		payload := jobPayload(&models.JobResult{
+			AffixProbability:      item.AffixProbability,
+			AffixValueProbability: item.AffixValueProbability,
+			StrengthenProbability: item.StrengthenProbability,
+		})
+		if payload != nil {
+			row[2] = probability(payloadProbability(payload))
+			tables, err := sheets(payload)
+			if err != nil {
+				return nil, err
+			}
+			for _, table := range tables {
+				table.title = fmt.Sprintf("items[%d] %s", swag.Int32Value(item.Index), table.title)
+				result = append(result, table)
+			}
+		}
+		summary.rows = append(summary.rows, row)
+	}
+	return append([]sheet{summary}, result...), nil
+}
+
+// payloadProbability 计算结果的总概率
+func payloadProbability(payload interface{}) float64 {
+	switch resp := payload.(type) {
+	case *models.AffixProbabilityResponse:
+		return swag.Float64Value(resp.Probability)
+	case *models.AffixValueProbabilityResponse:
+		return swag.Float64Value(resp.Probability)
+	case *models.StrengthenProbabilityResponse:
+		return swag.Float64Value(resp.Probability)
+	}
+	return 0
+}
+
+// jobPayload 异步任务的计算结果，与同步接口的响应相同，没有结果时返回nil
+func jobPayload(resp *models.JobResult) interface{} {
+	switch {
+	case resp.AffixProbability != nil:
+		return resp.AffixProbability
+	case resp.AffixValueProbability != nil:
+		return resp.AffixValueProbability
+	case resp.StrengthenProbability != nil:
+		return resp.StrengthenProbability
+	}
+	return nil
+}
+
+// affixSheets 词条概率：各稀有度的概率和合计，以及满足条件的组合
+func affixSheets(resp *models.AffixProbabilityResponse) []sheet {
+	outcomes := sheet{
+		title:  "rarityBreakdown",
+		header: []string{"rarity", "slotCount", "weight", "probability", "validCombinations", "totalCombinations"},
+	}
+	for _, item := range resp.RarityBreakdown {
+		outcomes.rows = append(outcomes.rows, []interface{}{
+			item.Rarity, item.SlotCount, item.Weight, probability(item.Probability), item.ValidCombinations, item.TotalCombinations,
+		})
+	}
+	outcomes.rows = append(outcomes.rows, []interface{}{
+		totalLabel(resp.Rarity, len(resp.RarityBreakdown)), resp.SlotCount, "",
+		probability(swag.Float64Value(resp.Probability)), swag.Int64Value(resp.ValidCombinations), swag.Int64Value(resp.TotalCombinations),
+	})
+
+	result := []sheet{outcomes}
+	if len(resp.Combinations) > 0 {
+		combinations := sheet{title: "combinations", header: []string{"index", "affixIds"}}
+		for i, combination := range resp.Combinations {
+			combinations.rows = append(combinations.rows, []interface{}{i + 1, joinInts(combination)})
+		}
+		result = append(result, combinations)
+	}
+	return result
+}
+
+// affixValueSheets 词条数值概率：各词条达到数值的概率和合计
+func affixValueSheets(resp *models.AffixValueProbabilityResponse) []sheet {
+	details := sheet{
+		title:  "details",
+		header: []string{"affixId", "minValue", "unit", "minTier", "min", "max", "probability"},
+	}
+	for _, detail := range resp.Details {
+		details.rows = append(details.rows, []interface{}{
+			detail.AffixID, detail.MinValue, detail.Unit, detail.MinTier, detail.Min, detail.Max, probability(detail.Probability),
+		})
+	}
+	details.rows = append(details.rows, []interface{}{
+		"total", "", "", "", "", "", probability(swag.Float64Value(resp.Probability)),
+	})
+	return []sheet{details}
+}
+
+// strengthenSheets 强化概率：请求了概率表时以初始等级×目标等级的概率表为主要结果，其次是各稀有度的概率和合计
+func strengthenSheets(resp *models.StrengthenProbabilityResponse) []sheet {
+	var result []sheet
+	if resp.Table != nil {
+		table := sheet{title: "table", header: []string{`initialLevel\targetLevel`}}
+		for _, level := range resp.Table.Levels {
+			table.header = append(table.header, strconv.Itoa(int(level)))
+		}
+		for _, row := range resp.Table.Rows {
+			cells := []interface{}{swag.Int32Value(row.InitialLevel)}
+			for _, p := range row.Probabilities {
+				cells = append(cells, probability(p))
+			}
+			table.rows = append(table.rows, cells)
+		}
+		result = append(result, table)
+	}
+
+	outcomes := sheet{
+		title:  "rarityBreakdown",
+		header: []string{"rarity", "slotCount", "weight", "probability", "successfulOutcomes", "totalOutcomes"},
+	}
+	for _, item := range resp.RarityBreakdown {
+		outcomes.rows = append(outcomes.rows, []interface{}{
+			item.Rarity, item.SlotCount, item.Weight, probability(item.Probability), item.ValidCombinations, item.TotalCombinations,
+		})
+	}
+	outcomes.rows = append(outcomes.rows, []interface{}{
+		totalLabel(resp.Rarity, len(resp.RarityBreakdown)), "", "",
+		probability(swag.Float64Value(resp.Probability)), swag.Int64Value(resp.SuccessfulOutcomes), swag.Int64Value(resp.TotalOutcomes),
+	})
+	return append(result, outcomes)
+}
+
+// totalLabel 合计行的标签，有稀有度明细时为total，否则为计算使用的稀有度
+func totalLabel(rarity string, breakdown int) string {
+	if breakdown > 0 || rarity == "" {
+		return "total"
+	}
+	return rarity
+}
+
+// formatCell 格式化单元格，percent为true时概率输出百分比
+func formatCell(cell interface{}, percent bool) string {
+	switch v := cell.(type) {
+	case string:
+		return v
+	case probability:
+		if percent {
+			return strconv.FormatFloat(float64(v)*100, 'f', 4, 64) + "%"
+		}
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+	return fmt.Sprint(cell)
+}
+
+// joinInts 以+连接词条ID
+func joinInts(values []int32) string {
+	var text []byte
+	for i, v := range values {
+		if i > 0 {
+			text = append(text, '+')
+		}
+		text = strconv.AppendInt(text, int64(v), 10)
+	}
+	return string(text)
+}
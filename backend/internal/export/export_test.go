@@ -0,0 +1,185 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+func affixResponse() *models.AffixProbabilityResponse {
+	return &models.AffixProbabilityResponse{
+		Probability:       swag.Float64(0.25),
+		TotalCombinations: swag.Int64(8),
+		ValidCombinations: swag.Int64(2),
+		SlotCount:         2,
+		Combinations:      [][]int32{{1, 4}, {4, 5}},
+	}
+}
+
+func strengthenResponse() *models.StrengthenProbabilityResponse {
+	return &models.StrengthenProbabilityResponse{
+		Probability:        swag.Float64(0.5),
+		SuccessfulOutcomes: swag.Int64(1),
+		TotalOutcomes:      swag.Int64(2),
+		Rarity:             "gold",
+		Table: &models.StrengthenTable{
+			Levels: []int32{1, 2},
+			Rows: []*models.StrengthenTableRow{
+				{InitialLevel: swag.Int32(1), Probabilities: []float64{1, 0.5}},
+				{InitialLevel: swag.Int32(2), Probabilities: []float64{0, 1}},
+			},
+		},
+	}
+}
+
+// batchResult 批量任务结果，第二项计算失败
+func batchResult() *models.JobResult {
+	return &models.JobResult{
+		Items: []*models.JobItemResult{
+			{Index: swag.Int32(0), Type: swag.String("affixProbability"), AffixProbability: affixResponse()},
+			{Index: swag.Int32(1), Type: swag.String("strengthenProbability"), Error: &models.ErrorResponse{Message: swag.String("bad")}},
+		},
+	}
+}
+
+func TestProducers(t *testing.T) {
+	tests := []struct {
+		name     string
+		producer runtime.Producer
+		data     interface{}
+		want     string
+	}{
+		{"affix csv", CSVProducer(), affixResponse(),
+			"rarity,slotCount,weight,probability,validCombinations,totalCombinations\n" +
+				"total,2,,0.25,2,8\n"},
+		{"strengthen table csv", CSVProducer(), strengthenResponse(),
+			"initialLevel\\targetLevel,1,2\n1,1,0.5\n2,0,1\n"},
+		{"affix value csv", CSVProducer(), &models.AffixValueProbabilityResponse{
+			Probability: swag.Float64(0.1),
+			Details:     []*models.AffixValueDetail{{AffixID: 5, MinValue: 8, Unit: "%", Min: 5, Max: 10, Probability: 0.1}},
+		},
+			"affixId,minValue,unit,minTier,min,max,probability\n5,8,%,0,5,10,0.1\ntotal,,,,,,0.1\n"},
+		{"affix markdown", MarkdownProducer(), affixResponse(),
+			"### rarityBreakdown\n\n" +
+				"| rarity | slotCount | weight | probability | validCombinations | totalCombinations |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| total | 2 |  | 25.0000% | 2 | 8 |\n\n" +
+				"### combinations\n\n" +
+				"| index | affixIds |\n| --- | --- |\n| 1 | 1+4 |\n| 2 | 4+5 |\n"},
+		{"affix jsonl", JSONLinesProducer(), affixResponse(), "[1,4]\n[4,5]\n"},
+		{"strengthen jsonl", JSONLinesProducer(), strengthenResponse(),
+			"{\"initialLevel\":1,\"probabilities\":[1,0.5]}\n{\"initialLevel\":2,\"probabilities\":[0,1]}\n"},
+		{"job result csv", CSVProducer(), &models.JobResult{StrengthenProbability: strengthenResponse()},
+			"initialLevel\\targetLevel,1,2\n1,1,0.5\n2,0,1\n"},
+		{"job result jsonl", JSONLinesProducer(), &models.JobResult{AffixProbability: affixResponse()}, "[1,4]\n[4,5]\n"},
+		{"batch csv", CSVProducer(), batchResult(), "index,type,probability,error\n0,affixProbability,0.25,\n1,strengthenProbability,,bad\n"},
+		{"batch markdown", MarkdownProducer(), batchResult(),
+			"### items\n\n" +
+				"| index | type | probability | error |\n| --- | --- | --- | --- |\n" +
+				"| 0 | affixProbability | 25.0000% |  |\n| 1 | strengthenProbability |  | bad |\n\n" +
+				"### items[0] rarityBreakdown\n\n" +
+				"| rarity | slotCount | weight | probability | validCombinations | totalCombinations |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| total | 2 |  | 25.0000% | 2 | 8 |\n\n" +
+				"### items[0] combinations\n\n" +
+				"| index | affixIds |\n| --- | --- |\n| 1 | 1+4 |\n| 2 | 4+5 |\n"},
+		{"batch jsonl", JSONLinesProducer(), &models.JobResult{Items: batchResult().Items[1:]},
+			"{\"error\":{\"error\":null,\"message\":\"bad\"},\"index\":1,\"type\":\"strengthenProbability\"}\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := tt.producer.Produce(&buf, tt.data); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s:\ngot  %q\nwant %q", tt.name, got, tt.want)
+		}
+	}
+
+	// 同一结果多次导出的内容相同
+	first, second := new(bytes.Buffer), new(bytes.Buffer)
+	MarkdownProducer().Produce(first, strengthenResponse())
+	MarkdownProducer().Produce(second, strengthenResponse())
+	if first.String() != second.String() {
+		t.Error("markdown output is not deterministic")
+	}
+
+	for _, data := range []interface{}{&models.ErrorResponse{}, &models.JobResult{}} {
+		if err := CSVProducer().Produce(new(bytes.Buffer), data); err == nil {
+			t.Errorf("CSVProducer accepted an unsupported payload %T", data)
+		}
+	}
+}
+
+func TestMarkdownLimits(t *testing.T) {
+	resp := affixResponse()
+	resp.Combinations = nil
+	for i := 0; i < maxMarkdownRows+5; i++ {
+		resp.Combinations = append(resp.Combinations, []int32{int32(i)})
+	}
+	var buf bytes.Buffer
+	if err := MarkdownProducer().Produce(&buf, resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "_… 5 more rows_") {
+		t.Errorf("truncated table does not note the omitted rows:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	writer := bufio.NewWriter(&buf)
+	writeMarkdownTable(writer, sheet{title: "t", header: []string{"a|b"}, rows: [][]interface{}{{"c|d"}}})
+	writer.Flush()
+	if want := "### t\n\n| a\\|b |\n| --- |\n| c\\|d |\n"; buf.String() != want {
+		t.Errorf("pipes not escaped:\ngot  %q\nwant %q", buf.String(), want)
+	}
+}
+
+func TestNegotiation(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		accept []string
+		want   string
+	}{
+		{"default", "", nil, runtime.JSONMime},
+		{"wildcard", "", []string{"*/*"}, runtime.JSONMime},
+		{"browser", "", []string{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"}, runtime.JSONMime},
+		{"accept csv", "", []string{CSVMime}, CSVMime},
+		{"accept markdown over wildcard", "", []string{MarkdownMime + ", */*;q=0.1"}, MarkdownMime},
+		{"format csv", "csv", []string{runtime.JSONMime}, CSVMime},
+		{"format markdown", "markdown", nil, MarkdownMime},
+		{"format jsonl", "jsonl", nil, JSONLinesMime},
+		{"unknown format", "xml", nil, runtime.JSONMime},
+	}
+	for _, tt := range tests {
+		target := "/api/v1/mod/affix/probability"
+		if tt.format != "" {
+			target += "?format=" + tt.format
+		}
+		r := httptest.NewRequest(http.MethodPost, target, nil)
+		for _, value := range tt.accept {
+			r.Header.Add(runtime.HeaderAccept, value)
+		}
+
+		var got string
+		w := httptest.NewRecorder()
+		FormatMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = MediaType(r)
+		})).ServeHTTP(w, r)
+		if got != tt.want {
+			t.Errorf("%s: MediaType = %s, want %s", tt.name, got, tt.want)
+		}
+		if vary := w.Header().Get("Vary"); vary != runtime.HeaderAccept {
+			t.Errorf("%s: Vary = %q, want Accept", tt.name, vary)
+		}
+	}
+}
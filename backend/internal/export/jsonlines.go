@@ -0,0 +1,71 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// JSONLinesProducer 每行输出一个JSON值，用于大量组合和路径的流式处理
+// 词条概率逐行输出组合，强化概率逐行输出路径，其次是概率表的行和稀有度明细，批量任务逐行输出每项的结果；都没有时输出完整结果
+func JSONLinesProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		encoder := json.NewEncoder(w)
+		for _, line := range jsonLines(data) {
+			if err := encoder.Encode(line); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// jsonLines 获取逐行输出的值
+func jsonLines(data interface{}) []interface{} {
+	var lines []interface{}
+	switch resp := data.(type) {
+	case *models.AffixProbabilityResponse:
+		if len(resp.Combinations) > 0 {
+			for _, combination := range resp.Combinations {
+				lines = append(lines, combination)
+			}
+		} else {
+			for _, item := range resp.RarityBreakdown {
+				lines = append(lines, item)
+			}
+		}
+	case *models.AffixValueProbabilityResponse:
+		for _, detail := range resp.Details {
+			lines = append(lines, detail)
+		}
+	case *models.JobResult:
+		for _, item := range resp.Items {
+			lines = append(lines, item)
+		}
+		if payload := jobPayload(resp); payload != nil {
+			return jsonLines(payload)
+		}
+	case *models.StrengthenProbabilityResponse:
+		switch {
+		case len(resp.Paths) > 0:
+			for _, path := range resp.Paths {
+				lines = append(lines, path)
+			}
+		case resp.Table != nil:
+			for _, row := range resp.Table.Rows {
+				lines = append(lines, row)
+			}
+		default:
+			for _, item := range resp.RarityBreakdown {
+				lines = append(lines, item)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return []interface{}{data}
+	}
+	return lines
+}
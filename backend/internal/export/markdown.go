@@ -0,0 +1,67 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+)
+
+// maxMarkdownRows 每个Markdown表格的最多行数，论坛帖子有长度限制，完整的组合列表使用JSON Lines
+const maxMarkdownRows = 100
+
+// MarkdownProducer 以Markdown表格输出计算结果的全部表格，用于论坛帖子
+func MarkdownProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		tables, err := sheets(data)
+		if err != nil {
+			return err
+		}
+
+		buf := bufio.NewWriter(w)
+		for i, table := range tables {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			writeMarkdownTable(buf, table)
+		}
+		return buf.Flush()
+	})
+}
+
+// writeMarkdownTable 写入一个带标题的Markdown表格，超出的行只注明数量
+func writeMarkdownTable(w *bufio.Writer, table sheet) {
+	w.WriteString("### " + table.title + "\n\n")
+	writeMarkdownRow(w, table.header)
+	separator := make([]string, len(table.header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeMarkdownRow(w, separator)
+
+	rows := table.rows
+	if len(rows) > maxMarkdownRows {
+		rows = rows[:maxMarkdownRows]
+	}
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = formatCell(cell, true)
+		}
+		writeMarkdownRow(w, cells)
+	}
+	if omitted := len(table.rows) - len(rows); omitted > 0 {
+		w.WriteString("\n_… " + strconv.Itoa(omitted) + " more rows_\n")
+	}
+}
+
+// writeMarkdownRow 写入一行，转义单元格中的竖线
+func writeMarkdownRow(w *bufio.Writer, cells []string) {
+	w.WriteString("|")
+	for _, cell := range cells {
+		w.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+	}
+	w.WriteString("\n")
+}
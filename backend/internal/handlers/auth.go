@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/admin"
+)
+
+const (
+	// scopeExtension 接口要求的权限范围的规范扩展字段，未设置的接口公开
+	scopeExtension = "x-scope"
+	// keyRequiredExtension 接口是否始终需要API key的规范扩展字段，保存个人数据的接口按key区分所有者，不允许匿名访问
+	keyRequiredExtension = "x-key-required"
+)
+
+// AuthHandler API key认证和管理处理器
+type AuthHandler struct {
+	authenticator *auth.Authenticator
+}
+
+// NewAuthHandler 创建认证处理器
+func NewAuthHandler(authenticator *auth.Authenticator) *AuthHandler {
+	return &AuthHandler{authenticator: authenticator}
+}
+
+// APIKeyAuth 校验X-API-Key请求头，返回的*auth.Key作为处理器的principal
+func (h *AuthHandler) APIKeyAuth(token string) (interface{}, error) {
+	key, err := h.authenticator.Authenticate(token)
+	if err != nil {
+		return nil, newAuthError(err)
+	}
+	return key, nil
+}
+
+// Authorize 按接口的x-scope和x-key-required检查权限并记录用量，匿名请求的principal为nil
+func (h *AuthHandler) Authorize(r *http.Request, principal interface{}) error {
+	var scope, operation string
+	var keyRequired bool
+	if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+		scope, _ = route.Operation.Extensions.GetString(scopeExtension)
+		keyRequired, _ = route.Operation.Extensions.GetBool(keyRequiredExtension)
+		operation = route.Operation.ID
+	}
+
+	key, _ := principal.(*auth.Key)
+	if key != nil {
+		logging.AddAttrs(r.Context(), slog.String("api_key", key.ID))
+	}
+	return h.authorize(key, scope, operation, keyRequired)
+}
+
+// authorize 检查key的权限并转换为带状态码的认证错误，keyRequired为true时匿名请求返回401
+func (h *AuthHandler) authorize(key *auth.Key, scope, operation string, keyRequired bool) error {
+	if key == nil && keyRequired {
+		return newAuthError(auth.ErrKeyRequired)
+	}
+	if err := h.authenticator.Authorize(key, scope, operation, time.Now()); err != nil {
+		if errors.Is(err, auth.ErrInsufficientScope) {
+			return &authError{
+				status: http.StatusForbidden,
+				err:    services.NewError(services.ErrCodeInsufficientScope, "", msgInsufficientScope, scope),
+			}
+		}
+		if errors.Is(err, auth.ErrQuotaExceeded) {
+			retryAfter := int(math.Ceil(auth.QuotaResetAfter(time.Now()).Seconds()))
+			return &authError{
+				status:     http.StatusTooManyRequests,
+				err:        services.NewError(services.ErrCodeQuotaExceeded, "", msgQuotaExceeded, key.DailyQuota, retryAfter),
+				retryAfter: retryAfter,
+			}
+		}
+		return newAuthError(err)
+	}
+	return nil
+}
+
+// ListAPIKeys 获取API key列表和用量
+func (h *AuthHandler) ListAPIKeys(params admin.ListAPIKeysParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	store := h.authenticator.Store()
+	keys, err := store.List()
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusInternalServerError, err, locale)
+	}
+
+	keyList := make([]*models.APIKey, 0, len(keys))
+	for _, key := range keys {
+		usage, err := store.Usage(key.ID)
+		if err != nil {
+			return newErrorResponse(params.HTTPRequest, http.StatusInternalServerError, err, locale)
+		}
+		keyList = append(keyList, convertAPIKey(key, usage))
+	}
+
+	return admin.NewListAPIKeysOK().WithPayload(&models.APIKeyListResponse{
+		Keys:  keyList,
+		Total: int32(len(keyList)),
+	})
+}
+
+// convertAPIKey 转换API key为API模型，不包含摘要
+func convertAPIKey(key auth.Key, usage auth.Usage) *models.APIKey {
+	id := key.ID
+	name := key.Name
+	dailyQuota := int32(key.DailyQuota)
+	createdAt := strfmt.DateTime(key.CreatedAt)
+	today := int32(usage.Today)
+	total := usage.Total
+	response := &models.APIKey{
+		ID:         &id,
+		Name:       &name,
+		Scopes:     key.Scopes,
+		Guilds:     key.Guilds,
+		DailyQuota: &dailyQuota,
+		CreatedAt:  &createdAt,
+		Usage: &models.APIKeyUsage{
+			Today: &today,
+			Total: &total,
+		},
+	}
+	if key.RevokedAt != nil {
+		revokedAt := strfmt.DateTime(*key.RevokedAt)
+		response.RevokedAt = &revokedAt
+	}
+	if usage.LastUsedAt != nil {
+		lastUsedAt := strfmt.DateTime(*usage.LastUsedAt)
+		response.Usage.LastUsedAt = &lastUsedAt
+	}
+	return response
+}
+
+// authError 认证和授权错误，实现go-openapi的errors.Error以保留状态码
+type authError struct {
+	status     int
+	err        error
+	retryAfter int // 大于0时写入Retry-After响应头
+}
+
+// newAuthError 转换认证器的错误，未知错误按500处理
+func newAuthError(err error) *authError {
+	switch {
+	case errors.Is(err, auth.ErrKeyRequired):
+		return &authError{
+			status: http.StatusUnauthorized,
+			err:    services.NewError(services.ErrCodeAPIKeyRequired, "", msgAPIKeyRequired),
+		}
+	case errors.Is(err, auth.ErrInvalidKey):
+		return &authError{
+			status: http.StatusUnauthorized,
+			err:    services.NewError(services.ErrCodeInvalidAPIKey, "", msgInvalidAPIKey),
+		}
+	default:
+		return &authError{
+			status: http.StatusInternalServerError,
+			err:    err,
+		}
+	}
+}
+
+func (e *authError) Error() string {
+	return e.err.Error()
+}
+
+func (e *authError) Unwrap() error {
+	return e.err
+}
+
+// Code 获取HTTP状态码
+func (e *authError) Code() int32 {
+	return int32(e.status)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+)
+
+func newTestAuthHandler(t *testing.T) *AuthHandler {
+	t.Helper()
+	store, err := auth.NewFileStore(filepath.Join(t.TempDir(), "api_keys.json"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authenticator := auth.NewAuthenticator(store, false)
+	t.Cleanup(func() { authenticator.Close() })
+	return NewAuthHandler(authenticator)
+}
+
+func TestAuthorizeKeyRequired(t *testing.T) {
+	h := newTestAuthHandler(t)
+	key := &auth.Key{ID: "k1", Scopes: []string{auth.ScopeCalculate}}
+
+	tests := []struct {
+		name        string
+		key         *auth.Key
+		scope       string
+		keyRequired bool
+		status      int
+	}{
+		{"anonymous calculate", nil, auth.ScopeCalculate, false, 0},
+		{"anonymous inventory", nil, auth.ScopeCalculate, true, http.StatusUnauthorized},
+		{"key inventory", key, auth.ScopeCalculate, true, 0},
+		{"anonymous admin", nil, auth.ScopeAdmin, false, http.StatusUnauthorized},
+		{"key admin", key, auth.ScopeAdmin, false, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		err := h.authorize(tt.key, tt.scope, "test", tt.keyRequired)
+		var authErr *authError
+		switch {
+		case tt.status == 0 && err != nil:
+			t.Errorf("%s: authorize() = %v, want nil", tt.name, err)
+		case tt.status != 0 && (!errors.As(err, &authErr) || authErr.status != tt.status):
+			t.Errorf("%s: authorize() = %v, want status %d", tt.name, err, tt.status)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// affixProbabilityInput 词条概率计算参数，同步接口和任务共用
+type affixProbabilityInput struct {
+	gameVersion      string
+	slotCount        int
+	rarity           string
+	targetAffixIDs   []int
+	showCombinations bool
+}
+
+// newAffixProbabilityInput 转换词条概率请求
+func newAffixProbabilityInput(body *models.AffixProbabilityRequest) affixProbabilityInput {
+	input := affixProbabilityInput{
+		gameVersion:    body.GameVersion,
+		slotCount:      int(body.SlotCount),
+		rarity:         body.Rarity,
+		targetAffixIDs: make([]int, len(body.TargetAffixIds)),
+	}
+	for i, id := range body.TargetAffixIds {
+		input.targetAffixIDs[i] = int(id)
+	}
+	if body.ShowCombinations != nil {
+		input.showCombinations = *body.ShowCombinations
+	}
+	return input
+}
+
+// logParams 将计算参数记入访问日志
+func (in affixProbabilityInput) logParams(ctx context.Context) {
+	logging.AddAttrs(ctx, slog.Group("params",
+		slog.String("gameVersion", in.gameVersion),
+		slog.Int("slotCount", in.slotCount),
+		slog.String("rarity", in.rarity),
+		slog.Any("targetAffixIds", in.targetAffixIDs),
+		slog.Bool("showCombinations", in.showCombinations)))
+}
+
+// cacheKey 获取计算结果的缓存键
+func (in affixProbabilityInput) cacheKey(service *services.AffixProbabilityService) string {
+	return service.CacheKey(in.gameVersion, in.slotCount, in.rarity, in.targetAffixIDs, in.showCombinations)
+}
+
+// calculate 调用服务计算
+func (in affixProbabilityInput) calculate(ctx context.Context, service *services.AffixProbabilityService) (*services.AffixProbabilityResult, error) {
+	return service.CalculateProbability(ctx, in.gameVersion, in.slotCount, in.rarity, in.targetAffixIDs, in.showCombinations)
+}
+
+// convertAffixProbabilityResult 转换词条概率结果为API模型
+func convertAffixProbabilityResult(result *services.AffixProbabilityResult, showCombinations bool, locale string) *models.AffixProbabilityResponse {
+	targetRange := make([]int32, len(result.TargetRange))
+	for i, id := range result.TargetRange {
+		targetRange[i] = int32(id)
+	}
+
+	response := &models.AffixProbabilityResponse{
+		Probability:        &result.Probability,
+		ProbabilityPercent: &result.ProbabilityPercent,
+		TotalCombinations:  &result.TotalCombinations,
+		ValidCombinations:  &result.ValidCombinations,
+		SlotCount:          int32(result.SlotCount),
+		TargetRange:        targetRange,
+		Rarity:             result.Rarity,
+		RarityBreakdown:    convertRarityBreakdown(result.RarityBreakdown, locale),
+		GameVersion:        result.GameVersion,
+	}
+
+	// 添加组合数据
+	if showCombinations && len(result.Combinations) > 0 {
+		combinations := make([][]int32, len(result.Combinations))
+		for i, combo := range result.Combinations {
+			combinations[i] = make([]int32, len(combo))
+			for j, id := range combo {
+				combinations[i][j] = int32(id)
+			}
+		}
+		response.Combinations = combinations
+	}
+
+	return response
+}
+
+// affixValueProbabilityInput 词条数值概率计算参数，同步接口和任务共用
+type affixValueProbabilityInput struct {
+	gameVersion  string
+	slotCount    int
+	level        int
+	requirements []services.AffixValueRequirement
+}
+
+// newAffixValueProbabilityInput 转换词条数值概率请求，等级默认为1
+func newAffixValueProbabilityInput(body *models.AffixValueProbabilityRequest) affixValueProbabilityInput {
+	input := affixValueProbabilityInput{
+		gameVersion:  body.GameVersion,
+		level:        1,
+		requirements: make([]services.AffixValueRequirement, 0, len(body.Requirements)),
+	}
+	if body.SlotCount != nil {
+		input.slotCount = int(*body.SlotCount)
+	}
+	if body.Level != 0 {
+		input.level = int(body.Level)
+	}
+
+	for _, req := range body.Requirements {
+		requirement := services.AffixValueRequirement{
+			AffixID:  int(*req.AffixID),
+			MinValue: req.MinValue,
+		}
+		if req.MinTier != nil {
+			requirement.MinTier = int(*req.MinTier)
+		}
+		if req.TopTier != nil {
+			requirement.TopTier = *req.TopTier
+		}
+		input.requirements = append(input.requirements, requirement)
+	}
+	return input
+}
+
+// logParams 将计算参数记入访问日志
+func (in affixValueProbabilityInput) logParams(ctx context.Context) {
+	logging.AddAttrs(ctx, slog.Group("params",
+		slog.String("gameVersion", in.gameVersion),
+		slog.Int("slotCount", in.slotCount),
+		slog.Int("level", in.level),
+		slog.Any("requirements", in.requirements)))
+}
+
+// cacheKey 获取计算结果的缓存键
+func (in affixValueProbabilityInput) cacheKey(service *services.AffixValueProbabilityService) string {
+	return service.CacheKey(in.gameVersion, in.slotCount, in.level, in.requirements)
+}
+
+// calculate 调用服务计算
+func (in affixValueProbabilityInput) calculate(ctx context.Context, service *services.AffixValueProbabilityService) (*services.AffixValueProbabilityResult, error) {
+	return service.CalculateProbability(ctx, in.gameVersion, in.slotCount, in.level, in.requirements)
+}
+
+// convertAffixValueProbabilityResult 转换词条数值概率结果为API模型
+func convertAffixValueProbabilityResult(result *services.AffixValueProbabilityResult) *models.AffixValueProbabilityResponse {
+	details := make([]*models.AffixValueDetail, 0, len(result.Details))
+	for _, detail := range result.Details {
+		tiers := make([]*models.AffixValueTier, 0, len(detail.Range.Tiers))
+		for _, tier := range detail.Range.Tiers {
+			tiers = append(tiers, &models.AffixValueTier{
+				Tier:   int32(tier.Tier),
+				Min:    tier.Min,
+				Max:    tier.Max,
+				Weight: tier.Weight,
+			})
+		}
+
+		details = append(details, &models.AffixValueDetail{
+			AffixID:     int32(detail.AffixID),
+			MinValue:    detail.MinValue,
+			MinTier:     int32(detail.MinTier),
+			Probability: detail.Probability,
+			Unit:        detail.Range.Unit,
+			Min:         detail.Range.Min,
+			Max:         detail.Range.Max,
+			Tiers:       tiers,
+		})
+	}
+
+	return &models.AffixValueProbabilityResponse{
+		Probability:        &result.Probability,
+		ProbabilityPercent: &result.ProbabilityPercent,
+		AppearProbability:  result.AppearProbability,
+		ValueProbability:   result.ValueProbability,
+		SlotCount:          int32(result.SlotCount),
+		Level:              int32(result.Level),
+		Details:            details,
+		GameVersion:        result.GameVersion,
+	}
+}
+
+// strengthenProbabilityInput 强化概率计算参数，同步接口和任务共用
+type strengthenProbabilityInput struct {
+	gameVersion      string
+	rarity           string
+	initialLevels    []int
+	targetLevels     []int
+	orderIndependent bool
+	showPaths        bool
+}
+
+// newStrengthenProbabilityInput 转换强化概率请求，默认顺序无关
+func newStrengthenProbabilityInput(body *models.StrengthenProbabilityRequest) strengthenProbabilityInput {
+	input := strengthenProbabilityInput{
+		gameVersion:      body.GameVersion,
+		rarity:           body.Rarity,
+		initialLevels:    make([]int, len(body.InitialLevels)),
+		targetLevels:     make([]int, len(body.TargetLevels)),
+		orderIndependent: true,
+	}
+	for i, level := range body.InitialLevels {
+		input.initialLevels[i] = int(level)
+	}
+	for i, level := range body.TargetLevels {
+		input.targetLevels[i] = int(level)
+	}
+	if body.OrderIndependent != nil {
+		input.orderIndependent = *body.OrderIndependent
+	}
+	if body.ShowPaths != nil {
+		input.showPaths = *body.ShowPaths
+	}
+	return input
+}
+
+// logParams 将计算参数记入访问日志
+func (in strengthenProbabilityInput) logParams(ctx context.Context) {
+	logging.AddAttrs(ctx, slog.Group("params",
+		slog.String("gameVersion", in.gameVersion),
+		slog.String("rarity", in.rarity),
+		slog.Any("initialLevels", in.initialLevels),
+		slog.Any("targetLevels", in.targetLevels),
+		slog.Bool("orderIndependent", in.orderIndependent),
+		slog.Bool("showPaths", in.showPaths)))
+}
+
+// cacheKey 获取计算结果的缓存键
+func (in strengthenProbabilityInput) cacheKey(service *services.StrengthenProbabilityService) string {
+	return service.CacheKey(in.gameVersion, in.initialLevels, in.targetLevels, in.rarity, in.orderIndependent, in.showPaths)
+}
+
+// calculate 调用服务计算
+func (in strengthenProbabilityInput) calculate(ctx context.Context, service *services.StrengthenProbabilityService) (*services.StrengthenProbabilityResult, error) {
+	return service.CalculateProbability(ctx, in.gameVersion, in.initialLevels, in.targetLevels, in.rarity, in.orderIndependent, in.showPaths)
+}
+
+// convertStrengthenProbabilityResult 转换强化概率结果为API模型
+func convertStrengthenProbabilityResult(result *services.StrengthenProbabilityResult, showPaths bool, locale string) *models.StrengthenProbabilityResponse {
+	response := &models.StrengthenProbabilityResponse{
+		Probability:        &result.Probability,
+		ProbabilityPercent: &result.ProbabilityPercent,
+		SuccessfulOutcomes: &result.SuccessfulOutcomes,
+		TotalOutcomes:      &result.TotalOutcomes,
+		Rarity:             result.Rarity,
+		RarityBreakdown:    convertRarityBreakdown(result.RarityBreakdown, locale),
+		GameVersion:        result.GameVersion,
+	}
+
+	// 添加路径数据
+	if showPaths && len(result.Paths) > 0 {
+		paths := make([]*models.StrengthenPath, 0, len(result.Paths))
+		for _, path := range result.Paths {
+			// 转换等级
+			finalLevels := make([]int32, len(path.FinalLevels))
+			for i, level := range path.FinalLevels {
+				finalLevels[i] = int32(level)
+			}
+
+			// 转换步骤
+			steps := make([]*models.StrengthenStep, 0, len(path.Steps))
+			for _, step := range path.Steps {
+				steps = append(steps, &models.StrengthenStep{
+					Step:     int32(step.Step),
+					Slot:     int32(step.Slot),
+					NewLevel: int32(step.NewLevel),
+				})
+			}
+
+			paths = append(paths, &models.StrengthenPath{
+				Success:     path.Success,
+				FinalLevels: finalLevels,
+				Steps:       steps,
+			})
+		}
+		response.Paths = paths
+	}
+
+	return response
+}
+
+// convertStrengthenTable 转换强化概率表为API模型
+func convertStrengthenTable(table *services.StrengthenTable) *models.StrengthenTable {
+	levels := make([]int32, len(table.Levels))
+	for i, level := range table.Levels {
+		levels[i] = int32(level)
+	}
+	rows := make([]*models.StrengthenTableRow, 0, len(table.Probabilities))
+	for i, probabilities := range table.Probabilities {
+		rows = append(rows, &models.StrengthenTableRow{
+			InitialLevel:  swag.Int32(levels[i]),
+			Probabilities: probabilities,
+		})
+	}
+	return &models.StrengthenTable{
+		Rarity: swag.String(table.Rarity),
+		Levels: levels,
+		Rows:   rows,
+	}
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// problemMime RFC 7807问题详情的媒体类型
+const problemMime = "application/problem+json"
+
+// problemTypeBase 问题类型URI前缀，后接错误码
+const problemTypeBase = "https://oncehuman.tools/problems/"
+
+// errorResponder 错误响应，请求头Accept包含application/problem+json时输出ProblemDetails，否则输出ErrorResponse
+type errorResponder struct {
+	status   int
+	err      *services.Error
+	message  string
+	instance string
+	problem  bool
+}
+
+// newErrorResponse 创建错误响应，消息按语言输出，非服务错误按原始消息输出
+func newErrorResponse(req *http.Request, status int, err error, locale string) middleware.Responder {
+	responder := &errorResponder{status: status}
+	responder.err, responder.message = localizeError(err, locale)
+	if req != nil {
+		responder.instance = req.URL.Path
+		responder.problem = acceptsProblem(req.Header.Values(runtime.HeaderAccept))
+	}
+	return responder
+}
+
+// localizeError 获取服务错误和按语言输出的消息，非服务错误返回空的服务错误和原始消息
+func localizeError(err error, locale string) (*services.Error, string) {
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr, serviceErr.Localize(locale)
+	}
+	return &services.Error{}, err.Error()
+}
+
+// convertError 转换错误为API模型，用于嵌入其他响应
+func convertError(status int, err error, locale string) *models.ErrorResponse {
+	serviceErr, message := localizeError(err, locale)
+	title := errorTitle(status)
+	return &models.ErrorResponse{
+		Error:   &title,
+		Code:    serviceErr.Code,
+		Message: &message,
+		Details: convertErrorDetails(serviceErr),
+	}
+}
+
+// calculationError 计算失败的错误响应，停止服务时被取消的计算返回503，脚本超过执行限制返回422，其余按参数错误返回400
+func calculationError(req *http.Request, err error, locale string) middleware.Responder {
+	if errors.Is(err, context.Canceled) {
+		return shuttingDown(req, locale)
+	}
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) && scriptLimitCodes[serviceErr.Code] {
+		return newErrorResponse(req, http.StatusUnprocessableEntity, err, locale)
+	}
+	return newErrorResponse(req, http.StatusBadRequest, err, locale)
+}
+
+// scriptLimitCodes 脚本超过执行步数、时间或内存上限的错误码，请求本身有效，返回422
+var scriptLimitCodes = map[string]bool{
+	services.ErrCodeScriptStepLimit:   true,
+	services.ErrCodeScriptTimeout:     true,
+	services.ErrCodeScriptMemoryLimit: true,
+}
+
+// shuttingDown 服务正在停止的错误响应
+func shuttingDown(req *http.Request, locale string) middleware.Responder {
+	err := services.NewError(services.ErrCodeShuttingDown, "", msgShuttingDown)
+	return newErrorResponse(req, http.StatusServiceUnavailable, err, locale)
+}
+
+// storageUnavailable 未启用数据库或数据库出错的错误响应，err不为nil时记录日志
+func storageUnavailable(req *http.Request, err error, locale string) middleware.Responder {
+	if err != nil {
+		logging.FromContext(req.Context()).Error("访问数据库失败", "error", err)
+	}
+	return newErrorResponse(req, http.StatusServiceUnavailable,
+		services.NewError(services.ErrCodeStorageUnavailable, "", msgStorageDown), locale)
+}
+
+// WriteResponse 写入错误响应，始终使用JSON生产者，协商得到的CSV等导出格式只用于计算结果
+func (r *errorResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	title := errorTitle(r.status)
+
+	var payload interface{}
+	if r.problem {
+		rw.Header().Set(runtime.HeaderContentType, problemMime)
+		problemType := problemTypeBase + r.err.Code
+		if r.err.Code == "" {
+			problemType = "about:blank"
+		}
+		status := int32(r.status)
+		problem := &models.ProblemDetails{
+			Type:     &problemType,
+			Title:    &title,
+			Status:   &status,
+			Detail:   r.message,
+			Instance: r.instance,
+			Code:     r.err.Code,
+			Field:    r.err.Field,
+			Allowed:  r.err.Allowed,
+		}
+		problem.Min, problem.Max = errorBounds(r.err)
+		payload = problem
+	} else {
+		// 事件流、导出格式等非JSON响应的错误也按JSON输出
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		payload = &models.ErrorResponse{
+			Error:   &title,
+			Code:    r.err.Code,
+			Message: &r.message,
+			Details: convertErrorDetails(r.err),
+		}
+	}
+
+	rw.WriteHeader(r.status)
+	if err := runtime.JSONProducer().Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// acceptsProblem 检查Accept请求头是否接受问题详情，q=0表示拒绝
+func acceptsProblem(accept []string) bool {
+	for _, value := range accept {
+		for _, part := range strings.Split(value, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil || mediaType != problemMime {
+				continue
+			}
+			if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// errorTitle 根据HTTP状态码获取错误类别
+func errorTitle(status int) string {
+	switch status {
+	case http.StatusUnauthorized:
+		return "unauthorized"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusMethodNotAllowed:
+		return "method_not_allowed"
+	case http.StatusNotAcceptable:
+		return "not_acceptable"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusUnsupportedMediaType:
+		return "unsupported_media_type"
+	case http.StatusUnprocessableEntity:
+		return "unprocessable_entity"
+	case http.StatusTooManyRequests:
+		return "too_many_requests"
+	case http.StatusInternalServerError:
+		return "internal_error"
+	case http.StatusBadGateway:
+		return "bad_gateway"
+	case http.StatusServiceUnavailable:
+		return "service_unavailable"
+	case http.StatusGatewayTimeout:
+		return "gateway_timeout"
+	default:
+		return "bad_request"
+	}
+}
+
+// convertErrorDetails 转换错误详情，无详情时返回nil
+func convertErrorDetails(err *services.Error) *models.ErrorDetails {
+	if err.Field == "" && err.Min == nil && err.Max == nil && len(err.Allowed) == 0 {
+		return nil
+	}
+	details := &models.ErrorDetails{
+		Field:   err.Field,
+		Allowed: err.Allowed,
+	}
+	details.Min, details.Max = errorBounds(err)
+	return details
+}
+
+// errorBounds 转换错误的允许范围，未设置的边界返回nil
+func errorBounds(err *services.Error) (min, max *int32) {
+	if err.Min != nil {
+		min = swag.Int32(int32(*err.Min))
+	}
+	if err.Max != nil {
+		max = swag.Int32(int32(*err.Max))
+	}
+	return min, max
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+func TestCalculationError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		title  string
+	}{
+		{name: "invalid parameter", err: services.NewError(services.ErrCodeOutOfRange, "slotCount", "error.out_of_range"), status: http.StatusBadRequest, title: "bad_request"},
+		{name: "script error", err: services.NewError(services.ErrCodeScriptError, "source", "error.script_error", "boom"), status: http.StatusBadRequest, title: "bad_request"},
+		{name: "step limit", err: services.NewError(services.ErrCodeScriptStepLimit, "source", "error.script_step_limit", 10), status: http.StatusUnprocessableEntity, title: "unprocessable_entity"},
+		{name: "timeout", err: services.NewError(services.ErrCodeScriptTimeout, "source", "error.script_timeout", "5s"), status: http.StatusUnprocessableEntity, title: "unprocessable_entity"},
+		{name: "memory limit", err: fmt.Errorf("run: %w", services.NewError(services.ErrCodeScriptMemoryLimit, "source", "error.script_memory_limit", 256)), status: http.StatusUnprocessableEntity, title: "unprocessable_entity"},
+		{name: "canceled", err: context.Canceled, status: http.StatusServiceUnavailable, title: "service_unavailable"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/scripts/run", nil)
+		rec := httptest.NewRecorder()
+		calculationError(req, tt.err, "en").WriteResponse(rec, nil)
+
+		if rec.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.status)
+		}
+		var body struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Error != tt.title {
+			t.Errorf("%s: error = %q (%v), want %q", tt.name, body.Error, err, tt.title)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// resultETag 由计算结果的缓存键、语言和响应的媒体类型生成ETag，缓存键包含规范化的参数和目录摘要，key为空时不生成
+func resultETag(key, locale, mediaType string) string {
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(key + "|" + locale + "|" + mediaType))
+	return `"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+// etagMatches 检查If-None-Match是否包含etag，按弱比较忽略W/前缀
+// 不支持*，参数无效的请求没有可比较的结果
+func etagMatches(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil || etag == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+
+	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+func TestResultETag(t *testing.T) {
+	affix := services.NewAffixProbabilityService()
+	key := affix.CacheKey("", 4, "", []int{1, 4, 5, 6}, false)
+	base := resultETag(key, "zh-CN", "application/json")
+
+	tests := []struct {
+		name string
+		etag string
+		same bool
+	}{
+		{"reordered targets", resultETag(affix.CacheKey("", 4, "", []int{6, 5, 4, 1}, false), "zh-CN", "application/json"), true},
+		{"duplicate targets", resultETag(affix.CacheKey("", 4, "", []int{1, 1, 4, 5, 6}, false), "zh-CN", "application/json"), true},
+		{"latest pinned", resultETag(affix.CacheKey(internalModels.GameVersionLatest, 4, "", []int{1, 4, 5, 6}, false), "zh-CN", "application/json"), true},
+		{"other targets", resultETag(affix.CacheKey("", 4, "", []int{1, 4, 5}, false), "zh-CN", "application/json"), false},
+		{"other version", resultETag(affix.CacheKey("1.0", 4, "", []int{1, 4, 5, 6}, false), "zh-CN", "application/json"), false},
+		{"other locale", resultETag(key, "en", "application/json"), false},
+		{"other media type", resultETag(key, "zh-CN", "text/csv"), false},
+	}
+	for _, tt := range tests {
+		if (tt.etag == base) != tt.same {
+			t.Errorf("%s: etag = %s, base %s, want same=%v", tt.name, tt.etag, base, tt.same)
+		}
+	}
+
+	if etag := resultETag("", "zh-CN", "application/json"); etag != "" {
+		t.Errorf("resultETag without key = %s, want empty", etag)
+	}
+	if key := affix.CacheKey("0.0", 4, "", []int{1}, false); key != "" {
+		t.Errorf("CacheKey for an unknown version = %s, want empty", key)
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	const etag = `"abc"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch *string
+		etag        string
+		want        bool
+	}{
+		{"no header", nil, etag, false},
+		{"exact", swag.String(`"abc"`), etag, true},
+		{"weak", swag.String(`W/"abc"`), etag, true},
+		{"list", swag.String(`"x", "abc"`), etag, true},
+		{"other", swag.String(`"x"`), etag, false},
+		{"unquoted", swag.String(`abc`), etag, false},
+		{"wildcard unsupported", swag.String(`*`), etag, false},
+		{"no etag", swag.String(`""`), "", false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.ifNoneMatch, tt.etag); got != tt.want {
+			t.Errorf("%s: etagMatches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	internalJobs "github.com/SpenserCai/OnceHumanTools/backend/internal/jobs"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/jobs"
+)
+
+// eventStreamMime Server-Sent Events的媒体类型
+const eventStreamMime = "text/event-stream"
+
+// eventKeepAlive 事件流空闲时发送注释行的间隔，避免代理断开连接
+const eventKeepAlive = 15 * time.Second
+
+// StreamJobEvents 以Server-Sent Events推送任务进度，任务结束后关闭连接
+func (h *JobHandler) StreamJobEvents(params jobs.StreamJobEventsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	updates, unsubscribe, ok := h.manager.Subscribe(params.ID, callerID(principal))
+	if !ok {
+		return jobNotFound(params.HTTPRequest, params.ID, locale)
+	}
+	return &jobEventsResponder{
+		ctx:         params.HTTPRequest.Context(),
+		updates:     updates,
+		unsubscribe: unsubscribe,
+		locale:      locale,
+	}
+}
+
+// jobEventsResponder 任务事件流响应，直接写入事件而不使用生产者
+type jobEventsResponder struct {
+	ctx         context.Context
+	updates     <-chan internalJobs.Job
+	unsubscribe func()
+	locale      string
+}
+
+// WriteResponse 写入事件流，任务结束或客户端断开时返回
+func (r *jobEventsResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer r.unsubscribe()
+
+	// 事件流的持续时间可能超过服务器的写超时
+	controller := http.NewResponseController(rw)
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	header := rw.Header()
+	header.Set(runtime.HeaderContentType, eventStreamMime)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(rw, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case job, ok := <-r.updates:
+			if !ok {
+				return
+			}
+			event := "progress"
+			if job.Status.Finished() {
+				event = "completed"
+			}
+			data, err := json.Marshal(convertJob(job, r.locale))
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", event, data); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// 处理器消息的翻译key
+const (
+	msgAffixNotFound     = "error.affix_not_found"
+	msgUnknownCategory   = "error.unknown_category"
+	msgRateLimited       = "error.rate_limited"
+	msgJobNotFound       = "error.job_not_found"
+	msgJobQueueFull      = "error.job_queue_full"
+	msgJobNotFinished    = "error.job_not_finished"
+	msgJobFailed         = "error.job_failed"
+	msgJobCanceled       = "error.job_canceled"
+	msgJobTimeout        = "error.job_timeout"
+	msgJobRequired       = "error.job_required"
+	msgAPIKeyRequired    = "error.api_key_required"
+	msgInvalidAPIKey     = "error.invalid_api_key"
+	msgInsufficientScope = "error.insufficient_scope"
+	msgQuotaExceeded     = "error.quota_exceeded"
+	msgShuttingDown      = "error.shutting_down"
+	msgShareNotFound     = "error.share_not_found"
+	msgStorageDown       = "error.storage_unavailable"
+	msgModNotFound       = "error.mod_not_found"
+	msgInventoryFull     = "error.inventory_full"
+	msgGuildRequired     = "error.guild_required"
+	msgToolNotFound      = "error.tool_not_found"
+	msgInvalidInput      = "error.invalid_input"
+	msgToolFailed        = "error.tool_failed"
+	msgToolRestarting    = "error.tool_restarting"
+	msgToolTimeout       = "error.tool_timeout"
+
+	msgFieldRequired        = "error.field_required"
+	msgFieldInvalid         = "error.field_invalid"
+	msgFieldNotAllowed      = "error.field_not_allowed"
+	msgFieldOutOfRange      = "error.field_out_of_range"
+	msgFieldDuplicate       = "error.field_duplicate"
+	msgRouteNotFound        = "error.route_not_found"
+	msgMethodNotAllowed     = "error.method_not_allowed"
+	msgUnsupportedMediaType = "error.unsupported_media_type"
+	msgNotAcceptable        = "error.not_acceptable"
+	msgGuildNotAllowed      = "error.guild_not_allowed"
+)
+
+func init() {
+	i18n.Register("zh-CN", map[string]string{
+		msgAffixNotFound:     "词条 %d 不存在",
+		msgUnknownCategory:   "未知的词条分类: %s",
+		msgRateLimited:       "请求过于频繁，请在%d秒后重试",
+		msgJobNotFound:       "任务 %s 不存在或已过期",
+		msgJobQueueFull:      "任务队列已满，请稍后重试",
+		msgJobNotFinished:    "任务 %s 尚未完成",
+		msgJobFailed:         "任务 %s 执行失败",
+		msgJobCanceled:       "任务 %s 已取消",
+		msgJobTimeout:        "任务执行超时，已停止",
+		msgJobRequired:       "计算类型为%s时必须填写%s",
+		msgAPIKeyRequired:    "需要在X-API-Key请求头中提供API key",
+		msgInvalidAPIKey:     "API key无效或已吊销",
+		msgInsufficientScope: "API key没有%s权限",
+		msgQuotaExceeded:     "API key今日请求次数已达上限%d，请在%d秒后重试",
+		msgShuttingDown:      "服务正在停止，请稍后重试",
+		msgShareNotFound:     "分享 %s 不存在",
+		msgStorageDown:       "数据存储不可用，请稍后重试",
+		msgModNotFound:       "模组 %s 不存在",
+		msgInventoryFull:     "背包最多保存%d个模组",
+		msgGuildRequired:     "服务器预设需要提供X-Guild-ID",
+		msgToolNotFound:      "工具 %s 不存在或未启用",
+		msgInvalidInput:      "工具输入无效: %s",
+		msgToolFailed:        "工具 %s 运行失败",
+		msgToolRestarting:    "工具 %s 正在重新启动，请稍后重试",
+		msgToolTimeout:       "工具 %s 运行超时，已停止",
+
+		msgFieldRequired:        "缺少必填字段 %s",
+		msgFieldInvalid:         "字段 %s 的值无效",
+		msgFieldNotAllowed:      "字段 %s 必须是以下值之一: %s",
+		msgFieldOutOfRange:      "字段 %s 超出允许范围",
+		msgFieldDuplicate:       "字段 %s 包含重复的值",
+		msgRouteNotFound:        "接口 %s 不存在",
+		msgMethodNotAllowed:     "接口不支持%s方法",
+		msgUnsupportedMediaType: "不支持的请求格式: %s",
+		msgNotAcceptable:        "不支持的响应格式: %s",
+		msgGuildNotAllowed:      "API key不能管理服务器%s的预设",
+	})
+
+	i18n.Register("en", map[string]string{
+		msgAffixNotFound:     "Affix %d does not exist",
+		msgUnknownCategory:   "Unknown affix category: %s",
+		msgRateLimited:       "Too many requests, please retry in %d seconds",
+		msgJobNotFound:       "Job %s does not exist or has expired",
+		msgJobQueueFull:      "The job queue is full, please retry later",
+		msgJobNotFinished:    "Job %s has not finished yet",
+		msgJobFailed:         "Job %s failed",
+		msgJobCanceled:       "Job %s was canceled",
+		msgJobTimeout:        "The job timed out and was stopped",
+		msgJobRequired:       "%[2]s is required when the calculation type is %[1]s",
+		msgAPIKeyRequired:    "An API key is required in the X-API-Key header",
+		msgInvalidAPIKey:     "The API key is invalid or has been revoked",
+		msgInsufficientScope: "The API key does not have the %s scope",
+		msgQuotaExceeded:     "The API key has reached its daily limit of %d requests, please retry in %d seconds",
+		msgShuttingDown:      "The server is shutting down, please retry later",
+		msgShareNotFound:     "Share %s does not exist",
+		msgStorageDown:       "Storage is unavailable, please retry later",
+		msgModNotFound:       "Mod %s does not exist",
+		msgInventoryFull:     "The inventory can hold at most %d mods",
+		msgGuildRequired:     "X-Guild-ID is required for guild presets",
+		msgToolNotFound:      "Tool %s does not exist or is disabled",
+		msgInvalidInput:      "Invalid tool input: %s",
+		msgToolFailed:        "Tool %s failed",
+		msgToolRestarting:    "Tool %s is restarting, please retry later",
+		msgToolTimeout:       "Tool %s timed out and was stopped",
+
+		msgFieldRequired:        "%s is required",
+		msgFieldInvalid:         "Invalid value for %s",
+		msgFieldNotAllowed:      "%s must be one of: %s",
+		msgFieldOutOfRange:      "%s is out of range",
+		msgFieldDuplicate:       "%s contains duplicate values",
+		msgRouteNotFound:        "Route %s does not exist",
+		msgMethodNotAllowed:     "Method %s is not allowed",
+		msgUnsupportedMediaType: "Unsupported content type: %s",
+		msgNotAcceptable:        "Unsupported response format: %s",
+		msgGuildNotAllowed:      "This API key cannot manage presets of guild %s",
+
+		"tool.affix-probability.name":              "Mod Affix Probability Calculator",
+		"tool.affix-probability.description":       "Calculates the probability of a specific affix combination",
+		"tool.affix-value-probability.name":        "Mod Affix Value Probability Calculator",
+		"tool.affix-value-probability.description": "Calculates the probability of affix values meeting the requirements",
+		"tool.strengthen-probability.name":         "Mod Enhancement Probability Calculator",
+		"tool.strengthen-probability.description":  "Calculates the probability of enhancing mod affixes to target levels",
+	})
+}
+
+// resolveLocale 根据lang参数和Accept-Language请求头确定响应语言
+func resolveLocale(lang, acceptLanguage *string) string {
+	var l, a string
+	if lang != nil {
+		l = *lang
+	}
+	if acceptLanguage != nil {
+		a = *acceptLanguage
+	}
+	return i18n.Resolve(l, a)
+}
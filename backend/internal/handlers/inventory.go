@@ -0,0 +1,340 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/inventory"
+)
+
+// maxInventoryMods 每个玩家背包最多保存的模组数量，限制分析的计算量
+const maxInventoryMods = 500
+
+// defaultPlayerID 未指定X-Player-ID时的玩家
+const defaultPlayerID = "default"
+
+// InventoryHandler 玩家模组背包处理器，模组保存在数据库中，未启用数据库时返回503
+type InventoryHandler struct {
+	db                *store.DB
+	strengthenService *services.StrengthenProbabilityService
+}
+
+// NewInventoryHandler 创建背包处理器，db为nil表示未启用数据库
+func NewInventoryHandler(db *store.DB, resultCache *cache.Cache) *InventoryHandler {
+	return &InventoryHandler{
+		db:                db,
+		strengthenService: services.NewStrengthenProbabilityService().WithCache(resultCache),
+	}
+}
+
+// ListInventoryMods 获取背包中的模组
+func (h *InventoryHandler) ListInventoryMods(params inventory.ListInventoryModsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	mods, err := h.listMods(params.HTTPRequest, inventoryOwner(principal, params.XPlayerID), params.Type)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+
+	payload := &models.InventoryModListResponse{
+		Mods:  make([]*models.InventoryMod, len(mods)),
+		Total: swag.Int32(int32(len(mods))),
+	}
+	for i, mod := range mods {
+		payload.Mods[i] = convertInventoryMod(mod)
+	}
+	return inventory.NewListInventoryModsOK().WithPayload(payload)
+}
+
+// CreateInventoryMod 添加模组
+func (h *InventoryHandler) CreateInventoryMod(params inventory.CreateInventoryModParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	mod, err := newStoreMod(params.Body)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+	mod.Owner = inventoryOwner(principal, params.XPlayerID)
+
+	count, err := h.db.Mods().Count(ctx, mod.Owner)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	if count >= maxInventoryMods {
+		err := services.NewError(services.ErrCodeInventoryFull, "", msgInventoryFull, maxInventoryMods)
+		return newErrorResponse(params.HTTPRequest, http.StatusConflict, err, locale)
+	}
+
+	mod, err = h.db.Mods().Save(ctx, mod)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	logging.AddAttrs(ctx, slog.String("mod", mod.ID))
+
+	location, _ := (&inventory.GetInventoryModURL{ID: mod.ID}).Build()
+	return inventory.NewCreateInventoryModCreated().WithLocation(location.String()).WithPayload(convertInventoryMod(mod))
+}
+
+// GetInventoryMod 获取模组
+func (h *InventoryHandler) GetInventoryMod(params inventory.GetInventoryModParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	mod, err := h.db.Mods().Get(params.HTTPRequest.Context(), inventoryOwner(principal, params.XPlayerID), params.ID)
+	if err != nil {
+		return modError(params.HTTPRequest, err, params.ID, locale)
+	}
+	return inventory.NewGetInventoryModOK().WithPayload(convertInventoryMod(mod))
+}
+
+// UpdateInventoryMod 更新模组
+func (h *InventoryHandler) UpdateInventoryMod(params inventory.UpdateInventoryModParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+	logging.AddAttrs(ctx, slog.String("mod", params.ID))
+
+	owner := inventoryOwner(principal, params.XPlayerID)
+	existing, err := h.db.Mods().Get(ctx, owner, params.ID)
+	if err != nil {
+		return modError(params.HTTPRequest, err, params.ID, locale)
+	}
+
+	mod, err := newStoreMod(params.Body)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+	mod.ID = existing.ID
+	mod.Owner = owner
+	mod.CreatedAt = existing.CreatedAt
+
+	mod, err = h.db.Mods().Save(ctx, mod)
+	if err != nil {
+		return modError(params.HTTPRequest, err, params.ID, locale)
+	}
+	return inventory.NewUpdateInventoryModOK().WithPayload(convertInventoryMod(mod))
+}
+
+// DeleteInventoryMod 删除模组
+func (h *InventoryHandler) DeleteInventoryMod(params inventory.DeleteInventoryModParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	err := h.db.Mods().Delete(params.HTTPRequest.Context(), inventoryOwner(principal, params.XPlayerID), params.ID)
+	if err != nil {
+		return modError(params.HTTPRequest, err, params.ID, locale)
+	}
+	return inventory.NewDeleteInventoryModNoContent()
+}
+
+// AnalyzeInventory 分析背包中的模组：达成目标的概率、期望提升和建议分解的模组
+func (h *InventoryHandler) AnalyzeInventory(params inventory.AnalyzeInventoryParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	mods, err := h.listMods(params.HTTPRequest, inventoryOwner(principal, params.XPlayerID), params.Type)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+
+	target := services.InventoryTarget{
+		TargetLevels:     make([]int, len(params.TargetLevels)),
+		AffixIDs:         toInts(params.AffixIds),
+		OrderIndependent: swag.BoolValue(params.OrderIndependent),
+	}
+	for i, level := range params.TargetLevels {
+		target.TargetLevels[i] = int(level)
+	}
+
+	// targets中的词条和预设与affixIds合并
+	if targets := swag.StringValue(params.Targets); targets != "" {
+		lookup := presetLookup(h.db, principal, params.XPlayerID, params.XGuildID)
+		resolved, err := services.NewAffixSearchService().ResolveTargets(ctx, targets, lookup)
+		if err != nil {
+			return targetsError(params.HTTPRequest, err, locale)
+		}
+		seen := make(map[int]bool, len(target.AffixIDs))
+		for _, id := range target.AffixIDs {
+			seen[id] = true
+		}
+		for _, id := range resolved {
+			if !seen[id] {
+				seen[id] = true
+				target.AffixIDs = append(target.AffixIDs, id)
+			}
+		}
+	}
+
+	byID := make(map[string]store.Mod, len(mods))
+	inputs := make([]services.InventoryMod, len(mods))
+	for i, mod := range mods {
+		byID[mod.ID] = mod
+		inputs[i] = services.InventoryMod{
+			ID:               mod.ID,
+			Type:             mod.Type,
+			Rarity:           mod.Rarity,
+			AffixIDs:         make([]int, len(mod.Affixes)),
+			Levels:           make([]int, len(mod.Affixes)),
+			EnhancementsUsed: mod.EnhancementsUsed,
+		}
+		for j, affix := range mod.Affixes {
+			inputs[i].AffixIDs[j] = affix.AffixID
+			inputs[i].Levels[j] = affix.Level
+		}
+	}
+
+	gameVersion := swag.StringValue(params.GameVersion)
+	logging.AddAttrs(ctx, slog.Group("params",
+		slog.String("gameVersion", gameVersion),
+		slog.Any("targetLevels", target.TargetLevels),
+		slog.Any("affixIds", target.AffixIDs),
+		slog.Bool("orderIndependent", target.OrderIndependent),
+		slog.Int("mods", len(mods))))
+
+	result, err := h.strengthenService.AnalyzeInventory(ctx, gameVersion, inputs, target)
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
+	}
+
+	payload := &models.InventoryAnalysis{
+		GameVersion:      swag.String(result.GameVersion),
+		TargetLevels:     params.TargetLevels,
+		AffixIds:         toInt32s(target.AffixIDs),
+		OrderIndependent: swag.Bool(target.OrderIndependent),
+		Mods:             make([]*models.InventoryModOutlook, len(result.Mods)),
+		Scrap:            make([]*models.ScrapSuggestion, len(result.Scrap)),
+	}
+	for i, outlook := range result.Mods {
+		payload.Mods[i] = &models.InventoryModOutlook{
+			Mod:                convertInventoryMod(byID[outlook.ModID]),
+			EnhancementsLeft:   swag.Int32(int32(outlook.EnhancementsLeft)),
+			Probability:        swag.Float64(outlook.Probability),
+			ProbabilityPercent: swag.Float64(outlook.Probability * 100),
+			ExpectedLevels:     outlook.ExpectedLevels,
+			ExpectedGain:       swag.Float64(outlook.ExpectedGain),
+		}
+	}
+	for i, suggestion := range result.Scrap {
+		payload.Scrap[i] = &models.ScrapSuggestion{
+			ModID:       swag.String(suggestion.ModID),
+			Reason:      swag.String(suggestion.Reason),
+			BetterModID: suggestion.BetterModID,
+		}
+	}
+	return inventory.NewAnalyzeInventoryOK().WithPayload(payload)
+}
+
+// listMods 获取所有者的模组，modType不为空时只返回该类型的模组
+func (h *InventoryHandler) listMods(req *http.Request, owner string, modType *string) ([]store.Mod, error) {
+	mods, err := h.db.Mods().List(req.Context(), owner)
+	if err != nil || modType == nil {
+		return mods, err
+	}
+
+	filtered := mods[:0]
+	for _, mod := range mods {
+		if mod.Type == *modType {
+			filtered = append(filtered, mod)
+		}
+	}
+	return filtered, nil
+}
+
+// inventoryOwner 背包的所有者：请求的API key（匿名请求为anonymous）加上X-Player-ID指定的玩家
+func inventoryOwner(principal interface{}, playerID *string) string {
+	player := swag.StringValue(playerID)
+	if player == "" {
+		player = defaultPlayerID
+	}
+	return callerID(principal) + ":" + player
+}
+
+// callerID 调用方的API key ID，匿名访问时为anonymous
+func callerID(principal interface{}) string {
+	if key, _ := principal.(*auth.Key); key != nil {
+		return key.ID
+	}
+	return "anonymous"
+}
+
+// newStoreMod 校验请求中的模组并转换为存储模型，词条和等级按最新游戏版本的规则校验
+func newStoreMod(body *models.InventoryModInput) (store.Mod, error) {
+	mod := store.Mod{
+		Name:             body.Name,
+		Type:             swag.StringValue(body.Type),
+		Rarity:           swag.StringValue(body.Rarity),
+		Affixes:          make([]store.ModAffix, len(body.Affixes)),
+		EnhancementsUsed: int(swag.Int32Value(body.EnhancementsUsed)),
+	}
+	input := services.InventoryMod{
+		Rarity:           mod.Rarity,
+		AffixIDs:         make([]int, len(body.Affixes)),
+		Levels:           make([]int, len(body.Affixes)),
+		EnhancementsUsed: mod.EnhancementsUsed,
+	}
+	for i, affix := range body.Affixes {
+		mod.Affixes[i] = store.ModAffix{AffixID: int(swag.Int32Value(affix.AffixID)), Level: int(swag.Int32Value(affix.Level))}
+		input.AffixIDs[i] = mod.Affixes[i].AffixID
+		input.Levels[i] = mod.Affixes[i].Level
+	}
+	return mod, services.ValidateInventoryMod("", input)
+}
+
+// modError 模组不存在时返回404，其余为存储错误
+func modError(req *http.Request, err error, id, locale string) middleware.Responder {
+	if errors.Is(err, store.ErrNotFound) {
+		err := services.NewError(services.ErrCodeModNotFound, "id", msgModNotFound, id)
+		return newErrorResponse(req, http.StatusNotFound, err, locale)
+	}
+	return storageUnavailable(req, err, locale)
+}
+
+// convertInventoryMod 转换模组为API模型
+func convertInventoryMod(mod store.Mod) *models.InventoryMod {
+	createdAt := strfmt.DateTime(mod.CreatedAt)
+	updatedAt := strfmt.DateTime(mod.UpdatedAt)
+	out := &models.InventoryMod{
+		ID:               swag.String(mod.ID),
+		Name:             mod.Name,
+		Type:             swag.String(mod.Type),
+		Rarity:           swag.String(mod.Rarity),
+		Affixes:          make([]*models.InventoryModAffix, len(mod.Affixes)),
+		EnhancementsUsed: swag.Int32(int32(mod.EnhancementsUsed)),
+		CreatedAt:        &createdAt,
+		UpdatedAt:        &updatedAt,
+	}
+	for i, affix := range mod.Affixes {
+		out.Affixes[i] = &models.InventoryModAffix{
+			AffixID: swag.Int32(int32(affix.AffixID)),
+			Level:   swag.Int32(int32(affix.Level)),
+		}
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/inventory"
+)
+
+// openInventoryDB 打开临时的SQLite数据库，保存k1默认玩家的一个金色模组和用户预设mine
+func openInventoryDB(t *testing.T) *store.DB {
+	t.Helper()
+	ctx := context.Background()
+	db, err := store.Open(ctx, store.Options{Driver: store.DriverSQLite, DSN: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Migrate(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := inventoryOwner(&auth.Key{ID: "k1"}, nil)
+	mod := store.Mod{Owner: owner, Name: "m1", Type: "helmet", Rarity: "gold", Affixes: []store.ModAffix{
+		{AffixID: 4, Level: 1}, {AffixID: 5, Level: 1}, {AffixID: 6, Level: 1}, {AffixID: 7, Level: 1},
+	}}
+	if _, err := db.Mods().Save(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	preset := store.Preset{Scope: store.PresetScopeUser, Owner: owner, Name: "mine", Targets: []int32{7}}
+	if _, err := db.Presets().Save(ctx, preset); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestAnalyzeInventoryTargets(t *testing.T) {
+	h := NewInventoryHandler(openInventoryDB(t), nil)
+	principal := &auth.Key{ID: "k1"}
+
+	tests := []struct {
+		name     string
+		affixIDs []int32
+		targets  string
+		status   int
+		want     []int32
+	}{
+		{"affix ids only", []int32{5}, "", http.StatusOK, []int32{5}},
+		{"user preset and name", []int32{5}, "preset:mine, 4, 5", http.StatusOK, []int32{5, 7, 4}},
+		{"built-in preset", nil, "preset:elite-dps", http.StatusOK, []int32{4, 5, 6}},
+		{"unknown preset", nil, "preset:missing", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		params := inventory.AnalyzeInventoryParams{
+			HTTPRequest:      httptest.NewRequest(http.MethodGet, "/api/v1/inventory/analysis", nil),
+			TargetLevels:     []int32{2},
+			AffixIds:         tt.affixIDs,
+			OrderIndependent: swag.Bool(true),
+		}
+		if tt.targets != "" {
+			params.Targets = swag.String(tt.targets)
+		}
+
+		resp := h.AnalyzeInventory(params, principal)
+		if tt.status != http.StatusOK {
+			rec := httptest.NewRecorder()
+			resp.WriteResponse(rec, nil)
+			if rec.Code != tt.status {
+				t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.status)
+			}
+			continue
+		}
+		ok, isOK := resp.(*inventory.AnalyzeInventoryOK)
+		if !isOK {
+			t.Errorf("%s: response %T, want AnalyzeInventoryOK", tt.name, resp)
+			continue
+		}
+		if !reflect.DeepEqual(ok.Payload.AffixIds, tt.want) {
+			t.Errorf("%s: affixIds = %v, want %v", tt.name, ok.Payload.AffixIds, tt.want)
+		}
+	}
+}
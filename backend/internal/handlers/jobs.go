@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	internalJobs "github.com/SpenserCai/OnceHumanTools/backend/internal/jobs"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/progress"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/jobs"
+)
+
+// JobHandler 异步计算任务处理器
+type JobHandler struct {
+	manager           *internalJobs.Manager
+	db                *store.DB
+	affixService      *services.AffixProbabilityService
+	affixValueService *services.AffixValueProbabilityService
+	strengthenService *services.StrengthenProbabilityService
+}
+
+// NewJobHandler 创建任务处理器，计算服务与同步接口共用resultCache，db用于查找保存的预设
+func NewJobHandler(manager *internalJobs.Manager, db *store.DB, resultCache *cache.Cache) *JobHandler {
+	return &JobHandler{
+		manager:           manager,
+		db:                db,
+		affixService:      services.NewAffixProbabilityService().WithCache(resultCache),
+		affixValueService: services.NewAffixValueProbabilityService().WithCache(resultCache),
+		strengthenService: services.NewStrengthenProbabilityService().WithCache(resultCache),
+	}
+}
+
+// jobOutput 任务的计算结果，获取结果时按请求语言转换为API模型
+type jobOutput func(result *models.JobResult, locale string)
+
+// SubmitJob 提交异步计算任务
+func (h *JobHandler) SubmitJob(params jobs.SubmitJobParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	lookup := presetLookup(h.db, principal, params.XPlayerID, params.XGuildID)
+	if resp := resolveAffixTargets(params.HTTPRequest, params.Body.AffixProbability, lookup, locale); resp != nil {
+		return resp
+	}
+	for _, item := range params.Body.Items {
+		if resp := resolveAffixTargets(params.HTTPRequest, item.AffixProbability, lookup, locale); resp != nil {
+			return resp
+		}
+	}
+	fn, err := h.newJobFunc(ctx, params.Body)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+
+	job, err := h.manager.Submit(ctx, *params.Body.Type, callerID(principal), fn)
+	if errors.Is(err, internalJobs.ErrClosed) {
+		return shuttingDown(params.HTTPRequest, locale)
+	}
+	if err != nil {
+		err := services.NewError(services.ErrCodeJobQueueFull, "", msgJobQueueFull)
+		return newErrorResponse(params.HTTPRequest, http.StatusServiceUnavailable, err, locale)
+	}
+
+	location, _ := (&jobs.GetJobURL{ID: job.ID}).Build()
+	return jobs.NewSubmitJobAccepted().WithLocation(location.String()).WithPayload(convertJob(job, locale))
+}
+
+// GetJob 获取任务状态
+func (h *JobHandler) GetJob(params jobs.GetJobParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	job, ok := h.manager.Get(params.ID, callerID(principal))
+	if !ok {
+		return jobNotFound(params.HTTPRequest, params.ID, locale)
+	}
+	return jobs.NewGetJobOK().WithPayload(convertJob(job, locale))
+}
+
+// CancelJob 取消任务
+func (h *JobHandler) CancelJob(params jobs.CancelJobParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	job, ok := h.manager.Cancel(params.ID, callerID(principal))
+	if !ok {
+		return jobNotFound(params.HTTPRequest, params.ID, locale)
+	}
+	return jobs.NewCancelJobOK().WithPayload(convertJob(job, locale))
+}
+
+// GetJobResult 获取任务结果，任务未成功完成时返回409
+func (h *JobHandler) GetJobResult(params jobs.GetJobResultParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	job, ok := h.manager.Get(params.ID, callerID(principal))
+	if !ok {
+		return jobNotFound(params.HTTPRequest, params.ID, locale)
+	}
+
+	var err error
+	switch job.Status {
+	case internalJobs.StatusSucceeded:
+	case internalJobs.StatusFailed:
+		err = services.NewError(services.ErrCodeJobFailed, "", msgJobFailed, job.ID)
+	case internalJobs.StatusCanceled:
+		err = services.NewError(services.ErrCodeJobCanceled, "", msgJobCanceled, job.ID)
+	default:
+		err = services.NewError(services.ErrCodeJobNotFinished, "", msgJobNotFinished, job.ID)
+	}
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusConflict, err, locale)
+	}
+
+	result := &models.JobResult{
+		ID:   &job.ID,
+		Type: &job.Type,
+	}
+	if output, ok := job.Result.(jobOutput); ok {
+		output(result, locale)
+	}
+	return jobs.NewGetJobResultOK().WithPayload(result)
+}
+
+// newJobFunc 按计算类型创建任务函数，参数在提交时转换并记入访问日志，计算时才校验
+func (h *JobHandler) newJobFunc(ctx context.Context, body *models.JobRequest) (internalJobs.Func, error) {
+	if *body.Type == models.JobRequestTypeBatch {
+		return h.newBatchJobFunc(ctx, body.Items)
+	}
+	item := &models.JobItem{
+		Type:                  body.Type,
+		AffixProbability:      body.AffixProbability,
+		AffixValueProbability: body.AffixValueProbability,
+		StrengthenProbability: body.StrengthenProbability,
+	}
+	return h.newCalculationFunc(ctx, item, "")
+}
+
+// newCalculationFunc 按计算类型创建单个计算的任务函数，prefix为缺少参数时出错字段的前缀
+func (h *JobHandler) newCalculationFunc(ctx context.Context, item *models.JobItem, prefix string) (internalJobs.Func, error) {
+	jobType := *item.Type
+	switch jobType {
+	case models.JobItemTypeAffixProbability:
+		if item.AffixProbability == nil {
+			return nil, newJobRequiredError(jobType, prefix+"affixProbability")
+		}
+		input := newAffixProbabilityInput(item.AffixProbability)
+		input.logParams(ctx)
+		return func(ctx context.Context) (interface{}, error) {
+			result, err := input.calculate(ctx, h.affixService)
+			if err != nil {
+				return nil, err
+			}
+			return jobOutput(func(out *models.JobResult, locale string) {
+				out.AffixProbability = convertAffixProbabilityResult(result, input.showCombinations, locale)
+			}), nil
+		}, nil
+
+	case models.JobItemTypeAffixValueProbability:
+		if item.AffixValueProbability == nil {
+			return nil, newJobRequiredError(jobType, prefix+"affixValueProbability")
+		}
+		input := newAffixValueProbabilityInput(item.AffixValueProbability)
+		input.logParams(ctx)
+		return func(ctx context.Context) (interface{}, error) {
+			result, err := input.calculate(ctx, h.affixValueService)
+			if err != nil {
+				return nil, err
+			}
+			return jobOutput(func(out *models.JobResult, locale string) {
+				out.AffixValueProbability = convertAffixValueProbabilityResult(result)
+			}), nil
+		}, nil
+
+	default:
+		if item.StrengthenProbability == nil {
+			return nil, newJobRequiredError(jobType, prefix+"strengthenProbability")
+		}
+		input := newStrengthenProbabilityInput(item.StrengthenProbability)
+		input.logParams(ctx)
+		return func(ctx context.Context) (interface{}, error) {
+			result, err := input.calculate(ctx, h.strengthenService)
+			if err != nil {
+				return nil, err
+			}
+			return jobOutput(func(out *models.JobResult, locale string) {
+				out.StrengthenProbability = convertStrengthenProbabilityResult(result, input.showPaths, locale)
+			}), nil
+		}, nil
+	}
+}
+
+// newBatchJobFunc 创建批量计算任务，依次计算每一项，进度按已完成的项数计算
+// 单项计算失败时记入该项的结果，不影响其余计算；取消和超时结束整个任务
+func (h *JobHandler) newBatchJobFunc(ctx context.Context, items []*models.JobItem) (internalJobs.Func, error) {
+	if len(items) == 0 {
+		return nil, newJobRequiredError(models.JobRequestTypeBatch, "items")
+	}
+	fns := make([]internalJobs.Func, len(items))
+	for i, item := range items {
+		fn, err := h.newCalculationFunc(ctx, item, fmt.Sprintf("items[%d].", i))
+		if err != nil {
+			return nil, err
+		}
+		fns[i] = fn
+	}
+
+	return func(ctx context.Context) (interface{}, error) {
+		results := make([]interface{}, len(fns))
+		errs := make([]error, len(fns))
+		span := 1 / float64(len(fns))
+		for i, fn := range fns {
+			results[i], errs[i] = fn(progress.Span(ctx, span*float64(i), span))
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		return jobOutput(func(out *models.JobResult, locale string) {
+			out.Items = make([]*models.JobItemResult, len(items))
+			for i, item := range items {
+				itemResult := &models.JobItemResult{
+					Index: swag.Int32(int32(i)),
+					Type:  item.Type,
+				}
+				if output, ok := results[i].(jobOutput); ok {
+					var result models.JobResult
+					output(&result, locale)
+					itemResult.AffixProbability = result.AffixProbability
+					itemResult.AffixValueProbability = result.AffixValueProbability
+					itemResult.StrengthenProbability = result.StrengthenProbability
+				}
+				if errs[i] != nil {
+					itemResult.Error = convertJobError(errs[i], locale)
+				}
+				out.Items[i] = itemResult
+			}
+		}), nil
+	}, nil
+}
+
+// newJobRequiredError 创建缺少计算参数的错误
+func newJobRequiredError(jobType, field string) error {
+	return services.NewError(services.ErrCodeRequired, field, msgJobRequired, jobType, field)
+}
+
+// jobNotFound 任务不存在、已过期或由其他调用方提交的错误响应
+func jobNotFound(req *http.Request, id, locale string) middleware.Responder {
+	err := services.NewError(services.ErrCodeJobNotFound, "id", msgJobNotFound, id)
+	return newErrorResponse(req, http.StatusNotFound, err, locale)
+}
+
+// convertJob 转换任务状态为API模型
+func convertJob(job internalJobs.Job, locale string) *models.Job {
+	status := string(job.Status)
+	createdAt := strfmt.DateTime(job.CreatedAt)
+	response := &models.Job{
+		ID:        &job.ID,
+		Type:      &job.Type,
+		Status:    &status,
+		Progress:  &job.Progress,
+		Estimate:  job.Estimate,
+		CreatedAt: &createdAt,
+	}
+	if !job.StartedAt.IsZero() {
+		startedAt := strfmt.DateTime(job.StartedAt)
+		response.StartedAt = &startedAt
+	}
+	if !job.FinishedAt.IsZero() {
+		finishedAt := strfmt.DateTime(job.FinishedAt)
+		expiresAt := strfmt.DateTime(job.ExpiresAt)
+		response.FinishedAt = &finishedAt
+		response.ExpiresAt = &expiresAt
+	}
+	if job.Err != nil {
+		response.Error = convertJobError(job.Err, locale)
+	}
+	return response
+}
+
+// convertJobError 转换任务失败的原因，参数错误按400类别输出，超时和异常按500类别输出
+func convertJobError(err error, locale string) *models.ErrorResponse {
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = services.NewError(services.ErrCodeJobTimeout, "", msgJobTimeout)
+		return convertError(http.StatusInternalServerError, err, locale)
+	}
+
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) {
+		return convertError(http.StatusBadRequest, err, locale)
+	}
+	return convertError(http.StatusInternalServerError, err, locale)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/progress"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// affixJobItem 按全部词条计算的词条概率计算项
+func affixJobItem(slotCount int32, targets ...int32) *models.JobItem {
+	return &models.JobItem{
+		Type:             swag.String(models.JobItemTypeAffixProbability),
+		AffixProbability: &models.AffixProbabilityRequest{SlotCount: slotCount, TargetAffixIds: targets},
+	}
+}
+
+func TestBatchJob(t *testing.T) {
+	h := NewJobHandler(nil, nil, nil)
+	body := &models.JobRequest{
+		Type: swag.String(models.JobRequestTypeBatch),
+		Items: []*models.JobItem{affixJobItem(4, 1), affixJobItem(0, 1), {
+			Type: swag.String(models.JobItemTypeStrengthenProbability),
+			StrengthenProbability: &models.StrengthenProbabilityRequest{
+				Rarity:        "gold",
+				InitialLevels: []int32{1, 1, 1, 1},
+				TargetLevels:  []int32{3, 1, 1, 1},
+			},
+		}},
+	}
+	fn, err := h.newJobFunc(context.Background(), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 最后一项报告的进度映射到任务进度的最后三分之一
+	var reported []float64
+	ctx := progress.WithReporter(context.Background(), func(u progress.Update) {
+		reported = append(reported, u.Progress)
+	})
+	output, err := fn(ctx)
+	if err != nil {
+		t.Fatalf("batch job failed: %v", err)
+	}
+	if len(reported) == 0 {
+		t.Error("no progress reported")
+	}
+	for _, p := range reported {
+		if p < 2.0/3 || p > 1 {
+			t.Errorf("progress %v outside the last item's span", reported)
+			break
+		}
+	}
+
+	result := &models.JobResult{}
+	output.(jobOutput)(result, "en")
+	if len(result.Items) != 3 {
+		t.Fatalf("got %d item results, want 3", len(result.Items))
+	}
+	// 第二项的词条数量无效，只有该项失败
+	for i, item := range result.Items {
+		failed := i == 1
+		succeeded := item.AffixProbability != nil || item.StrengthenProbability != nil
+		if swag.Int32Value(item.Index) != int32(i) || (item.Error != nil) != failed || succeeded == failed {
+			t.Errorf("item %d: index %d, error %+v", i, swag.Int32Value(item.Index), item.Error)
+		}
+	}
+	if code := result.Items[1].Error; code == nil || code.Code != services.ErrCodeOutOfRange {
+		t.Errorf("item 1 error = %+v, want %s", code, services.ErrCodeOutOfRange)
+	}
+}
+
+func TestBatchJobRequired(t *testing.T) {
+	h := NewJobHandler(nil, nil, nil)
+	tests := []struct {
+		name  string
+		items []*models.JobItem
+		field string
+	}{
+		{"no items", nil, "items"},
+		{"missing body", []*models.JobItem{affixJobItem(4, 1), {Type: swag.String(models.JobItemTypeStrengthenProbability)}},
+			"items[1].strengthenProbability"},
+	}
+	for _, tt := range tests {
+		body := &models.JobRequest{Type: swag.String(models.JobRequestTypeBatch), Items: tt.items}
+		_, err := h.newJobFunc(context.Background(), body)
+		var serviceErr *services.Error
+		if !errors.As(err, &serviceErr) || serviceErr.Code != services.ErrCodeRequired || serviceErr.Field != tt.field {
+			t.Errorf("%s: newJobFunc() = %v, want required %s", tt.name, err, tt.field)
+		}
+	}
+}
@@ -1,44 +1,58 @@
 package handlers
 
 import (
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/export"
 	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
 	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
 	"github.com/SpenserCai/OnceHumanTools/backend/models"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/mod"
 )
 
 // ModHandler 模组处理器
 type ModHandler struct {
+	db                *store.DB
 	affixService      *services.AffixProbabilityService
+	affixValueService *services.AffixValueProbabilityService
 	strengthenService *services.StrengthenProbabilityService
+	searchService     *services.AffixSearchService
 }
 
-// NewModHandler 创建模组处理器
-func NewModHandler() *ModHandler {
+// NewModHandler 创建模组处理器，计算服务共用resultCache缓存结果，nil表示不缓存；db用于查找保存的预设，nil表示只有内置预设
+func NewModHandler(db *store.DB, resultCache *cache.Cache) *ModHandler {
 	return &ModHandler{
-		affixService:      services.NewAffixProbabilityService(),
-		strengthenService: services.NewStrengthenProbabilityService(),
+		db:                db,
+		affixService:      services.NewAffixProbabilityService().WithCache(resultCache),
+		affixValueService: services.NewAffixValueProbabilityService().WithCache(resultCache),
+		strengthenService: services.NewStrengthenProbabilityService().WithCache(resultCache),
+		searchService:     services.NewAffixSearchService(),
 	}
 }
 
 // ListAffixes 获取词条列表
-func (h *ModHandler) ListAffixes(params mod.ListAffixesParams) middleware.Responder {
-	affixes := internalModels.GetAllAffixes()
+func (h *ModHandler) ListAffixes(params mod.ListAffixesParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	catalog, gameVersion := resolveCatalog(params.GameVersion)
+	if catalog == nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, services.NewUnknownGameVersionError(gameVersion), locale)
+	}
+
+	affixes := catalog.Affixes()
+	if params.Category != nil && *params.Category != "" {
+		affixes = catalog.AffixesByCategory(internalModels.AffixCategory(*params.Category))
+	}
 
 	// 转换为API模型
 	affixList := make([]*models.Affix, 0, len(affixes))
 	for _, affix := range affixes {
-		id := int32(affix.ID)
-		name := affix.Name
-		category := affix.Category
-		affixList = append(affixList, &models.Affix{
-			ID:          &id,
-			Name:        &name,
-			Description: affix.Description,
-			Category:    category,
-		})
+		affixList = append(affixList, convertAffix(affix, locale))
 	}
 
 	total := int32(len(affixList))
@@ -50,139 +64,254 @@ func (h *ModHandler) ListAffixes(params mod.ListAffixesParams) middleware.Respon
 	return mod.NewListAffixesOK().WithPayload(response)
 }
 
-// CalculateAffixProbability 计算词条概率
-func (h *ModHandler) CalculateAffixProbability(params mod.CalculateAffixProbabilityParams) middleware.Responder {
-	// 转换参数
-	slotCount := int(*params.Body.SlotCount)
-	targetAffixIDs := make([]int, len(params.Body.TargetAffixIds))
-	for i, id := range params.Body.TargetAffixIds {
-		targetAffixIDs[i] = int(id)
+// GetAffix 获取词条详情
+func (h *ModHandler) GetAffix(params mod.GetAffixParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	catalog, gameVersion := resolveCatalog(params.GameVersion)
+	if catalog == nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, services.NewUnknownGameVersionError(gameVersion), locale)
 	}
 
-	showCombinations := false
-	if params.Body.ShowCombinations != nil {
-		showCombinations = *params.Body.ShowCombinations
+	affix := catalog.AffixByID(int(params.ID))
+	if affix == nil {
+		err := services.NewError(services.ErrCodeAffixNotFound, "id", msgAffixNotFound, params.ID)
+		return newErrorResponse(params.HTTPRequest, http.StatusNotFound, err, locale)
 	}
 
-	// 调用服务计算
-	result := h.affixService.CalculateProbability(slotCount, targetAffixIDs, showCombinations)
+	return mod.NewGetAffixOK().WithPayload(convertAffix(*affix, locale))
+}
 
-	// 检查错误
-	if result.Error != "" {
-		errorMsg := result.Error
-		error := "bad_request"
-		return mod.NewCalculateAffixProbabilityBadRequest().WithPayload(&models.ErrorResponse{
-			Error:   &error,
-			Message: &errorMsg,
+// SearchAffixes 搜索词条
+func (h *ModHandler) SearchAffixes(params mod.SearchAffixesParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	var query, category string
+	if params.Q != nil {
+		query = *params.Q
+	}
+	if params.Category != nil {
+		category = *params.Category
+	}
+
+	if category != "" && !isValidAffixCategory(category) {
+		err := services.NewError(services.ErrCodeUnknownCategory, "category", msgUnknownCategory, category).WithAllowed(affixCategoryIDs()...)
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+
+	limit := 10
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	matches := h.searchService.Search(query, category, limit)
+
+	// 转换为API模型
+	results := make([]*models.AffixSearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, &models.AffixSearchResult{
+			Affix:     convertAffix(match.Affix, locale),
+			Score:     int32(match.Score),
+			MatchedBy: match.MatchedBy,
 		})
 	}
 
-	// 转换结果
-	slotCount32 := int32(result.SlotCount)
-	targetRange := make([]int32, len(result.TargetRange))
-	for i, id := range result.TargetRange {
-		targetRange[i] = int32(id)
+	response := &models.AffixSearchResponse{
+		Results: results,
+		Total:   int32(len(results)),
 	}
 
-	response := &models.AffixProbabilityResponse{
-		Probability:        &result.Probability,
-		ProbabilityPercent: &result.ProbabilityPercent,
-		TotalCombinations:  &result.TotalCombinations,
-		ValidCombinations:  &result.ValidCombinations,
-		SlotCount:          slotCount32,
-		TargetRange:        targetRange,
+	return mod.NewSearchAffixesOK().WithPayload(response)
+}
+
+// ListRarities 获取稀有度列表
+func (h *ModHandler) ListRarities(params mod.ListRaritiesParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	catalog, gameVersion := resolveCatalog(params.GameVersion)
+	if catalog == nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, services.NewUnknownGameVersionError(gameVersion), locale)
 	}
 
-	// 添加组合数据
-	if showCombinations && len(result.Combinations) > 0 {
-		combinations := make([][]int32, len(result.Combinations))
-		for i, combo := range result.Combinations {
-			combinations[i] = make([]int32, len(combo))
-			for j, id := range combo {
-				combinations[i][j] = int32(id)
-			}
+	rarities := catalog.Rarities()
+
+	// 转换为API模型
+	rarityList := make([]*models.Rarity, 0, len(rarities))
+	for _, rarity := range rarities {
+		rarity = rarity.Localize(locale)
+		id := rarity.ID
+		name := rarity.Name
+		affixPool := make([]int32, len(rarity.AffixPool))
+		for i, affixID := range rarity.AffixPool {
+			affixPool[i] = int32(affixID)
 		}
-		response.Combinations = combinations
+		rarityList = append(rarityList, &models.Rarity{
+			ID:              &id,
+			Name:            &name,
+			DropWeight:      rarity.DropWeight,
+			SlotCount:       int32(rarity.SlotCount),
+			AffixPool:       affixPool,
+			MinStartLevel:   int32(rarity.MinStartLevel),
+			MaxStartLevel:   int32(rarity.MaxStartLevel),
+			MaxLevel:        int32(rarity.MaxLevel),
+			MaxEnhancements: int32(rarity.MaxEnhancements),
+		})
+	}
+
+	response := &models.RarityListResponse{
+		Rarities: rarityList,
+		Total:    int32(len(rarityList)),
 	}
 
-	return mod.NewCalculateAffixProbabilityOK().WithPayload(response)
+	return mod.NewListRaritiesOK().WithPayload(response)
 }
 
-// CalculateStrengthenProbability 计算强化概率
-func (h *ModHandler) CalculateStrengthenProbability(params mod.CalculateStrengthenProbabilityParams) middleware.Responder {
-	// 转换参数
-	initialLevels := make([]int, len(params.Body.InitialLevels))
-	for i, level := range params.Body.InitialLevels {
-		initialLevels[i] = int(level)
+// CalculateAffixProbability 计算词条概率
+func (h *ModHandler) CalculateAffixProbability(params mod.CalculateAffixProbabilityParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	lookup := presetLookup(h.db, principal, params.XPlayerID, params.XGuildID)
+	if resp := resolveAffixTargets(params.HTTPRequest, params.Body, lookup, locale); resp != nil {
+		return resp
+	}
+	input := newAffixProbabilityInput(params.Body)
+	input.logParams(ctx)
+
+	// 结果未变化时直接返回304
+	etag := resultETag(input.cacheKey(h.affixService), locale, export.MediaType(params.HTTPRequest))
+	if etagMatches(params.IfNoneMatch, etag) {
+		return mod.NewCalculateAffixProbabilityNotModified().WithETag(etag)
 	}
 
-	targetLevels := make([]int, len(params.Body.TargetLevels))
-	for i, level := range params.Body.TargetLevels {
-		targetLevels[i] = int(level)
+	// 调用服务计算
+	result, err := input.calculate(ctx, h.affixService)
+
+	// 检查错误
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
 	}
 
-	orderIndependent := true
-	if params.Body.OrderIndependent != nil {
-		orderIndependent = *params.Body.OrderIndependent
+	response := convertAffixProbabilityResult(result, input.showCombinations, locale)
+	return mod.NewCalculateAffixProbabilityOK().WithETag(etag).WithPayload(response)
+}
+
+// CalculateAffixValueProbability 计算词条数值概率
+func (h *ModHandler) CalculateAffixValueProbability(params mod.CalculateAffixValueProbabilityParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	input := newAffixValueProbabilityInput(params.Body)
+	input.logParams(ctx)
+
+	// 结果未变化时直接返回304
+	etag := resultETag(input.cacheKey(h.affixValueService), locale, export.MediaType(params.HTTPRequest))
+	if etagMatches(params.IfNoneMatch, etag) {
+		return mod.NewCalculateAffixValueProbabilityNotModified().WithETag(etag)
 	}
 
-	showPaths := false
-	if params.Body.ShowPaths != nil {
-		showPaths = *params.Body.ShowPaths
+	// 调用服务计算
+	result, err := input.calculate(ctx, h.affixValueService)
+
+	// 检查错误
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
+	}
+
+	response := convertAffixValueProbabilityResult(result)
+	return mod.NewCalculateAffixValueProbabilityOK().WithETag(etag).WithPayload(response)
+}
+
+// CalculateStrengthenProbability 计算强化概率
+func (h *ModHandler) CalculateStrengthenProbability(params mod.CalculateStrengthenProbabilityParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	input := newStrengthenProbabilityInput(params.Body)
+	input.logParams(ctx)
+
+	// 结果未变化时直接返回304
+	withTable := swag.BoolValue(params.Body.Table)
+	key := input.cacheKey(h.strengthenService)
+	if withTable && key != "" {
+		key += "|table"
+	}
+	etag := resultETag(key, locale, export.MediaType(params.HTTPRequest))
+	if etagMatches(params.IfNoneMatch, etag) {
+		return mod.NewCalculateStrengthenProbabilityNotModified().WithETag(etag)
 	}
 
 	// 调用服务计算
-	result := h.strengthenService.CalculateProbability(initialLevels, targetLevels, orderIndependent, showPaths)
+	result, err := input.calculate(ctx, h.strengthenService)
 
 	// 检查错误
-	if result.Error != "" {
-		errorMsg := result.Error
-		error := "bad_request"
-		return mod.NewCalculateStrengthenProbabilityBadRequest().WithPayload(&models.ErrorResponse{
-			Error:   &error,
-			Message: &errorMsg,
-		})
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
 	}
 
-	// 转换结果
-	response := &models.StrengthenProbabilityResponse{
-		Probability:        &result.Probability,
-		ProbabilityPercent: &result.ProbabilityPercent,
-		SuccessfulOutcomes: &result.SuccessfulOutcomes,
-		TotalOutcomes:      &result.TotalOutcomes,
-	}
-
-	// 添加路径数据
-	if showPaths && len(result.Paths) > 0 {
-		paths := make([]*models.StrengthenPath, 0, len(result.Paths))
-		for _, path := range result.Paths {
-			// 转换等级
-			finalLevels := make([]int32, len(path.FinalLevels))
-			for i, level := range path.FinalLevels {
-				finalLevels[i] = int32(level)
-			}
-
-			// 转换步骤
-			steps := make([]*models.StrengthenStep, 0, len(path.Steps))
-			for _, step := range path.Steps {
-				step32 := int32(step.Step)
-				slot32 := int32(step.Slot)
-				level32 := int32(step.NewLevel)
-				steps = append(steps, &models.StrengthenStep{
-					Step:     step32,
-					Slot:     slot32,
-					NewLevel: level32,
-				})
-			}
-
-			paths = append(paths, &models.StrengthenPath{
-				Success:     path.Success,
-				FinalLevels: finalLevels,
-				Steps:       steps,
-			})
+	response := convertStrengthenProbabilityResult(result, input.showPaths, locale)
+	if withTable {
+		table, err := h.strengthenService.CalculateTable(ctx, input.gameVersion, input.rarity)
+		if err != nil {
+			return calculationError(params.HTTPRequest, err, locale)
 		}
-		response.Paths = paths
+		response.Table = convertStrengthenTable(table)
 	}
+	return mod.NewCalculateStrengthenProbabilityOK().WithETag(etag).WithPayload(response)
+}
 
-	return mod.NewCalculateStrengthenProbabilityOK().WithPayload(response)
+// convertAffix 转换词条为API模型，名称和描述按语言输出
+func convertAffix(affix internalModels.Affix, locale string) *models.Affix {
+	affix = affix.Localize(locale)
+	id := int32(affix.ID)
+	name := affix.Name
+	return &models.Affix{
+		ID:          &id,
+		Name:        &name,
+		Description: affix.Description,
+		Category:    affix.Category,
+		Pinyin:      affix.Pinyin,
+		Aliases:     affix.Aliases,
+	}
+}
+
+// isValidAffixCategory 检查词条分类是否有效
+func isValidAffixCategory(category string) bool {
+	for _, c := range internalModels.GetAllAffixCategories() {
+		if string(c) == category {
+			return true
+		}
+	}
+	return false
+}
+
+// affixCategoryIDs 获取所有词条分类的ID
+func affixCategoryIDs() []string {
+	categories := internalModels.GetAllAffixCategories()
+	ids := make([]string, 0, len(categories))
+	for _, c := range categories {
+		ids = append(ids, string(c))
+	}
+	return ids
+}
+
+// convertRarityBreakdown 转换稀有度概率明细，稀有度名称按语言输出
+func convertRarityBreakdown(breakdown []services.RarityProbability, locale string) []*models.RarityProbability {
+	if len(breakdown) == 0 {
+		return nil
+	}
+
+	result := make([]*models.RarityProbability, 0, len(breakdown))
+	for _, item := range breakdown {
+		result = append(result, &models.RarityProbability{
+			Rarity:            item.Rarity,
+			Name:              internalModels.GetRarityName(item.Rarity, locale),
+			Weight:            item.Weight,
+			SlotCount:         int32(item.SlotCount),
+			Probability:       item.Probability,
+			TotalCombinations: item.TotalCombinations,
+			ValidCombinations: item.ValidCombinations,
+		})
+	}
+	return result
 }
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/preset"
+)
+
+// presetScopeBuiltin 内置预设的范围
+const presetScopeBuiltin = "builtin"
+
+// PresetHandler 目标词条预设处理器，玩家和服务器的预设保存在数据库中，未启用数据库时只有内置预设
+type PresetHandler struct {
+	db            *store.DB
+	searchService *services.AffixSearchService
+}
+
+// NewPresetHandler 创建预设处理器，db为nil表示未启用数据库
+func NewPresetHandler(db *store.DB) *PresetHandler {
+	return &PresetHandler{
+		db:            db,
+		searchService: services.NewAffixSearchService(),
+	}
+}
+
+// ListPresets 获取玩家、服务器和内置预设
+func (h *PresetHandler) ListPresets(params preset.ListPresetsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	var presets []*models.Preset
+	if h.db != nil {
+		for _, owner := range presetOwners(principal, params.XPlayerID, params.XGuildID) {
+			saved, err := h.db.Presets().List(ctx, owner.scope, owner.owner)
+			if err != nil {
+				return storageUnavailable(params.HTTPRequest, err, locale)
+			}
+			for _, p := range saved {
+				presets = append(presets, convertStorePreset(p, locale))
+			}
+		}
+	}
+	for _, p := range internalModels.LatestCatalog().Presets() {
+		presets = append(presets, convertBuiltinPreset(p, locale))
+	}
+
+	return preset.NewListPresetsOK().WithPayload(&models.PresetListResponse{
+		Presets: presets,
+		Total:   swag.Int32(int32(len(presets))),
+	})
+}
+
+// SavePreset 创建或更新玩家或服务器的预设
+func (h *PresetHandler) SavePreset(params preset.SavePresetParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+	logging.AddAttrs(ctx, slog.String("preset", params.Name))
+
+	owner, err := presetOwner(params.Scope, principal, params.XPlayerID, params.XGuildID)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+	if err := authorizePresetWrite(params.Scope, principal, params.XGuildID); err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusForbidden, err, locale)
+	}
+
+	ids := toInts(params.Body.TargetAffixIds)
+	if params.Body.Targets != "" {
+		lookup := presetLookup(h.db, principal, params.XPlayerID, params.XGuildID)
+		resolved, err := h.searchService.ResolveTargets(ctx, params.Body.Targets, lookup)
+		if err != nil {
+			return targetsError(params.HTTPRequest, err, locale)
+		}
+		ids = append(ids, resolved...)
+	}
+	ids, err = services.ValidatePreset(params.Name, ids)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+
+	record := store.Preset{Scope: params.Scope, Owner: owner, Name: params.Name, Targets: toInt32s(ids)}
+	existing, err := h.db.Presets().Get(ctx, params.Scope, owner, params.Name)
+	if err == nil {
+		record.CreatedAt = existing.CreatedAt
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	saved, err := h.db.Presets().Save(ctx, record)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	return preset.NewSavePresetOK().WithPayload(convertStorePreset(saved, locale))
+}
+
+// DeletePreset 删除玩家或服务器的预设
+func (h *PresetHandler) DeletePreset(params preset.DeletePresetParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+	logging.AddAttrs(ctx, slog.String("preset", params.Name))
+
+	owner, err := presetOwner(params.Scope, principal, params.XPlayerID, params.XGuildID)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+	if err := authorizePresetWrite(params.Scope, principal, params.XGuildID); err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusForbidden, err, locale)
+	}
+
+	err = h.db.Presets().Delete(ctx, params.Scope, owner, params.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		err := services.NewError(services.ErrCodePresetNotFound, "name", services.MsgPresetNotFound, params.Name)
+		return newErrorResponse(params.HTTPRequest, http.StatusNotFound, err, locale)
+	}
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	return preset.NewDeletePresetNoContent()
+}
+
+// scopedOwner 预设的范围和所有者
+type scopedOwner struct {
+	scope string
+	owner string
+}
+
+// presetOwners 按查找顺序返回预设的所有者：玩家，指定了X-Guild-ID时还有服务器
+func presetOwners(principal interface{}, playerID, guildID *string) []scopedOwner {
+	owners := []scopedOwner{{store.PresetScopeUser, inventoryOwner(principal, playerID)}}
+	if guild := swag.StringValue(guildID); guild != "" {
+		owners = append(owners, scopedOwner{store.PresetScopeGuild, callerID(principal) + ":" + guild})
+	}
+	return owners
+}
+
+// presetOwner 获取指定范围的预设所有者，服务器范围必须提供X-Guild-ID
+func presetOwner(scope string, principal interface{}, playerID, guildID *string) (string, error) {
+	if scope == store.PresetScopeUser {
+		return inventoryOwner(principal, playerID), nil
+	}
+	guild := swag.StringValue(guildID)
+	if guild == "" {
+		return "", services.NewError(services.ErrCodeRequired, "X-Guild-ID", msgGuildRequired)
+	}
+	return callerID(principal) + ":" + guild, nil
+}
+
+// authorizePresetWrite 检查能否修改预设：规范要求修改预设时携带key，服务器预设还需要key可以管理该服务器
+func authorizePresetWrite(scope string, principal interface{}, guildID *string) error {
+	if scope != store.PresetScopeGuild {
+		return nil
+	}
+	guild := swag.StringValue(guildID)
+	if key, _ := principal.(*auth.Key); !key.CanManageGuild(guild) {
+		return services.NewError(services.ErrCodeGuildNotAllowed, "X-Guild-ID", msgGuildNotAllowed, guild)
+	}
+	return nil
+}
+
+// presetLookup 按玩家、服务器的顺序查找保存的预设，未启用数据库时返回nil，只能使用内置预设
+func presetLookup(db *store.DB, principal interface{}, playerID, guildID *string) services.PresetLookup {
+	if db == nil {
+		return nil
+	}
+	owners := presetOwners(principal, playerID, guildID)
+	return func(ctx context.Context, name string) ([]int, bool, error) {
+		for _, owner := range owners {
+			p, err := db.Presets().Get(ctx, owner.scope, owner.owner, name)
+			if errors.Is(err, store.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, false, err
+			}
+			return toInts(p.Targets), true, nil
+		}
+		return nil, false, nil
+	}
+}
+
+// resolveAffixTargets 把请求中targets的词条和预设并入targetAffixIds，出错时返回错误响应
+func resolveAffixTargets(req *http.Request, body *models.AffixProbabilityRequest, lookup services.PresetLookup, locale string) middleware.Responder {
+	if err := mergeAffixTargets(req.Context(), body, lookup); err != nil {
+		return targetsError(req, err, locale)
+	}
+	return nil
+}
+
+// mergeAffixTargets 把targets的词条和预设并入targetAffixIds并清空targets，lookup为nil时只有内置预设
+func mergeAffixTargets(ctx context.Context, body *models.AffixProbabilityRequest, lookup services.PresetLookup) error {
+	if body == nil || body.Targets == "" {
+		return nil
+	}
+	resolved, err := services.NewAffixSearchService().ResolveTargets(ctx, body.Targets, lookup)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[int32]bool, len(body.TargetAffixIds))
+	for _, id := range body.TargetAffixIds {
+		seen[id] = true
+	}
+	for _, id := range resolved {
+		if !seen[int32(id)] {
+			seen[int32(id)] = true
+			body.TargetAffixIds = append(body.TargetAffixIds, int32(id))
+		}
+	}
+	body.Targets = ""
+	return nil
+}
+
+// targetsError 解析目标词条的错误，查找保存的预设失败时返回503
+func targetsError(req *http.Request, err error, locale string) middleware.Responder {
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) {
+		return newErrorResponse(req, http.StatusBadRequest, err, locale)
+	}
+	return storageUnavailable(req, err, locale)
+}
+
+// convertStorePreset 转换保存的预设为API模型
+func convertStorePreset(p store.Preset, locale string) *models.Preset {
+	createdAt := strfmt.DateTime(p.CreatedAt)
+	updatedAt := strfmt.DateTime(p.UpdatedAt)
+	return &models.Preset{
+		Name:           swag.String(p.Name),
+		Scope:          swag.String(p.Scope),
+		TargetAffixIds: p.Targets,
+		AffixNames:     affixNames(toInts(p.Targets), locale),
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+}
+
+// convertBuiltinPreset 转换内置预设为API模型
+func convertBuiltinPreset(p internalModels.Preset, locale string) *models.Preset {
+	p = p.Localize(locale)
+	return &models.Preset{
+		Name:           swag.String(p.ID),
+		Title:          p.Name,
+		Scope:          swag.String(presetScopeBuiltin),
+		TargetAffixIds: toInt32s(p.Targets),
+		AffixNames:     affixNames(p.Targets, locale),
+	}
+}
+
+// affixNames 获取词条的本地化名称
+func affixNames(ids []int, locale string) []string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = internalModels.GetAffixName(id, locale)
+	}
+	return names
+}
+
+// toInts 转换API模型的int32切片为整数切片
+func toInts(values []int32) []int {
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result
+}
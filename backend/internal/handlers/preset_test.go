@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+func TestAuthorizePresetWrite(t *testing.T) {
+	plain := &auth.Key{ID: "k1", Scopes: []string{auth.ScopeCalculate}}
+	guildKey := &auth.Key{ID: "k2", Scopes: []string{auth.ScopeCalculate}, Guilds: []string{"g1"}}
+	bot := &auth.Key{ID: "k3", Scopes: []string{auth.ScopeCalculate}, Guilds: []string{auth.AllGuilds}}
+	admin := &auth.Key{ID: "k4", Scopes: []string{auth.ScopeAdmin}}
+
+	tests := []struct {
+		name    string
+		scope   string
+		key     *auth.Key
+		guild   string
+		allowed bool
+	}{
+		{"user preset", store.PresetScopeUser, plain, "", true},
+		{"anonymous guild", store.PresetScopeGuild, nil, "g1", false},
+		{"key without guilds", store.PresetScopeGuild, plain, "g1", false},
+		{"own guild", store.PresetScopeGuild, guildKey, "g1", true},
+		{"other guild", store.PresetScopeGuild, guildKey, "g2", false},
+		{"all guilds", store.PresetScopeGuild, bot, "g2", true},
+		{"admin", store.PresetScopeGuild, admin, "g2", true},
+	}
+	for _, tt := range tests {
+		var principal interface{}
+		if tt.key != nil {
+			principal = tt.key
+		}
+		err := authorizePresetWrite(tt.scope, principal, swag.String(tt.guild))
+		var serviceErr *services.Error
+		switch {
+		case tt.allowed && err != nil:
+			t.Errorf("%s: authorizePresetWrite() = %v, want nil", tt.name, err)
+		case !tt.allowed && (!errors.As(err, &serviceErr) || serviceErr.Code != services.ErrCodeGuildNotAllowed):
+			t.Errorf("%s: authorizePresetWrite() = %v, want guild_not_allowed", tt.name, err)
+		}
+	}
+}
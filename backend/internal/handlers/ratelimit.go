@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/ratelimit"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// RateLimited 写入请求被限流的错误响应，语言按lang参数和Accept-Language请求头确定
+func RateLimited(w http.ResponseWriter, r *http.Request, result ratelimit.Result) {
+	locale := i18n.Resolve(r.URL.Query().Get("lang"), r.Header.Get("Accept-Language"))
+
+	retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+	err := services.NewError(services.ErrCodeRateLimited, "", msgRateLimited, retryAfter)
+
+	w.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+	newErrorResponse(r, http.StatusTooManyRequests, err, locale).WriteResponse(w, runtime.JSONProducer())
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/script"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/scripts"
+)
+
+// ScriptHandler Starlark脚本处理器
+type ScriptHandler struct {
+	opts script.Options
+}
+
+// NewScriptHandler 创建脚本处理器，opts为每次执行的步数和时间上限
+func NewScriptHandler(opts script.Options) *ScriptHandler {
+	return &ScriptHandler{opts: opts}
+}
+
+// RunScript 在沙箱中执行脚本并返回main的结果
+func (h *ScriptHandler) RunScript(params scripts.RunScriptParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	req := params.HTTPRequest
+
+	var input json.RawMessage
+	if params.Body.Input != nil {
+		data, err := json.Marshal(params.Body.Input)
+		if err != nil {
+			return invalidToolInput(req, err.Error(), locale)
+		}
+		input = data
+	}
+
+	result, err := script.Run(req.Context(), script.Request{
+		Source:      swag.StringValue(params.Body.Source),
+		Input:       input,
+		GameVersion: params.Body.GameVersion,
+		Locale:      locale,
+	}, h.opts)
+	if err != nil {
+		return calculationError(req, err, locale)
+	}
+
+	steps := int64(result.Steps)
+	return scripts.NewRunScriptOK().WithPayload(&models.ScriptRunResponse{
+		Result:      result.Value,
+		Output:      result.Output,
+		Steps:       &steps,
+		GameVersion: swag.String(result.GameVersion),
+	})
+}
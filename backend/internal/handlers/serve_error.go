@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	openapiErrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/ratelimit"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// ServeError 写入go-openapi产生的错误响应：认证和授权错误、参数校验和解析错误、路由错误都转换为错误码，
+// 按请求语言和Accept输出ErrorResponse或ProblemDetails
+func ServeError(rw http.ResponseWriter, r *http.Request, err error) {
+	locale := i18n.Resolve(r.URL.Query().Get("lang"), r.Header.Get("Accept-Language"))
+
+	var authErr *authError
+	if errors.As(err, &authErr) {
+		if authErr.status == http.StatusUnauthorized {
+			rw.Header().Set("WWW-Authenticate", `APIKey header="`+ratelimit.APIKeyHeader+`"`)
+		}
+		if authErr.retryAfter > 0 {
+			rw.Header().Set("Retry-After", strconv.Itoa(authErr.retryAfter))
+		}
+		newErrorResponse(r, authErr.status, authErr.err, locale).WriteResponse(rw, runtime.JSONProducer())
+		return
+	}
+
+	var methodErr *openapiErrors.MethodNotAllowedError
+	if errors.As(err, &methodErr) {
+		rw.Header().Set("Allow", strings.Join(methodErr.Allowed, ","))
+	}
+	status, serviceErr := convertOpenAPIError(r, err)
+	newErrorResponse(r, status, serviceErr, locale).WriteResponse(rw, runtime.JSONProducer())
+}
+
+// convertOpenAPIError 转换go-openapi的错误为HTTP状态码和服务错误；组合错误只输出第一个错误，
+// 校验错误（go-openapi的6xx错误码和422）按400返回
+func convertOpenAPIError(r *http.Request, err error) (int, error) {
+	err = firstError(err)
+
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) {
+		return http.StatusBadRequest, serviceErr
+	}
+
+	var validation *openapiErrors.Validation
+	if errors.As(err, &validation) {
+		return convertValidation(validation)
+	}
+
+	var parseErr *openapiErrors.ParseError
+	if errors.As(err, &parseErr) {
+		return http.StatusBadRequest, services.NewError(services.ErrCodeInvalidValue, parseErr.Name, msgFieldInvalid, parseErr.Name)
+	}
+
+	var methodErr *openapiErrors.MethodNotAllowedError
+	if errors.As(err, &methodErr) {
+		return http.StatusMethodNotAllowed,
+			services.NewError(services.ErrCodeMethodNotAllowed, "", msgMethodNotAllowed, r.Method).WithAllowed(methodErr.Allowed...)
+	}
+
+	var apiErr openapiErrors.Error
+	if errors.As(err, &apiErr) {
+		switch status := httpStatus(apiErr.Code()); status {
+		case http.StatusNotFound:
+			return status, services.NewError(services.ErrCodeNotFound, "", msgRouteNotFound, r.URL.Path)
+		default:
+			return status, err
+		}
+	}
+	return http.StatusInternalServerError, err
+}
+
+// convertValidation 转换参数校验错误，Content-Type和Accept不受支持时分别返回415和406
+func convertValidation(v *openapiErrors.Validation) (int, error) {
+	field := v.Name
+	allowed := validationValues(v.Values)
+
+	switch v.Code() {
+	case http.StatusUnsupportedMediaType:
+		return http.StatusUnsupportedMediaType,
+			services.NewError(services.ErrCodeUnsupportedMediaType, field, msgUnsupportedMediaType, fmt.Sprint(v.Value)).WithAllowed(allowed...)
+	case http.StatusNotAcceptable:
+		return http.StatusNotAcceptable,
+			services.NewError(services.ErrCodeNotAcceptable, field, msgNotAcceptable, fmt.Sprint(v.Value)).WithAllowed(allowed...)
+	case openapiErrors.RequiredFailCode:
+		return http.StatusBadRequest, services.NewError(services.ErrCodeRequired, field, msgFieldRequired, field)
+	case openapiErrors.EnumFailCode:
+		return http.StatusBadRequest,
+			services.NewError(services.ErrCodeInvalidValue, field, msgFieldNotAllowed, field, strings.Join(allowed, ", ")).WithAllowed(allowed...)
+	case openapiErrors.MaxFailCode, openapiErrors.MinFailCode, openapiErrors.TooLongFailCode, openapiErrors.TooShortFailCode,
+		openapiErrors.MaxItemsFailCode, openapiErrors.MinItemsFailCode:
+		return http.StatusBadRequest, services.NewError(services.ErrCodeOutOfRange, field, msgFieldOutOfRange, field)
+	case openapiErrors.UniqueFailCode:
+		return http.StatusBadRequest, services.NewError(services.ErrCodeDuplicate, field, msgFieldDuplicate, field)
+	default:
+		return http.StatusBadRequest, services.NewError(services.ErrCodeInvalidValue, field, msgFieldInvalid, field)
+	}
+}
+
+// firstError 展开组合错误，返回第一个错误
+func firstError(err error) error {
+	for {
+		var composite *openapiErrors.CompositeError
+		if !errors.As(err, &composite) || len(composite.Errors) == 0 {
+			return err
+		}
+		err = composite.Errors[0]
+	}
+}
+
+// validationValues 转换校验错误中允许的取值
+func validationValues(values []interface{}) []string {
+	allowed := make([]string, 0, len(values))
+	for _, v := range values {
+		allowed = append(allowed, fmt.Sprint(v))
+	}
+	return allowed
+}
+
+// httpStatus go-openapi的错误码大于等于600时为校验错误，按400处理
+func httpStatus(code int32) int {
+	if code >= 600 || code == http.StatusUnprocessableEntity {
+		return http.StatusBadRequest
+	}
+	return int(code)
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapiErrors "github.com/go-openapi/errors"
+)
+
+func TestServeError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		code    string
+		field   string
+		allowed []string
+	}{
+		{
+			name:   "required",
+			err:    openapiErrors.CompositeValidationError(openapiErrors.Required("targetLevels", "body", nil)),
+			status: http.StatusBadRequest, code: "required", field: "targetLevels",
+		},
+		{
+			name:   "enum",
+			err:    openapiErrors.EnumFail("format", "query", "xml", []interface{}{"json", "csv"}),
+			status: http.StatusBadRequest, code: "invalid_value", field: "format", allowed: []string{"json", "csv"},
+		},
+		{
+			name:   "maximum",
+			err:    openapiErrors.ExceedsMaximumInt("slotCount", "body", 10, false, 11),
+			status: http.StatusBadRequest, code: "out_of_range", field: "slotCount",
+		},
+		{
+			name:   "unique",
+			err:    openapiErrors.DuplicateItems("targetAffixIds", "body"),
+			status: http.StatusBadRequest, code: "duplicate", field: "targetAffixIds",
+		},
+		{
+			name:   "invalid type",
+			err:    openapiErrors.InvalidType("slotCount", "body", "integer", "x"),
+			status: http.StatusBadRequest, code: "invalid_value", field: "slotCount",
+		},
+		{
+			name:   "parse",
+			err:    openapiErrors.NewParseError("body", "body", "", errors.New("unexpected EOF")),
+			status: http.StatusBadRequest, code: "invalid_value", field: "body",
+		},
+		{
+			name: "nested composite",
+			err: openapiErrors.CompositeValidationError(
+				openapiErrors.CompositeValidationError(openapiErrors.Required("rarity", "body", nil)),
+				openapiErrors.Required("slotCount", "body", nil)),
+			status: http.StatusBadRequest, code: "required", field: "rarity",
+		},
+		{
+			name:   "content type",
+			err:    openapiErrors.InvalidContentType("text/plain", []string{"application/json"}),
+			status: http.StatusUnsupportedMediaType, code: "unsupported_media_type", field: "Content-Type", allowed: []string{"application/json"},
+		},
+		{
+			name:   "response format",
+			err:    openapiErrors.InvalidResponseFormat("text/xml", []string{"application/json"}),
+			status: http.StatusNotAcceptable, code: "not_acceptable", field: "Accept", allowed: []string{"application/json"},
+		},
+		{
+			name:   "method",
+			err:    openapiErrors.MethodNotAllowed("DELETE", []string{"POST"}),
+			status: http.StatusMethodNotAllowed, code: "method_not_allowed", allowed: []string{"POST"},
+		},
+		{
+			name:   "route",
+			err:    openapiErrors.NotFound("path not found"),
+			status: http.StatusNotFound, code: "not_found",
+		},
+		{
+			name:   "unknown",
+			err:    errors.New("boom"),
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, problem := range []bool{false, true} {
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/mod/affix/probability", nil)
+			if problem {
+				req.Header.Set("Accept", problemMime)
+			}
+			rec := httptest.NewRecorder()
+			ServeError(rec, req, tt.err)
+
+			if rec.Code != tt.status {
+				t.Errorf("%s (problem=%v): status = %d, want %d", tt.name, problem, rec.Code, tt.status)
+			}
+			var body struct {
+				Code    string   `json:"code"`
+				Field   string   `json:"field"`
+				Allowed []string `json:"allowed"`
+				Details struct {
+					Field   string   `json:"field"`
+					Allowed []string `json:"allowed"`
+				} `json:"details"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("%s: invalid JSON %q: %v", tt.name, rec.Body.String(), err)
+			}
+			field, allowed := body.Details.Field, body.Details.Allowed
+			if problem {
+				field, allowed = body.Field, body.Allowed
+			}
+			if body.Code != tt.code || field != tt.field || len(allowed) != len(tt.allowed) {
+				t.Errorf("%s (problem=%v): got code=%q field=%q allowed=%v, want %q %q %v",
+					tt.name, problem, body.Code, field, allowed, tt.code, tt.field, tt.allowed)
+			}
+		}
+	}
+}
+
+func TestServeErrorAllowHeader(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ServeError(rec, httptest.NewRequest(http.MethodDelete, "/", nil), openapiErrors.MethodNotAllowed("DELETE", []string{"GET", "POST"}))
+	if got := rec.Header().Get("Allow"); got != "GET,POST" {
+		t.Errorf("Allow = %q, want GET,POST", got)
+	}
+}
@@ -0,0 +1,320 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/share"
+)
+
+// sharePathPrefix 网页中打开分享的路径前缀
+const sharePathPrefix = "/s/"
+
+// ShareHandler 计算分享处理器，分享保存在数据库中，未启用数据库时返回503
+type ShareHandler struct {
+	db                *store.DB
+	affixService      *services.AffixProbabilityService
+	affixValueService *services.AffixValueProbabilityService
+	strengthenService *services.StrengthenProbabilityService
+}
+
+// NewShareHandler 创建分享处理器，db为nil表示未启用数据库，计算服务与同步接口共用resultCache
+func NewShareHandler(db *store.DB, resultCache *cache.Cache) *ShareHandler {
+	return &ShareHandler{
+		db:                db,
+		affixService:      services.NewAffixProbabilityService().WithCache(resultCache),
+		affixValueService: services.NewAffixValueProbabilityService().WithCache(resultCache),
+		strengthenService: services.NewStrengthenProbabilityService().WithCache(resultCache),
+	}
+}
+
+// CreateShare 创建计算分享，先计算一次校验参数，相同的计算返回已有的分享
+func (h *ShareHandler) CreateShare(params share.CreateShareParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+
+	lookup := presetLookup(h.db, principal, params.XPlayerID, params.XGuildID)
+	if resp := resolveAffixTargets(params.HTTPRequest, params.Body.AffixProbability, lookup, locale); resp != nil {
+		return resp
+	}
+	request, gameVersion, err := normalizeShareRequest(params.Body)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, err, locale)
+	}
+	result, err := h.calculate(ctx, request, locale)
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusInternalServerError, err, locale)
+	}
+	record := store.Share{
+		ID:             shareID(data),
+		Kind:           *request.Type,
+		CatalogVersion: gameVersion,
+		Request:        data,
+		CreatedAt:      time.Now().UTC(),
+	}
+	logging.AddAttrs(ctx, slog.String("share", record.ID))
+
+	created, err := h.db.Shares().Create(ctx, record)
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+	if !created {
+		existing, err := h.db.Shares().Get(ctx, record.ID)
+		if err != nil {
+			return storageUnavailable(params.HTTPRequest, err, locale)
+		}
+		// ID由请求摘要生成，已有的分享应是同一个计算
+		if stored, err := decodeShareRequest(existing); err != nil || !sameShareRequest(stored, request) {
+			err := fmt.Errorf("分享ID %s 冲突", record.ID)
+			return newErrorResponse(params.HTTPRequest, http.StatusInternalServerError, err, locale)
+		}
+		return share.NewCreateShareOK().WithPayload(convertShare(existing, request, result))
+	}
+
+	location, _ := (&share.GetShareURL{ID: record.ID}).Build()
+	return share.NewCreateShareCreated().WithLocation(location.String()).WithPayload(convertShare(record, request, result))
+}
+
+// GetShare 获取计算分享，按分享时的游戏版本重新计算结果
+func (h *ShareHandler) GetShare(params share.GetShareParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	ctx := params.HTTPRequest.Context()
+
+	if h.db == nil {
+		return storageUnavailable(params.HTTPRequest, nil, locale)
+	}
+	logging.AddAttrs(ctx, slog.String("share", params.ID))
+
+	record, err := h.db.Shares().Get(ctx, params.ID)
+	if errors.Is(err, store.ErrNotFound) {
+		err := services.NewError(services.ErrCodeShareNotFound, "id", msgShareNotFound, params.ID)
+		return newErrorResponse(params.HTTPRequest, http.StatusNotFound, err, locale)
+	}
+	if err != nil {
+		return storageUnavailable(params.HTTPRequest, err, locale)
+	}
+
+	request, err := decodeShareRequest(record)
+	if err != nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusInternalServerError, err, locale)
+	}
+	result, err := h.calculate(ctx, request, locale)
+	if err != nil {
+		return calculationError(params.HTTPRequest, err, locale)
+	}
+
+	return share.NewGetShareOK().WithPayload(convertShare(record, request, result))
+}
+
+// calculate 按计算类型计算分享的结果，参数记入访问日志
+func (h *ShareHandler) calculate(ctx context.Context, request *models.ShareRequest, locale string) (*models.ShareResult, error) {
+	out := &models.ShareResult{}
+	switch *request.Type {
+	case models.ShareRequestTypeAffixProbability:
+		input := newAffixProbabilityInput(request.AffixProbability)
+		input.logParams(ctx)
+		result, err := input.calculate(ctx, h.affixService)
+		if err != nil {
+			return nil, err
+		}
+		out.AffixProbability = convertAffixProbabilityResult(result, input.showCombinations, locale)
+
+	case models.ShareRequestTypeAffixValueProbability:
+		input := newAffixValueProbabilityInput(request.AffixValueProbability)
+		input.logParams(ctx)
+		result, err := input.calculate(ctx, h.affixValueService)
+		if err != nil {
+			return nil, err
+		}
+		out.AffixValueProbability = convertAffixValueProbabilityResult(result)
+
+	default:
+		input := newStrengthenProbabilityInput(request.StrengthenProbability)
+		input.logParams(ctx)
+		result, err := input.calculate(ctx, h.strengthenService)
+		if err != nil {
+			return nil, err
+		}
+		out.StrengthenProbability = convertStrengthenProbabilityResult(result, input.showPaths, locale)
+	}
+	return out, nil
+}
+
+// normalizeShareRequest 规范化分享的计算请求：游戏版本固定为实际使用的版本，可选参数填入默认值，
+// 与顺序和重复无关的目标词条排序去重（与缓存键相同），使相同的计算得到相同的ID；参数的合法性在计算时校验
+func normalizeShareRequest(body *models.ShareRequest) (*models.ShareRequest, string, error) {
+	shareType := *body.Type
+	normalized := &models.ShareRequest{Type: swag.String(shareType)}
+
+	switch shareType {
+	case models.ShareRequestTypeAffixProbability:
+		if body.AffixProbability == nil {
+			return nil, "", newJobRequiredError(shareType, "affixProbability")
+		}
+		input := newAffixProbabilityInput(body.AffixProbability)
+		gameVersion, err := pinGameVersion(input.gameVersion)
+		if err != nil {
+			return nil, "", err
+		}
+
+		targets := uniqueSortedIDs(input.targetAffixIDs)
+		slotCount := int32(input.slotCount)
+		if input.rarity != "" {
+			// 指定稀有度时词条数量由稀有度决定
+			slotCount = 0
+		}
+		normalized.AffixProbability = &models.AffixProbabilityRequest{
+			GameVersion:      gameVersion,
+			SlotCount:        slotCount,
+			Rarity:           input.rarity,
+			TargetAffixIds:   targets,
+			ShowCombinations: swag.Bool(input.showCombinations),
+		}
+		return normalized, gameVersion, nil
+
+	case models.ShareRequestTypeAffixValueProbability:
+		if body.AffixValueProbability == nil {
+			return nil, "", newJobRequiredError(shareType, "affixValueProbability")
+		}
+		input := newAffixValueProbabilityInput(body.AffixValueProbability)
+		gameVersion, err := pinGameVersion(input.gameVersion)
+		if err != nil {
+			return nil, "", err
+		}
+
+		requirements := make([]*models.AffixValueRequirement, len(input.requirements))
+		for i, req := range input.requirements {
+			requirements[i] = &models.AffixValueRequirement{
+				AffixID:  swag.Int32(int32(req.AffixID)),
+				MinValue: req.MinValue,
+				MinTier:  swag.Int32(int32(req.MinTier)),
+				TopTier:  swag.Bool(req.TopTier),
+			}
+		}
+		normalized.AffixValueProbability = &models.AffixValueProbabilityRequest{
+			GameVersion:  gameVersion,
+			SlotCount:    swag.Int32(int32(input.slotCount)),
+			Level:        int32(input.level),
+			Requirements: requirements,
+		}
+		return normalized, gameVersion, nil
+
+	default:
+		if body.StrengthenProbability == nil {
+			return nil, "", newJobRequiredError(shareType, "strengthenProbability")
+		}
+		input := newStrengthenProbabilityInput(body.StrengthenProbability)
+		gameVersion, err := pinGameVersion(input.gameVersion)
+		if err != nil {
+			return nil, "", err
+		}
+
+		normalized.StrengthenProbability = &models.StrengthenProbabilityRequest{
+			GameVersion:      gameVersion,
+			Rarity:           input.rarity,
+			InitialLevels:    toInt32s(input.initialLevels),
+			TargetLevels:     toInt32s(input.targetLevels),
+			OrderIndependent: swag.Bool(input.orderIndependent),
+			ShowPaths:        swag.Bool(input.showPaths),
+		}
+		return normalized, gameVersion, nil
+	}
+}
+
+// uniqueSortedIDs 目标词条排序并去重
+func uniqueSortedIDs(ids []int) []int32 {
+	set := make(map[int32]bool, len(ids))
+	targets := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		if !set[int32(id)] {
+			set[int32(id)] = true
+			targets = append(targets, int32(id))
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i] < targets[j] })
+	return targets
+}
+
+// pinGameVersion 把空的或latest游戏版本解析为当前最新版本的ID
+func pinGameVersion(gameVersion string) (string, error) {
+	catalog := internalModels.GetCatalog(gameVersion)
+	if catalog == nil {
+		return "", services.NewUnknownGameVersionError(gameVersion)
+	}
+	return catalog.Version.ID, nil
+}
+
+// shareID 由规范化请求的摘要生成12位URL安全的ID
+func shareID(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:9])
+}
+
+// decodeShareRequest 解析保存的计算请求
+func decodeShareRequest(record store.Share) (*models.ShareRequest, error) {
+	var request models.ShareRequest
+	if err := json.Unmarshal(record.Request, &request); err != nil {
+		return nil, fmt.Errorf("解析分享 %s 失败: %w", record.ID, err)
+	}
+	if request.Type == nil {
+		return nil, fmt.Errorf("分享 %s 缺少计算类型", record.ID)
+	}
+	return &request, nil
+}
+
+// sameShareRequest 比较两个规范化的请求，PostgreSQL的JSONB不保留原始格式，按重新序列化的结果比较
+func sameShareRequest(a, b *models.ShareRequest) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(dataA) == string(dataB)
+}
+
+// convertShare 转换分享为API模型
+func convertShare(record store.Share, request *models.ShareRequest, result *models.ShareResult) *models.Share {
+	createdAt := strfmt.DateTime(record.CreatedAt)
+	return &models.Share{
+		ID:          swag.String(record.ID),
+		Type:        swag.String(record.Kind),
+		GameVersion: swag.String(record.CatalogVersion),
+		Path:        swag.String(sharePathPrefix + record.ID),
+		Request:     request,
+		Result:      result,
+		CreatedAt:   &createdAt,
+	}
+}
+
+// toInt32s 转换整数切片为API模型使用的int32切片
+func toInt32s(values []int) []int32 {
+	result := make([]int32, len(values))
+	for i, v := range values {
+		result[i] = int32(v)
+	}
+	return result
+}
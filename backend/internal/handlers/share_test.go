@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/swag"
+
+	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// testShareID 规范化分享请求并生成分享ID
+func testShareID(t *testing.T, request *models.ShareRequest) string {
+	t.Helper()
+	normalized, _, err := normalizeShareRequest(request)
+	if err != nil {
+		t.Fatalf("normalizeShareRequest() = %v", err)
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shareID(data)
+}
+
+func affixShareRequest(gameVersion string, slotCount int32, rarity string, targets ...int32) *models.ShareRequest {
+	return &models.ShareRequest{
+		Type: swag.String(models.ShareRequestTypeAffixProbability),
+		AffixProbability: &models.AffixProbabilityRequest{
+			GameVersion:    gameVersion,
+			SlotCount:      slotCount,
+			Rarity:         rarity,
+			TargetAffixIds: targets,
+		},
+	}
+}
+
+func TestShareIDStable(t *testing.T) {
+	base := testShareID(t, affixShareRequest("", 4, "", 1, 4, 5, 6))
+
+	tests := []struct {
+		name    string
+		request *models.ShareRequest
+		same    bool
+	}{
+		{"reordered", affixShareRequest("", 4, "", 6, 5, 4, 1), true},
+		{"duplicates", affixShareRequest("", 4, "", 6, 5, 4, 1, 1), true},
+		{"all duplicated", affixShareRequest("", 4, "", 1, 1, 4, 4, 5, 5, 6, 6), true},
+		{"latest pinned", affixShareRequest(internalModels.GameVersionLatest, 4, "", 1, 4, 5, 6), true},
+		{"explicit version", affixShareRequest(internalModels.LatestCatalog().Version.ID, 4, "", 1, 4, 5, 6), true},
+		{"other targets", affixShareRequest("", 4, "", 1, 4, 5), false},
+		{"other slot count", affixShareRequest("", 3, "", 1, 4, 5, 6), false},
+		{"other version", affixShareRequest("1.0", 4, "", 1, 4, 5, 6), false},
+	}
+	for _, tt := range tests {
+		if got := testShareID(t, tt.request); (got == base) != tt.same {
+			t.Errorf("%s: shareID = %s, base %s, want same=%v", tt.name, got, base, tt.same)
+		}
+	}
+}
+
+func TestNormalizeShareRequestTargets(t *testing.T) {
+	normalized, _, err := normalizeShareRequest(affixShareRequest("", 4, "", 6, 5, 4, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := normalized.AffixProbability.TargetAffixIds
+	want := []int32{1, 4, 5, 6}
+	if len(got) != len(want) {
+		t.Fatalf("targets = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("targets = %v, want %v", got, want)
+		}
+	}
+}
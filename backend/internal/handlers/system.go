@@ -6,29 +6,77 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/buildinfo"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/health"
 	"github.com/SpenserCai/OnceHumanTools/backend/models"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/system"
 )
 
 // SystemHandler 系统处理器
-type SystemHandler struct{}
+type SystemHandler struct {
+	checker *health.Checker
+}
 
-// NewSystemHandler 创建系统处理器
-func NewSystemHandler() *SystemHandler {
-	return &SystemHandler{}
+// NewSystemHandler 创建系统处理器，checker用于就绪检查
+func NewSystemHandler(checker *health.Checker) *SystemHandler {
+	return &SystemHandler{checker: checker}
 }
 
-// HealthCheck 健康检查
+// HealthCheck 健康检查，与存活检查相同
 func (h *SystemHandler) HealthCheck(params system.HealthCheckParams) middleware.Responder {
-	status := "ok"
-	version := "1.0.0"
-	timestamp := strfmt.DateTime(time.Now())
+	return system.NewHealthCheckOK().WithPayload(newHealthResponse(models.HealthResponseStatusOk))
+}
+
+// LivenessCheck 存活检查，不检查组件
+func (h *SystemHandler) LivenessCheck(params system.LivenessCheckParams) middleware.Responder {
+	return system.NewLivenessCheckOK().WithPayload(newHealthResponse(models.HealthResponseStatusOk))
+}
+
+// ReadinessCheck 就绪检查，有组件不可用或服务正在停止时返回503
+func (h *SystemHandler) ReadinessCheck(params system.ReadinessCheckParams) middleware.Responder {
+	ready, components := h.checker.Check(params.HTTPRequest.Context())
+
+	status := models.HealthResponseStatusOk
+	switch {
+	case h.checker.Draining():
+		status = models.HealthResponseStatusDraining
+	case !ready:
+		status = models.HealthResponseStatusUnavailable
+	}
 
-	response := &models.HealthResponse{
+	response := newHealthResponse(status)
+	response.Components = make([]*models.HealthComponent, 0, len(components))
+	for _, component := range components {
+		name := component.Name
+		componentStatus := component.Status
+		response.Components = append(response.Components, &models.HealthComponent{
+			Name:      &name,
+			Status:    &componentStatus,
+			LatencyMs: float64(component.Latency.Microseconds()) / 1000,
+			Error:     component.Error,
+		})
+	}
+
+	if !ready {
+		return system.NewReadinessCheckServiceUnavailable().WithPayload(response)
+	}
+	return system.NewReadinessCheckOK().WithPayload(response)
+}
+
+// newHealthResponse 创建带有构建信息的健康检查响应
+func newHealthResponse(status string) *models.HealthResponse {
+	info := buildinfo.Get()
+	timestamp := strfmt.DateTime(time.Now())
+	return &models.HealthResponse{
 		Status:    &status,
 		Timestamp: &timestamp,
-		Version:   version,
+		Version:   info.Version,
+		Build: &models.BuildInfo{
+			Version:   &info.Version,
+			Commit:    info.Commit,
+			BuildTime: info.BuildTime,
+			Modified:  info.Modified,
+			GoVersion: &info.GoVersion,
+		},
 	}
-
-	return system.NewHealthCheckOK().WithPayload(response)
 }
@@ -1,52 +1,244 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/plugins"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/registry"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
 	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/tools"
 )
 
 // ToolsHandler 工具处理器
-type ToolsHandler struct{}
+type ToolsHandler struct {
+	registry *registry.Registry
+}
 
-// NewToolsHandler 创建工具处理器
-func NewToolsHandler() *ToolsHandler {
-	return &ToolsHandler{}
+// NewToolsHandler 创建工具处理器，工具列表由注册表生成
+func NewToolsHandler(reg *registry.Registry) *ToolsHandler {
+	return &ToolsHandler{registry: reg}
 }
 
 // ListTools 获取工具列表
-func (h *ToolsHandler) ListTools(params tools.ListToolsParams) middleware.Responder {
-	// 定义可用工具
-	toolList := []*models.Tool{
+func (h *ToolsHandler) ListTools(params tools.ListToolsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	registered := h.registry.Tools()
+	toolList := make([]*models.Tool, 0, len(registered))
+	for _, tool := range registered {
+		toolList = append(toolList, convertTool(tool, locale))
+	}
+
+	response := &models.ToolsListResponse{
+		Tools:      toolList,
+		Categories: h.registry.Categories(),
+	}
+
+	return tools.NewListToolsOK().WithPayload(response)
+}
+
+// RunTool 按输入模式校验输入后运行工具
+func (h *ToolsHandler) RunTool(params tools.RunToolParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	req := params.HTTPRequest
+
+	tool, ok := h.registry.Get(params.ID)
+	if !ok {
+		err := services.NewError(services.ErrCodeToolNotFound, "id", msgToolNotFound, params.ID)
+		return newErrorResponse(req, http.StatusNotFound, err, locale)
+	}
+
+	if err := validateToolInput(tool.InputSchema, params.Body); err != nil {
+		return invalidToolInput(req, err.Error(), locale)
+	}
+	input, err := json.Marshal(params.Body)
+	if err != nil {
+		return invalidToolInput(req, err.Error(), locale)
+	}
+
+	result, err := tool.Handler(req.Context(), input, locale)
+	if err != nil {
+		return toolError(req, tool, err, locale)
+	}
+	return tools.NewRunToolOK().WithPayload(&models.ToolRunResponse{
+		ToolID: swag.String(tool.ID),
+		Result: result,
+	})
+}
+
+// validateToolInput 按工具的输入模式校验输入，没有输入模式时不校验
+func validateToolInput(inputSchema json.RawMessage, input interface{}) error {
+	if len(inputSchema) == 0 {
+		return nil
+	}
+	schema := new(spec.Schema)
+	if err := json.Unmarshal(inputSchema, schema); err != nil {
+		return err
+	}
+	return validate.AgainstSchema(schema, input, strfmt.Default)
+}
+
+// invalidToolInput 工具输入无效的错误响应
+func invalidToolInput(req *http.Request, reason, locale string) middleware.Responder {
+	err := services.NewError(services.ErrCodeInvalidInput, "body", msgInvalidInput, reason)
+	return newErrorResponse(req, http.StatusBadRequest, err, locale)
+}
+
+// toolError 工具运行失败的错误响应：内置工具的错误为计算参数错误，插件按失败原因返回超时、重新启动中或运行失败
+func toolError(req *http.Request, tool registry.Tool, err error, locale string) middleware.Responder {
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) || errors.Is(err, context.Canceled) {
+		return calculationError(req, err, locale)
+	}
+	if !tool.IsPlugin() {
+		return invalidToolInput(req, err.Error(), locale)
+	}
+
+	var rpcErr *plugins.RPCError
+	switch {
+	case errors.As(err, &rpcErr) && rpcErr.Code == plugins.CodeInvalidParams:
+		return invalidToolInput(req, rpcErr.Message, locale)
+	case errors.Is(err, plugins.ErrTimeout):
+		err := services.NewError(services.ErrCodeToolTimeout, "", msgToolTimeout, tool.ID)
+		return newErrorResponse(req, http.StatusGatewayTimeout, err, locale)
+	case errors.Is(err, plugins.ErrClosed):
+		return shuttingDown(req, locale)
+	case errors.Is(err, plugins.ErrUnavailable):
+		err := services.NewError(services.ErrCodeToolFailed, "", msgToolRestarting, tool.ID)
+		return newErrorResponse(req, http.StatusServiceUnavailable, err, locale)
+	}
+	logging.FromContext(req.Context()).Error("插件运行失败", "tool", tool.ID, "error", err)
+	return newErrorResponse(req, http.StatusBadGateway,
+		services.NewError(services.ErrCodeToolFailed, "", msgToolFailed, tool.ID), locale)
+}
+
+// convertTool 转换工具为API模型，名称和描述按语言输出
+func convertTool(tool registry.Tool, locale string) *models.Tool {
+	tool = tool.Localize(locale)
+	source := tool.Source
+	if source == "" {
+		source = registry.SourceBuiltin
+	}
+	result := &models.Tool{
+		ID:          swag.String(tool.ID),
+		Name:        swag.String(tool.Name),
+		Description: tool.Description,
+		Category:    swag.String(tool.Category),
+		Icon:        tool.Icon,
+		Source:      source,
+	}
+	if len(tool.InputSchema) > 0 {
+		result.InputSchema = tool.InputSchema
+	}
+	return result
+}
+
+// BuiltinTools 内置的计算工具，输入为对应计算接口的请求体，schema按定义名称获取请求体的JSON Schema
+func (h *ModHandler) BuiltinTools(schema func(definition string) json.RawMessage) []registry.Tool {
+	return []registry.Tool{
 		{
-			ID:          stringPtr("affix-probability"),
-			Name:        stringPtr("模组词条概率计算器"),
+			ID:          "affix-probability",
+			Name:        "模组词条概率计算器",
 			Description: "计算特定词条组合出现的概率",
-			Category:    stringPtr("mod"),
+			Category:    "mod",
 			Icon:        "dice",
+			InputSchema: schema("AffixProbabilityRequest"),
+			Handler:     h.runAffixProbability,
+			Enabled:     true,
+		},
+		{
+			ID:          "affix-value-probability",
+			Name:        "模组词条数值概率计算器",
+			Description: "计算词条数值达到要求的概率",
+			Category:    "mod",
+			Icon:        "chart",
+			InputSchema: schema("AffixValueProbabilityRequest"),
+			Handler:     h.runAffixValueProbability,
+			Enabled:     true,
 		},
 		{
-			ID:          stringPtr("strengthen-probability"),
-			Name:        stringPtr("模组强化概率计算器"),
+			ID:          "strengthen-probability",
+			Name:        "模组强化概率计算器",
 			Description: "计算模组词条强化到目标等级的概率",
-			Category:    stringPtr("mod"),
+			Category:    "mod",
 			Icon:        "trending-up",
+			InputSchema: schema("StrengthenProbabilityRequest"),
+			Handler:     h.runStrengthenProbability,
+			Enabled:     true,
 		},
 	}
+}
+
+// decodeToolInput 解析并校验工具输入
+func decodeToolInput(input json.RawMessage, body interface{ Validate(strfmt.Registry) error }) error {
+	if err := json.Unmarshal(input, body); err != nil {
+		return err
+	}
+	return body.Validate(strfmt.Default)
+}
 
-	// 获取分类
-	categories := []string{"mod", "weapon", "character"}
+// runAffixProbability 运行词条概率工具，目标词条只能引用内置预设
+func (h *ModHandler) runAffixProbability(ctx context.Context, raw json.RawMessage, locale string) (interface{}, error) {
+	body := &models.AffixProbabilityRequest{}
+	if err := decodeToolInput(raw, body); err != nil {
+		return nil, err
+	}
+	if err := mergeAffixTargets(ctx, body, nil); err != nil {
+		return nil, err
+	}
 
-	response := &models.ToolsListResponse{
-		Tools:      toolList,
-		Categories: categories,
+	input := newAffixProbabilityInput(body)
+	result, err := input.calculate(ctx, h.affixService)
+	if err != nil {
+		return nil, err
 	}
+	return convertAffixProbabilityResult(result, input.showCombinations, locale), nil
+}
 
-	return tools.NewListToolsOK().WithPayload(response)
+// runAffixValueProbability 运行词条数值概率工具
+func (h *ModHandler) runAffixValueProbability(ctx context.Context, raw json.RawMessage, locale string) (interface{}, error) {
+	body := &models.AffixValueProbabilityRequest{}
+	if err := decodeToolInput(raw, body); err != nil {
+		return nil, err
+	}
+
+	result, err := newAffixValueProbabilityInput(body).calculate(ctx, h.affixValueService)
+	if err != nil {
+		return nil, err
+	}
+	return convertAffixValueProbabilityResult(result), nil
 }
 
-// 辅助函数：创建字符串指针
-func stringPtr(s string) *string {
-	return &s
+// runStrengthenProbability 运行强化概率工具
+func (h *ModHandler) runStrengthenProbability(ctx context.Context, raw json.RawMessage, locale string) (interface{}, error) {
+	body := &models.StrengthenProbabilityRequest{}
+	if err := decodeToolInput(raw, body); err != nil {
+		return nil, err
+	}
+
+	input := newStrengthenProbabilityInput(body)
+	result, err := input.calculate(ctx, h.strengthenService)
+	if err != nil {
+		return nil, err
+	}
+	response := convertStrengthenProbabilityResult(result, input.showPaths, locale)
+	if swag.BoolValue(body.Table) {
+		table, err := h.strengthenService.CalculateTable(ctx, input.gameVersion, input.rarity)
+		if err != nil {
+			return nil, err
+		}
+		response.Table = convertStrengthenTable(table)
+	}
+	return response, nil
 }
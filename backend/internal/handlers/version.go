@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	internalModels "github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/mod"
+)
+
+// ListGameVersions 获取游戏版本列表及各版本的变动
+func (h *ModHandler) ListGameVersions(params mod.ListGameVersionsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+	latest := internalModels.LatestCatalog()
+
+	versions := make([]*models.GameVersion, 0)
+	for _, version := range internalModels.GetGameVersions() {
+		catalog := internalModels.GetCatalog(version.ID)
+		version = version.Localize(locale)
+
+		id := version.ID
+		name := version.Name
+		item := &models.GameVersion{
+			ID:          &id,
+			Name:        &name,
+			ReleaseDate: version.ReleaseDate,
+			Notes:       version.Notes,
+			Latest:      catalog == latest,
+			Changes:     []*models.CatalogChange{},
+		}
+		if previous := internalModels.PreviousCatalog(catalog); previous != nil {
+			item.Changes = convertCatalogChanges(internalModels.DiffCatalogs(previous, catalog))
+		}
+		versions = append(versions, item)
+	}
+
+	latestID := latest.Version.ID
+	response := &models.GameVersionListResponse{
+		Versions: versions,
+		Latest:   &latestID,
+		Total:    int32(len(versions)),
+	}
+
+	return mod.NewListGameVersionsOK().WithPayload(response)
+}
+
+// DiffGameVersions 比较两个游戏版本
+func (h *ModHandler) DiffGameVersions(params mod.DiffGameVersionsParams, principal interface{}) middleware.Responder {
+	locale := resolveLocale(params.Lang, params.AcceptLanguage)
+
+	from := internalModels.GetCatalog(params.From)
+	if from == nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, services.NewUnknownGameVersionError(params.From), locale)
+	}
+	to, gameVersion := resolveCatalog(params.To)
+	if to == nil {
+		return newErrorResponse(params.HTTPRequest, http.StatusBadRequest, services.NewUnknownGameVersionError(gameVersion), locale)
+	}
+
+	fromID := from.Version.ID
+	toID := to.Version.ID
+	response := &models.GameVersionDiffResponse{
+		From:    &fromID,
+		To:      &toID,
+		Changes: convertCatalogChanges(internalModels.DiffCatalogs(from, to)),
+	}
+
+	return mod.NewDiffGameVersionsOK().WithPayload(response)
+}
+
+// resolveCatalog 根据gameVersion参数获取目录，版本不存在时返回nil和请求的版本
+func resolveCatalog(gameVersion *string) (*internalModels.Catalog, string) {
+	var version string
+	if gameVersion != nil {
+		version = *gameVersion
+	}
+	return internalModels.GetCatalog(version), version
+}
+
+// convertCatalogChanges 转换版本变动为API模型
+func convertCatalogChanges(changes []internalModels.CatalogChange) []*models.CatalogChange {
+	result := make([]*models.CatalogChange, 0, len(changes))
+	for _, change := range changes {
+		target := change.Target
+		id := change.ID
+		action := change.Action
+		result = append(result, &models.CatalogChange{
+			Target: &target,
+			ID:     &id,
+			Action: &action,
+			Field:  change.Field,
+			Old:    change.Old,
+			New:    change.New,
+		})
+	}
+	return result
+}
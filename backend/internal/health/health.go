@@ -0,0 +1,118 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 组件状态
+const (
+	StatusOK       = "ok"       // 正常
+	StatusFail     = "fail"     // 不可用
+	StatusDisabled = "disabled" // 未启用，不影响就绪状态
+)
+
+// ErrDisabled 组件未启用，检查函数返回此错误时组件状态为disabled
+var ErrDisabled = errors.New("未启用")
+
+// checkTimeout 单个组件检查的超时时间
+const checkTimeout = 2 * time.Second
+
+// Check 组件检查函数，返回nil表示正常
+type Check func(ctx context.Context) error
+
+// Component 组件检查结果
+type Component struct {
+	Name    string
+	Status  string
+	Latency time.Duration
+	Error   string
+}
+
+// Checker 就绪检查器，所有组件正常且服务未在停止时就绪
+type Checker struct {
+	mu       sync.RWMutex
+	names    []string
+	checks   map[string]Check
+	draining atomic.Bool
+}
+
+// NewChecker 创建就绪检查器
+func NewChecker() *Checker {
+	return &Checker{checks: make(map[string]Check)}
+}
+
+// Register 注册组件检查，同名组件覆盖之前的检查
+func (c *Checker) Register(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// SetDraining 标记服务正在停止，之后就绪检查始终失败，负载均衡据此摘除实例
+func (c *Checker) SetDraining() {
+	c.draining.Store(true)
+}
+
+// Draining 检查服务是否正在停止
+func (c *Checker) Draining() bool {
+	return c.draining.Load()
+}
+
+// Check 并发检查所有组件，按注册顺序返回结果
+func (c *Checker) Check(ctx context.Context) (ready bool, components []Component) {
+	c.mu.RLock()
+	names := append([]string(nil), c.names...)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = c.checks[name]
+	}
+	c.mu.RUnlock()
+
+	components = make([]Component, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			components[i] = run(ctx, names[i], checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	ready = !c.Draining()
+	for _, component := range components {
+		if component.Status == StatusFail {
+			ready = false
+		}
+	}
+	return ready, components
+}
+
+// run 执行单个组件检查，超时按失败处理
+func run(ctx context.Context, name string, check Check) Component {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	component := Component{
+		Name:    name,
+		Status:  StatusOK,
+		Latency: time.Since(start),
+	}
+	switch {
+	case errors.Is(err, ErrDisabled):
+		component.Status = StatusDisabled
+	case err != nil:
+		component.Status = StatusFail
+		component.Error = err.Error()
+	}
+	return component
+}
@@ -0,0 +1,47 @@
+package jobs
+
+// Subscribe 订阅owner提交的任务状态，通道先收到当前状态，之后在状态和进度变化时收到最新状态，任务结束后关闭
+// 消费较慢时只保留最新状态；任务不存在、已过期或不属于owner时ok为false，不再需要时调用unsubscribe
+func (m *Manager) Subscribe(id, owner string) (updates <-chan Job, unsubscribe func(), ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.lookup(id, owner)
+	if !ok {
+		return nil, nil, false
+	}
+
+	ch := make(chan Job, 1)
+	ch <- e.job
+	if e.job.Status.Finished() {
+		close(ch)
+		return ch, func() {}, true
+	}
+
+	e.subscribers[ch] = struct{}{}
+	return ch, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(e.subscribers, ch)
+	}, true
+}
+
+// notify 向订阅方发送最新状态，任务结束时关闭所有订阅，调用方需持有锁
+func (m *Manager) notify(e *entry) {
+	for ch := range e.subscribers {
+		select {
+		case ch <- e.job:
+		default:
+			// 丢弃未读取的旧状态
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- e.job
+		}
+		if e.job.Status.Finished() {
+			close(ch)
+			delete(e.subscribers, ch)
+		}
+	}
+}
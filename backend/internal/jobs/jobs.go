@@ -0,0 +1,350 @@
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// Status 任务状态
+type Status string
+
+const (
+	StatusQueued    Status = "queued"    // 排队中
+	StatusRunning   Status = "running"   // 执行中
+	StatusSucceeded Status = "succeeded" // 已完成
+	StatusFailed    Status = "failed"    // 执行失败
+	StatusCanceled  Status = "canceled"  // 已取消
+)
+
+// Finished 检查任务是否已结束
+func (s Status) Finished() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCanceled
+}
+
+// ErrQueueFull 任务队列已满
+var ErrQueueFull = errors.New("任务队列已满")
+
+// ErrClosed 任务管理器已关闭
+var ErrClosed = errors.New("任务管理器已关闭")
+
+// Func 任务函数，ctx在任务取消或超时时取消
+type Func func(ctx context.Context) (interface{}, error)
+
+// Job 任务状态快照
+type Job struct {
+	ID         string
+	Type       string
+	Owner      string // 提交任务的调用方，只有同一调用方可以查看和取消任务
+	Status     Status
+	Progress   float64  // 进度，0到1
+	Estimate   *float64 // 计算过程中的概率估计，nil表示没有估计
+	Result     interface{}
+	Err        error
+	CreatedAt  time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+	ExpiresAt  time.Time // 结束的任务在此之后删除
+}
+
+// Config 任务管理器配置
+type Config struct {
+	Workers   int           // 并发执行的任务数
+	QueueSize int           // 排队任务数上限，超过时拒绝提交
+	Timeout   time.Duration // 单个任务的执行时间上限，0表示不限制
+	ResultTTL time.Duration // 结束的任务保留时间
+}
+
+// entry 任务条目
+type entry struct {
+	job         Job
+	fn          Func
+	ctx         context.Context
+	cancel      context.CancelFunc
+	subscribers map[chan Job]struct{}
+	notified    float64 // 上次通知订阅方时的进度
+}
+
+// Manager 任务管理器，任务进入有界队列后由固定数量的工作协程执行
+type Manager struct {
+	config   Config
+	queue    chan *entry
+	mu       sync.Mutex
+	jobs     map[string]*entry
+	active   int  // 未结束的任务数
+	draining bool // 停止接受新任务，等待已提交的任务结束
+	idle     chan struct{}
+	closed   bool
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// sweepInterval 清理过期任务的间隔
+const sweepInterval = time.Minute
+
+// NewManager 创建任务管理器并启动工作协程
+func NewManager(config Config) *Manager {
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.QueueSize < 0 {
+		config.QueueSize = 0
+	}
+
+	m := &Manager{
+		config: config,
+		queue:  make(chan *entry, config.QueueSize),
+		jobs:   make(map[string]*entry),
+		idle:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	for i := 0; i < config.Workers; i++ {
+		m.wg.Add(1)
+		go m.work()
+	}
+	go m.sweep()
+	return m
+}
+
+// Submit 以owner的身份提交任务，队列已满时返回ErrQueueFull，停止后返回ErrClosed
+// 任务上下文沿用ctx中的值（如请求ID和日志记录器），但不随提交请求结束而取消
+func (m *Manager) Submit(ctx context.Context, jobType, owner string, fn Func) (Job, error) {
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	e := &entry{
+		job: Job{
+			ID:        newJobID(),
+			Type:      jobType,
+			Owner:     owner,
+			Status:    StatusQueued,
+			CreatedAt: time.Now(),
+		},
+		fn:          fn,
+		cancel:      cancel,
+		subscribers: make(map[chan Job]struct{}),
+	}
+	e.ctx = withProgress(jobCtx, m, e)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed || m.draining {
+		cancel()
+		return Job{}, ErrClosed
+	}
+	select {
+	case m.queue <- e:
+	default:
+		cancel()
+		return Job{}, ErrQueueFull
+	}
+	m.jobs[e.job.ID] = e
+	m.active++
+
+	logging.FromContext(ctx).Info("任务已提交", "job_id", e.job.ID, "job_type", jobType)
+	return e.job, nil
+}
+
+// Get 获取owner提交的任务状态，任务不存在、已过期或不属于owner时ok为false
+func (m *Manager) Get(id, owner string) (Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.lookup(id, owner)
+	if !ok {
+		return Job{}, false
+	}
+	return e.job, true
+}
+
+// Cancel 取消owner提交的任务，排队中的任务立即结束，执行中的任务取消上下文后由服务尽快返回
+func (m *Manager) Cancel(id, owner string) (Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.lookup(id, owner)
+	if !ok {
+		return Job{}, false
+	}
+	if e.job.Status == StatusQueued {
+		m.finish(e, nil, context.Canceled)
+	}
+	e.cancel()
+	return e.job, true
+}
+
+// Drain 停止接受新任务，已提交的任务继续执行
+func (m *Manager) Drain() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.draining = true
+	m.checkIdle()
+}
+
+// Shutdown 停止接受新任务并等待已提交的任务结束，ctx结束时取消剩余的任务，返回时工作协程已退出
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.Drain()
+
+	var err error
+	select {
+	case <-m.idle:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	m.Close()
+	return err
+}
+
+// checkIdle 停止接受新任务且所有任务都已结束时通知Shutdown，调用方需持有锁
+func (m *Manager) checkIdle() {
+	if !m.draining || m.active > 0 {
+		return
+	}
+	select {
+	case <-m.idle:
+	default:
+		close(m.idle)
+	}
+}
+
+// Close 停止接受任务，取消所有未结束的任务并等待工作协程退出
+func (m *Manager) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	for _, e := range m.jobs {
+		if e.job.Status == StatusQueued {
+			m.finish(e, nil, context.Canceled)
+		}
+		e.cancel()
+	}
+	close(m.queue)
+	close(m.done)
+	m.mu.Unlock()
+
+	m.wg.Wait()
+}
+
+// work 工作协程，依次执行队列中的任务
+func (m *Manager) work() {
+	defer m.wg.Done()
+	for e := range m.queue {
+		m.run(e)
+	}
+}
+
+// run 执行任务，排队期间已取消的任务直接跳过
+func (m *Manager) run(e *entry) {
+	m.mu.Lock()
+	if e.job.Status != StatusQueued {
+		m.mu.Unlock()
+		return
+	}
+	e.job.Status = StatusRunning
+	e.job.StartedAt = time.Now()
+	m.notify(e)
+	m.mu.Unlock()
+
+	ctx := e.ctx
+	if m.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.config.Timeout)
+		defer cancel()
+	}
+
+	result, err := m.call(ctx, e.fn)
+
+	m.mu.Lock()
+	m.finish(e, result, err)
+	job := e.job
+	m.mu.Unlock()
+	e.cancel()
+
+	logging.FromContext(e.ctx).Info("任务已结束",
+		"job_id", job.ID,
+		"job_type", job.Type,
+		"status", job.Status,
+		"duration_ms", float64(job.FinishedAt.Sub(job.StartedAt).Microseconds())/1000)
+}
+
+// call 调用任务函数，任务函数panic时按失败处理
+func (m *Manager) call(ctx context.Context, fn Func) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.FromContext(ctx).Error("任务执行异常", "panic", r)
+			err = errors.New("任务执行异常")
+		}
+	}()
+	return fn(ctx)
+}
+
+// finish 记录任务结果并通知订阅方，取消导致的错误记为已取消，调用方需持有锁
+func (m *Manager) finish(e *entry, result interface{}, err error) {
+	now := time.Now()
+	switch {
+	case err == nil:
+		e.job.Status = StatusSucceeded
+		e.job.Progress = 1
+		e.job.Result = result
+	case errors.Is(err, context.Canceled):
+		e.job.Status = StatusCanceled
+	default:
+		e.job.Status = StatusFailed
+		e.job.Err = err
+	}
+	e.job.FinishedAt = now
+	e.job.ExpiresAt = now.Add(m.config.ResultTTL)
+	m.notify(e)
+	m.active--
+	m.checkIdle()
+}
+
+// lookup 查找owner提交的未过期任务，调用方需持有锁
+// 其他调用方的任务与不存在的任务结果相同，不暴露任务ID是否存在
+func (m *Manager) lookup(id, owner string) (*entry, bool) {
+	e, ok := m.jobs[id]
+	if !ok || e.job.Owner != owner || m.expired(e, time.Now()) {
+		return nil, false
+	}
+	return e, true
+}
+
+// expired 检查结束的任务是否已过保留时间，调用方需持有锁
+func (m *Manager) expired(e *entry, now time.Time) bool {
+	return e.job.Status.Finished() && !now.Before(e.job.ExpiresAt)
+}
+
+// sweep 定期删除过期的任务
+func (m *Manager) sweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case now := <-ticker.C:
+			m.mu.Lock()
+			for id, e := range m.jobs {
+				if m.expired(e, now) {
+					delete(m.jobs, id)
+				}
+			}
+			m.mu.Unlock()
+		}
+	}
+}
+
+// newJobID 生成随机的任务ID
+func newJobID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return hex.EncodeToString([]byte(time.Now().Format(time.RFC3339Nano)))
+	}
+	return hex.EncodeToString(b)
+}
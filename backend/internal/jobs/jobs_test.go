@@ -0,0 +1,229 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// blockingJob 开始执行时关闭started，之后等待release或ctx取消
+func blockingJob(started, release chan struct{}) Func {
+	return func(ctx context.Context) (interface{}, error) {
+		close(started)
+		select {
+		case <-release:
+			return "done", nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// waitFinished 等待任务结束并返回最终状态
+func waitFinished(t *testing.T, m *Manager, id, owner string) Job {
+	t.Helper()
+	updates, unsubscribe, ok := m.Subscribe(id, owner)
+	if !ok {
+		t.Fatalf("Subscribe(%s) not found", id)
+	}
+	defer unsubscribe()
+
+	timeout := time.After(5 * time.Second)
+	var job Job
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return job
+			}
+			job = update
+		case <-timeout:
+			t.Fatalf("job %s did not finish", id)
+		}
+	}
+}
+
+func TestQueueFull(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: time.Minute})
+	defer m.Close()
+	ctx := context.Background()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	if _, err := m.Submit(ctx, "test", "k1", blockingJob(started, release)); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	queued, err := m.Submit(ctx, "test", "k1", blockingJob(make(chan struct{}), release))
+	if err != nil || queued.Status != StatusQueued {
+		t.Fatalf("Submit() = %+v, %v, want queued", queued, err)
+	}
+	if _, err := m.Submit(ctx, "test", "k1", blockingJob(make(chan struct{}), release)); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Submit() on a full queue = %v, want ErrQueueFull", err)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: time.Minute})
+	defer m.Close()
+	ctx := context.Background()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	running, err := m.Submit(ctx, "test", "k1", blockingJob(started, release))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	queued, err := m.Submit(ctx, "test", "k1", blockingJob(make(chan struct{}), release))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 排队中的任务立即结束，执行中的任务在计算返回后结束
+	if job, ok := m.Cancel(queued.ID, "k1"); !ok || job.Status != StatusCanceled {
+		t.Errorf("Cancel(queued) = %+v, %v, want canceled", job, ok)
+	}
+	if _, ok := m.Cancel(running.ID, "k1"); !ok {
+		t.Fatal("Cancel(running) not found")
+	}
+	if job := waitFinished(t, m, running.ID, "k1"); job.Status != StatusCanceled || job.Err != nil {
+		t.Errorf("running job after Cancel = %+v, want canceled", job)
+	}
+	if _, ok := m.Cancel("missing", "k1"); ok {
+		t.Error("Cancel(missing) found a job")
+	}
+}
+
+func TestFinish(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 4, Timeout: 50 * time.Millisecond, ResultTTL: time.Minute})
+	defer m.Close()
+
+	tests := []struct {
+		name   string
+		fn     Func
+		status Status
+		err    bool
+	}{
+		{"succeeded", func(ctx context.Context) (interface{}, error) { return 1, nil }, StatusSucceeded, false},
+		{"failed", func(ctx context.Context) (interface{}, error) { return nil, errors.New("boom") }, StatusFailed, true},
+		{"panic", func(ctx context.Context) (interface{}, error) { panic("boom") }, StatusFailed, true},
+		{"timeout", func(ctx context.Context) (interface{}, error) { <-ctx.Done(); return nil, ctx.Err() }, StatusFailed, true},
+	}
+	for _, tt := range tests {
+		job, err := m.Submit(context.Background(), "test", "k1", tt.fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		job = waitFinished(t, m, job.ID, "k1")
+		if job.Status != tt.status || (job.Err != nil) != tt.err {
+			t.Errorf("%s: status %s, err %v", tt.name, job.Status, job.Err)
+		}
+		if job.ExpiresAt.Sub(job.FinishedAt) != time.Minute {
+			t.Errorf("%s: expires %v after finishing, want 1m", tt.name, job.ExpiresAt.Sub(job.FinishedAt))
+		}
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: 20 * time.Millisecond})
+	defer m.Close()
+
+	job, err := m.Submit(context.Background(), "test", "k1", func(ctx context.Context) (interface{}, error) { return 1, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFinished(t, m, job.ID, "k1")
+	if got, ok := m.Get(job.ID, "k1"); !ok || got.Result != 1 {
+		t.Fatalf("Get() = %+v, %v, want the result", got, ok)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := m.Get(job.ID, "k1"); ok {
+		t.Error("Get() found an expired job")
+	}
+	if _, ok := m.Cancel(job.ID, "k1"); ok {
+		t.Error("Cancel() found an expired job")
+	}
+	if _, _, ok := m.Subscribe(job.ID, "k1"); ok {
+		t.Error("Subscribe() found an expired job")
+	}
+}
+
+func TestOwner(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: time.Minute})
+	defer m.Close()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	job, err := m.Submit(context.Background(), "test", "k1", blockingJob(started, release))
+	if err != nil || job.Owner != "k1" {
+		t.Fatalf("Submit() = %+v, %v", job, err)
+	}
+	<-started
+
+	// 其他调用方的任务与不存在的任务相同
+	if _, ok := m.Get(job.ID, "k2"); ok {
+		t.Error("Get() by another owner found the job")
+	}
+	if _, _, ok := m.Subscribe(job.ID, "k2"); ok {
+		t.Error("Subscribe() by another owner found the job")
+	}
+	if _, ok := m.Cancel(job.ID, "k2"); ok {
+		t.Error("Cancel() by another owner found the job")
+	}
+	if got, ok := m.Get(job.ID, "k1"); !ok || got.Status != StatusRunning {
+		t.Errorf("Get() by the owner = %+v, %v, want running", got, ok)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: time.Minute})
+	ctx := context.Background()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	job, err := m.Submit(ctx, "test", "k1", blockingJob(started, release))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	// 停止接受新任务后，已提交的任务继续执行，Shutdown等待其结束
+	m.Drain()
+	if _, err := m.Submit(ctx, "test", "k1", blockingJob(make(chan struct{}), release)); !errors.Is(err, ErrClosed) {
+		t.Errorf("Submit() after Drain = %v, want ErrClosed", err)
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	if err := m.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() = %v", err)
+	}
+	if got, ok := m.Get(job.ID, "k1"); !ok || got.Status != StatusSucceeded {
+		t.Errorf("job after Shutdown = %+v, %v, want succeeded", got, ok)
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	m := NewManager(Config{Workers: 1, QueueSize: 1, ResultTTL: time.Minute})
+
+	started := make(chan struct{})
+	job, err := m.Submit(context.Background(), "test", "k1", blockingJob(started, make(chan struct{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	// 超过等待时间后取消剩余的任务
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := m.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() = %v, want DeadlineExceeded", err)
+	}
+	if got, ok := m.Get(job.ID, "k1"); !ok || got.Status != StatusCanceled {
+		t.Errorf("job after Shutdown = %+v, %v, want canceled", got, ok)
+	}
+}
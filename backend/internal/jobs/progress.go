@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/progress"
+)
+
+// notifyStep 进度每增加这么多才通知订阅方，避免频繁推送
+const notifyStep = 0.01
+
+// withProgress 为任务上下文设置进度接收方，计算服务报告的进度记入任务
+func withProgress(ctx context.Context, m *Manager, e *entry) context.Context {
+	return progress.WithReporter(ctx, func(u progress.Update) {
+		m.report(e, u)
+	})
+}
+
+// report 记录任务进度和概率估计，进度只增不减，只在任务执行中生效
+func (m *Manager) report(e *entry, u progress.Update) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job := &e.job
+	if job.Status != StatusRunning {
+		return
+	}
+	if u.Progress > 1 {
+		u.Progress = 1
+	}
+	if u.Progress > job.Progress {
+		job.Progress = u.Progress
+	}
+	if u.Estimate != nil {
+		job.Estimate = u.Estimate
+	}
+	if job.Progress-e.notified >= notifyStep {
+		e.notified = job.Progress
+		m.notify(e)
+	}
+}
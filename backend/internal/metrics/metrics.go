@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace 指标名前缀
+const namespace = "oncehuman"
+
+// Registry 后端指标的注册表，包含Go运行时和进程指标
+var Registry = prometheus.NewRegistry()
+
+var (
+	// httpRequests 按操作ID、方法和状态码统计的请求数
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP请求数，按go-swagger操作ID、方法和状态码统计",
+	}, []string{"operation_id", "method", "status"})
+
+	// httpDuration 按操作ID和方法统计的请求耗时
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP请求耗时，按go-swagger操作ID和方法统计",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 4, 9),
+	}, []string{"operation_id", "method"})
+
+	// calculationDuration 按计算服务统计的计算耗时
+	calculationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "calculation",
+		Name:      "duration_seconds",
+		Help:      "概率计算耗时，按计算服务统计",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+	}, []string{"calculator"})
+
+	// calculationCombinations 按计算服务统计的组合或结果总数
+	calculationCombinations = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "calculation",
+		Name:      "combinations",
+		Help:      "一次计算枚举的词条组合数或强化结果数",
+		Buckets:   prometheus.ExponentialBuckets(1, 10, 10),
+	}, []string{"calculator"})
+
+	// calculationPaths 按计算服务统计的输出路径数
+	calculationPaths = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "calculation",
+		Name:      "paths",
+		Help:      "一次计算输出的强化路径数或词条组合数，只统计要求输出明细的请求",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	}, []string{"calculator"})
+
+	// cacheRequests 按缓存名称和结果统计的缓存查询数，命中率为hit占全部查询的比例
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "缓存查询数，result为hit或miss",
+	}, []string{"cache", "result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		calculationDuration,
+		calculationCombinations,
+		calculationPaths,
+		cacheRequests,
+	)
+}
+
+// Handler 输出Prometheus文本格式指标的处理器
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveCalculation 记录一次计算的耗时
+func ObserveCalculation(calculator string, duration time.Duration) {
+	calculationDuration.WithLabelValues(calculator).Observe(duration.Seconds())
+}
+
+// ObserveCombinations 记录一次计算枚举的组合数
+func ObserveCombinations(calculator string, count int64) {
+	calculationCombinations.WithLabelValues(calculator).Observe(float64(count))
+}
+
+// ObservePaths 记录一次计算输出的路径数
+func ObservePaths(calculator string, count int) {
+	calculationPaths.WithLabelValues(calculator).Observe(float64(count))
+}
+
+// CacheHit 记录一次缓存命中
+func CacheHit(cache string) {
+	cacheRequests.WithLabelValues(cache, "hit").Inc()
+}
+
+// CacheMiss 记录一次缓存未命中
+func CacheMiss(cache string) {
+	cacheRequests.WithLabelValues(cache, "miss").Inc()
+}
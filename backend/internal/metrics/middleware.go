@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// unknownOperation 未匹配到路由的请求使用的操作ID，如限流拒绝、404和文档页面
+const unknownOperation = "unknown"
+
+// Middleware 请求指标中间件，按路由记录的操作ID统计请求数和耗时
+// 需要放在logging.AccessLog内层以便读取操作ID
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		operationID := logging.OperationID(r.Context())
+		if operationID == "" {
+			operationID = unknownOperation
+		}
+		httpRequests.WithLabelValues(operationID, r.Method, strconv.Itoa(recorder.status)).Inc()
+		httpDuration.WithLabelValues(operationID, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// statusRecorder 记录响应状态码
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+// WriteHeader 记录状态码
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write 写入响应体，未显式写入状态码时为200
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush 支持流式响应
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap 返回原始ResponseWriter，供http.ResponseController使用
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -2,10 +2,12 @@ package models
 
 // Affix 词条
 type Affix struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Category    string `json:"category,omitempty"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Category    string   `json:"category,omitempty"`
+	Pinyin      string   `json:"pinyin,omitempty"`  // 名称拼音，音节以空格分隔
+	Aliases     []string `json:"aliases,omitempty"` // 英文别名
 }
 
 // AffixCategory 词条分类
@@ -17,41 +19,48 @@ const (
 	AffixCategoryUtility AffixCategory = "utility" // 功能类
 )
 
-// GetAllAffixes 获取所有词条定义
-func GetAllAffixes() []Affix {
+// GetAllAffixCategories 获取所有词条分类
+func GetAllAffixCategories() []AffixCategory {
+	return []AffixCategory{AffixCategoryDamage, AffixCategoryDefense, AffixCategoryUtility}
+}
+
+// baseAffixes 首个游戏版本的词条定义，之后的变动记录在版本补丁中
+func baseAffixes() []Affix {
 	return []Affix{
-		{ID: 1, Name: "异常伤害", Description: "提升异常状态伤害", Category: string(AffixCategoryDamage)},
-		{ID: 2, Name: "弹匣容量", Description: "增加武器弹匣容量", Category: string(AffixCategoryUtility)},
-		{ID: 3, Name: "换弹速度加成", Description: "提升换弹速度", Category: string(AffixCategoryUtility)},
-		{ID: 4, Name: "对普通敌人伤害", Description: "对普通敌人造成额外伤害", Category: string(AffixCategoryDamage)},
-		{ID: 5, Name: "对精英敌人伤害", Description: "对精英敌人造成额外伤害", Category: string(AffixCategoryDamage)},
-		{ID: 6, Name: "对上位者伤害", Description: "对上位者敌人造成额外伤害", Category: string(AffixCategoryDamage)},
-		{ID: 7, Name: "最大生命值", Description: "增加角色最大生命值", Category: string(AffixCategoryDefense)},
-		{ID: 8, Name: "头部受伤减免", Description: "减少头部受到的伤害", Category: string(AffixCategoryDefense)},
-		{ID: 9, Name: "枪械伤害减免", Description: "减少枪械造成的伤害", Category: string(AffixCategoryDefense)},
-		{ID: 10, Name: "异常伤害减免", Description: "减少异常状态伤害", Category: string(AffixCategoryDefense)},
+		{ID: 1, Name: "异常伤害", Description: "提升异常状态伤害", Category: string(AffixCategoryDamage),
+			Pinyin: "yi chang shang hai", Aliases: []string{"Status DMG", "Status Damage", "Elemental DMG"}},
+		{ID: 2, Name: "弹匣容量", Description: "增加武器弹匣容量", Category: string(AffixCategoryUtility),
+			Pinyin: "dan xia rong liang", Aliases: []string{"Magazine Capacity", "Mag Capacity", "Mag"}},
+		{ID: 3, Name: "换弹速度加成", Description: "提升换弹速度", Category: string(AffixCategoryUtility),
+			Pinyin: "huan dan su du jia cheng", Aliases: []string{"Reload Speed", "Reload"}},
+		{ID: 4, Name: "对普通敌人伤害", Description: "对普通敌人造成额外伤害", Category: string(AffixCategoryDamage),
+			Pinyin: "dui pu tong di ren shang hai", Aliases: []string{"Normal Enemy DMG", "DMG vs Normal", "Normal DMG"}},
+		{ID: 5, Name: "对精英敌人伤害", Description: "对精英敌人造成额外伤害", Category: string(AffixCategoryDamage),
+			Pinyin: "dui jing ying di ren shang hai", Aliases: []string{"Elite Enemy DMG", "DMG vs Elite", "Elite DMG"}},
+		{ID: 6, Name: "对上位者伤害", Description: "对上位者敌人造成额外伤害", Category: string(AffixCategoryDamage),
+			Pinyin: "dui shang wei zhe shang hai", Aliases: []string{"Great One DMG", "DMG vs Great Ones", "Boss DMG"}},
+		{ID: 7, Name: "最大生命值", Description: "增加角色最大生命值", Category: string(AffixCategoryDefense),
+			Pinyin: "zui da sheng ming zhi", Aliases: []string{"Max HP", "Max Health", "HP"}},
+		{ID: 8, Name: "头部受伤减免", Description: "减少头部受到的伤害", Category: string(AffixCategoryDefense),
+			Pinyin: "tou bu shou shang jian mian", Aliases: []string{"Head DMG Reduction", "Head DR", "Headshot DR"}},
+		{ID: 9, Name: "枪械伤害减免", Description: "减少枪械造成的伤害", Category: string(AffixCategoryDefense),
+			Pinyin: "qiang xie shang hai jian mian", Aliases: []string{"Gunshot DMG Reduction", "Gun DR", "Weapon DR"}},
+		{ID: 10, Name: "异常伤害减免", Description: "减少异常状态伤害", Category: string(AffixCategoryDefense),
+			Pinyin: "yi chang shang hai jian mian", Aliases: []string{"Status DMG Reduction", "Status DR", "Elemental DR"}},
 	}
 }
 
-// GetAffixByID 根据ID获取词条
+// GetAllAffixes 获取最新游戏版本的所有词条定义
+func GetAllAffixes() []Affix {
+	return LatestCatalog().Affixes()
+}
+
+// GetAffixByID 根据ID获取最新游戏版本的词条
 func GetAffixByID(id int) *Affix {
-	affixes := GetAllAffixes()
-	for _, affix := range affixes {
-		if affix.ID == id {
-			return &affix
-		}
-	}
-	return nil
+	return LatestCatalog().AffixByID(id)
 }
 
-// GetAffixesByCategory 根据分类获取词条
+// GetAffixesByCategory 根据分类获取最新游戏版本的词条
 func GetAffixesByCategory(category AffixCategory) []Affix {
-	var result []Affix
-	affixes := GetAllAffixes()
-	for _, affix := range affixes {
-		if affix.Category == string(category) {
-			result = append(result, affix)
-		}
-	}
-	return result
-}
\ No newline at end of file
+	return LatestCatalog().AffixesByCategory(category)
+}
@@ -0,0 +1,69 @@
+package models
+
+// AffixValueTier 词条数值档位
+type AffixValueTier struct {
+	Tier   int     `json:"tier"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Weight float64 `json:"weight"`
+}
+
+// AffixValueRange 词条在指定等级下的数值分布
+type AffixValueRange struct {
+	AffixID int              `json:"affixId"`
+	Level   int              `json:"level"`
+	Unit    string           `json:"unit,omitempty"`
+	Min     float64          `json:"min"`
+	Max     float64          `json:"max"`
+	Tiers   []AffixValueTier `json:"tiers"`
+}
+
+// TopTier 获取最高档位编号
+func (r *AffixValueRange) TopTier() int {
+	top := 0
+	for _, tier := range r.Tiers {
+		if tier.Tier > top {
+			top = tier.Tier
+		}
+	}
+	return top
+}
+
+// affixValueBase 词条1级数值基准
+type affixValueBase struct {
+	unit  string
+	min   float64
+	max   float64
+	tiers []float64 // 各档位权重，从低到高，数值区间等分
+}
+
+// 默认档位权重：普通、优秀、完美
+var defaultTierWeights = []float64{0.5, 0.35, 0.15}
+
+// affixValueBases 首个游戏版本各词条1级数值基准
+var affixValueBases = map[int]affixValueBase{
+	1:  {unit: "%", min: 4, max: 10, tiers: defaultTierWeights},
+	2:  {unit: "%", min: 6, max: 15, tiers: defaultTierWeights},
+	3:  {unit: "%", min: 5, max: 12, tiers: defaultTierWeights},
+	4:  {unit: "%", min: 4, max: 10, tiers: defaultTierWeights},
+	5:  {unit: "%", min: 4, max: 10, tiers: defaultTierWeights},
+	6:  {unit: "%", min: 4, max: 10, tiers: defaultTierWeights},
+	7:  {unit: "%", min: 5, max: 12, tiers: defaultTierWeights},
+	8:  {unit: "%", min: 6, max: 15, tiers: defaultTierWeights},
+	9:  {unit: "%", min: 3, max: 8, tiers: defaultTierWeights},
+	10: {unit: "%", min: 3, max: 8, tiers: defaultTierWeights},
+}
+
+// affixLevelScales 首个游戏版本各等级相对1级的数值倍率
+var affixLevelScales = map[int]float64{
+	1: 1.0,
+	2: 1.25,
+	3: 1.5,
+	4: 1.75,
+	5: 2.0,
+}
+
+// GetAffixValueRange 获取词条在最新游戏版本指定等级下的数值分布
+func GetAffixValueRange(affixID, level int) *AffixValueRange {
+	return LatestCatalog().AffixValueRange(affixID, level)
+}
@@ -0,0 +1,206 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// Catalog 某个游戏版本的词条目录和强化规则
+type Catalog struct {
+	Version     GameVersion
+	affixes     []Affix
+	rarities    []Rarity
+	valueBases  map[int]affixValueBase
+	levelScales map[int]float64
+	presets     []Preset
+	revision    string
+}
+
+// newBaseCatalog 创建首个游戏版本的目录
+func newBaseCatalog(version GameVersion) *Catalog {
+	return &Catalog{
+		Version:     version,
+		affixes:     baseAffixes(),
+		rarities:    baseRarities(),
+		valueBases:  affixValueBases,
+		levelScales: affixLevelScales,
+		presets:     basePresets(),
+	}
+}
+
+// clone 深拷贝目录，供版本补丁在副本上修改
+func (c *Catalog) clone(version GameVersion) *Catalog {
+	affixes := make([]Affix, len(c.affixes))
+	for i, affix := range c.affixes {
+		affix.Aliases = append([]string(nil), affix.Aliases...)
+		affixes[i] = affix
+	}
+
+	rarities := make([]Rarity, len(c.rarities))
+	for i, rarity := range c.rarities {
+		rarity.AffixPool = append([]int(nil), rarity.AffixPool...)
+		rarities[i] = rarity
+	}
+
+	valueBases := make(map[int]affixValueBase, len(c.valueBases))
+	for id, base := range c.valueBases {
+		base.tiers = append([]float64(nil), base.tiers...)
+		valueBases[id] = base
+	}
+
+	levelScales := make(map[int]float64, len(c.levelScales))
+	for level, scale := range c.levelScales {
+		levelScales[level] = scale
+	}
+
+	presets := make([]Preset, len(c.presets))
+	for i, preset := range c.presets {
+		preset.Targets = append([]int(nil), preset.Targets...)
+		presets[i] = preset
+	}
+
+	return &Catalog{
+		Version:     version,
+		affixes:     affixes,
+		rarities:    rarities,
+		valueBases:  valueBases,
+		levelScales: levelScales,
+		presets:     presets,
+	}
+}
+
+// Revision 获取目录内容的摘要，数据变化时摘要随之变化，用于计算结果的缓存键
+func (c *Catalog) Revision() string {
+	return c.revision
+}
+
+// fingerprint 计算目录内容的摘要，%#v输出全部字段且按键排序输出map，结果与生成顺序无关；预设不影响计算结果，不计入摘要
+func (c *Catalog) fingerprint() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%#v|%#v|%#v|%#v", c.affixes, c.rarities, c.valueBases, c.levelScales)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Affixes 获取所有词条定义
+func (c *Catalog) Affixes() []Affix {
+	return append([]Affix(nil), c.affixes...)
+}
+
+// AffixByID 根据ID获取词条
+func (c *Catalog) AffixByID(id int) *Affix {
+	for _, affix := range c.affixes {
+		if affix.ID == id {
+			return &affix
+		}
+	}
+	return nil
+}
+
+// AffixesByCategory 根据分类获取词条
+func (c *Catalog) AffixesByCategory(category AffixCategory) []Affix {
+	var result []Affix
+	for _, affix := range c.affixes {
+		if affix.Category == string(category) {
+			result = append(result, affix)
+		}
+	}
+	return result
+}
+
+// Rarities 获取所有稀有度定义，按稀有度从高到低排列
+func (c *Catalog) Rarities() []Rarity {
+	return append([]Rarity(nil), c.rarities...)
+}
+
+// RarityByID 根据ID获取稀有度
+func (c *Catalog) RarityByID(id string) *Rarity {
+	for _, rarity := range c.rarities {
+		if rarity.ID == id {
+			return &rarity
+		}
+	}
+	return nil
+}
+
+// Presets 获取所有内置预设
+func (c *Catalog) Presets() []Preset {
+	return append([]Preset(nil), c.presets...)
+}
+
+// PresetByID 根据ID获取内置预设
+func (c *Catalog) PresetByID(id string) *Preset {
+	for _, preset := range c.presets {
+		if preset.ID == id {
+			return &preset
+		}
+	}
+	return nil
+}
+
+// ResolveRarities 解析稀有度参数，any返回所有稀有度
+func (c *Catalog) ResolveRarities(id string) []Rarity {
+	if id == RarityAny {
+		return c.Rarities()
+	}
+	if rarity := c.RarityByID(id); rarity != nil {
+		return []Rarity{*rarity}
+	}
+	return nil
+}
+
+// MaxAffixLevel 获取有数值定义的最高词条等级
+func (c *Catalog) MaxAffixLevel() int {
+	max := 0
+	for level := range c.levelScales {
+		if level > max {
+			max = level
+		}
+	}
+	return max
+}
+
+// AffixValueRange 获取词条在指定等级下的数值分布
+func (c *Catalog) AffixValueRange(affixID, level int) *AffixValueRange {
+	base, ok := c.valueBases[affixID]
+	if !ok {
+		return nil
+	}
+	scale, ok := c.levelScales[level]
+	if !ok {
+		return nil
+	}
+
+	min := base.min * scale
+	max := base.max * scale
+	step := (max - min) / float64(len(base.tiers))
+
+	tiers := make([]AffixValueTier, 0, len(base.tiers))
+	for i, weight := range base.tiers {
+		tiers = append(tiers, AffixValueTier{
+			Tier:   i + 1,
+			Min:    min + step*float64(i),
+			Max:    min + step*float64(i+1),
+			Weight: weight,
+		})
+	}
+
+	return &AffixValueRange{
+		AffixID: affixID,
+		Level:   level,
+		Unit:    base.unit,
+		Min:     min,
+		Max:     max,
+		Tiers:   tiers,
+	}
+}
+
+// valueBaseIDs 获取有数值定义的词条ID，按ID排序
+func (c *Catalog) valueBaseIDs() []int {
+	ids := make([]int, 0, len(c.valueBases))
+	for id := range c.valueBases {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// 目录翻译，中文以目录定义为准，其他语言在此注册
+func init() {
+	i18n.Register("en", map[string]string{
+		"affix.1.name":         "Status DMG",
+		"affix.1.description":  "Increases status effect damage",
+		"affix.2.name":         "Magazine Capacity",
+		"affix.2.description":  "Increases weapon magazine capacity",
+		"affix.3.name":         "Reload Speed Bonus",
+		"affix.3.description":  "Increases reload speed",
+		"affix.4.name":         "DMG vs. Normal Enemies",
+		"affix.4.description":  "Deals extra damage to normal enemies",
+		"affix.5.name":         "DMG vs. Elite Enemies",
+		"affix.5.description":  "Deals extra damage to elite enemies",
+		"affix.6.name":         "DMG vs. Great Ones",
+		"affix.6.description":  "Deals extra damage to Great Ones",
+		"affix.7.name":         "Max HP",
+		"affix.7.description":  "Increases the character's max HP",
+		"affix.8.name":         "Head DMG Reduction",
+		"affix.8.description":  "Reduces damage taken to the head",
+		"affix.9.name":         "Gunshot DMG Reduction",
+		"affix.9.description":  "Reduces damage taken from firearms",
+		"affix.10.name":        "Status DMG Reduction",
+		"affix.10.description": "Reduces status effect damage taken",
+
+		"affix.category.damage":  "Damage",
+		"affix.category.defense": "Defense",
+		"affix.category.utility": "Utility",
+
+		"rarity.gold.name":   "Gold",
+		"rarity.purple.name": "Purple",
+		"rarity.blue.name":   "Blue",
+
+		"preset.elite-dps.name": "Elite DPS",
+		"preset.tank.name":      "Tank",
+
+		"version.1.0.name":  "Global Launch",
+		"version.1.1.name":  "Season Update",
+		"version.1.1.notes": "Elite enemy damage can roll on blue mods, gold drop weight increased, reload speed bonus values raised",
+	})
+}
+
+// Localize 返回指定语言的词条副本，缺少翻译时沿用目录定义
+func (a Affix) Localize(locale string) Affix {
+	a.Name = i18n.TOr(locale, fmt.Sprintf("affix.%d.name", a.ID), a.Name)
+	a.Description = i18n.TOr(locale, fmt.Sprintf("affix.%d.description", a.ID), a.Description)
+	return a
+}
+
+// Localize 返回指定语言的稀有度副本，缺少翻译时沿用目录定义
+func (r Rarity) Localize(locale string) Rarity {
+	r.Name = i18n.TOr(locale, fmt.Sprintf("rarity.%s.name", r.ID), r.Name)
+	return r
+}
+
+// Localize 返回指定语言的预设副本，缺少翻译时沿用目录定义
+func (p Preset) Localize(locale string) Preset {
+	p.Name = i18n.TOr(locale, "preset."+p.ID+".name", p.Name)
+	return p
+}
+
+// Localize 返回指定语言的游戏版本副本，缺少翻译时沿用版本定义
+func (v GameVersion) Localize(locale string) GameVersion {
+	v.Name = i18n.TOr(locale, "version."+v.ID+".name", v.Name)
+	v.Notes = i18n.TOr(locale, "version."+v.ID+".notes", v.Notes)
+	return v
+}
+
+// LocalizeAffixCategory 获取词条分类的本地化名称
+func LocalizeAffixCategory(category AffixCategory, locale string) string {
+	return i18n.TOr(locale, "affix.category."+string(category), string(category))
+}
+
+// GetAffixName 获取词条的本地化名称，词条不存在时返回空字符串
+func GetAffixName(id int, locale string) string {
+	affix := GetAffixByID(id)
+	if affix == nil {
+		return ""
+	}
+	return affix.Localize(locale).Name
+}
+
+// GetRarityName 获取稀有度的本地化名称，稀有度不存在时返回ID
+func GetRarityName(id, locale string) string {
+	rarity := GetRarityByID(id)
+	if rarity == nil {
+		return id
+	}
+	return rarity.Localize(locale).Name
+}
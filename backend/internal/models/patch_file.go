@@ -0,0 +1,167 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// patchFile 补丁目录中的游戏版本补丁，在上一版本目录的副本上修改，只修改列出的字段
+type patchFile struct {
+	Version     GameVersion       `json:"version"`
+	Rarities    []rarityPatch     `json:"rarities"`
+	AffixValues []affixValuePatch `json:"affixValues"`
+	LevelScales map[int]float64   `json:"levelScales"` // 等级相对1级的数值倍率
+}
+
+// rarityPatch 稀有度的修改，nil表示不修改
+type rarityPatch struct {
+	ID              string   `json:"id"`
+	DropWeight      *float64 `json:"dropWeight"`
+	SlotCount       *int     `json:"slotCount"`
+	AffixPool       []int    `json:"affixPool"`
+	MinStartLevel   *int     `json:"minStartLevel"`
+	MaxStartLevel   *int     `json:"maxStartLevel"`
+	MaxLevel        *int     `json:"maxLevel"`
+	MaxEnhancements *int     `json:"maxEnhancements"`
+}
+
+// affixValuePatch 词条1级数值基准的修改，nil表示不修改
+type affixValuePatch struct {
+	AffixID int       `json:"affixId"`
+	Min     *float64  `json:"min"`
+	Max     *float64  `json:"max"`
+	Tiers   []float64 `json:"tiers"`
+}
+
+// readPatchFiles 按文件名顺序读取目录中的*.json补丁，dir为空或目录不存在时没有补丁
+func readPatchFiles(dir string) ([]patchFile, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	patches := make([]patchFile, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var patch patchFile
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&patch); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if patch.Version.ID == "" {
+			return nil, fmt.Errorf("%s: version.id is required", name)
+		}
+		patches = append(patches, patch)
+	}
+	return patches, nil
+}
+
+// apply 在目录上应用补丁，修改不存在的稀有度或词条时返回错误
+func (p patchFile) apply(c *Catalog) error {
+	for _, patch := range p.Rarities {
+		var r *Rarity
+		for i := range c.rarities {
+			if c.rarities[i].ID == patch.ID {
+				r = &c.rarities[i]
+			}
+		}
+		if r == nil {
+			return fmt.Errorf("unknown rarity %q", patch.ID)
+		}
+		setFloat(&r.DropWeight, patch.DropWeight)
+		setInt(&r.SlotCount, patch.SlotCount)
+		setInt(&r.MinStartLevel, patch.MinStartLevel)
+		setInt(&r.MaxStartLevel, patch.MaxStartLevel)
+		setInt(&r.MaxLevel, patch.MaxLevel)
+		setInt(&r.MaxEnhancements, patch.MaxEnhancements)
+		if patch.AffixPool != nil {
+			r.AffixPool = append([]int(nil), patch.AffixPool...)
+		}
+	}
+
+	for _, patch := range p.AffixValues {
+		base, ok := c.valueBases[patch.AffixID]
+		if !ok {
+			return fmt.Errorf("unknown affix value %d", patch.AffixID)
+		}
+		setFloat(&base.min, patch.Min)
+		setFloat(&base.max, patch.Max)
+		if patch.Tiers != nil {
+			base.tiers = append([]float64(nil), patch.Tiers...)
+		}
+		c.valueBases[patch.AffixID] = base
+	}
+
+	for level, scale := range p.LevelScales {
+		c.levelScales[level] = scale
+	}
+	return c.validate()
+}
+
+// validate 检查补丁后的稀有度、数值基准和等级倍率能否用于计算
+func (c *Catalog) validate() error {
+	for _, r := range c.rarities {
+		switch {
+		case r.DropWeight <= 0:
+			return fmt.Errorf("rarity %s: dropWeight must be positive", r.ID)
+		case r.SlotCount < 1 || r.SlotCount > len(r.AffixPool):
+			return fmt.Errorf("rarity %s: slotCount must be between 1 and the affix pool size", r.ID)
+		case r.MinStartLevel < 1 || r.MinStartLevel > r.MaxStartLevel || r.MaxStartLevel > r.MaxLevel:
+			return fmt.Errorf("rarity %s: start levels must satisfy 1 <= minStartLevel <= maxStartLevel <= maxLevel", r.ID)
+		case r.MaxEnhancements < 0:
+			return fmt.Errorf("rarity %s: maxEnhancements must not be negative", r.ID)
+		}
+		for _, id := range r.AffixPool {
+			if c.AffixByID(id) == nil {
+				return fmt.Errorf("rarity %s: unknown affix %d in affixPool", r.ID, id)
+			}
+		}
+		for level := 1; level <= r.MaxLevel; level++ {
+			if _, ok := c.levelScales[level]; !ok {
+				return fmt.Errorf("rarity %s: no level scale for level %d", r.ID, level)
+			}
+		}
+	}
+	for id, base := range c.valueBases {
+		if base.min <= 0 || base.max < base.min || len(base.tiers) == 0 {
+			return fmt.Errorf("affix value %d: need 0 < min <= max and at least one tier", id)
+		}
+	}
+	return nil
+}
+
+func setInt(field *int, value *int) {
+	if value != nil {
+		*field = *value
+	}
+}
+
+func setFloat(field *float64, value *float64) {
+	if value != nil {
+		*field = *value
+	}
+}
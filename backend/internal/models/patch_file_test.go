@@ -0,0 +1,92 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// usePatchDir 使用临时补丁目录，测试结束后恢复为只有内置版本
+func usePatchDir(t *testing.T, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	SetPatchDir(dir)
+	t.Cleanup(func() {
+		SetPatchDir("")
+		if err := ReloadCatalogs(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestBuiltinCatalogsValid(t *testing.T) {
+	for _, catalog := range loadCatalogs() {
+		if err := catalog.validate(); err != nil {
+			t.Errorf("%s: %v", catalog.Version.ID, err)
+		}
+	}
+}
+
+// 补丁目录中的补丁追加在内置版本之后，成为最新版本
+func TestReloadCatalogsFromPatchDir(t *testing.T) {
+	usePatchDir(t, map[string]string{
+		"1.2.json": `{"version": {"id": "1.2", "name": "test"},
+			"rarities": [{"id": "blue", "dropWeight": 0.5}],
+			"affixValues": [{"affixId": 3, "max": 14}]}`,
+		"README.txt": "not a patch",
+	})
+	if err := ReloadCatalogs(); err != nil {
+		t.Fatal(err)
+	}
+
+	patched := GetCatalog("1.2")
+	if patched == nil || GetCatalog("") != patched {
+		t.Fatalf("GetCatalog(1.2) = %v, want the latest catalog", patched)
+	}
+	if got := patched.RarityByID("blue"); got.DropWeight != 0.5 || len(got.AffixPool) != 9 {
+		t.Errorf("1.2 blue = %+v, want dropWeight 0.5 and the 1.1 pool", got)
+	}
+	if got := patched.AffixValueRange(3, 1); got.Min != 6 || got.Max != 14 {
+		t.Errorf("1.2 affix 3 range = %v-%v, want 6-14", got.Min, got.Max)
+	}
+	if got := GetCatalog("1.1").RarityByID("blue").DropWeight; got != 0.6 {
+		t.Errorf("1.1 blue dropWeight = %v, want 0.6", got)
+	}
+	if patched.Revision() == GetCatalog("1.1").Revision() {
+		t.Error("1.1 and 1.2 have the same revision")
+	}
+}
+
+// 补丁有错误时返回错误，继续使用当前的目录
+func TestReloadCatalogsInvalidPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"missing version", `{"rarities": [{"id": "blue", "dropWeight": 0.5}]}`},
+		{"existing version", `{"version": {"id": "1.1"}}`},
+		{"unknown field", `{"version": {"id": "1.2"}, "dropWeight": 0.5}`},
+		{"unknown rarity", `{"version": {"id": "1.2"}, "rarities": [{"id": "red", "dropWeight": 0.5}]}`},
+		{"unknown affix value", `{"version": {"id": "1.2"}, "affixValues": [{"affixId": 99, "max": 1}]}`},
+		{"max level below start level", `{"version": {"id": "1.2"}, "rarities": [{"id": "gold", "maxLevel": 1}]}`},
+		{"slots exceed pool", `{"version": {"id": "1.2"}, "rarities": [{"id": "blue", "affixPool": [1]}]}`},
+		{"missing level scale", `{"version": {"id": "1.2"}, "rarities": [{"id": "gold", "maxLevel": 6}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usePatchDir(t, map[string]string{"patch.json": tt.patch})
+			before := loadCatalogs()
+			if err := ReloadCatalogs(); err == nil {
+				t.Fatal("ReloadCatalogs() succeeded")
+			}
+			if after := loadCatalogs(); len(after) != len(before) || after[0] != before[0] {
+				t.Error("catalogs changed after a failed reload")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+// PresetPrefix 目标词条中引用预设的前缀，如preset:elite-dps
+const PresetPrefix = "preset:"
+
+// Preset 随目录发布的内置目标词条预设
+type Preset struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Targets []int  `json:"targets"` // 目标词条ID
+}
+
+// basePresets 首个游戏版本的内置预设
+func basePresets() []Preset {
+	return []Preset{
+		{ID: "elite-dps", Name: "精英输出", Targets: []int{4, 5, 6}},
+		{ID: "tank", Name: "坦克", Targets: []int{7, 8, 9, 10}},
+	}
+}
@@ -0,0 +1,74 @@
+package models
+
+// Rarity 模组稀有度
+type Rarity struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	DropWeight      float64 `json:"dropWeight"`      // 掉落权重
+	SlotCount       int     `json:"slotCount"`       // 词条数量
+	AffixPool       []int   `json:"affixPool"`       // 可出现的词条ID
+	MinStartLevel   int     `json:"minStartLevel"`   // 掉落时词条最低等级
+	MaxStartLevel   int     `json:"maxStartLevel"`   // 掉落时词条最高等级
+	MaxLevel        int     `json:"maxLevel"`        // 词条最高等级
+	MaxEnhancements int     `json:"maxEnhancements"` // 强化次数
+}
+
+// RarityAny 任意稀有度，按掉落权重对所有稀有度积分
+const RarityAny = "any"
+
+// RarityGold 金色稀有度，未指定稀有度时的强化规则
+const RarityGold = "gold"
+
+// baseRarities 首个游戏版本的稀有度定义，按稀有度从高到低排列
+func baseRarities() []Rarity {
+	return []Rarity{
+		{
+			ID:              RarityGold,
+			Name:            "金色",
+			DropWeight:      0.1,
+			SlotCount:       4,
+			AffixPool:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			MinStartLevel:   1,
+			MaxStartLevel:   2,
+			MaxLevel:        5,
+			MaxEnhancements: 5,
+		},
+		{
+			ID:              "purple",
+			Name:            "紫色",
+			DropWeight:      0.3,
+			SlotCount:       3,
+			AffixPool:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			MinStartLevel:   1,
+			MaxStartLevel:   1,
+			MaxLevel:        4,
+			MaxEnhancements: 4,
+		},
+		{
+			ID:              "blue",
+			Name:            "蓝色",
+			DropWeight:      0.6,
+			SlotCount:       2,
+			AffixPool:       []int{1, 2, 3, 4, 7, 8, 9, 10},
+			MinStartLevel:   1,
+			MaxStartLevel:   1,
+			MaxLevel:        3,
+			MaxEnhancements: 3,
+		},
+	}
+}
+
+// GetAllRarities 获取最新游戏版本的所有稀有度定义，按稀有度从高到低排列
+func GetAllRarities() []Rarity {
+	return LatestCatalog().Rarities()
+}
+
+// GetRarityByID 根据ID获取最新游戏版本的稀有度
+func GetRarityByID(id string) *Rarity {
+	return LatestCatalog().RarityByID(id)
+}
+
+// ResolveRarities 解析最新游戏版本的稀有度参数，any返回所有稀有度
+func ResolveRarities(id string) []Rarity {
+	return LatestCatalog().ResolveRarities(id)
+}
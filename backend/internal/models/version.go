@@ -0,0 +1,412 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GameVersion 游戏版本
+type GameVersion struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Notes       string `json:"notes,omitempty"`
+}
+
+// GameVersionLatest 最新游戏版本，未指定版本时使用
+const GameVersionLatest = "latest"
+
+// gamePatch 游戏版本补丁，在上一版本目录的副本上修改词条、数值和规则
+type gamePatch struct {
+	version GameVersion
+	apply   func(c *Catalog)
+}
+
+// gamePatches 按发布时间排列的游戏版本，第一个为基础版本
+// 游戏调整词条或强化规则时追加新的补丁，已发布版本的数据保持不变
+var gamePatches = []gamePatch{
+	{
+		version: GameVersion{ID: "1.0", Name: "正式上线", ReleaseDate: "2024-07-09"},
+	},
+	{
+		version: GameVersion{ID: "1.1", Name: "赛季更新", ReleaseDate: "2024-10-17",
+			Notes: "蓝色模组可出现对精英敌人伤害，金色掉落权重提高，换弹速度加成数值上调"},
+		apply: func(c *Catalog) {
+			c.updateRarity(RarityGold, func(r *Rarity) { r.DropWeight = 0.12 })
+			c.updateRarity("purple", func(r *Rarity) { r.DropWeight = 0.28 })
+			c.updateRarity("blue", func(r *Rarity) { r.AffixPool = []int{1, 2, 3, 4, 5, 7, 8, 9, 10} })
+			c.updateValueBase(3, func(b *affixValueBase) { b.min, b.max = 6, 13 })
+		},
+	},
+}
+
+// updateRarity 在补丁中修改稀有度
+func (c *Catalog) updateRarity(id string, update func(r *Rarity)) {
+	for i := range c.rarities {
+		if c.rarities[i].ID == id {
+			update(&c.rarities[i])
+			return
+		}
+	}
+	panic(fmt.Sprintf("models: patch %s updates unknown rarity %q", c.Version.ID, id))
+}
+
+// updateValueBase 在补丁中修改词条1级数值基准
+func (c *Catalog) updateValueBase(affixID int, update func(b *affixValueBase)) {
+	base, ok := c.valueBases[affixID]
+	if !ok {
+		panic(fmt.Sprintf("models: patch %s updates unknown affix value %d", c.Version.ID, affixID))
+	}
+	update(&base)
+	c.valueBases[affixID] = base
+}
+
+var (
+	catalogsMu  sync.RWMutex
+	catalogs    []*Catalog
+	patchDir    string
+	reloadHooks []func()
+)
+
+// buildCatalogs 依次应用内置补丁和补丁目录中的补丁生成各版本目录
+func buildCatalogs(files []patchFile) ([]*Catalog, error) {
+	built := make([]*Catalog, 0, len(gamePatches)+len(files))
+	for i, patch := range gamePatches {
+		var catalog *Catalog
+		if i == 0 {
+			catalog = newBaseCatalog(patch.version)
+		} else {
+			catalog = built[i-1].clone(patch.version)
+		}
+		if patch.apply != nil {
+			patch.apply(catalog)
+		}
+		catalog.revision = catalog.fingerprint()
+		built = append(built, catalog)
+	}
+
+	for _, file := range files {
+		for _, catalog := range built {
+			if catalog.Version.ID == file.Version.ID {
+				return nil, fmt.Errorf("models: patch %s: version already exists", file.Version.ID)
+			}
+		}
+		catalog := built[len(built)-1].clone(file.Version)
+		if err := file.apply(catalog); err != nil {
+			return nil, fmt.Errorf("models: patch %s: %w", file.Version.ID, err)
+		}
+		catalog.revision = catalog.fingerprint()
+		built = append(built, catalog)
+	}
+	return built, nil
+}
+
+// loadCatalogs 获取各版本目录，首次调用时只用内置补丁生成，补丁目录在ReloadCatalogs时读取
+func loadCatalogs() []*Catalog {
+	catalogsMu.RLock()
+	loaded := catalogs
+	catalogsMu.RUnlock()
+	if loaded != nil {
+		return loaded
+	}
+
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	if catalogs == nil {
+		catalogs, _ = buildCatalogs(nil)
+	}
+	return catalogs
+}
+
+// CatalogsLoaded 检查各版本目录是否已生成
+func CatalogsLoaded() bool {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+	return len(catalogs) > 0
+}
+
+// SetPatchDir 设置补丁目录，目录中的*.json补丁按文件名顺序追加在内置版本之后，调用ReloadCatalogs后生效
+func SetPatchDir(dir string) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	patchDir = dir
+}
+
+// ReloadCatalogs 重新读取补丁目录并生成各版本目录，完成后依次调用OnCatalogReload注册的回调
+// 补丁无法读取或应用时返回错误，继续使用当前的目录
+func ReloadCatalogs() error {
+	catalogsMu.RLock()
+	dir := patchDir
+	catalogsMu.RUnlock()
+
+	files, err := readPatchFiles(dir)
+	if err != nil {
+		return fmt.Errorf("models: read patches: %w", err)
+	}
+	built, err := buildCatalogs(files)
+	if err != nil {
+		return err
+	}
+
+	catalogsMu.Lock()
+	catalogs = built
+	hooks := append([]func(){}, reloadHooks...)
+	catalogsMu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+// OnCatalogReload 注册目录重新加载后的回调，如清空计算结果缓存
+func OnCatalogReload(hook func()) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	reloadHooks = append(reloadHooks, hook)
+}
+
+// GetGameVersions 获取所有游戏版本，按发布时间从早到晚排列
+func GetGameVersions() []GameVersion {
+	versions := make([]GameVersion, 0, len(gamePatches))
+	for _, catalog := range loadCatalogs() {
+		versions = append(versions, catalog.Version)
+	}
+	return versions
+}
+
+// LatestCatalog 获取最新游戏版本的目录
+func LatestCatalog() *Catalog {
+	all := loadCatalogs()
+	return all[len(all)-1]
+}
+
+// GetCatalog 获取指定游戏版本的目录，空字符串或latest表示最新版本，版本不存在时返回nil
+func GetCatalog(version string) *Catalog {
+	if version == "" || version == GameVersionLatest {
+		return LatestCatalog()
+	}
+	for _, catalog := range loadCatalogs() {
+		if catalog.Version.ID == version {
+			return catalog
+		}
+	}
+	return nil
+}
+
+// PreviousCatalog 获取指定目录的上一个游戏版本，首个版本返回nil
+func PreviousCatalog(c *Catalog) *Catalog {
+	all := loadCatalogs()
+	for i, catalog := range all {
+		if catalog == c && i > 0 {
+			return all[i-1]
+		}
+	}
+	return nil
+}
+
+// 变动对象
+const (
+	ChangeTargetAffix      = "affix"
+	ChangeTargetRarity     = "rarity"
+	ChangeTargetAffixValue = "affixValue"
+	ChangeTargetLevelScale = "levelScale"
+)
+
+// 变动类型
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// CatalogChange 两个游戏版本之间的单项变动
+type CatalogChange struct {
+	Target string `json:"target"`
+	ID     string `json:"id"`
+	Action string `json:"action"`
+	Field  string `json:"field,omitempty"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// DiffCatalogs 比较两个游戏版本的词条、数值、掉落权重和强化规则
+func DiffCatalogs(from, to *Catalog) []CatalogChange {
+	var changes []CatalogChange
+
+	// 词条
+	for _, id := range unionIDs(affixIDs(from), affixIDs(to)) {
+		key := strconv.Itoa(id)
+		old, cur := from.AffixByID(id), to.AffixByID(id)
+		switch {
+		case old == nil:
+			changes = append(changes, CatalogChange{Target: ChangeTargetAffix, ID: key, Action: ChangeAdded, New: cur.Name})
+		case cur == nil:
+			changes = append(changes, CatalogChange{Target: ChangeTargetAffix, ID: key, Action: ChangeRemoved, Old: old.Name})
+		default:
+			changes = appendFieldChanges(changes, ChangeTargetAffix, key, []fieldPair{
+				{"name", old.Name, cur.Name},
+				{"description", old.Description, cur.Description},
+				{"category", old.Category, cur.Category},
+			})
+		}
+	}
+
+	// 稀有度与强化规则
+	for _, id := range unionStrings(rarityIDs(from), rarityIDs(to)) {
+		old, cur := from.RarityByID(id), to.RarityByID(id)
+		switch {
+		case old == nil:
+			changes = append(changes, CatalogChange{Target: ChangeTargetRarity, ID: id, Action: ChangeAdded, New: cur.Name})
+		case cur == nil:
+			changes = append(changes, CatalogChange{Target: ChangeTargetRarity, ID: id, Action: ChangeRemoved, Old: old.Name})
+		default:
+			changes = appendFieldChanges(changes, ChangeTargetRarity, id, []fieldPair{
+				{"dropWeight", formatFloat(old.DropWeight), formatFloat(cur.DropWeight)},
+				{"slotCount", strconv.Itoa(old.SlotCount), strconv.Itoa(cur.SlotCount)},
+				{"affixPool", formatInts(old.AffixPool), formatInts(cur.AffixPool)},
+				{"minStartLevel", strconv.Itoa(old.MinStartLevel), strconv.Itoa(cur.MinStartLevel)},
+				{"maxStartLevel", strconv.Itoa(old.MaxStartLevel), strconv.Itoa(cur.MaxStartLevel)},
+				{"maxLevel", strconv.Itoa(old.MaxLevel), strconv.Itoa(cur.MaxLevel)},
+				{"maxEnhancements", strconv.Itoa(old.MaxEnhancements), strconv.Itoa(cur.MaxEnhancements)},
+			})
+		}
+	}
+
+	// 词条数值
+	for _, id := range unionIDs(from.valueBaseIDs(), to.valueBaseIDs()) {
+		key := strconv.Itoa(id)
+		old, oldOK := from.valueBases[id]
+		cur, curOK := to.valueBases[id]
+		switch {
+		case !oldOK:
+			changes = append(changes, CatalogChange{Target: ChangeTargetAffixValue, ID: key, Action: ChangeAdded, New: cur.String()})
+		case !curOK:
+			changes = append(changes, CatalogChange{Target: ChangeTargetAffixValue, ID: key, Action: ChangeRemoved, Old: old.String()})
+		default:
+			changes = appendFieldChanges(changes, ChangeTargetAffixValue, key, []fieldPair{
+				{"unit", old.unit, cur.unit},
+				{"min", formatFloat(old.min), formatFloat(cur.min)},
+				{"max", formatFloat(old.max), formatFloat(cur.max)},
+				{"tierWeights", formatFloats(old.tiers), formatFloats(cur.tiers)},
+			})
+		}
+	}
+
+	// 等级倍率
+	for level := 1; level <= maxInt(from.MaxAffixLevel(), to.MaxAffixLevel()); level++ {
+		key := strconv.Itoa(level)
+		old, oldOK := from.levelScales[level]
+		cur, curOK := to.levelScales[level]
+		switch {
+		case !oldOK && curOK:
+			changes = append(changes, CatalogChange{Target: ChangeTargetLevelScale, ID: key, Action: ChangeAdded, New: formatFloat(cur)})
+		case oldOK && !curOK:
+			changes = append(changes, CatalogChange{Target: ChangeTargetLevelScale, ID: key, Action: ChangeRemoved, Old: formatFloat(old)})
+		case oldOK && curOK && old != cur:
+			changes = append(changes, CatalogChange{Target: ChangeTargetLevelScale, ID: key, Action: ChangeChanged, Field: "scale", Old: formatFloat(old), New: formatFloat(cur)})
+		}
+	}
+
+	return changes
+}
+
+// fieldPair 字段的新旧值
+type fieldPair struct {
+	field string
+	old   string
+	new   string
+}
+
+// appendFieldChanges 追加发生变化的字段
+func appendFieldChanges(changes []CatalogChange, target, id string, fields []fieldPair) []CatalogChange {
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, CatalogChange{
+				Target: target,
+				ID:     id,
+				Action: ChangeChanged,
+				Field:  f.field,
+				Old:    f.old,
+				New:    f.new,
+			})
+		}
+	}
+	return changes
+}
+
+// String 输出数值基准摘要，用于变动记录
+func (b affixValueBase) String() string {
+	return fmt.Sprintf("%s-%s%s", formatFloat(b.min), formatFloat(b.max), b.unit)
+}
+
+func affixIDs(c *Catalog) []int {
+	ids := make([]int, 0, len(c.affixes))
+	for _, affix := range c.affixes {
+		ids = append(ids, affix.ID)
+	}
+	return ids
+}
+
+func rarityIDs(c *Catalog) []string {
+	ids := make([]string, 0, len(c.rarities))
+	for _, rarity := range c.rarities {
+		ids = append(ids, rarity.ID)
+	}
+	return ids
+}
+
+// unionIDs 合并两个ID列表，保持首次出现的顺序
+func unionIDs(a, b []int) []int {
+	seen := make(map[int]bool)
+	var result []int
+	for _, id := range append(append([]int(nil), a...), b...) {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// unionStrings 合并两个字符串列表，保持首次出现的顺序
+func unionStrings(a, b []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, id := range append(append([]string(nil), a...), b...) {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func formatFloats(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatFloat(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
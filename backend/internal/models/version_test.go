@@ -0,0 +1,69 @@
+package models
+
+import "testing"
+
+func TestGetCatalog(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"", "1.1"},
+		{GameVersionLatest, "1.1"},
+		{"1.0", "1.0"},
+		{"1.1", "1.1"},
+	}
+	for _, tt := range tests {
+		catalog := GetCatalog(tt.version)
+		if catalog == nil || catalog.Version.ID != tt.want {
+			t.Errorf("GetCatalog(%q) = %v, want %s", tt.version, catalog, tt.want)
+		}
+	}
+	if catalog := GetCatalog("0.9"); catalog != nil {
+		t.Errorf("GetCatalog(0.9) = %s, want nil", catalog.Version.ID)
+	}
+}
+
+func TestDiffCatalogs(t *testing.T) {
+	from, to := GetCatalog("1.0"), GetCatalog("1.1")
+	changes := DiffCatalogs(from, to)
+
+	want := []CatalogChange{
+		{Target: ChangeTargetRarity, ID: "gold", Action: ChangeChanged, Field: "dropWeight", Old: "0.1", New: "0.12"},
+		{Target: ChangeTargetRarity, ID: "purple", Action: ChangeChanged, Field: "dropWeight", Old: "0.3", New: "0.28"},
+		{Target: ChangeTargetRarity, ID: "blue", Action: ChangeChanged, Field: "affixPool", Old: "1,2,3,4,7,8,9,10", New: "1,2,3,4,5,7,8,9,10"},
+		{Target: ChangeTargetAffixValue, ID: "3", Action: ChangeChanged, Field: "min", Old: "5", New: "6"},
+		{Target: ChangeTargetAffixValue, ID: "3", Action: ChangeChanged, Field: "max", Old: "12", New: "13"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("DiffCatalogs(1.0, 1.1) = %+v, want %+v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	if reverse := DiffCatalogs(to, from); len(reverse) != len(want) {
+		t.Errorf("DiffCatalogs(1.1, 1.0) has %d changes, want %d", len(reverse), len(want))
+	}
+	if same := DiffCatalogs(to, to); len(same) != 0 {
+		t.Errorf("DiffCatalogs(1.1, 1.1) = %+v, want no changes", same)
+	}
+}
+
+// 补丁在副本上修改，已发布版本的数据和摘要保持不变
+func TestPatchesKeepPreviousVersions(t *testing.T) {
+	base := GetCatalog("1.0")
+	if got := base.RarityByID("blue").AffixPool; len(got) != 8 {
+		t.Errorf("1.0 blue pool = %v, want 8 affixes", got)
+	}
+	if got := base.AffixValueRange(3, 1); got.Min != 5 || got.Max != 12 {
+		t.Errorf("1.0 affix 3 range = %v-%v, want 5-12", got.Min, got.Max)
+	}
+	if base.Revision() == GetCatalog("1.1").Revision() {
+		t.Error("1.0 and 1.1 have the same revision")
+	}
+	if prev := PreviousCatalog(GetCatalog("1.1")); prev != base {
+		t.Errorf("PreviousCatalog(1.1) = %v, want 1.0", prev)
+	}
+}
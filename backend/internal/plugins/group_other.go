@@ -0,0 +1,13 @@
+//go:build !unix
+
+package plugins
+
+import "os/exec"
+
+// setProcessGroup 没有进程组的平台不设置
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup 没有进程组的平台只结束插件进程
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
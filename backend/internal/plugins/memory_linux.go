@@ -0,0 +1,9 @@
+package plugins
+
+import "golang.org/x/sys/unix"
+
+// limitMemory 设置进程的虚拟内存上限（RLIMIT_AS），超过上限的内存分配失败，插件通常因此退出
+func limitMemory(pid int, limit int64) error {
+	rlimit := &unix.Rlimit{Cur: uint64(limit), Max: uint64(limit)}
+	return unix.Prlimit(pid, unix.RLIMIT_AS, rlimit, nil)
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+
+package plugins
+
+// limitMemory 非Linux平台不限制插件内存
+func limitMemory(pid int, limit int64) error {
+	return nil
+}
@@ -0,0 +1,324 @@
+// Package plugins 外部工具插件：插件目录中的可执行文件，通过标准输入输出以JSON-RPC 2.0通信
+//
+// 每条消息为一行JSON。插件需要实现两个方法：
+//   - describe：无参数，返回Info，声明工具的ID、名称、分类和输入的JSON Schema
+//   - run：参数为{"input": 工具输入, "locale": 语言}，返回任意JSON结果；输入无效时返回错误码-32602
+//
+// 插件进程在独立的进程组中运行，Linux上设置虚拟内存上限（RLIMIT_AS），超过上限的内存分配失败。
+// 插件的标准错误输出记入日志。调用超时时结束插件的进程组，进程退出后按退避时间重新启动。
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/registry"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// CategoryPlugin 插件未声明分类时使用的分类
+const CategoryPlugin = "plugin"
+
+// 插件调用的错误
+var (
+	ErrTimeout     = errors.New("plugins: call timed out")
+	ErrExited      = errors.New("plugins: process exited")
+	ErrUnavailable = errors.New("plugins: plugin is restarting")
+	ErrClosed      = errors.New("plugins: manager closed")
+)
+
+// idPattern 插件ID，同时用作Discord命令名称
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// Options 插件运行限制
+type Options struct {
+	Timeout      time.Duration // 单次调用的时间上限，超时后结束插件进程
+	MemoryLimit  int64         // 插件进程的虚拟内存（RLIMIT_AS）上限，字节，0表示不限制；只在Linux上生效
+	RestartDelay time.Duration // 进程退出后重新启动的初始等待时间，连续退出时加倍
+}
+
+// ConfigOptions 根据插件配置生成运行限制，服务和机器人共用
+func ConfigOptions(cfg config.PluginsConfig) Options {
+	return Options{
+		Timeout:      cfg.Timeout,
+		MemoryLimit:  int64(cfg.MemoryLimitMB) << 20,
+		RestartDelay: cfg.RestartDelay,
+	}
+}
+
+// Info 插件通过describe声明的工具信息
+type Info struct {
+	ID           string                       `json:"id"`
+	Name         string                       `json:"name"`
+	Description  string                       `json:"description,omitempty"`
+	Category     string                       `json:"category,omitempty"`
+	Icon         string                       `json:"icon,omitempty"`
+	InputSchema  json.RawMessage              `json:"inputSchema,omitempty"`
+	Translations map[string]map[string]string `json:"translations,omitempty"` // 语言 -> name/description
+}
+
+// Manager 管理插件进程
+type Manager struct {
+	opts    Options
+	mu      sync.Mutex
+	plugins []*Plugin
+}
+
+// Load 启动目录中的全部可执行文件并读取工具信息，目录不存在时没有插件；无法启动或声明无效的插件记录日志后跳过
+func Load(ctx context.Context, dir string, opts Options) (*Manager, error) {
+	m := &Manager{opts: opts}
+	// 插件进程的工作目录为插件目录，使用绝对路径启动
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		p, err := m.start(ctx, path)
+		if err == nil && seen[p.info.ID] {
+			err = fmt.Errorf("duplicate plugin id %q", p.info.ID)
+			p.close()
+		}
+		if err != nil {
+			slog.Error("加载插件失败", "path", path, "error", err)
+			continue
+		}
+		seen[p.info.ID] = true
+		m.plugins = append(m.plugins, p)
+		slog.Info("已加载插件", "plugin", p.info.ID, "path", path)
+	}
+	return m, nil
+}
+
+// start 启动插件并读取工具信息
+func (m *Manager) start(ctx context.Context, path string) (*Plugin, error) {
+	p := &Plugin{path: path, opts: m.opts, delay: m.opts.RestartDelay}
+	if err := p.spawn(); err != nil {
+		return nil, err
+	}
+
+	var info Info
+	if err := p.call(ctx, "describe", nil, &info); err != nil {
+		p.close()
+		return nil, fmt.Errorf("describe: %w", err)
+	}
+	if !idPattern.MatchString(info.ID) {
+		p.close()
+		return nil, fmt.Errorf("invalid plugin id %q", info.ID)
+	}
+	if info.Name == "" {
+		info.Name = info.ID
+	}
+	if info.Category == "" {
+		info.Category = CategoryPlugin
+	}
+	p.info = info
+	p.registerTranslations()
+	return p, nil
+}
+
+// Plugins 获取已加载的插件，按文件名排序
+func (m *Manager) Plugins() []*Plugin {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*Plugin(nil), m.plugins...)
+}
+
+// Close 结束全部插件进程，之后的调用返回ErrClosed
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, p := range m.plugins {
+		p.close()
+	}
+	return nil
+}
+
+// Plugin 一个插件及其当前进程
+type Plugin struct {
+	path string
+	opts Options
+	info Info
+
+	mu      sync.Mutex
+	proc    *process
+	closed  bool
+	delay   time.Duration // 下次重新启动前的等待时间
+	restart *time.Timer
+}
+
+// Info 获取插件声明的工具信息
+func (p *Plugin) Info() Info {
+	return p.info
+}
+
+// Tool 转换为工具注册表中的工具，默认启用
+func (p *Plugin) Tool() registry.Tool {
+	return registry.Tool{
+		ID:          p.info.ID,
+		Name:        p.info.Name,
+		Description: p.info.Description,
+		Category:    p.info.Category,
+		Icon:        p.info.Icon,
+		InputSchema: p.info.InputSchema,
+		Source:      registry.SourcePlugin,
+		Handler: func(ctx context.Context, input json.RawMessage, locale string) (interface{}, error) {
+			return p.Run(ctx, input, locale)
+		},
+		Enabled: true,
+	}
+}
+
+// Run 调用插件的run方法
+func (p *Plugin) Run(ctx context.Context, input json.RawMessage, locale string) (json.RawMessage, error) {
+	params := struct {
+		Input  json.RawMessage `json:"input"`
+		Locale string          `json:"locale"`
+	}{input, locale}
+	var result json.RawMessage
+	if err := p.call(ctx, "run", params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// registerTranslations 将插件声明的名称和描述翻译注册到i18n
+func (p *Plugin) registerTranslations() {
+	for locale, texts := range p.info.Translations {
+		messages := make(map[string]string, 2)
+		for _, field := range []string{"name", "description"} {
+			if text := texts[field]; text != "" {
+				messages["tool."+p.info.ID+"."+field] = text
+			}
+		}
+		i18n.Register(locale, messages)
+	}
+}
+
+// call 调用插件方法，进程未运行时启动；超时时结束进程
+func (p *Plugin) call(ctx context.Context, method string, params, result interface{}) error {
+	proc, err := p.running()
+	if err != nil {
+		return err
+	}
+
+	if p.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.opts.Timeout)
+		defer cancel()
+	}
+	err = proc.call(ctx, method, params, result)
+	if errors.Is(err, context.DeadlineExceeded) {
+		// 插件可能卡住，结束进程，之后的调用使用新进程
+		slog.Warn("插件调用超时，结束进程", "plugin", p.info.ID, "method", method, "timeout", p.opts.Timeout)
+		proc.kill(ErrTimeout)
+		return ErrTimeout
+	}
+	return err
+}
+
+// running 获取运行中的进程，进程已退出且过了退避时间时重新启动
+func (p *Plugin) running() (*process, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrClosed
+	}
+	if p.proc != nil && !p.proc.exited() {
+		return p.proc, nil
+	}
+	if p.restart != nil {
+		return nil, ErrUnavailable
+	}
+	if err := p.spawnLocked(); err != nil {
+		return nil, err
+	}
+	return p.proc, nil
+}
+
+// spawn 启动插件进程
+func (p *Plugin) spawn() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.spawnLocked()
+}
+
+// spawnLocked 启动插件进程，进程退出后按退避时间重新启动，调用方需持有锁
+func (p *Plugin) spawnLocked() error {
+	proc, err := startProcess(p.path, p.opts.MemoryLimit)
+	if err != nil {
+		return err
+	}
+	p.proc = proc
+	started := time.Now()
+
+	go func() {
+		<-proc.done
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.closed || p.proc != proc {
+			return
+		}
+
+		// 稳定运行一段时间后退避时间恢复初始值
+		if time.Since(started) > time.Minute {
+			p.delay = p.opts.RestartDelay
+		}
+		delay := p.delay
+		p.delay = min(2*p.delay, time.Minute)
+		slog.Warn("插件进程已退出，稍后重新启动", "plugin", p.info.ID, "error", proc.err, "delay", delay)
+
+		p.restart = time.AfterFunc(delay, func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.restart = nil
+			if p.closed || p.proc != proc {
+				return
+			}
+			if err := p.spawnLocked(); err != nil {
+				slog.Error("重新启动插件失败", "plugin", p.info.ID, "error", err)
+			}
+		})
+	}()
+	return nil
+}
+
+// close 结束插件进程并停止重新启动
+func (p *Plugin) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	if p.restart != nil {
+		p.restart.Stop()
+		p.restart = nil
+	}
+	if p.proc != nil {
+		p.proc.kill(ErrClosed)
+	}
+}
@@ -0,0 +1,173 @@
+//go:build unix
+
+package plugins
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// helperEnv 设置时测试二进制作为插件运行
+const helperEnv = "PLUGINS_TEST_HELPER"
+
+// TestHelperPlugin 测试插件：run的输入为{"mode": ...}，echo返回输入，invalid返回参数错误，
+// sleep不返回，alloc分配超过内存上限的内存，spawn启动子进程并返回其PID后不返回
+func TestHelperPlugin(t *testing.T) {
+	if os.Getenv(helperEnv) == "" {
+		t.Skip("helper process")
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Input struct {
+					Mode string `json:"mode"`
+				} `json:"input"`
+			} `json:"params"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			os.Exit(2)
+		}
+		reply := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch {
+		case req.Method == "describe":
+			reply["result"] = Info{ID: "helper", InputSchema: json.RawMessage(`{"type":"object"}`)}
+		case req.Params.Input.Mode == "echo":
+			reply["result"] = req.Params.Input
+		case req.Params.Input.Mode == "invalid":
+			reply["error"] = RPCError{Code: CodeInvalidParams, Message: "bad mode"}
+		case req.Params.Input.Mode == "alloc":
+			data := make([]byte, 8<<30)
+			reply["result"] = len(data)
+		case req.Params.Input.Mode == "spawn":
+			child := exec.Command("sleep", "60")
+			if err := child.Start(); err != nil {
+				os.Exit(2)
+			}
+			_ = out.Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": child.Process.Pid})
+			time.Sleep(time.Hour)
+		default:
+			time.Sleep(time.Hour)
+		}
+		_ = out.Encode(reply)
+	}
+	os.Exit(0)
+}
+
+// loadHelper 在临时插件目录中放置运行TestHelperPlugin的脚本并加载
+func loadHelper(t *testing.T, opts Options) *Plugin {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	script := fmt.Sprintf("#!/bin/sh\nexec %s -test.run='^TestHelperPlugin$'\n", strconv.Quote(exe))
+	if err := os.WriteFile(filepath.Join(dir, "helper"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(helperEnv, "1")
+
+	m, err := Load(context.Background(), dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { m.Close() })
+	if len(m.Plugins()) != 1 {
+		t.Fatalf("loaded %d plugins, want 1", len(m.Plugins()))
+	}
+	return m.Plugins()[0]
+}
+
+func runMode(p *Plugin, mode string) (json.RawMessage, error) {
+	return p.Run(context.Background(), json.RawMessage(`{"mode":"`+mode+`"}`), "en")
+}
+
+func TestPluginRun(t *testing.T) {
+	p := loadHelper(t, Options{Timeout: 500 * time.Millisecond, RestartDelay: time.Hour})
+	if id := p.Info().ID; id != "helper" {
+		t.Fatalf("Info().ID = %q, want helper", id)
+	}
+	if p.Info().Category != CategoryPlugin {
+		t.Errorf("Info().Category = %q, want %q", p.Info().Category, CategoryPlugin)
+	}
+
+	tests := []struct {
+		mode   string
+		result string
+		err    error
+		code   int
+	}{
+		{mode: "echo", result: `{"mode":"echo"}`},
+		{mode: "invalid", code: CodeInvalidParams},
+		{mode: "sleep", err: ErrTimeout},
+	}
+	for _, tt := range tests {
+		result, err := runMode(p, tt.mode)
+		var rpcErr *RPCError
+		switch {
+		case tt.code != 0:
+			if !errors.As(err, &rpcErr) || rpcErr.Code != tt.code {
+				t.Errorf("%s: Run() error = %v, want rpc error %d", tt.mode, err, tt.code)
+			}
+		case tt.err != nil:
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%s: Run() error = %v, want %v", tt.mode, err, tt.err)
+			}
+		case err != nil || string(result) != tt.result:
+			t.Errorf("%s: Run() = %s, %v, want %s", tt.mode, result, err, tt.result)
+		}
+	}
+}
+
+func TestPluginTimeoutKillsProcessGroup(t *testing.T) {
+	p := loadHelper(t, Options{Timeout: time.Second, RestartDelay: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	proc, err := p.running()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pid int
+	if err := proc.call(ctx, "run", map[string]interface{}{"input": map[string]string{"mode": "spawn"}}, &pid); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	// spawn之后插件不再响应，调用超时后整个进程组被结束
+	if _, err := runMode(p, "echo"); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Run() error = %v, want %v", err, ErrTimeout)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for syscall.Kill(pid, 0) == nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("child process %d still running", pid)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestPluginMemoryLimit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("memory limit only applies on linux")
+	}
+	p := loadHelper(t, Options{Timeout: 5 * time.Second, MemoryLimit: 4 << 30, RestartDelay: time.Hour})
+	if _, err := runMode(p, "alloc"); !errors.Is(err, ErrExited) {
+		t.Fatalf("Run() error = %v, want %v", err, ErrExited)
+	}
+}
@@ -0,0 +1,224 @@
+package plugins
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"sync"
+)
+
+// maxMessage 单条JSON-RPC消息的长度上限
+const maxMessage = 16 << 20
+
+// CodeInvalidParams JSON-RPC参数无效的错误码，插件用它表示工具输入无效
+const CodeInvalidParams = -32602
+
+// RPCError 插件返回的JSON-RPC错误
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("plugins: rpc error %d: %s", e.Code, e.Message)
+}
+
+// request JSON-RPC请求
+type request struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      int64       `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// response JSON-RPC响应
+type response struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// process 运行中的插件进程，请求按ID与响应对应，可以同时进行多个调用
+type process struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	name  string
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	nextID  int64
+	pending map[int64]chan response
+
+	killed chan error    // 主动结束进程的原因
+	done   chan struct{} // 进程退出后关闭
+	err    error         // 进程退出的原因，done关闭后可读
+}
+
+// startProcess 在新的进程组中启动插件进程，memoryLimit大于0时启动后立即设置进程的内存上限
+func startProcess(path string, memoryLimit int64) (*process, error) {
+	cmd := exec.Command(path)
+	cmd.Dir = filepath.Dir(path)
+	setProcessGroup(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	if memoryLimit > 0 {
+		if err := limitMemory(cmd.Process.Pid, memoryLimit); err != nil {
+			_ = killProcessGroup(cmd)
+			_ = cmd.Wait()
+			return nil, fmt.Errorf("limit memory: %w", err)
+		}
+	}
+
+	p := &process{
+		cmd:     cmd,
+		stdin:   stdin,
+		name:    filepath.Base(path),
+		pending: make(map[int64]chan response),
+		killed:  make(chan error, 1),
+		done:    make(chan struct{}),
+	}
+	go p.logStderr(stderr)
+
+	// 读完标准输出后等待进程退出，被主动结束时记录结束的原因
+	go func() {
+		p.readResponses(stdout)
+		err := cmd.Wait()
+		// 结束留在进程组中的子进程
+		_ = killProcessGroup(cmd)
+		if err == nil {
+			err = ErrExited
+		} else {
+			err = fmt.Errorf("%w: %v", ErrExited, err)
+		}
+		select {
+		case reason := <-p.killed:
+			err = reason
+		default:
+		}
+		p.finish(err)
+	}()
+	return p, nil
+}
+
+// call 发送请求并等待响应，ctx结束或进程退出时返回错误
+func (p *process) call(ctx context.Context, method string, params, result interface{}) error {
+	p.mu.Lock()
+	if p.exited() {
+		p.mu.Unlock()
+		return p.err
+	}
+	p.nextID++
+	id := p.nextID
+	ch := make(chan response, 1)
+	p.pending[id] = ch
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.pending, id)
+		p.mu.Unlock()
+	}()
+
+	data, err := json.Marshal(request{JSONRPC: "2.0", ID: id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	p.writeMu.Lock()
+	_, err = p.stdin.Write(append(data, '\n'))
+	p.writeMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrExited, err)
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(resp.Result, result)
+	case <-p.done:
+		return p.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readResponses 逐行读取响应并交给等待的调用，无法解析的行记入日志
+func (p *process) readResponses(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64<<10), maxMessage)
+	for scanner.Scan() {
+		var resp response
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			slog.Warn("插件输出了无效的JSON-RPC消息", "plugin", p.name, "error", err)
+			continue
+		}
+		p.mu.Lock()
+		ch, ok := p.pending[resp.ID]
+		p.mu.Unlock()
+		if ok {
+			ch <- resp
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		// 消息过长等读取错误无法恢复，结束进程
+		p.kill(fmt.Errorf("%w: %v", ErrExited, err))
+	}
+}
+
+// logStderr 将插件的标准错误输出逐行记入日志
+func (p *process) logStderr(stderr io.Reader) {
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		slog.Info("插件输出", "plugin", p.name, "line", scanner.Text())
+	}
+}
+
+// kill 结束进程组，reason作为进行中和之后的调用返回的错误
+func (p *process) kill(reason error) {
+	select {
+	case p.killed <- reason:
+	default:
+	}
+	_ = killProcessGroup(p.cmd)
+}
+
+// finish 记录退出原因，通知等待中的调用
+func (p *process) finish(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+	close(p.done)
+}
+
+// exited 检查进程是否已退出
+func (p *process) exited() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
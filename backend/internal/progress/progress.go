@@ -0,0 +1,52 @@
+package progress
+
+import "context"
+
+// Update 计算进度
+type Update struct {
+	Progress float64  // 完成比例，0到1
+	Estimate *float64 // 根据已完成部分得到的概率估计，随计算进行逐步收敛，nil表示没有估计
+}
+
+// Reporter 接收计算进度，计算过程中可能被频繁调用，需尽快返回
+type Reporter func(Update)
+
+// reporterKey 上下文中进度接收方的键
+type reporterKey struct{}
+
+// WithReporter 将进度接收方放入上下文，计算服务通过Report报告进度
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, reporter)
+}
+
+// Report 报告计算进度，ctx中没有接收方时忽略
+func Report(ctx context.Context, progress float64) {
+	if reporter, ok := ctx.Value(reporterKey{}).(Reporter); ok {
+		reporter(Update{Progress: progress})
+	}
+}
+
+// ReportEstimate 报告计算进度和当前的概率估计，ctx中没有接收方时忽略
+func ReportEstimate(ctx context.Context, progress, estimate float64) {
+	if reporter, ok := ctx.Value(reporterKey{}).(Reporter); ok {
+		reporter(Update{Progress: progress, Estimate: &estimate})
+	}
+}
+
+// Enabled 检查ctx中是否有进度接收方，没有时计算服务可跳过进度统计
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(reporterKey{}).(Reporter)
+	return ok
+}
+
+// Span 返回将计算进度映射到[lo, lo+span]的上下文，用于在一个任务中依次执行多个计算
+// 单个计算的概率估计不代表整个任务，不再上报
+func Span(ctx context.Context, lo, span float64) context.Context {
+	reporter, ok := ctx.Value(reporterKey{}).(Reporter)
+	if !ok {
+		return ctx
+	}
+	return WithReporter(ctx, func(u Update) {
+		reporter(Update{Progress: lo + span*u.Progress})
+	})
+}
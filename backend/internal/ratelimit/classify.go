@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+)
+
+// Class 请求的开销类别
+type Class int
+
+const (
+	ClassCheap     Class = iota // 低开销，如词条列表
+	ClassExpensive              // 高开销，如输出强化路径
+)
+
+// String 获取类别名称，用于限流键
+func (c Class) String() string {
+	if c == ClassExpensive {
+		return "expensive"
+	}
+	return "cheap"
+}
+
+// expensiveRule 高开销接口规则，path可以使用path.Match的通配符，flag为请求体中开启后按高开销计的布尔字段，为空表示总是高开销
+type expensiveRule struct {
+	method string
+	path   string
+	flag   string
+}
+
+// expensiveRules 高开销接口，其余接口按低开销计
+var expensiveRules = []expensiveRule{
+	{method: http.MethodPost, path: "/api/v1/mod/strengthen/probability", flag: "showPaths"},
+	{method: http.MethodPost, path: "/api/v1/mod/affix/probability", flag: "showCombinations"},
+	{method: http.MethodPost, path: "/api/v1/jobs"},
+	{method: http.MethodPost, path: "/api/v1/share"},
+	{method: http.MethodGet, path: "/api/v1/inventory/analysis"},
+	{method: http.MethodPost, path: "/api/v1/tools/*/run"},
+	{method: http.MethodPost, path: "/api/v1/scripts/run"},
+}
+
+// maxPeekBody 判断类别时读取请求体的上限
+const maxPeekBody = 64 << 10
+
+// Classify 判断请求的开销类别，需要时读取请求体并还原
+func Classify(r *http.Request) Class {
+	for _, rule := range expensiveRules {
+		if r.Method != rule.method {
+			continue
+		}
+		if matched, _ := path.Match(rule.path, r.URL.Path); !matched {
+			continue
+		}
+		if rule.flag == "" || bodyFlag(r, rule.flag) {
+			return ClassExpensive
+		}
+		return ClassCheap
+	}
+	return ClassCheap
+}
+
+// bodyFlag 读取JSON请求体中的布尔字段，请求体无法解析时按开启处理
+func bodyFlag(r *http.Request, flag string) bool {
+	if r.Body == nil || r.Body == http.NoBody {
+		return false
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxPeekBody))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), r.Body), r.Body}
+	if err != nil {
+		return true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return true
+	}
+	var value bool
+	if raw, ok := fields[flag]; ok && json.Unmarshal(raw, &value) == nil {
+		return value
+	}
+	return false
+}
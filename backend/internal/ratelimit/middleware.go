@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// APIKeyHeader 携带API key的请求头
+const APIKeyHeader = "X-API-Key"
+
+// Policy 限流策略，低开销和高开销接口使用独立的令牌桶
+type Policy struct {
+	Cheap     Rate
+	Expensive Rate
+}
+
+// rate 获取类别对应的速率
+func (p Policy) rate(class Class) Rate {
+	if class == ClassExpensive {
+		return p.Expensive
+	}
+	return p.Cheap
+}
+
+// Config 限流配置
+type Config struct {
+	IP             Policy            // 按客户端IP限流
+	APIKey         Policy            // 按API key限流，只对已知的key生效
+	APIKeys        []string          // 已知的API key，未知的key按客户端IP限流
+	KnownKey       func(string) bool // 检查APIKeys之外的key是否已知，如认证存储中有效的key，可为nil
+	TrustedProxies []*net.IPNet      // 可信代理，来自这些地址的请求按X-Forwarded-For识别客户端IP
+	PathPrefix     string            // 限流的路径前缀
+	ExemptPaths    []string          // 不限流的路径
+}
+
+// DenyFunc 请求被限流时写入响应
+type DenyFunc func(w http.ResponseWriter, r *http.Request, result Result)
+
+// Limiter 限流器
+type Limiter struct {
+	store   Store
+	config  Config
+	apiKeys map[string]string
+	deny    DenyFunc
+}
+
+// NewLimiter 创建限流器
+func NewLimiter(store Store, config Config, deny DenyFunc) *Limiter {
+	apiKeys := make(map[string]string, len(config.APIKeys))
+	for _, key := range config.APIKeys {
+		if key != "" {
+			apiKeys[key] = hashAPIKey(key)
+		}
+	}
+	return &Limiter{
+		store:   store,
+		config:  config,
+		apiKeys: apiKeys,
+		deny:    deny,
+	}
+}
+
+// Middleware 限流中间件，写入X-RateLimit-*响应头，超限时写入Retry-After并调用deny
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.applies(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		class := Classify(r)
+		key, policy := l.identify(r)
+		rate := policy.rate(class)
+		if !rate.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		result, err := l.store.Take(r.Context(), key+":"+class.String(), rate)
+		if err != nil {
+			// 存储不可用时放行请求，避免限流故障导致服务不可用
+			logging.FromContext(r.Context()).Warn("限流失败，放行请求", "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header := w.Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		header.Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		if !result.Allowed {
+			header.Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			l.deny(w, r, result)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// applies 检查请求是否需要限流
+func (l *Limiter) applies(r *http.Request) bool {
+	if r.Method == http.MethodOptions || !strings.HasPrefix(r.URL.Path, l.config.PathPrefix) {
+		return false
+	}
+	for _, path := range l.config.ExemptPaths {
+		if r.URL.Path == path {
+			return false
+		}
+	}
+	return true
+}
+
+// identify 获取请求的限流键和策略，已知的API key优先，否则使用客户端IP
+func (l *Limiter) identify(r *http.Request) (string, Policy) {
+	key := r.Header.Get(APIKeyHeader)
+	if hash, ok := l.apiKeys[key]; ok {
+		return "key:" + hash, l.config.APIKey
+	}
+	if key != "" && l.config.KnownKey != nil && l.config.KnownKey(key) {
+		return "key:" + hashAPIKey(key), l.config.APIKey
+	}
+	return "ip:" + ClientIP(r, l.config.TrustedProxies), l.config.IP
+}
+
+// hashAPIKey 计算API key的摘要，避免在存储中保存明文key
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
+
+// ceilSeconds 将时长向上取整为秒
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Rate 令牌桶速率，桶容量为Limit，每Period补满一次
+type Rate struct {
+	Limit  int
+	Period time.Duration
+}
+
+// Enabled 检查速率是否启用限流，Limit不大于0表示不限流
+func (r Rate) Enabled() bool {
+	return r.Limit > 0 && r.Period > 0
+}
+
+// perSecond 每秒补充的令牌数
+func (r Rate) perSecond() float64 {
+	return float64(r.Limit) / r.Period.Seconds()
+}
+
+// Result 取令牌的结果
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // 被拒绝时距下一个令牌的时间
+	ResetAfter time.Duration // 令牌桶补满的时间
+}
+
+// Store 令牌桶存储
+type Store interface {
+	// Take 从key对应的令牌桶取一个令牌
+	Take(ctx context.Context, key string, rate Rate) (Result, error)
+}
+
+// bucket 令牌桶状态
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take 按当前时间补充令牌并尝试取一个令牌
+func (b *bucket) take(now time.Time, rate Rate) Result {
+	perSecond := rate.perSecond()
+	limit := float64(rate.Limit)
+
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(limit, b.tokens+elapsed*perSecond)
+		b.last = now
+	}
+
+	return b.consume(rate)
+}
+
+// consume 取一个令牌，令牌不足时不扣减
+func (b *bucket) consume(rate Rate) Result {
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return newResult(rate, b.tokens, allowed)
+}
+
+// newResult 根据取令牌后剩余的令牌数生成结果
+func newResult(rate Rate, tokens float64, allowed bool) Result {
+	perSecond := rate.perSecond()
+	result := Result{
+		Allowed:    allowed,
+		Limit:      rate.Limit,
+		Remaining:  int(tokens),
+		ResetAfter: seconds((float64(rate.Limit) - tokens) / perSecond),
+	}
+	if !allowed {
+		result.RetryAfter = seconds((1 - tokens) / perSecond)
+	}
+	return result
+}
+
+// seconds 将秒数转换为时长
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
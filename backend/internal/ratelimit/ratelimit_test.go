@@ -0,0 +1,160 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBucketTake(t *testing.T) {
+	rate := Rate{Limit: 2, Period: 2 * time.Second}
+	start := time.Unix(0, 0)
+	b := &bucket{tokens: 2, last: start}
+
+	tests := []struct {
+		name      string
+		after     time.Duration
+		allowed   bool
+		remaining int
+	}{
+		{"first", 0, true, 1},
+		{"second", 0, true, 0},
+		{"empty", 0, false, 0},
+		{"half a token", 500 * time.Millisecond, false, 0},
+		{"refilled one", time.Second, true, 0},
+		{"capped at limit", time.Hour, true, 1},
+	}
+	for _, tt := range tests {
+		result := b.take(start.Add(tt.after), rate)
+		if result.Allowed != tt.allowed || result.Remaining != tt.remaining {
+			t.Errorf("%s: allowed=%v remaining=%d, want %v %d", tt.name, result.Allowed, result.Remaining, tt.allowed, tt.remaining)
+		}
+		if !result.Allowed && result.RetryAfter <= 0 {
+			t.Errorf("%s: RetryAfter = %v, want > 0", tt.name, result.RetryAfter)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		remote    string
+		forwarded []string
+		want      string
+	}{
+		{"direct", "1.2.3.4:5678", nil, "1.2.3.4"},
+		{"untrusted remote ignores header", "1.2.3.4:5678", []string{"9.9.9.9"}, "1.2.3.4"},
+		{"trusted proxy", "10.0.0.1:80", []string{"1.2.3.4"}, "1.2.3.4"},
+		{"spoofed left entry", "10.0.0.1:80", []string{"9.9.9.9, 1.2.3.4"}, "1.2.3.4"},
+		{"proxy chain", "10.0.0.1:80", []string{"1.2.3.4, 192.168.1.1", "10.0.0.2"}, "1.2.3.4"},
+		{"only proxies", "10.0.0.1:80", []string{"10.0.0.2"}, "10.0.0.2"},
+		{"no header", "10.0.0.1:80", nil, "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/affixes", nil)
+		r.RemoteAddr = tt.remote
+		for _, value := range tt.forwarded {
+			r.Header.Add("X-Forwarded-For", value)
+		}
+		if got := ClientIP(r, trusted); got != tt.want {
+			t.Errorf("%s: ClientIP = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := ParseTrustedProxies([]string{"not-an-ip"}); err == nil {
+		t.Error("ParseTrustedProxies accepted an invalid address")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		want   Class
+	}{
+		{http.MethodGet, "/api/v1/affixes", "", ClassCheap},
+		{http.MethodPost, "/api/v1/mod/strengthen/probability", `{"showPaths":false}`, ClassCheap},
+		{http.MethodPost, "/api/v1/mod/strengthen/probability", `{"showPaths":true}`, ClassExpensive},
+		{http.MethodPost, "/api/v1/mod/affix/probability", `{"showCombinations":true}`, ClassExpensive},
+		{http.MethodPost, "/api/v1/mod/affix/probability", `not json`, ClassExpensive},
+		{http.MethodPost, "/api/v1/jobs", "", ClassExpensive},
+		{http.MethodPost, "/api/v1/tools/demo/run", `{}`, ClassExpensive},
+		{http.MethodGet, "/api/v1/tools/demo", "", ClassCheap},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		if got := Classify(r); got != tt.want {
+			t.Errorf("%s %s %s: Classify = %v, want %v", tt.method, tt.path, tt.body, got, tt.want)
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) != tt.body {
+			t.Errorf("%s %s: body after Classify = %q, want %q", tt.method, tt.path, body, tt.body)
+		}
+	}
+}
+
+// failingStore 总是失败的令牌桶存储
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Rate) (Result, error) {
+	return Result{}, io.ErrUnexpectedEOF
+}
+
+func TestMiddleware(t *testing.T) {
+	one := Rate{Limit: 1, Period: time.Hour}
+	config := Config{
+		IP:          Policy{Cheap: one, Expensive: one},
+		APIKey:      Policy{Cheap: Rate{Limit: 3, Period: time.Hour}, Expensive: one},
+		APIKeys:     []string{"static"},
+		KnownKey:    func(key string) bool { return key == "stored" },
+		PathPrefix:  "/api/v1/",
+		ExemptPaths: []string{"/api/v1/health"},
+	}
+	deny := func(w http.ResponseWriter, r *http.Request, result Result) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name   string
+		store  Store
+		path   string
+		keys   []string // 每个请求的API key，都来自同一个IP
+		status []int
+	}{
+		{"ip limited", NewMemoryStore(), "/api/v1/affixes", []string{"", ""}, []int{200, 429}},
+		{"unknown keys share the ip bucket", NewMemoryStore(), "/api/v1/affixes", []string{"a", "b"}, []int{200, 429}},
+		{"static key", NewMemoryStore(), "/api/v1/affixes", []string{"static", "static", "static", "static"}, []int{200, 200, 200, 429}},
+		{"stored key", NewMemoryStore(), "/api/v1/affixes", []string{"stored", "stored", "", ""}, []int{200, 200, 200, 429}},
+		{"exempt path", NewMemoryStore(), "/api/v1/health", []string{"", "", ""}, []int{200, 200, 200}},
+		{"other prefix", NewMemoryStore(), "/docs", []string{"", ""}, []int{200, 200}},
+		{"store failure lets requests through", failingStore{}, "/api/v1/affixes", []string{"", ""}, []int{200, 200}},
+	}
+	for _, tt := range tests {
+		handler := NewLimiter(tt.store, config, deny).Middleware(ok)
+		for i, key := range tt.keys {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			r.RemoteAddr = "1.2.3.4:5678"
+			if key != "" {
+				r.Header.Set(APIKeyHeader, key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.status[i] {
+				t.Errorf("%s: request %d status = %d, want %d", tt.name, i, w.Code, tt.status[i])
+			}
+			if w.Code == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+				t.Errorf("%s: request %d has no Retry-After", tt.name, i)
+			}
+		}
+	}
+}
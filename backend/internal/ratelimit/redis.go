@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript 在Redis中原子地补充令牌并取一个令牌，使用Redis服务器时间避免实例间时钟偏差
+var takeScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'last')
+local tokens = tonumber(state[1])
+local last = tonumber(state[2])
+if tokens == nil or last == nil then
+	tokens = limit
+	last = now
+end
+
+if now > last then
+	tokens = math.min(limit, tokens + (now - last) * limit / period)
+	last = now
+end
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'last', last)
+redis.call('PEXPIRE', KEYS[1], period)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore Redis令牌桶存储，多实例部署时共享限流状态
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore 创建Redis令牌桶存储，prefix为键名前缀
+func NewRedisStore(client *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Take 从key对应的令牌桶取一个令牌
+func (s *RedisStore) Take(ctx context.Context, key string, rate Rate) (Result, error) {
+	reply, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}, rate.Limit, rate.Period.Milliseconds()).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("redis限流失败: %w", err)
+	}
+	if len(reply) != 2 {
+		return Result{}, fmt.Errorf("redis限流返回值无效: %v", reply)
+	}
+
+	allowed, _ := reply[0].(int64)
+	tokensText, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(tokensText, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("redis限流返回值无效: %w", err)
+	}
+	return newResult(rate, tokens, allowed == 1), nil
+}
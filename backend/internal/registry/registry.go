@@ -0,0 +1,132 @@
+// Package registry 工具注册表，/tools接口、机器人和前端按注册表发现可用的工具
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// Handler 运行工具，input为符合输入模式的JSON，结果按locale输出名称等文本，返回可编码为JSON的值
+type Handler func(ctx context.Context, input json.RawMessage, locale string) (interface{}, error)
+
+// 工具来源
+const (
+	SourceBuiltin = "builtin"
+	SourcePlugin  = "plugin"
+	SourceScript  = "script"
+)
+
+// Tool 工具定义
+type Tool struct {
+	ID          string
+	Name        string // 默认名称，其他语言使用i18n键tool.<ID>.name
+	Description string // 默认描述，其他语言使用i18n键tool.<ID>.description
+	Category    string
+	Icon        string
+	Source      string          // 工具来源，为空表示内置工具
+	InputSchema json.RawMessage // 输入的JSON Schema
+	Handler     Handler
+	Enabled     bool // 默认是否启用，配置的功能开关可以覆盖
+}
+
+// IsPlugin 检查是否为外部插件提供的工具
+func (t Tool) IsPlugin() bool {
+	return t.Source == SourcePlugin
+}
+
+// Localize 按语言输出名称和描述，没有翻译时使用默认文本
+func (t Tool) Localize(locale string) Tool {
+	t.Name = i18n.TOr(locale, "tool."+t.ID+".name", t.Name)
+	t.Description = i18n.TOr(locale, "tool."+t.ID+".description", t.Description)
+	return t
+}
+
+// Registry 工具注册表，按注册顺序列出工具
+type Registry struct {
+	mu       sync.RWMutex
+	tools    []Tool
+	index    map[string]int
+	features map[string]bool
+}
+
+// New 创建工具注册表，enabled和disabled为功能开关，按工具ID启用或隐藏工具，disabled优先
+func New(enabled, disabled []string) *Registry {
+	features := make(map[string]bool, len(enabled)+len(disabled))
+	for _, id := range enabled {
+		features[id] = true
+	}
+	for _, id := range disabled {
+		features[id] = false
+	}
+	return &Registry{
+		index:    make(map[string]int),
+		features: features,
+	}
+}
+
+// Register 注册工具，ID重复时返回错误
+func (r *Registry) Register(tool Tool) error {
+	if tool.ID == "" {
+		return fmt.Errorf("registry: tool id is empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.index[tool.ID]; ok {
+		return fmt.Errorf("registry: tool %q already registered", tool.ID)
+	}
+	r.index[tool.ID] = len(r.tools)
+	r.tools = append(r.tools, tool)
+	return nil
+}
+
+// Tools 获取启用的工具，按注册顺序排列
+func (r *Registry) Tools() []Tool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tools := make([]Tool, 0, len(r.tools))
+	for _, tool := range r.tools {
+		if r.enabled(tool) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// Get 按ID获取启用的工具
+func (r *Registry) Get(id string) (Tool, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.index[id]
+	if !ok || !r.enabled(r.tools[i]) {
+		return Tool{}, false
+	}
+	return r.tools[i], true
+}
+
+// Categories 获取启用的工具所属的分类，按首次出现的顺序排列
+func (r *Registry) Categories() []string {
+	seen := make(map[string]bool)
+	var categories []string
+	for _, tool := range r.Tools() {
+		if !seen[tool.Category] {
+			seen[tool.Category] = true
+			categories = append(categories, tool.Category)
+		}
+	}
+	return categories
+}
+
+// enabled 检查工具是否启用，功能开关优先于工具的默认值，调用方需持有锁
+func (r *Registry) enabled(tool Tool) bool {
+	if enabled, ok := r.features[tool.ID]; ok {
+		return enabled
+	}
+	return tool.Enabled
+}
@@ -0,0 +1,193 @@
+package script
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// affixModule 词条相关函数，数据来自脚本使用的游戏版本
+var affixModule = &starlarkstruct.Module{
+	Name: "affix",
+	Members: starlark.StringDict{
+		"list":        starlark.NewBuiltin("affix.list", affixList),
+		"get":         starlark.NewBuiltin("affix.get", affixGet),
+		"rarities":    starlark.NewBuiltin("affix.rarities", affixRarities),
+		"pool":        starlark.NewBuiltin("affix.pool", affixPool),
+		"draw":        starlark.NewBuiltin("affix.draw", affixDraw),
+		"probability": starlark.NewBuiltin("affix.probability", affixProbability),
+	},
+}
+
+// affixList 全部词条：affix.list()
+func affixList(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	affixes := threadEnv(thread).catalog.Affixes()
+	values := make([]starlark.Value, len(affixes))
+	for i, affix := range affixes {
+		values[i] = affixDict(affix)
+	}
+	return starlark.NewList(values), nil
+}
+
+// affixGet 按ID获取词条，不存在时返回None：affix.get(id)
+func affixGet(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var id int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &id); err != nil {
+		return nil, err
+	}
+	affix := threadEnv(thread).catalog.AffixByID(id)
+	if affix == nil {
+		return starlark.None, nil
+	}
+	return affixDict(*affix), nil
+}
+
+// affixRarities 全部稀有度，按稀有度从高到低排列：affix.rarities()
+func affixRarities(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	rarities := threadEnv(thread).catalog.Rarities()
+	values := make([]starlark.Value, len(rarities))
+	for i, r := range rarities {
+		values[i] = rarityDict(r)
+	}
+	return starlark.NewList(values), nil
+}
+
+// affixPool 稀有度的词条池，rarity为空时为全部词条：affix.pool(rarity="")
+func affixPool(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var rarity string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "rarity?", &rarity); err != nil {
+		return nil, err
+	}
+	catalog := threadEnv(thread).catalog
+	if rarity == "" {
+		affixes := catalog.Affixes()
+		ids := make([]int, len(affixes))
+		for i, affix := range affixes {
+			ids[i] = affix.ID
+		}
+		return intList(ids), nil
+	}
+	r, err := lookupRarity(catalog, rarity)
+	if err != nil {
+		return nil, err
+	}
+	return intList(r.AffixPool), nil
+}
+
+// affixDraw 从稀有度的词条池中不放回抽取词条数量个词条，模拟一次掉落：affix.draw(rng, rarity="gold")
+func affixDraw(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var rng *random
+	rarity := models.RarityGold
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "rng", &rng, "rarity?", &rarity); err != nil {
+		return nil, err
+	}
+	r, err := lookupRarity(threadEnv(thread).catalog, rarity)
+	if err != nil {
+		return nil, err
+	}
+	if r.SlotCount > len(r.AffixPool) {
+		return nil, fmt.Errorf("%s: pool of %s has fewer than %d affixes", b.Name(), r.ID, r.SlotCount)
+	}
+	pool := append([]int(nil), r.AffixPool...)
+	rng.rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+	return intList(pool[:r.SlotCount]), nil
+}
+
+// affixProbability 抽取的词条全部在目标范围内的概率，与词条概率计算接口相同：affix.probability(targets, slots=0, rarity="")
+func affixProbability(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var targets starlark.Iterable
+	var slots int
+	var rarity string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "targets", &targets, "slots?", &slots, "rarity?", &rarity); err != nil {
+		return nil, err
+	}
+	ids, err := toInts(thread, b.Name(), targets)
+	if err != nil {
+		return nil, err
+	}
+
+	e := threadEnv(thread)
+	result, err := services.NewAffixProbabilityService().CalculateProbability(e.ctx, e.catalog.Version.ID, slots, rarity, ids, false)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Float(result.Probability), nil
+}
+
+// lookupRarity 按ID获取稀有度，any和未知的稀有度返回错误
+func lookupRarity(catalog *models.Catalog, id string) (*models.Rarity, error) {
+	r := catalog.RarityByID(id)
+	if r == nil {
+		allowed := make([]string, 0)
+		for _, rarity := range catalog.Rarities() {
+			allowed = append(allowed, rarity.ID)
+		}
+		return nil, fmt.Errorf("unknown rarity %q, expected one of %q", id, allowed)
+	}
+	return r, nil
+}
+
+// affixDict 词条转换为字典
+func affixDict(affix models.Affix) *starlark.Dict {
+	d := starlark.NewDict(4)
+	_ = d.SetKey(starlark.String("id"), starlark.MakeInt(affix.ID))
+	_ = d.SetKey(starlark.String("name"), starlark.String(affix.Name))
+	_ = d.SetKey(starlark.String("category"), starlark.String(affix.Category))
+	aliases := make([]starlark.Value, len(affix.Aliases))
+	for i, alias := range affix.Aliases {
+		aliases[i] = starlark.String(alias)
+	}
+	_ = d.SetKey(starlark.String("aliases"), starlark.NewList(aliases))
+	return d
+}
+
+// rarityDict 稀有度转换为字典
+func rarityDict(r models.Rarity) *starlark.Dict {
+	d := starlark.NewDict(9)
+	_ = d.SetKey(starlark.String("id"), starlark.String(r.ID))
+	_ = d.SetKey(starlark.String("name"), starlark.String(r.Name))
+	_ = d.SetKey(starlark.String("drop_weight"), starlark.Float(r.DropWeight))
+	_ = d.SetKey(starlark.String("slot_count"), starlark.MakeInt(r.SlotCount))
+	_ = d.SetKey(starlark.String("pool"), intList(r.AffixPool))
+	_ = d.SetKey(starlark.String("min_start_level"), starlark.MakeInt(r.MinStartLevel))
+	_ = d.SetKey(starlark.String("max_start_level"), starlark.MakeInt(r.MaxStartLevel))
+	_ = d.SetKey(starlark.String("max_level"), starlark.MakeInt(r.MaxLevel))
+	_ = d.SetKey(starlark.String("max_enhancements"), starlark.MakeInt(r.MaxEnhancements))
+	return d
+}
+
+// intList 整数切片转换为列表
+func intList(values []int) *starlark.List {
+	list := make([]starlark.Value, len(values))
+	for i, v := range values {
+		list[i] = starlark.MakeInt(v)
+	}
+	return starlark.NewList(list)
+}
+
+// toInts 读取整数序列
+func toInts(thread *starlark.Thread, name string, iterable starlark.Iterable) ([]int, error) {
+	values, err := collect(thread, name, iterable)
+	if err != nil {
+		return nil, err
+	}
+	ints := make([]int, len(values))
+	for i, v := range values {
+		n, err := starlark.AsInt32(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: element %d: %v", name, i, err)
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
@@ -0,0 +1,100 @@
+package script
+
+import (
+	"fmt"
+	"math/big"
+
+	"go.starlark.net/starlark"
+)
+
+// 组合函数的上限，避免单次调用占用过多内存
+const (
+	maxChooseN      = 10000
+	maxCombinations = 100000
+)
+
+// choose 组合数C(n, k)
+func choose(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var n, k int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &n, &k); err != nil {
+		return nil, err
+	}
+	if n < 0 || n > maxChooseN {
+		return nil, fmt.Errorf("%s: n must be between 0 and %d", b.Name(), maxChooseN)
+	}
+	if k < 0 || k > n {
+		return starlark.MakeInt(0), nil
+	}
+	return starlark.MakeBigInt(new(big.Int).Binomial(int64(n), int64(k))), nil
+}
+
+// combinations 按顺序枚举items中k个元素的所有组合，返回元组列表
+func combinations(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var iterable starlark.Iterable
+	var k int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &iterable, &k); err != nil {
+		return nil, err
+	}
+	// 能获取长度时先检查组合数，避免读取大量元素后才发现超过上限
+	if n := starlark.Len(iterable); n >= 0 {
+		if err := checkCombinations(b.Name(), n, k); err != nil {
+			return nil, err
+		}
+	}
+	items, err := collect(thread, b.Name(), iterable)
+	if err != nil {
+		return nil, err
+	}
+	if k < 0 || k > len(items) {
+		return starlark.NewList(nil), nil
+	}
+	if err := checkCombinations(b.Name(), len(items), k); err != nil {
+		return nil, err
+	}
+	count := new(big.Int).Binomial(int64(len(items)), int64(k)).Int64()
+	e := threadEnv(thread)
+	if err := e.charge(count * int64(k+1) * valueBytes); err != nil {
+		return nil, err
+	}
+
+	result := make([]starlark.Value, 0, count)
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	for {
+		if err := e.poll(len(result)); err != nil {
+			return nil, err
+		}
+		combination := make(starlark.Tuple, k)
+		for i, index := range indices {
+			combination[i] = items[index]
+		}
+		result = append(result, combination)
+
+		// 从右向左找到可以后移的位置
+		i := k - 1
+		for i >= 0 && indices[i] == len(items)-k+i {
+			i--
+		}
+		if i < 0 {
+			return starlark.NewList(result), nil
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// checkCombinations 检查从n个元素中取k个的组合数不超过maxCombinations
+func checkCombinations(name string, n, k int) error {
+	if k < 0 || k > n {
+		return nil
+	}
+	count := new(big.Int).Binomial(int64(n), int64(k))
+	if !count.IsInt64() || count.Int64() > maxCombinations {
+		return fmt.Errorf("%s: %s combinations exceed the limit of %d", name, count, maxCombinations)
+	}
+	return nil
+}
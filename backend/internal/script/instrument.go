@@ -0,0 +1,448 @@
+package script
+
+import (
+	"fmt"
+	"strings"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// 改写后的运算调用的内置函数和临时变量，名称不是合法的标识符，脚本不能直接使用
+const (
+	binaryFn  = "binary$"
+	methodFn  = "method$"
+	tempLocal = "aug$"
+)
+
+// countedOps 结果大小与操作数有关的运算，改写为调用binary$，在运算前按结果大小记录内存
+var countedOps = map[syntax.Token]bool{
+	syntax.PLUS:       true,
+	syntax.STAR:       true,
+	syntax.PERCENT:    true,
+	syntax.PLUS_EQ:    true,
+	syntax.STAR_EQ:    true,
+	syntax.PERCENT_EQ: true,
+	syntax.LTLT:       true,
+	syntax.LTLT_EQ:    true,
+}
+
+// countedMethods 结果可能远大于参数的方法，调用改写为method$，在调用前按结果大小记录内存
+var countedMethods = map[string]bool{
+	"join":    true,
+	"replace": true,
+	"format":  true,
+	"extend":  true,
+}
+
+// instrument 改写语法树：重复、拼接和格式化运算以及countedMethods中的方法调用改为调用记录内存的内置函数；
+// 增量赋值展开为普通赋值，目标中的表达式先保存到临时变量，只求值一次
+func instrument(f *syntax.File) {
+	r := &rewriter{}
+	f.Stmts = r.stmts(f.Stmts)
+}
+
+// rewriter 语法树改写，temps为已使用的临时变量个数
+type rewriter struct {
+	temps int
+}
+
+func (r *rewriter) stmts(stmts []syntax.Stmt) []syntax.Stmt {
+	result := make([]syntax.Stmt, 0, len(stmts))
+	for _, stmt := range stmts {
+		result = append(result, r.stmt(stmt)...)
+	}
+	return result
+}
+
+func (r *rewriter) stmt(stmt syntax.Stmt) []syntax.Stmt {
+	switch stmt := stmt.(type) {
+	case *syntax.AssignStmt:
+		if countedOps[stmt.Op] {
+			return r.augmented(stmt)
+		}
+		stmt.LHS = r.expr(stmt.LHS)
+		stmt.RHS = r.expr(stmt.RHS)
+	case *syntax.DefStmt:
+		r.params(stmt.Params)
+		stmt.Body = r.stmts(stmt.Body)
+	case *syntax.ExprStmt:
+		stmt.X = r.expr(stmt.X)
+	case *syntax.ForStmt:
+		stmt.Vars = r.expr(stmt.Vars)
+		stmt.X = r.expr(stmt.X)
+		stmt.Body = r.stmts(stmt.Body)
+	case *syntax.WhileStmt:
+		stmt.Cond = r.expr(stmt.Cond)
+		stmt.Body = r.stmts(stmt.Body)
+	case *syntax.IfStmt:
+		stmt.Cond = r.expr(stmt.Cond)
+		stmt.True = r.stmts(stmt.True)
+		stmt.False = r.stmts(stmt.False)
+	case *syntax.ReturnStmt:
+		if stmt.Result != nil {
+			stmt.Result = r.expr(stmt.Result)
+		}
+	}
+	return []syntax.Stmt{stmt}
+}
+
+// augmented 展开增量赋值x op= y：x为变量时改为x = binary$("op=", x, y)，
+// 为a[i]或a.f时先把a和i保存到临时变量，与原语句一样只求值一次
+func (r *rewriter) augmented(stmt *syntax.AssignStmt) []syntax.Stmt {
+	var prelude []syntax.Stmt
+	target := stmt.LHS
+	switch lhs := stmt.LHS.(type) {
+	case *syntax.IndexExpr:
+		x := r.temp(&prelude, r.expr(lhs.X), stmt.OpPos)
+		y := r.temp(&prelude, r.expr(lhs.Y), stmt.OpPos)
+		target = &syntax.IndexExpr{X: x, Lbrack: lhs.Lbrack, Y: y, Rbrack: lhs.Rbrack}
+	case *syntax.DotExpr:
+		x := r.temp(&prelude, r.expr(lhs.X), stmt.OpPos)
+		target = &syntax.DotExpr{X: x, Dot: lhs.Dot, NamePos: lhs.NamePos, Name: lhs.Name}
+	}
+
+	value := r.call(binaryFn, stmt.OpPos, stmt.RHS.Span, opLiteral(stmt.Op, stmt.OpPos), copyTarget(target), r.expr(stmt.RHS))
+	assign := &syntax.AssignStmt{OpPos: stmt.OpPos, Op: syntax.EQ, LHS: target, RHS: value}
+	return append(prelude, assign)
+}
+
+// temp 把表达式的值保存到新的临时变量，返回引用该变量的表达式
+func (r *rewriter) temp(prelude *[]syntax.Stmt, x syntax.Expr, pos syntax.Position) syntax.Expr {
+	r.temps++
+	name := fmt.Sprintf("%s%d", tempLocal, r.temps)
+	*prelude = append(*prelude, &syntax.AssignStmt{OpPos: pos, Op: syntax.EQ, LHS: ident(name, pos), RHS: x})
+	return ident(name, pos)
+}
+
+// copyTarget 复制赋值目标，作为binary$的参数读取目标当前的值
+func copyTarget(target syntax.Expr) syntax.Expr {
+	switch target := target.(type) {
+	case *syntax.Ident:
+		return ident(target.Name, target.NamePos)
+	case *syntax.IndexExpr:
+		return &syntax.IndexExpr{X: copyTarget(target.X), Lbrack: target.Lbrack, Y: copyTarget(target.Y), Rbrack: target.Rbrack}
+	case *syntax.DotExpr:
+		return &syntax.DotExpr{X: copyTarget(target.X), Dot: target.Dot, NamePos: target.NamePos, Name: ident(target.Name.Name, target.Name.NamePos)}
+	}
+	return target
+}
+
+func (r *rewriter) params(params []syntax.Expr) {
+	for _, param := range params {
+		if binary, ok := param.(*syntax.BinaryExpr); ok && binary.Op == syntax.EQ {
+			binary.Y = r.expr(binary.Y)
+		}
+	}
+}
+
+func (r *rewriter) exprs(list []syntax.Expr) {
+	for i, x := range list {
+		list[i] = r.expr(x)
+	}
+}
+
+func (r *rewriter) expr(x syntax.Expr) syntax.Expr {
+	switch x := x.(type) {
+	case *syntax.BinaryExpr:
+		x.X = r.expr(x.X)
+		x.Y = r.expr(x.Y)
+		if countedOps[x.Op] {
+			return r.call(binaryFn, x.OpPos, x.Span, opLiteral(x.Op, x.OpPos), x.X, x.Y)
+		}
+	case *syntax.CallExpr:
+		x.Fn = r.expr(x.Fn)
+		for i, arg := range x.Args {
+			// 关键字参数name=value只改写value
+			if binary, ok := arg.(*syntax.BinaryExpr); ok && binary.Op == syntax.EQ {
+				binary.Y = r.expr(binary.Y)
+				continue
+			}
+			x.Args[i] = r.expr(arg)
+		}
+		if dot, ok := x.Fn.(*syntax.DotExpr); ok && countedMethods[dot.Name.Name] {
+			name := &syntax.Literal{Token: syntax.STRING, TokenPos: dot.NamePos, Raw: `"` + dot.Name.Name + `"`, Value: dot.Name.Name}
+			args := append([]syntax.Expr{name, dot.X}, x.Args...)
+			return &syntax.CallExpr{Fn: ident(methodFn, dot.NamePos), Lparen: x.Lparen, Args: args, Rparen: x.Rparen}
+		}
+	case *syntax.Comprehension:
+		x.Body = r.expr(x.Body)
+		for _, clause := range x.Clauses {
+			switch clause := clause.(type) {
+			case *syntax.ForClause:
+				clause.Vars = r.expr(clause.Vars)
+				clause.X = r.expr(clause.X)
+			case *syntax.IfClause:
+				clause.Cond = r.expr(clause.Cond)
+			}
+		}
+	case *syntax.CondExpr:
+		x.Cond = r.expr(x.Cond)
+		x.True = r.expr(x.True)
+		x.False = r.expr(x.False)
+	case *syntax.DictExpr:
+		for _, entry := range x.List {
+			entry := entry.(*syntax.DictEntry)
+			entry.Key = r.expr(entry.Key)
+			entry.Value = r.expr(entry.Value)
+		}
+	case *syntax.DotExpr:
+		x.X = r.expr(x.X)
+	case *syntax.IndexExpr:
+		x.X = r.expr(x.X)
+		x.Y = r.expr(x.Y)
+	case *syntax.LambdaExpr:
+		r.params(x.Params)
+		x.Body = r.expr(x.Body)
+	case *syntax.ListExpr:
+		r.exprs(x.List)
+	case *syntax.ParenExpr:
+		x.X = r.expr(x.X)
+	case *syntax.SliceExpr:
+		for _, part := range []*syntax.Expr{&x.X, &x.Lo, &x.Hi, &x.Step} {
+			if *part != nil {
+				*part = r.expr(*part)
+			}
+		}
+	case *syntax.TupleExpr:
+		r.exprs(x.List)
+	case *syntax.UnaryExpr:
+		if x.X != nil {
+			x.X = r.expr(x.X)
+		}
+	}
+	return x
+}
+
+// call 生成内置函数调用，位置为原运算的位置，出错时报告原运算所在的行列
+func (r *rewriter) call(fn string, pos syntax.Position, span func() (syntax.Position, syntax.Position), args ...syntax.Expr) *syntax.CallExpr {
+	_, end := span()
+	return &syntax.CallExpr{Fn: ident(fn, pos), Lparen: pos, Args: args, Rparen: end}
+}
+
+func ident(name string, pos syntax.Position) *syntax.Ident {
+	return &syntax.Ident{NamePos: pos, Name: name}
+}
+
+func opLiteral(op syntax.Token, pos syntax.Position) *syntax.Literal {
+	return &syntax.Literal{Token: syntax.STRING, TokenPos: pos, Raw: `"` + op.String() + `"`, Value: op.String()}
+}
+
+// binaryOps binary$的第一个参数对应的运算
+var binaryOps = func() map[string]syntax.Token {
+	ops := make(map[string]syntax.Token, len(countedOps))
+	for op := range countedOps {
+		ops[op.String()] = op
+	}
+	return ops
+}()
+
+// binary 执行改写后的运算：binary$(op, x, y)，运算前按结果大小记录内存；列表的+=与解释器一样原地扩展
+func binary(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var opName string
+	var x, y starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 3, &opName, &x, &y); err != nil {
+		return nil, err
+	}
+	op, ok := binaryOps[opName]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown operator %s", b.Name(), opName)
+	}
+
+	inplace := op == syntax.PLUS_EQ
+	switch op {
+	case syntax.PLUS_EQ:
+		op = syntax.PLUS
+	case syntax.STAR_EQ:
+		op = syntax.STAR
+	case syntax.PERCENT_EQ:
+		op = syntax.PERCENT
+	case syntax.LTLT_EQ:
+		op = syntax.LTLT
+	}
+
+	e := threadEnv(thread)
+	if list, ok := x.(*starlark.List); ok && inplace {
+		if iterable, ok := y.(starlark.Iterable); ok {
+			if err := e.charge(int64(max(starlark.Len(y), 0)) * valueBytes); err != nil {
+				return nil, err
+			}
+			extend, err := list.Attr("extend")
+			if err != nil {
+				return nil, err
+			}
+			if _, err := starlark.Call(thread, extend, starlark.Tuple{iterable}, nil); err != nil {
+				return nil, err
+			}
+			return list, nil
+		}
+	}
+	if err := e.charge(binarySize(op, x, y, e.remaining())); err != nil {
+		return nil, err
+	}
+	return starlark.Binary(op, x, y)
+}
+
+// binarySize 估算运算结果占用的内存，结果不是序列或大整数时为0
+func binarySize(op syntax.Token, x, y starlark.Value, limit int64) int64 {
+	switch op {
+	case syntax.PLUS:
+		return seqSize(x) + seqSize(y)
+	case syntax.STAR:
+		if xi, ok := x.(starlark.Int); ok {
+			if yi, ok := y.(starlark.Int); ok {
+				return intSize(xi.BigInt().BitLen() + yi.BigInt().BitLen())
+			}
+			x, y = y, x
+		}
+		n, ok := y.(starlark.Int)
+		if !ok {
+			return 0
+		}
+		count, ok := n.Int64()
+		if !ok {
+			return -1
+		}
+		if count <= 0 {
+			return 0
+		}
+		size := seqSize(x)
+		if size > 0 && count > (1<<62)/size {
+			return -1
+		}
+		return size * count
+	case syntax.LTLT:
+		if xi, ok := x.(starlark.Int); ok {
+			if yi, ok := y.(starlark.Int); ok {
+				shift, ok := yi.Int64()
+				if !ok || shift < 0 {
+					return 0
+				}
+				return intSize(xi.BigInt().BitLen() + int(min(shift, maxIntBits+1)))
+			}
+		}
+	case syntax.PERCENT:
+		if format, ok := x.(starlark.String); ok {
+			// 每个%最多输出一个参数，按参数中最长的转换结果估算
+			var longest int64
+			args, ok := y.(starlark.Tuple)
+			if !ok {
+				args = starlark.Tuple{y}
+			}
+			for _, arg := range args {
+				longest = max(longest, reprSize(arg, limit))
+			}
+			return int64(len(format)) + int64(strings.Count(string(format), "%"))*longest
+		}
+	}
+	return 0
+}
+
+// intSize 整数占用的内存，超过maxIntBits位时返回-1，避免单次乘法耗时过长
+func intSize(bits int) int64 {
+	if bits > maxIntBits {
+		return -1
+	}
+	return int64(bits) / 8
+}
+
+// seqSize 字符串、字节串、列表和元组占用的内存
+func seqSize(v starlark.Value) int64 {
+	switch v := v.(type) {
+	case starlark.String:
+		return int64(len(v))
+	case starlark.Bytes:
+		return int64(len(v))
+	case starlark.Tuple:
+		return int64(len(v)) * valueBytes
+	case *starlark.List:
+		return int64(v.Len()) * valueBytes
+	}
+	return 0
+}
+
+// method 执行改写后的方法调用：method$(name, x, *args, **kwargs)，字符串的join、replace、format和列表的extend在调用前按结果大小记录内存
+func method(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("%s: missing receiver", b.Name())
+	}
+	name, _ := starlark.AsString(args[0])
+	x, args := args[1], args[2:]
+
+	attrs, ok := x.(starlark.HasAttrs)
+	if !ok {
+		return nil, fmt.Errorf("%s has no .%s field or method", x.Type(), name)
+	}
+	fn, err := attrs.Attr(name)
+	if err != nil {
+		return nil, err
+	}
+	if fn == nil {
+		return nil, fmt.Errorf("%s has no .%s field or method", x.Type(), name)
+	}
+
+	e := threadEnv(thread)
+	if err := e.charge(methodSize(thread, x, name, args, kwargs, e.remaining())); err != nil {
+		return nil, err
+	}
+	return starlark.Call(thread, fn, args, kwargs)
+}
+
+// methodSize 估算方法调用结果占用的内存
+func methodSize(thread *starlark.Thread, x starlark.Value, name string, args starlark.Tuple, kwargs []starlark.Tuple, limit int64) int64 {
+	if _, ok := x.(*starlark.List); ok && name == "extend" && len(args) == 1 {
+		n := starlark.Len(args[0])
+		if n > maxItems {
+			return -1
+		}
+		return int64(max(n, 0)) * valueBytes
+	}
+
+	s, ok := x.(starlark.String)
+	if !ok {
+		return 0
+	}
+	switch name {
+	case "join":
+		if len(args) != 1 {
+			return 0
+		}
+		iterable, ok := args[0].(starlark.Iterable)
+		if !ok {
+			return 0
+		}
+		n, err := checkLen(name, iterable)
+		if err != nil {
+			return -1
+		}
+		size := int64(max(n, 0)) * int64(len(s))
+		iter := iterable.Iterate()
+		defer iter.Done()
+		var elem starlark.Value
+		for i := 0; iter.Next(&elem); i++ {
+			if i >= maxItems || size > limit || threadEnv(thread).poll(i) != nil {
+				return -1
+			}
+			size += seqSize(elem)
+		}
+		return size
+	case "replace":
+		if len(args) < 2 {
+			return 0
+		}
+		old, _ := starlark.AsString(args[0])
+		replacement, _ := starlark.AsString(args[1])
+		count := int64(strings.Count(string(s), old))
+		return int64(len(s)) + count*int64(len(replacement))
+	case "format":
+		var longest int64
+		for _, arg := range args {
+			longest = max(longest, reprSize(arg, limit))
+		}
+		for _, kwarg := range kwargs {
+			longest = max(longest, reprSize(kwarg[1], limit))
+		}
+		return int64(len(s)) + int64(strings.Count(string(s), "{"))*longest
+	}
+	return 0
+}
@@ -0,0 +1,224 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	starlarkjson "go.starlark.net/lib/json"
+)
+
+// 内置函数的资源上限
+const (
+	maxItems     = 100000  // 内置函数读取或展开的元素个数上限
+	pollInterval = 1024    // 迭代中检查脚本是否被停止的间隔（元素个数）
+	valueBytes   = 16      // 估算内存时列表、元组等每个元素占用的字节数
+	maxIntBits   = 1 << 23 // 乘法和左移结果的整数位数上限
+)
+
+// errAllocLimit 脚本分配的内存超过上限，由session.error转换为script_memory_limit
+var errAllocLimit = errors.New("memory limit exceeded")
+
+// charge 记录脚本将要分配的内存，超过上限时返回errAllocLimit；在分配前调用，避免单步分配大量内存
+func (e *env) charge(bytes int64) error {
+	if e.maxAlloc <= 0 {
+		return nil
+	}
+	if bytes < 0 || bytes > e.maxAlloc-e.alloc {
+		e.allocExceeded = true
+		return errAllocLimit
+	}
+	e.alloc += bytes
+	return nil
+}
+
+// remaining 剩余可以分配的内存，不限制时为-1
+func (e *env) remaining() int64 {
+	if e.maxAlloc <= 0 {
+		return -1
+	}
+	return e.maxAlloc - e.alloc
+}
+
+// poll 每隔pollInterval个元素检查脚本是否已超时或被取消
+func (e *env) poll(i int) error {
+	if i%pollInterval != 0 {
+		return nil
+	}
+	return e.ctx.Err()
+}
+
+// checkLen 检查可迭代对象的元素个数不超过maxItems，返回元素个数；无法获取长度时返回-1
+func checkLen(name string, iterable starlark.Value) (int, error) {
+	n := starlark.Len(iterable)
+	if n > maxItems {
+		return 0, fmt.Errorf("%s: %d elements exceed the limit of %d", name, n, maxItems)
+	}
+	return n, nil
+}
+
+// collect 读取可迭代对象的全部元素：先按长度检查元素个数，迭代时也限制个数并检查脚本是否被停止，读取的元素计入内存
+func collect(thread *starlark.Thread, name string, iterable starlark.Iterable) ([]starlark.Value, error) {
+	e := threadEnv(thread)
+	n, err := checkLen(name, iterable)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		if err := e.charge(int64(n) * valueBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	iter := iterable.Iterate()
+	defer iter.Done()
+
+	items := make([]starlark.Value, 0, max(n, 0))
+	var item starlark.Value
+	for iter.Next(&item) {
+		if len(items) == maxItems {
+			return nil, fmt.Errorf("%s: more than %d elements", name, maxItems)
+		}
+		if err := e.poll(len(items)); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if n < 0 {
+		if err := e.charge(int64(len(items)) * valueBytes); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// reprSize 估算值转换为字符串后的长度，超过limit时停止估算并返回大于limit的值；limit小于0时返回0
+func reprSize(v starlark.Value, limit int64) int64 {
+	if limit < 0 {
+		return 0
+	}
+	var size int64
+	var walk func(v starlark.Value)
+	walk = func(v starlark.Value) {
+		if size > limit {
+			return
+		}
+		switch v := v.(type) {
+		case starlark.String:
+			size += int64(len(v)) + 2
+		case starlark.Bytes:
+			size += 4*int64(len(v)) + 3
+		case starlark.Int:
+			size += int64(v.BigInt().BitLen())/3 + 2
+		case starlark.Tuple:
+			size += 2
+			for _, elem := range v {
+				size += 2
+				walk(elem)
+			}
+		case *starlark.List:
+			size += 2
+			for i := 0; i < v.Len() && size <= limit; i++ {
+				size += 2
+				walk(v.Index(i))
+			}
+		case *starlark.Dict:
+			size += 2
+			for _, item := range v.Items() {
+				size += 4
+				walk(item[0])
+				walk(item[1])
+			}
+		case *starlark.Set:
+			size += 5
+			iter := v.Iterate()
+			var elem starlark.Value
+			for size <= limit && iter.Next(&elem) {
+				size += 2
+				walk(elem)
+			}
+			iter.Done()
+		default:
+			size += 32
+		}
+	}
+	walk(v)
+	return size
+}
+
+// chargeRepr 按转换为字符串后的长度记录内存
+func chargeRepr(thread *starlark.Thread, args starlark.Tuple, kwargs []starlark.Tuple) error {
+	e := threadEnv(thread)
+	limit := e.remaining()
+	var size int64
+	for _, arg := range args {
+		size += reprSize(arg, limit-size)
+	}
+	for _, kwarg := range kwargs {
+		size += reprSize(kwarg[1], limit-size)
+	}
+	return e.charge(size)
+}
+
+// limitedBuiltin 包装会展开可迭代参数的内置函数：参数的元素个数不能超过maxItems，copies为true时展开的元素计入内存
+func limitedBuiltin(fn *starlark.Builtin, copies bool) *starlark.Builtin {
+	return starlark.NewBuiltin(fn.Name(), func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var total int64
+		for _, arg := range args {
+			if _, ok := arg.(starlark.Iterable); !ok {
+				continue
+			}
+			n, err := checkLen(b.Name(), arg)
+			if err != nil {
+				return nil, err
+			}
+			total += int64(max(n, 0))
+		}
+		if copies {
+			if err := threadEnv(thread).charge(total * valueBytes); err != nil {
+				return nil, err
+			}
+		}
+		return starlark.Call(thread, fn, args, kwargs)
+	})
+}
+
+// reprBuiltin 包装把参数转换为字符串的内置函数，转换结果的长度计入内存
+func reprBuiltin(fn *starlark.Builtin) *starlark.Builtin {
+	return starlark.NewBuiltin(fn.Name(), func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := chargeRepr(thread, args, kwargs); err != nil {
+			return nil, err
+		}
+		return starlark.Call(thread, fn, args, kwargs)
+	})
+}
+
+// limitedUniverse 替换Starlark内置函数中会展开可迭代对象或生成长字符串的函数
+func limitedUniverse() starlark.StringDict {
+	universe := make(starlark.StringDict)
+	for name, copies := range map[string]bool{
+		"list": true, "tuple": true, "sorted": true, "set": true, "dict": true,
+		"enumerate": true, "zip": true, "reversed": true,
+		"min": false, "max": false, "any": false, "all": false,
+	} {
+		universe[name] = limitedBuiltin(starlark.Universe[name].(*starlark.Builtin), copies)
+	}
+	for _, name := range []string{"str", "repr", "print"} {
+		universe[name] = reprBuiltin(starlark.Universe[name].(*starlark.Builtin))
+	}
+	return universe
+}
+
+// limitedJSON json模块，encode的结果长度计入内存
+func limitedJSON() *starlarkstruct.Module {
+	members := make(starlark.StringDict, len(starlarkjson.Module.Members))
+	for name, member := range starlarkjson.Module.Members {
+		members[name] = member
+	}
+	members["encode"] = reprBuiltin(members["encode"].(*starlark.Builtin))
+	module := *starlarkjson.Module
+	module.Members = members
+	return &module
+}
@@ -0,0 +1,30 @@
+package script
+
+import "github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+
+// 脚本错误消息的翻译key
+const (
+	msgScriptError       = "error.script_error"
+	msgScriptNoMain      = "error.script_no_main"
+	msgScriptStepLimit   = "error.script_step_limit"
+	msgScriptTimeout     = "error.script_timeout"
+	msgScriptMemoryLimit = "error.script_memory_limit"
+)
+
+func init() {
+	i18n.Register("zh-CN", map[string]string{
+		msgScriptError:       "脚本执行出错: %s",
+		msgScriptNoMain:      "脚本需要定义main(input)函数",
+		msgScriptStepLimit:   "脚本执行超过%d步，已停止",
+		msgScriptTimeout:     "脚本执行超过%s，已停止",
+		msgScriptMemoryLimit: "脚本分配的内存超过%dMB，已停止",
+	})
+
+	i18n.Register("en", map[string]string{
+		msgScriptError:       "Script error: %s",
+		msgScriptNoMain:      "The script must define a main(input) function",
+		msgScriptStepLimit:   "The script exceeded %d execution steps and was stopped",
+		msgScriptTimeout:     "The script ran longer than %s and was stopped",
+		msgScriptMemoryLimit: "The script allocated more than %d MB of memory and was stopped",
+	})
+}
@@ -0,0 +1,189 @@
+package script
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"go.starlark.net/starlark"
+)
+
+// random 可复现的随机数生成器，相同种子得到相同的序列
+type random struct {
+	seed int64
+	rng  *rand.Rand
+}
+
+var _ starlark.HasAttrs = (*random)(nil)
+
+// newRandom 创建随机数生成器：Random(seed=0)
+func newRandom(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var seed int64
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "seed?", &seed); err != nil {
+		return nil, err
+	}
+	return &random{seed: seed, rng: rand.New(rand.NewSource(seed))}, nil
+}
+
+func (r *random) String() string        { return fmt.Sprintf("Random(%d)", r.seed) }
+func (r *random) Type() string          { return "Random" }
+func (r *random) Freeze()               {}
+func (r *random) Truth() starlark.Bool  { return starlark.True }
+func (r *random) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: Random") }
+
+// randomMethods Random的方法
+var randomMethods = map[string]func(r *random, thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error){
+	"random":  (*random).random,
+	"randint": (*random).randint,
+	"choice":  (*random).choice,
+	"sample":  (*random).sample,
+}
+
+// Attr 获取方法
+func (r *random) Attr(name string) (starlark.Value, error) {
+	method, ok := randomMethods[name]
+	if !ok {
+		return nil, nil
+	}
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		return method(r, thread, b, args, kwargs)
+	}), nil
+}
+
+// AttrNames 方法名称
+func (r *random) AttrNames() []string {
+	names := make([]string, 0, len(randomMethods))
+	for name := range randomMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// random [0, 1)之间的浮点数：rng.random()
+func (r *random) random(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	return starlark.Float(r.rng.Float64()), nil
+}
+
+// randint [a, b]之间的整数：rng.randint(a, b)
+func (r *random) randint(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var lo, hi int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &lo, &hi); err != nil {
+		return nil, err
+	}
+	if hi < lo {
+		return nil, fmt.Errorf("%s: empty range [%d, %d]", b.Name(), lo, hi)
+	}
+	return starlark.MakeInt(lo + r.rng.Intn(hi-lo+1)), nil
+}
+
+// choice 随机选择一个元素：rng.choice(items)
+func (r *random) choice(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var iterable starlark.Iterable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &iterable); err != nil {
+		return nil, err
+	}
+	// 序列直接按下标选择，不读取全部元素
+	if indexable, ok := iterable.(starlark.Indexable); ok {
+		if indexable.Len() == 0 {
+			return nil, fmt.Errorf("%s: empty sequence", b.Name())
+		}
+		return indexable.Index(r.rng.Intn(indexable.Len())), nil
+	}
+	items, err := collect(thread, b.Name(), iterable)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%s: empty sequence", b.Name())
+	}
+	return items[r.rng.Intn(len(items))], nil
+}
+
+// sample 不放回抽取k个元素，weights为各元素的权重，省略时等概率：rng.sample(items, k, weights=None)
+func (r *random) sample(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var iterable starlark.Iterable
+	var k int
+	var weightsValue starlark.Value = starlark.None
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "items", &iterable, "k", &k, "weights?", &weightsValue); err != nil {
+		return nil, err
+	}
+	items, err := collect(thread, b.Name(), iterable)
+	if err != nil {
+		return nil, err
+	}
+	if k < 0 || k > len(items) {
+		return nil, fmt.Errorf("%s: k must be between 0 and %d", b.Name(), len(items))
+	}
+	weights, err := sampleWeights(thread, b.Name(), weightsValue, len(items))
+	if err != nil {
+		return nil, err
+	}
+
+	e := threadEnv(thread)
+	result := make([]starlark.Value, 0, k)
+	for len(result) < k {
+		if err := e.ctx.Err(); err != nil {
+			return nil, err
+		}
+		total := 0.0
+		for _, w := range weights {
+			total += w
+		}
+		if total <= 0 {
+			return nil, fmt.Errorf("%s: weights of the remaining items must be positive", b.Name())
+		}
+
+		// 按权重抽取一个元素后从候选中移除
+		x := r.rng.Float64() * total
+		i := -1
+		for j, w := range weights {
+			if w <= 0 {
+				continue
+			}
+			i = j
+			if x < w {
+				break
+			}
+			x -= w
+		}
+		result = append(result, items[i])
+		items = append(items[:i:i], items[i+1:]...)
+		weights = append(weights[:i:i], weights[i+1:]...)
+	}
+	return starlark.NewList(result), nil
+}
+
+// sampleWeights 解析权重，None表示等权重
+func sampleWeights(thread *starlark.Thread, name string, value starlark.Value, n int) ([]float64, error) {
+	weights := make([]float64, n)
+	if value == starlark.None {
+		for i := range weights {
+			weights[i] = 1
+		}
+		return weights, nil
+	}
+
+	iterable, ok := value.(starlark.Iterable)
+	if !ok {
+		return nil, fmt.Errorf("%s: weights must be a sequence, got %s", name, value.Type())
+	}
+	values, err := collect(thread, name, iterable)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != n {
+		return nil, fmt.Errorf("%s: got %d weights for %d items", name, len(values), n)
+	}
+	for i, v := range values {
+		w, ok := starlark.AsFloat(v)
+		if !ok || w < 0 {
+			return nil, fmt.Errorf("%s: weight %d must be a non-negative number", name, i)
+		}
+		weights[i] = w
+	}
+	return weights, nil
+}
@@ -0,0 +1,302 @@
+// Package script 在沙箱中执行Starlark脚本，用于自定义概率模型
+//
+// 脚本需要定义main(input)函数，input为请求中的输入，返回值编码为JSON作为结果。除Starlark内置函数外，脚本可以使用：
+//   - math、json：Starlark标准库模块
+//   - choose(n, k)、combinations(items, k)：组合数和组合枚举
+//   - Random(seed)：可复现的随机数，sample支持按权重不放回抽取
+//   - affix：游戏版本目录中的词条、稀有度和词条池，词条出现概率
+//   - strengthen：强化规则、强化状态机和强化概率
+//
+// 脚本不能使用load加载其他文件，也不能访问文件、网络或时钟；函数不能递归，执行步数、时间或分配的内存超过上限时停止。
+// 执行前改写语法树，字符串和列表的重复、拼接、格式化等运算在分配前按结果大小记录内存，展开可迭代对象的内置函数限制元素个数。
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.starlark.net/lib/math"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	starlarkjson "go.starlark.net/lib/json"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// print输出的上限
+const (
+	maxOutputLines = 100
+	maxOutputLine  = 1000
+)
+
+// fileOptions 脚本的语法选项，允许顶层控制语句和while循环，不允许递归
+var fileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// Options 脚本运行限制
+type Options struct {
+	MaxSteps uint64        // 执行步数上限，0表示不限制
+	Timeout  time.Duration // 执行时间上限，0表示不限制
+	MaxAlloc int64         // 字符串、列表等分配的内存上限，字节，0表示不限制；按结果大小估算，在分配前检查
+}
+
+// ConfigOptions 根据脚本配置生成运行限制
+func ConfigOptions(cfg config.ScriptsConfig) Options {
+	return Options{
+		MaxSteps: cfg.MaxSteps,
+		Timeout:  cfg.Timeout,
+		MaxAlloc: int64(cfg.MaxAllocMB) << 20,
+	}
+}
+
+// Request 一次脚本执行
+type Request struct {
+	Name        string          // 脚本名称，用于错误位置
+	Source      string          // 脚本源码
+	Input       json.RawMessage // main的参数，为空时传入空字典
+	GameVersion string          // 脚本使用的游戏版本，为空时使用最新版本
+	Locale      string          // 错误消息的语言
+}
+
+// Result 脚本执行结果
+type Result struct {
+	Value       json.RawMessage // main的返回值
+	Output      []string        // print输出
+	Steps       uint64          // 执行步数
+	GameVersion string
+}
+
+// Run 执行脚本并调用main(input)
+func Run(ctx context.Context, req Request, opts Options) (*Result, error) {
+	s, err := newSession(ctx, req, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer s.close()
+
+	globals, err := s.exec()
+	if err != nil {
+		return nil, err
+	}
+	main, ok := globals["main"].(starlark.Callable)
+	if !ok {
+		return nil, services.NewError(services.ErrCodeScriptError, "source", msgScriptNoMain)
+	}
+
+	input, err := s.decode(req.Input)
+	if err != nil {
+		return nil, err
+	}
+	value, err := starlark.Call(s.thread, main, starlark.Tuple{input}, nil)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	encoded, err := s.encode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Value:       encoded,
+		Output:      s.output,
+		Steps:       s.thread.ExecutionSteps(),
+		GameVersion: s.env.catalog.Version.ID,
+	}, nil
+}
+
+// env 脚本中的内置函数访问的执行环境
+type env struct {
+	ctx           context.Context
+	catalog       *models.Catalog
+	alloc         int64 // 已分配的内存（估算）
+	maxAlloc      int64 // 内存上限，0表示不限制
+	allocExceeded bool  // 是否因内存超过上限停止
+}
+
+// envKey 执行环境在线程中的键
+const envKey = "script.env"
+
+// threadEnv 获取线程的执行环境
+func threadEnv(thread *starlark.Thread) *env {
+	return thread.Local(envKey).(*env)
+}
+
+// session 一次脚本执行的线程、限制和输出
+type session struct {
+	req     Request
+	opts    Options
+	env     *env
+	thread  *starlark.Thread
+	output  []string
+	cancel  context.CancelFunc
+	stepped bool // 是否因执行步数超过上限停止
+}
+
+// newSession 创建执行环境，游戏版本不存在时返回错误；ctx结束或超时时停止脚本
+func newSession(ctx context.Context, req Request, opts Options) (*session, error) {
+	catalog := models.GetCatalog(req.GameVersion)
+	if catalog == nil {
+		return nil, services.NewUnknownGameVersionError(req.GameVersion)
+	}
+	if req.Name == "" {
+		req.Name = "script.star"
+	}
+
+	var cancel context.CancelFunc
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	s := &session{
+		req:    req,
+		opts:   opts,
+		env:    &env{ctx: ctx, catalog: catalog, maxAlloc: opts.MaxAlloc},
+		output: []string{},
+		cancel: cancel,
+	}
+	s.thread = &starlark.Thread{
+		Name:  req.Name,
+		Print: s.print,
+		OnMaxSteps: func(thread *starlark.Thread) {
+			s.stepped = true
+			thread.Cancel("too many steps")
+		},
+	}
+	s.thread.SetLocal(envKey, s.env)
+	if opts.MaxSteps > 0 {
+		s.thread.SetMaxExecutionSteps(opts.MaxSteps)
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.thread.Cancel(ctx.Err().Error())
+	}()
+	return s, nil
+}
+
+// close 释放执行环境
+func (s *session) close() {
+	s.cancel()
+}
+
+// exec 解析脚本并改写会分配大量内存的运算，执行顶层语句，返回全局变量
+func (s *session) exec() (starlark.StringDict, error) {
+	f, err := fileOptions.Parse(s.req.Name, s.req.Source, 0)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	instrument(f)
+	prog, err := starlark.FileProgram(f, predeclared.Has)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	globals, err := prog.Init(s.thread, predeclared)
+	globals.Freeze()
+	if err != nil {
+		return nil, s.error(err)
+	}
+	return globals, nil
+}
+
+// print 记录print输出，超过上限的输出丢弃
+func (s *session) print(_ *starlark.Thread, msg string) {
+	if len(s.output) < maxOutputLines {
+		s.output = append(s.output, truncate(msg, maxOutputLine))
+	}
+}
+
+// decode 将JSON输入转换为Starlark值
+func (s *session) decode(input json.RawMessage) (starlark.Value, error) {
+	if len(input) == 0 {
+		return starlark.NewDict(0), nil
+	}
+	value, err := starlark.Call(s.thread, starlarkjson.Module.Members["decode"], starlark.Tuple{starlark.String(input)}, nil)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	return value, nil
+}
+
+// encode 将main的返回值编码为JSON
+func (s *session) encode(value starlark.Value) (json.RawMessage, error) {
+	encoded, err := starlark.Call(s.thread, jsonModule.Members["encode"], starlark.Tuple{value}, nil)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	return json.RawMessage(encoded.(starlark.String)), nil
+}
+
+// error 转换执行错误：执行步数或时间超过上限、请求取消，其余为脚本错误，消息带出错位置
+func (s *session) error(err error) error {
+	ctxErr := s.env.ctx.Err()
+	switch {
+	case s.env.allocExceeded:
+		return services.NewError(services.ErrCodeScriptMemoryLimit, "source", msgScriptMemoryLimit, s.opts.MaxAlloc>>20)
+	case s.stepped:
+		return services.NewError(services.ErrCodeScriptStepLimit, "source", msgScriptStepLimit, s.opts.MaxSteps)
+	case errors.Is(ctxErr, context.DeadlineExceeded):
+		return services.NewError(services.ErrCodeScriptTimeout, "source", msgScriptTimeout, s.opts.Timeout)
+	case ctxErr != nil:
+		return context.Canceled
+	}
+
+	message := err.Error()
+	var serviceErr *services.Error
+	if errors.As(err, &serviceErr) {
+		message = serviceErr.Localize(s.req.Locale)
+	}
+	var evalErr *starlark.EvalError
+	if errors.As(err, &evalErr) {
+		// 从最内层向外找到脚本中的位置，跳过内置函数
+		for i := len(evalErr.CallStack) - 1; i >= 0; i-- {
+			if pos := evalErr.CallStack.At(i).Pos; pos.IsValid() && pos.Filename() == s.req.Name {
+				message = fmt.Sprintf("%s: %s", pos, message)
+				break
+			}
+		}
+	}
+	return services.NewError(services.ErrCodeScriptError, "source", msgScriptError, message)
+}
+
+// jsonModule json模块，encode的结果计入内存
+var jsonModule = limitedJSON()
+
+// predeclared 脚本可以使用的模块和函数，替换了会展开可迭代对象或生成长字符串的内置函数
+var predeclared = func() starlark.StringDict {
+	globals := starlark.StringDict{
+		"math":         math.Module,
+		"json":         jsonModule,
+		"choose":       starlark.NewBuiltin("choose", choose),
+		"combinations": starlark.NewBuiltin("combinations", combinations),
+		"Random":       starlark.NewBuiltin("Random", newRandom),
+		"affix":        affixModule,
+		"strengthen":   strengthenModule,
+		binaryFn:       starlark.NewBuiltin(binaryFn, binary),
+		methodFn:       starlark.NewBuiltin(methodFn, method),
+	}
+	for name, fn := range limitedUniverse() {
+		globals[name] = fn
+	}
+	return globals
+}()
+
+// truncate 按字符截断字符串
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,93 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// testOptions 测试使用的运行限制，与默认配置相同
+var testOptions = Options{MaxSteps: 10000000, Timeout: 5 * time.Second, MaxAlloc: 256 << 20}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		input  string
+		want   string
+	}{
+		{name: "input", source: "def main(input):\n    return input['n'] * 2\n", input: `{"n":21}`, want: "42"},
+		{name: "string ops", source: "def main(input):\n    s = 'ab' * 3 + '%d' % 7\n    return '-'.join([s, s.replace('a', 'x'), '{}!'.format(s)])\n", want: `"ababab7-xbxbxb7-ababab7!"`},
+		{name: "list extend in place", source: "def main(input):\n    a = [1]\n    b = a\n    b += [2, 3]\n    b.extend((4,))\n    return a\n", want: "[1,2,3,4]"},
+		{name: "augmented index", source: "def main(input):\n    calls = []\n    def key():\n        calls.append(1)\n        return 'k'\n    d = {'k': 1}\n    d[key()] += 2\n    d[key()] *= 3\n    return [d['k'], len(calls)]\n", want: "[9,2]"},
+		{name: "global augmented", source: "total = 0\nfor i in range(4):\n    total += i\ndef main(input):\n    return total\n", want: "6"},
+		{name: "keyword arguments", source: "def f(a, b = 'x' * 2):\n    return a + b\ndef main(input):\n    return f(a = 'y' + 'z')\n", want: `"yzxx"`},
+		{name: "combinations", source: "def main(input):\n    return [len(combinations(range(10), 3)), choose(10, 3)]\n", want: "[120,120]"},
+		{name: "random", source: "def main(input):\n    r = Random(1)\n    return len(r.sample(range(100), 5)) + (1 if r.choice(range(100000)) >= 0 else 0)\n", want: "6"},
+	}
+	for _, tt := range tests {
+		result, err := Run(context.Background(), Request{Source: tt.source, Input: []byte(tt.input)}, testOptions)
+		if err != nil {
+			t.Errorf("%s: Run() error = %v", tt.name, err)
+			continue
+		}
+		if string(result.Value) != tt.want {
+			t.Errorf("%s: Run() = %s, want %s", tt.name, result.Value, tt.want)
+		}
+	}
+}
+
+func TestRunLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		code    string
+		message string
+	}{
+		{name: "combinations of a huge range", source: "combinations(range(30000000), 1)", code: services.ErrCodeScriptError, message: "exceed the limit"},
+		{name: "list of a huge range", source: "list(range(30000000))", code: services.ErrCodeScriptError, message: "exceed the limit"},
+		{name: "string repeat", source: `"a" * (1 << 29)`, code: services.ErrCodeScriptMemoryLimit},
+		{name: "repeat in a loop", source: "s = 'a' * 1000000\nfor i in range(1000):\n    s += s[:1000000]\n", code: services.ErrCodeScriptMemoryLimit},
+		{name: "augmented repeat", source: "s = 'ab'\ns *= 1 << 28", code: services.ErrCodeScriptMemoryLimit},
+		{name: "join", source: `"".join(["x" * 100000] * 100000)`, code: services.ErrCodeScriptMemoryLimit},
+		{name: "list repeat", source: "[0] * (1 << 28)", code: services.ErrCodeScriptMemoryLimit},
+		{name: "big int", source: "x = 1 << 511\nfor i in range(30):\n    x = x * x\n", code: services.ErrCodeScriptMemoryLimit},
+		{name: "format", source: "s = 'x' * 10000000\nt = '%s%s%s%s%s%s%s%s%s%s' % (s, s, s, s, s, s, s, s, s, s)\nu = t + t + t", code: services.ErrCodeScriptMemoryLimit},
+		{name: "step limit", source: "while True:\n    pass\n", code: services.ErrCodeScriptStepLimit},
+		{name: "error position", source: "x = 1\ny = x + 'a'\n", code: services.ErrCodeScriptError, message: "script.star:2:7"},
+	}
+	for _, tt := range tests {
+		start := time.Now()
+		_, err := Run(context.Background(), Request{Source: tt.source + "\ndef main(input):\n    return 0\n"}, testOptions)
+		var serviceErr *services.Error
+		if !errors.As(err, &serviceErr) || serviceErr.Code != tt.code {
+			t.Errorf("%s: Run() error = %v, want %s", tt.name, err, tt.code)
+			continue
+		}
+		if message := serviceErr.Localize("en"); !strings.Contains(message, tt.message) {
+			t.Errorf("%s: message = %q, want it to contain %q", tt.name, message, tt.message)
+		}
+		if elapsed := time.Since(start); tt.code != services.ErrCodeScriptStepLimit && elapsed > time.Second {
+			t.Errorf("%s: took %s", tt.name, elapsed)
+		}
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	opts := testOptions
+	opts.MaxSteps = 0
+	opts.Timeout = 100 * time.Millisecond
+	start := time.Now()
+	_, err := Run(context.Background(), Request{Source: "def main(input):\n    while True:\n        pass\n"}, opts)
+	var serviceErr *services.Error
+	if !errors.As(err, &serviceErr) || serviceErr.Code != services.ErrCodeScriptTimeout {
+		t.Fatalf("Run() error = %v, want %s", err, services.ErrCodeScriptTimeout)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("timeout took %s", elapsed)
+	}
+}
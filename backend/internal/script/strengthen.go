@@ -0,0 +1,246 @@
+package script
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// strengthenModule 强化相关函数，规则来自脚本使用的游戏版本
+var strengthenModule = &starlarkstruct.Module{
+	Name: "strengthen",
+	Members: starlark.StringDict{
+		"rules":       starlark.NewBuiltin("strengthen.rules", strengthenRules),
+		"state":       starlark.NewBuiltin("strengthen.state", newStrengthenState),
+		"probability": starlark.NewBuiltin("strengthen.probability", strengthenProbability),
+	},
+}
+
+// strengthenRules 稀有度的强化规则：strengthen.rules(rarity="gold")
+func strengthenRules(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	rarity := models.RarityGold
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "rarity?", &rarity); err != nil {
+		return nil, err
+	}
+	r, err := lookupRarity(threadEnv(thread).catalog, rarity)
+	if err != nil {
+		return nil, err
+	}
+	return rarityDict(*r), nil
+}
+
+// strengthenProbability 从初始等级强化到目标等级的概率，与强化概率计算接口相同：
+// strengthen.probability(initial, target, rarity="gold", order_independent=False)
+func strengthenProbability(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var initial, target starlark.Iterable
+	rarity := models.RarityGold
+	var orderIndependent bool
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"initial", &initial, "target", &target, "rarity?", &rarity, "order_independent?", &orderIndependent); err != nil {
+		return nil, err
+	}
+	initialLevels, err := toInts(thread, b.Name(), initial)
+	if err != nil {
+		return nil, err
+	}
+	targetLevels, err := toInts(thread, b.Name(), target)
+	if err != nil {
+		return nil, err
+	}
+
+	e := threadEnv(thread)
+	result, err := services.NewStrengthenProbabilityService().CalculateProbability(e.ctx, e.catalog.Version.ID,
+		initialLevels, targetLevels, rarity, orderIndependent, false)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Float(result.Probability), nil
+}
+
+// strengthenState 强化状态机的一个状态：各词条等级和已用强化次数，状态不可修改，强化返回新状态
+type strengthenState struct {
+	rarity *models.Rarity
+	levels []int
+	used   int
+}
+
+var _ starlark.HasAttrs = (*strengthenState)(nil)
+
+// newStrengthenState 创建强化状态：strengthen.state(levels, rarity="gold", used=0)
+func newStrengthenState(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var levelsValue starlark.Iterable
+	rarity := models.RarityGold
+	var used int
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "levels", &levelsValue, "rarity?", &rarity, "used?", &used); err != nil {
+		return nil, err
+	}
+	r, err := lookupRarity(threadEnv(thread).catalog, rarity)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := toInts(thread, b.Name(), levelsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(levels) != r.SlotCount {
+		return nil, fmt.Errorf("%s: %s has %d slots, got %d levels", b.Name(), r.ID, r.SlotCount, len(levels))
+	}
+	for i, level := range levels {
+		if level < 1 || level > r.MaxLevel {
+			return nil, fmt.Errorf("%s: level of slot %d must be between 1 and %d", b.Name(), i, r.MaxLevel)
+		}
+	}
+	if used < 0 || used > r.MaxEnhancements {
+		return nil, fmt.Errorf("%s: used must be between 0 and %d", b.Name(), r.MaxEnhancements)
+	}
+	return &strengthenState{rarity: r, levels: levels, used: used}, nil
+}
+
+func (s *strengthenState) Type() string         { return "StrengthenState" }
+func (s *strengthenState) Freeze()              {}
+func (s *strengthenState) Truth() starlark.Bool { return starlark.True }
+
+func (s *strengthenState) Hash() (uint32, error) {
+	return 0, fmt.Errorf("unhashable type: StrengthenState")
+}
+
+func (s *strengthenState) String() string {
+	levels := make([]string, len(s.levels))
+	for i, level := range s.levels {
+		levels[i] = fmt.Sprint(level)
+	}
+	return fmt.Sprintf("StrengthenState(%s, [%s], used=%d)", s.rarity.ID, strings.Join(levels, ", "), s.used)
+}
+
+// strengthenStateMethods StrengthenState的方法
+var strengthenStateMethods = map[string]func(s *strengthenState, thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error){
+	"enhance":  (*strengthenState).enhance,
+	"step":     (*strengthenState).step,
+	"outcomes": (*strengthenState).outcomes,
+}
+
+// strengthenStateFields StrengthenState的属性
+var strengthenStateFields = []string{"available", "done", "levels", "rarity", "remaining", "used"}
+
+// Attr 获取属性或方法
+func (s *strengthenState) Attr(name string) (starlark.Value, error) {
+	switch name {
+	case "levels":
+		levels := make(starlark.Tuple, len(s.levels))
+		for i, level := range s.levels {
+			levels[i] = starlark.MakeInt(level)
+		}
+		return levels, nil
+	case "rarity":
+		return starlark.String(s.rarity.ID), nil
+	case "used":
+		return starlark.MakeInt(s.used), nil
+	case "remaining":
+		return starlark.MakeInt(s.remaining()), nil
+	case "available":
+		return intList(s.available()), nil
+	case "done":
+		return starlark.Bool(s.remaining() == 0 || len(s.available()) == 0), nil
+	}
+
+	method, ok := strengthenStateMethods[name]
+	if !ok {
+		return nil, nil
+	}
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		return method(s, thread, b, args, kwargs)
+	}), nil
+}
+
+// AttrNames 属性和方法名称
+func (s *strengthenState) AttrNames() []string {
+	names := append([]string(nil), strengthenStateFields...)
+	for name := range strengthenStateMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// remaining 剩余强化次数
+func (s *strengthenState) remaining() int {
+	return s.rarity.MaxEnhancements - s.used
+}
+
+// available 未达到最高等级、可以强化的词条位置
+func (s *strengthenState) available() []int {
+	slots := make([]int, 0, len(s.levels))
+	for i, level := range s.levels {
+		if level < s.rarity.MaxLevel {
+			slots = append(slots, i)
+		}
+	}
+	return slots
+}
+
+// next 强化指定位置的词条，返回新状态
+func (s *strengthenState) next(name string, slot int) (*strengthenState, error) {
+	if s.remaining() == 0 {
+		return nil, fmt.Errorf("%s: no enhancements remaining", name)
+	}
+	if slot < 0 || slot >= len(s.levels) {
+		return nil, fmt.Errorf("%s: slot must be between 0 and %d", name, len(s.levels)-1)
+	}
+	if s.levels[slot] >= s.rarity.MaxLevel {
+		return nil, fmt.Errorf("%s: slot %d is already at level %d", name, slot, s.rarity.MaxLevel)
+	}
+
+	levels := append([]int(nil), s.levels...)
+	levels[slot]++
+	return &strengthenState{rarity: s.rarity, levels: levels, used: s.used + 1}, nil
+}
+
+// enhance 强化指定位置的词条：state.enhance(slot)
+func (s *strengthenState) enhance(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var slot int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &slot); err != nil {
+		return nil, err
+	}
+	return s.next(b.Name(), slot)
+}
+
+// step 按游戏规则随机强化一个可以强化的词条：state.step(rng)
+func (s *strengthenState) step(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var rng *random
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &rng); err != nil {
+		return nil, err
+	}
+	available := s.available()
+	if len(available) == 0 {
+		return nil, fmt.Errorf("%s: all slots are at level %d", b.Name(), s.rarity.MaxLevel)
+	}
+	return s.next(b.Name(), available[rng.rng.Intn(len(available))])
+}
+
+// outcomes 用完剩余强化次数后的等级分布，按概率从高到低排列：state.outcomes()
+func (s *strengthenState) outcomes(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	e := threadEnv(thread)
+	outcomes, err := services.NewStrengthenProbabilityService().CalculateOutcomes(e.ctx, e.catalog.Version.ID, s.rarity.ID, s.levels, s.used)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]starlark.Value, len(outcomes))
+	for i, outcome := range outcomes {
+		d := starlark.NewDict(2)
+		_ = d.SetKey(starlark.String("levels"), intList(outcome.Levels))
+		_ = d.SetKey(starlark.String("probability"), starlark.Float(outcome.Probability))
+		values[i] = d
+	}
+	return starlark.NewList(values), nil
+}
@@ -0,0 +1,157 @@
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"go.starlark.net/starlark"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/registry"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// CategoryScript 脚本工具未声明分类时使用的分类
+const CategoryScript = "script"
+
+// toolIDPattern 脚本工具ID，与插件ID的规则相同，同时用作Discord命令名称
+var toolIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// ToolInfo 脚本通过全局变量TOOL声明的工具信息
+type ToolInfo struct {
+	ID           string                       `json:"id"`
+	Name         string                       `json:"name"`
+	Description  string                       `json:"description,omitempty"`
+	Category     string                       `json:"category,omitempty"`
+	Icon         string                       `json:"icon,omitempty"`
+	InputSchema  json.RawMessage              `json:"input_schema,omitempty"`
+	Translations map[string]map[string]string `json:"translations,omitempty"` // 语言 -> name/description
+}
+
+// LoadTools 加载目录中声明了TOOL的*.star脚本，作为工具运行时每次重新执行脚本并调用main(input)；
+// 目录不存在时没有工具，执行出错或声明无效的脚本记录日志后跳过
+func LoadTools(dir string, opts Options) ([]registry.Tool, error) {
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.star"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var tools []registry.Tool
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			slog.Error("读取脚本失败", "path", path, "error", err)
+			continue
+		}
+		name := filepath.Base(path)
+		info, err := declareTool(name, string(data), opts)
+		if err == nil && seen[info.ID] {
+			err = fmt.Errorf("duplicate tool id %q", info.ID)
+		}
+		if err != nil {
+			slog.Error("加载脚本工具失败", "path", path, "error", err)
+			continue
+		}
+		seen[info.ID] = true
+		registerTranslations(info)
+		tools = append(tools, newTool(info, name, string(data), opts))
+		slog.Info("已加载脚本工具", "tool", info.ID, "path", path)
+	}
+	return tools, nil
+}
+
+// declareTool 执行脚本的顶层语句并读取TOOL，脚本需要定义main
+func declareTool(name, source string, opts Options) (*ToolInfo, error) {
+	s, err := newSession(context.Background(), Request{Name: name, Source: source}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer s.close()
+
+	globals, err := s.exec()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := globals["main"].(starlark.Callable); !ok {
+		return nil, errors.New("main(input) is not defined")
+	}
+	declared, ok := globals["TOOL"]
+	if !ok {
+		return nil, errors.New("TOOL is not defined")
+	}
+	encoded, err := s.encode(declared)
+	if err != nil {
+		return nil, fmt.Errorf("TOOL: %w", err)
+	}
+
+	var info ToolInfo
+	if err := json.Unmarshal(encoded, &info); err != nil {
+		return nil, fmt.Errorf("TOOL: %w", err)
+	}
+	if !toolIDPattern.MatchString(info.ID) {
+		return nil, fmt.Errorf("invalid tool id %q", info.ID)
+	}
+	if info.Name == "" {
+		info.Name = info.ID
+	}
+	if info.Category == "" {
+		info.Category = CategoryScript
+	}
+	return &info, nil
+}
+
+// registerTranslations 注册脚本声明的名称和描述翻译
+func registerTranslations(info *ToolInfo) {
+	for locale, texts := range info.Translations {
+		messages := make(map[string]string, 2)
+		for _, field := range []string{"name", "description"} {
+			if text := texts[field]; text != "" {
+				messages["tool."+info.ID+"."+field] = text
+			}
+		}
+		i18n.Register(locale, messages)
+	}
+}
+
+// newTool 转换为工具注册表中的工具，默认启用；输入中的gameVersion作为脚本使用的游戏版本
+func newTool(info *ToolInfo, name, source string, opts Options) registry.Tool {
+	return registry.Tool{
+		ID:          info.ID,
+		Name:        info.Name,
+		Description: info.Description,
+		Category:    info.Category,
+		Icon:        info.Icon,
+		InputSchema: info.InputSchema,
+		Source:      registry.SourceScript,
+		Handler: func(ctx context.Context, input json.RawMessage, locale string) (interface{}, error) {
+			var version struct {
+				GameVersion string `json:"gameVersion"`
+			}
+			_ = json.Unmarshal(input, &version)
+
+			result, err := Run(ctx, Request{
+				Name:        name,
+				Source:      source,
+				Input:       input,
+				GameVersion: version.GameVersion,
+				Locale:      locale,
+			}, opts)
+			if err != nil {
+				return nil, err
+			}
+			return result.Value, nil
+		},
+		Enabled: true,
+	}
+}
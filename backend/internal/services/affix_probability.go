@@ -1,60 +1,105 @@
 package services
 
 import (
+	"context"
+	"log/slog"
 	"sort"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/metrics"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
 )
 
 // AffixProbabilityService 词条概率计算服务
-type AffixProbabilityService struct{}
+type AffixProbabilityService struct {
+	cache *cache.Cache
+}
 
 // NewAffixProbabilityService 创建词条概率计算服务
 func NewAffixProbabilityService() *AffixProbabilityService {
 	return &AffixProbabilityService{}
 }
 
+// WithCache 设置计算结果缓存，nil表示不缓存
+func (s *AffixProbabilityService) WithCache(c *cache.Cache) *AffixProbabilityService {
+	s.cache = c
+	return s
+}
+
+// CacheKey 获取计算结果的缓存键，游戏版本不存在时返回空字符串
+func (s *AffixProbabilityService) CacheKey(gameVersion string, slotCount int, rarity string, targetAffixIDs []int, showCombinations bool) string {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return ""
+	}
+	return affixProbabilityKey(catalog, slotCount, rarity, targetAffixIDs, showCombinations)
+}
+
+// affixProbabilityKey 生成缓存键，目标词条排序去重，结果与目标词条的顺序和重复无关
+func affixProbabilityKey(catalog *models.Catalog, slotCount int, rarity string, targetAffixIDs []int, showCombinations bool) string {
+	return cache.Key("affix_probability", catalog.Version.ID, catalog.Revision(),
+		slotCount, rarity, filterTargets(targetAffixIDs, targetAffixIDs), showCombinations)
+}
+
 // CalculateProbability 计算词条出现概率
-func (s *AffixProbabilityService) CalculateProbability(slotCount int, targetAffixIDs []int, showCombinations bool) *AffixProbabilityResult {
-	const totalAffixes = 10
+// gameVersion为空时使用最新游戏版本的目录
+// rarity为空时按slotCount从全部词条中抽取；指定稀有度时使用该稀有度的词条数量和词条池，any按掉落权重积分
+func (s *AffixProbabilityService) CalculateProbability(ctx context.Context, gameVersion string, slotCount int, rarity string, targetAffixIDs []int, showCombinations bool) (*AffixProbabilityResult, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
 
-	// 参数验证
-	if slotCount <= 0 || slotCount > totalAffixes {
-		return &AffixProbabilityResult{
-			Error: "词条数量必须在1-10之间",
-		}
+	key := affixProbabilityKey(catalog, slotCount, rarity, targetAffixIDs, showCombinations)
+	cached := &AffixProbabilityResult{}
+	if s.cache.Get(ctx, key, cached) {
+		return cached, nil
 	}
 
-	// 去重目标词条
-	targetSet := make(map[int]bool)
-	for _, id := range targetAffixIDs {
-		if id >= 1 && id <= totalAffixes {
-			targetSet[id] = true
-		}
+	start := time.Now()
+	result, err := s.calculate(catalog, slotCount, rarity, targetAffixIDs, showCombinations)
+	if err != nil {
+		return nil, err
 	}
+	result.GameVersion = catalog.Version.ID
+	s.cache.Set(ctx, key, result)
 
-	rangeSize := len(targetSet)
-	if rangeSize == 0 {
-		return &AffixProbabilityResult{
-			Error: "目标范围中没有有效的词条编号",
-		}
+	logCalculation(ctx, "affix_probability", result.GameVersion, start, result.Probability,
+		slog.Int("slot_count", result.SlotCount),
+		slog.String("rarity", rarity),
+		slog.Any("targets", result.TargetRange))
+	metrics.ObserveCombinations("affix_probability", result.TotalCombinations)
+	if showCombinations {
+		metrics.ObservePaths("affix_probability", len(result.Combinations))
 	}
+	return result, nil
+}
 
-	// 如果需要的词条数量大于范围大小，概率为0
-	if slotCount > rangeSize {
-		return &AffixProbabilityResult{
-			Probability:        0,
-			ProbabilityPercent: 0,
-			TotalCombinations:  combination(totalAffixes, slotCount),
-			ValidCombinations:  0,
-			SlotCount:          slotCount,
-			TargetRange:        getSortedKeys(targetSet),
-		}
+// calculate 按指定版本的目录计算词条出现概率
+func (s *AffixProbabilityService) calculate(catalog *models.Catalog, slotCount int, rarity string, targetAffixIDs []int, showCombinations bool) (*AffixProbabilityResult, error) {
+	if rarity != "" {
+		return s.calculateByRarity(catalog, rarity, targetAffixIDs, showCombinations)
 	}
 
-	// 计算总的可能组合数
-	totalCombinations := combination(totalAffixes, slotCount)
+	allAffixes := catalog.Affixes()
+	pool := make([]int, 0, len(allAffixes))
+	for _, affix := range allAffixes {
+		pool = append(pool, affix.ID)
+	}
 
-	// 计算满足条件的组合数
-	validCombinations := combination(rangeSize, slotCount)
+	// 参数验证
+	if slotCount <= 0 || slotCount > len(pool) {
+		return nil, NewRangeError(ErrCodeOutOfRange, "slotCount", 1, len(pool), MsgSlotCountRange)
+	}
+
+	// 去重目标词条
+	targets := filterTargets(pool, targetAffixIDs)
+	if len(targets) == 0 {
+		return nil, NewError(ErrCodeNoValidTargets, "targetAffixIds", MsgNoValidTargets)
+	}
+
+	totalCombinations, validCombinations := poolCombinations(len(pool), len(targets), slotCount)
 
 	// 计算概率
 	probability := float64(validCombinations) / float64(totalCombinations)
@@ -65,27 +110,126 @@ func (s *AffixProbabilityService) CalculateProbability(slotCount int, targetAffi
 		TotalCombinations:  totalCombinations,
 		ValidCombinations:  validCombinations,
 		SlotCount:          slotCount,
-		TargetRange:        getSortedKeys(targetSet),
+		TargetRange:        targets,
 	}
 
 	// 如果需要显示组合
 	if showCombinations && validCombinations > 0 && validCombinations <= 1000 {
-		result.Combinations = generateCombinations(getSortedKeys(targetSet), slotCount)
+		result.Combinations = generateCombinations(targets, slotCount)
 	}
 
-	return result
+	return result, nil
+}
+
+// calculateByRarity 按稀有度计算词条出现概率
+func (s *AffixProbabilityService) calculateByRarity(catalog *models.Catalog, rarity string, targetAffixIDs []int, showCombinations bool) (*AffixProbabilityResult, error) {
+	rarities := catalog.ResolveRarities(rarity)
+	if len(rarities) == 0 {
+		return nil, newUnknownRarityError(catalog, rarity)
+	}
+
+	// 目标词条需在至少一个稀有度的词条池中
+	var allTargets []int
+	for _, r := range rarities {
+		allTargets = append(allTargets, filterTargets(r.AffixPool, targetAffixIDs)...)
+	}
+	allTargets = filterTargets(allTargets, allTargets)
+	if len(allTargets) == 0 {
+		return nil, NewError(ErrCodeNoValidTargets, "targetAffixIds", MsgNoValidTargets)
+	}
+
+	var totalWeight float64
+	for _, r := range rarities {
+		totalWeight += r.DropWeight
+	}
+
+	result := &AffixProbabilityResult{
+		Rarity:      rarity,
+		TargetRange: allTargets,
+	}
+
+	for _, r := range rarities {
+		targets := filterTargets(r.AffixPool, targetAffixIDs)
+		totalCombinations, validCombinations := poolCombinations(len(r.AffixPool), len(targets), r.SlotCount)
+
+		probability := 0.0
+		if totalCombinations > 0 {
+			probability = float64(validCombinations) / float64(totalCombinations)
+		}
+		weight := r.DropWeight / totalWeight
+		result.Probability += weight * probability
+
+		result.RarityBreakdown = append(result.RarityBreakdown, RarityProbability{
+			Rarity:            r.ID,
+			Name:              r.Name,
+			Weight:            weight,
+			SlotCount:         r.SlotCount,
+			Probability:       probability,
+			TotalCombinations: totalCombinations,
+			ValidCombinations: validCombinations,
+		})
+
+		// 单一稀有度时与不指定稀有度的结果结构一致
+		if len(rarities) == 1 {
+			result.TotalCombinations = totalCombinations
+			result.ValidCombinations = validCombinations
+			result.SlotCount = r.SlotCount
+			result.TargetRange = targets
+			if showCombinations && validCombinations > 0 && validCombinations <= 1000 {
+				result.Combinations = generateCombinations(targets, r.SlotCount)
+			}
+		}
+	}
+
+	result.ProbabilityPercent = result.Probability * 100
+	return result, nil
 }
 
 // AffixProbabilityResult 词条概率计算结果
 type AffixProbabilityResult struct {
-	Probability        float64 `json:"probability"`
-	ProbabilityPercent float64 `json:"probabilityPercent"`
-	TotalCombinations  int64   `json:"totalCombinations"`
-	ValidCombinations  int64   `json:"validCombinations"`
-	SlotCount          int     `json:"slotCount"`
-	TargetRange        []int   `json:"targetRange"`
-	Combinations       [][]int `json:"combinations,omitempty"`
-	Error              string  `json:"error,omitempty"`
+	Probability        float64             `json:"probability"`
+	ProbabilityPercent float64             `json:"probabilityPercent"`
+	TotalCombinations  int64               `json:"totalCombinations"`
+	ValidCombinations  int64               `json:"validCombinations"`
+	SlotCount          int                 `json:"slotCount"`
+	TargetRange        []int               `json:"targetRange"`
+	Combinations       [][]int             `json:"combinations,omitempty"`
+	Rarity             string              `json:"rarity,omitempty"`
+	RarityBreakdown    []RarityProbability `json:"rarityBreakdown,omitempty"`
+	GameVersion        string              `json:"gameVersion,omitempty"`
+}
+
+// RarityProbability 单个稀有度下的概率
+type RarityProbability struct {
+	Rarity            string  `json:"rarity"`
+	Name              string  `json:"name"`
+	Weight            float64 `json:"weight"`
+	SlotCount         int     `json:"slotCount"`
+	Probability       float64 `json:"probability"`
+	TotalCombinations int64   `json:"totalCombinations,omitempty"`
+	ValidCombinations int64   `json:"validCombinations,omitempty"`
+}
+
+// filterTargets 过滤出词条池中的目标词条，去重并排序
+func filterTargets(pool []int, targetAffixIDs []int) []int {
+	inPool := make(map[int]bool, len(pool))
+	for _, id := range pool {
+		inPool[id] = true
+	}
+
+	targetSet := make(map[int]bool)
+	for _, id := range targetAffixIDs {
+		if inPool[id] {
+			targetSet[id] = true
+		}
+	}
+	return getSortedKeys(targetSet)
+}
+
+// poolCombinations 计算从词条池中抽取slotCount个词条的总组合数，以及全部落在目标范围内的组合数
+func poolCombinations(poolSize, rangeSize, slotCount int) (int64, int64) {
+	// 如果需要的词条数量大于范围大小，combination返回0
+	return combination(poolSize, slotCount), combination(rangeSize, slotCount)
 }
 
 // combination 计算组合数 C(n,r)
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// 1.0版本金色从10个词条中抽4个，紫色从10个中抽3个，蓝色从8个（没有5、6）中抽2个，掉落权重0.1、0.3、0.6
+func TestAffixProbabilityByRarity(t *testing.T) {
+	s := NewAffixProbabilityService()
+	ctx := context.Background()
+	targets := []int{1, 2, 3, 4, 5}
+
+	tiers := []struct {
+		rarity string
+		weight float64
+		slots  int
+		valid  int64
+		total  int64
+	}{
+		{"gold", 0.1, 4, 5, 210},    // C(5,4)/C(10,4)
+		{"purple", 0.3, 3, 10, 120}, // C(5,3)/C(10,3)
+		{"blue", 0.6, 2, 6, 28},     // 词条5不在词条池中，C(4,2)/C(8,2)
+	}
+	var weighted float64
+	for _, tier := range tiers {
+		result, err := s.CalculateProbability(ctx, "1.0", 0, tier.rarity, targets, false)
+		if err != nil {
+			t.Fatalf("%s: %v", tier.rarity, err)
+		}
+		want := float64(tier.valid) / float64(tier.total)
+		if result.SlotCount != tier.slots || result.ValidCombinations != tier.valid || result.TotalCombinations != tier.total ||
+			math.Abs(result.Probability-want) > 1e-12 {
+			t.Errorf("%s: %d slots, %d/%d, probability %v, want %d slots, %d/%d",
+				tier.rarity, result.SlotCount, result.ValidCombinations, result.TotalCombinations, result.Probability,
+				tier.slots, tier.valid, tier.total)
+		}
+		weighted += tier.weight * result.Probability
+	}
+
+	mixed, err := s.CalculateProbability(ctx, "1.0", 0, "any", targets, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(mixed.Probability-weighted) > 1e-12 {
+		t.Errorf("any: probability %v, want weighted sum %v", mixed.Probability, weighted)
+	}
+	if len(mixed.RarityBreakdown) != len(tiers) {
+		t.Fatalf("any: %d rarities in the breakdown, want %d", len(mixed.RarityBreakdown), len(tiers))
+	}
+	for i, b := range mixed.RarityBreakdown {
+		if b.Rarity != tiers[i].rarity || b.SlotCount != tiers[i].slots || b.Weight != tiers[i].weight {
+			t.Errorf("any breakdown %d = %+v, want %s with %d slots", i, b, tiers[i].rarity, tiers[i].slots)
+		}
+	}
+}
+
+func TestAffixProbabilityByRarityTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		rarity  string
+		targets []int
+		code    string
+		want    float64
+	}{
+		{"not in the blue pool", "blue", []int{5, 6}, ErrCodeNoValidTargets, 0},
+		{"in no pool", "any", []int{99}, ErrCodeNoValidTargets, 0},
+		// 目标词条在词条池中但少于词条数量时概率为0，不是错误
+		{"fewer targets than slots", "any", []int{5, 6}, "", 0},
+		{"only purple", "any", []int{4, 5, 6}, "", 0.3 * 1 / 120}, // C(3,3)/C(10,3)
+	}
+	s := NewAffixProbabilityService()
+	for _, tt := range tests {
+		result, err := s.CalculateProbability(context.Background(), "1.0", 0, tt.rarity, tt.targets, false)
+		if tt.code != "" {
+			var serviceErr *Error
+			if !errors.As(err, &serviceErr) || serviceErr.Code != tt.code {
+				t.Errorf("%s: err = %v, want %s", tt.name, err, tt.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if math.Abs(result.Probability-tt.want) > 1e-12 {
+			t.Errorf("%s: probability %v, want %v", tt.name, result.Probability, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// 匹配得分，越高越精确
+const (
+	matchScoreID          = 100
+	matchScoreExact       = 95
+	matchScorePrefix      = 85
+	matchScoreSubstring   = 75
+	matchScoreSubsequence = 60
+	matchScoreFuzzy       = 40
+)
+
+// 匹配来源
+const (
+	matchedByID       = "id"
+	matchedByName     = "name"
+	matchedByPinyin   = "pinyin"
+	matchedByInitials = "initials"
+	matchedByAlias    = "alias"
+)
+
+// AffixSearchService 词条搜索服务，支持中文名、拼音、拼音首字母和英文别名的模糊匹配
+type AffixSearchService struct{}
+
+// NewAffixSearchService 创建词条搜索服务
+func NewAffixSearchService() *AffixSearchService {
+	return &AffixSearchService{}
+}
+
+// AffixMatch 词条匹配结果
+type AffixMatch struct {
+	Affix     models.Affix `json:"affix"`
+	Score     int          `json:"score"`
+	MatchedBy string       `json:"matchedBy"`
+}
+
+// Search 搜索词条，query为空时返回分类下的全部词条，limit为0表示不限制数量
+func (s *AffixSearchService) Search(query, category string, limit int) []AffixMatch {
+	query = normalizeSearchText(query)
+
+	var matches []AffixMatch
+	for _, affix := range models.GetAllAffixes() {
+		if category != "" && affix.Category != category {
+			continue
+		}
+
+		if query == "" {
+			matches = append(matches, AffixMatch{Affix: affix})
+			continue
+		}
+
+		if score, matchedBy := matchAffix(affix, query); score > 0 {
+			matches = append(matches, AffixMatch{
+				Affix:     affix,
+				Score:     score,
+				MatchedBy: matchedBy,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Affix.ID < matches[j].Affix.ID
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Resolve 将用户输入解析为唯一词条，输入可以是ID、中文名、拼音、拼音首字母或英文别名
+// 返回的错误为*Error，可按调用方语言输出
+func (s *AffixSearchService) Resolve(input string) (*models.Affix, error) {
+	matches := s.Search(input, "", 0)
+	if len(matches) == 0 {
+		return nil, NewError(ErrCodeAffixNotFound, "affix", MsgAffixNotMatched, input)
+	}
+
+	// 最高分唯一时直接采用
+	if len(matches) == 1 || matches[0].Score > matches[1].Score {
+		return &matches[0].Affix, nil
+	}
+
+	var candidates []string
+	for _, match := range matches {
+		if match.Score < matches[0].Score {
+			break
+		}
+		candidates = append(candidates, fmt.Sprintf("%d=%s", match.Affix.ID, match.Affix.Name))
+	}
+	return nil, NewError(ErrCodeAffixAmbiguous, "affix", MsgAffixAmbiguous, input, strings.Join(candidates, ", ")).WithAllowed(candidates...)
+}
+
+// ResolveList 解析以逗号分隔的词条列表，返回去重后的词条ID，preset:<名称>展开为内置预设的词条
+func (s *AffixSearchService) ResolveList(input string) ([]int, error) {
+	return s.ResolveTargets(context.Background(), input, nil)
+}
+
+// ResolveTargets 解析以逗号分隔的词条列表，preset:<名称>展开为预设的词条，lookup查找保存的预设
+func (s *AffixSearchService) ResolveTargets(ctx context.Context, input string, lookup PresetLookup) ([]int, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == '，' || r == '、' || r == ';' || r == '；'
+	})
+
+	seen := make(map[int]bool)
+	var ids []int
+	add := func(id int) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if len(field) > len(models.PresetPrefix) && strings.EqualFold(field[:len(models.PresetPrefix)], models.PresetPrefix) {
+			targets, err := ResolvePreset(ctx, field[len(models.PresetPrefix):], lookup)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range targets {
+				add(id)
+			}
+			continue
+		}
+		affix, err := s.Resolve(field)
+		if err != nil {
+			return nil, err
+		}
+		add(affix.ID)
+	}
+
+	if len(ids) == 0 {
+		return nil, NewError(ErrCodeRequired, "affix", MsgAffixMissing)
+	}
+	return ids, nil
+}
+
+// matchAffix 计算词条与查询的最佳匹配得分
+func matchAffix(affix models.Affix, query string) (int, string) {
+	if id, err := strconv.Atoi(query); err == nil {
+		if id == affix.ID {
+			return matchScoreID, matchedByID
+		}
+		return 0, ""
+	}
+
+	best, matchedBy := matchText(normalizeSearchText(affix.Name), query), matchedByName
+
+	syllables := strings.Fields(strings.ToLower(affix.Pinyin))
+	if len(syllables) > 0 {
+		if score := matchText(strings.Join(syllables, ""), query); score > best {
+			best, matchedBy = score, matchedByPinyin
+		}
+
+		var initials strings.Builder
+		for _, syllable := range syllables {
+			initials.WriteByte(syllable[0])
+		}
+		if score := matchText(initials.String(), query); score > best {
+			best, matchedBy = score, matchedByInitials
+		}
+	}
+
+	for _, alias := range affix.Aliases {
+		if score := matchText(normalizeSearchText(alias), query); score > best {
+			best, matchedBy = score, matchedByAlias
+		}
+	}
+
+	return best, matchedBy
+}
+
+// matchText 计算单个候选文本与查询的匹配得分
+func matchText(text, query string) int {
+	switch {
+	case text == "" || query == "":
+		return 0
+	case text == query:
+		return matchScoreExact
+	case strings.HasPrefix(text, query):
+		return matchScorePrefix
+	case strings.Contains(text, query):
+		return matchScoreSubstring
+	}
+
+	// 较长的查询允许少量多余或错误字符
+	queryRunes := []rune(query)
+	tolerance := len(queryRunes) / 5
+	if skipped := subsequenceSkips(text, queryRunes); skipped == 0 {
+		return matchScoreSubsequence
+	} else if skipped <= tolerance {
+		return matchScoreFuzzy
+	}
+	return 0
+}
+
+// subsequenceSkips 计算查询作为候选文本子序列时需要跳过的最少查询字符数
+func subsequenceSkips(text string, query []rune) int {
+	// dp[j] 表示消耗查询前j个字符时最少跳过的字符数
+	dp := make([]int, len(query)+1)
+	for j := range dp {
+		dp[j] = j
+	}
+
+	for _, r := range text {
+		// 倒序更新保证每个文本字符只匹配一次
+		for j := len(query); j >= 1; j-- {
+			if query[j-1] == r && dp[j-1] < dp[j] {
+				dp[j] = dp[j-1]
+			}
+		}
+		for j := 1; j <= len(query); j++ {
+			if dp[j-1]+1 < dp[j] {
+				dp[j] = dp[j-1] + 1
+			}
+		}
+	}
+
+	return dp[len(query)]
+}
+
+// normalizeSearchText 统一大小写并去除空白和标点
+func normalizeSearchText(text string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
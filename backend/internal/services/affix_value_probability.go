@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// AffixValueProbabilityService 词条数值概率计算服务
+type AffixValueProbabilityService struct {
+	cache *cache.Cache
+}
+
+// NewAffixValueProbabilityService 创建词条数值概率计算服务
+func NewAffixValueProbabilityService() *AffixValueProbabilityService {
+	return &AffixValueProbabilityService{}
+}
+
+// WithCache 设置计算结果缓存，nil表示不缓存
+func (s *AffixValueProbabilityService) WithCache(c *cache.Cache) *AffixValueProbabilityService {
+	s.cache = c
+	return s
+}
+
+// CacheKey 获取计算结果的缓存键，游戏版本不存在时返回空字符串
+func (s *AffixValueProbabilityService) CacheKey(gameVersion string, slotCount, level int, requirements []AffixValueRequirement) string {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return ""
+	}
+	return affixValueProbabilityKey(catalog, slotCount, level, requirements)
+}
+
+// affixValueProbabilityKey 生成缓存键，数值要求保持原有顺序，结果明细按该顺序输出
+func affixValueProbabilityKey(catalog *models.Catalog, slotCount, level int, requirements []AffixValueRequirement) string {
+	return cache.Key("affix_value_probability", catalog.Version.ID, catalog.Revision(),
+		slotCount, level, requirements)
+}
+
+// AffixValueRequirement 词条数值要求
+type AffixValueRequirement struct {
+	AffixID  int     `json:"affixId"`
+	MinValue float64 `json:"minValue,omitempty"` // 数值下限，0表示不限制
+	MinTier  int     `json:"minTier,omitempty"`  // 档位下限，0表示不限制
+	TopTier  bool    `json:"topTier,omitempty"`  // 是否要求最高档位
+}
+
+// CalculateProbability 计算词条出现且数值满足要求的概率
+// gameVersion为空时使用最新游戏版本的数值；slotCount为0时只计算数值概率（假定词条已出现）
+func (s *AffixValueProbabilityService) CalculateProbability(ctx context.Context, gameVersion string, slotCount, level int, requirements []AffixValueRequirement) (*AffixValueProbabilityResult, error) {
+	start := time.Now()
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
+
+	key := affixValueProbabilityKey(catalog, slotCount, level, requirements)
+	cached := &AffixValueProbabilityResult{}
+	if s.cache.Get(ctx, key, cached) {
+		return cached, nil
+	}
+	totalAffixes := len(catalog.Affixes())
+	maxLevel := catalog.MaxAffixLevel()
+
+	// 参数验证
+	if slotCount < 0 || slotCount > totalAffixes {
+		return nil, NewRangeError(ErrCodeOutOfRange, "slotCount", 0, totalAffixes, MsgSlotCountRange)
+	}
+	if level < 1 || level > maxLevel {
+		return nil, NewRangeError(ErrCodeOutOfRange, "level", 1, maxLevel, MsgAffixLevelRange)
+	}
+	if len(requirements) == 0 {
+		return nil, NewError(ErrCodeRequired, "requirements", MsgValueRequirementMissing)
+	}
+
+	seen := make(map[int]bool)
+	details := make([]AffixValueDetail, 0, len(requirements))
+	valueProbability := 1.0
+
+	for _, req := range requirements {
+		if seen[req.AffixID] {
+			return nil, NewError(ErrCodeDuplicate, "requirements", MsgDuplicateRequirement)
+		}
+		seen[req.AffixID] = true
+
+		valueRange := catalog.AffixValueRange(req.AffixID, level)
+		if valueRange == nil {
+			return nil, NewError(ErrCodeNoValidTargets, "requirements", MsgNoValidTargets)
+		}
+
+		minTier := req.MinTier
+		if req.TopTier {
+			minTier = valueRange.TopTier()
+		}
+
+		probability := valueThresholdProbability(valueRange, req.MinValue, minTier)
+		valueProbability *= probability
+
+		details = append(details, AffixValueDetail{
+			AffixID:     req.AffixID,
+			MinValue:    req.MinValue,
+			MinTier:     minTier,
+			Probability: probability,
+			Range:       valueRange,
+		})
+	}
+
+	// 计算所有目标词条同时出现的概率
+	appearProbability := 1.0
+	if slotCount > 0 {
+		required := len(requirements)
+		if required > slotCount {
+			appearProbability = 0
+		} else {
+			appearProbability = float64(combination(totalAffixes-required, slotCount-required)) /
+				float64(combination(totalAffixes, slotCount))
+		}
+	}
+
+	probability := appearProbability * valueProbability
+
+	logCalculation(ctx, "affix_value_probability", catalog.Version.ID, start, probability,
+		slog.Int("slot_count", slotCount),
+		slog.Int("level", level),
+		slog.Int("requirements", len(requirements)))
+
+	result := &AffixValueProbabilityResult{
+		Probability:        probability,
+		ProbabilityPercent: probability * 100,
+		AppearProbability:  appearProbability,
+		ValueProbability:   valueProbability,
+		SlotCount:          slotCount,
+		Level:              level,
+		Details:            details,
+		GameVersion:        catalog.Version.ID,
+	}
+	s.cache.Set(ctx, key, result)
+	return result, nil
+}
+
+// AffixValueProbabilityResult 词条数值概率计算结果
+type AffixValueProbabilityResult struct {
+	Probability        float64            `json:"probability"`
+	ProbabilityPercent float64            `json:"probabilityPercent"`
+	AppearProbability  float64            `json:"appearProbability"`
+	ValueProbability   float64            `json:"valueProbability"`
+	SlotCount          int                `json:"slotCount"`
+	Level              int                `json:"level"`
+	Details            []AffixValueDetail `json:"details"`
+	GameVersion        string             `json:"gameVersion,omitempty"`
+}
+
+// AffixValueDetail 单个词条的数值概率
+type AffixValueDetail struct {
+	AffixID     int                     `json:"affixId"`
+	MinValue    float64                 `json:"minValue,omitempty"`
+	MinTier     int                     `json:"minTier,omitempty"`
+	Probability float64                 `json:"probability"`
+	Range       *models.AffixValueRange `json:"range"`
+}
+
+// valueThresholdProbability 计算数值不低于minValue且档位不低于minTier的概率
+// 档位按权重抽取，档位内数值均匀分布
+func valueThresholdProbability(valueRange *models.AffixValueRange, minValue float64, minTier int) float64 {
+	var totalWeight, hitWeight float64
+
+	for _, tier := range valueRange.Tiers {
+		totalWeight += tier.Weight
+		if tier.Tier < minTier {
+			continue
+		}
+
+		lower := math.Max(tier.Min, minValue)
+		if lower >= tier.Max {
+			continue
+		}
+
+		width := tier.Max - tier.Min
+		if width <= 0 {
+			hitWeight += tier.Weight
+			continue
+		}
+		hitWeight += tier.Weight * (tier.Max - lower) / width
+	}
+
+	if totalWeight == 0 {
+		return 0
+	}
+	return hitWeight / totalWeight
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// 1.0版本词条1在1级时为4-10，三档依次为4-6、6-8、8-10，权重0.5、0.35、0.15
+// 词条9在1级时为3-8；共10个词条，4个词条位时指定1个词条出现的概率为C(9,3)/C(10,4)=0.4，指定2个为C(8,2)/C(10,4)=2/15
+func TestAffixValueProbability(t *testing.T) {
+	tests := []struct {
+		name         string
+		slotCount    int
+		level        int
+		requirements []AffixValueRequirement
+		appear       float64
+		value        float64
+	}{
+		{"range minimum", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 4}}, 1, 1},
+		{"tier edge", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 6}}, 1, 0.5},
+		{"inside tier", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 7}}, 1, 0.35*0.5 + 0.15},
+		{"tier edge at level 2", 0, 2, []AffixValueRequirement{{AffixID: 1, MinValue: 7.5}}, 1, 0.5},
+		{"min tier", 0, 1, []AffixValueRequirement{{AffixID: 1, MinTier: 2}}, 1, 0.5},
+		{"top tier", 0, 1, []AffixValueRequirement{{AffixID: 1, TopTier: true}}, 1, 0.15},
+		{"min tier and value", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 9, MinTier: 2}}, 1, 0.075},
+		{"range maximum", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 10}}, 1, 0},
+		{"above range", 0, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 11}}, 1, 0},
+		{"no such tier", 0, 1, []AffixValueRequirement{{AffixID: 1, MinTier: 4}}, 1, 0},
+		{"single affix", 4, 1, []AffixValueRequirement{{AffixID: 1, MinValue: 7}}, 0.4, 0.325},
+		{"multiple affixes", 4, 1, []AffixValueRequirement{{AffixID: 1, TopTier: true}, {AffixID: 9, MinTier: 2}}, 2.0 / 15, 0.15 * 0.5},
+		{"more affixes than slots", 2, 1, []AffixValueRequirement{{AffixID: 1}, {AffixID: 2}, {AffixID: 3}}, 0, 1},
+	}
+	s := NewAffixValueProbabilityService()
+	for _, tt := range tests {
+		result, err := s.CalculateProbability(context.Background(), "1.0", tt.slotCount, tt.level, tt.requirements)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if math.Abs(result.AppearProbability-tt.appear) > 1e-9 || math.Abs(result.ValueProbability-tt.value) > 1e-9 {
+			t.Errorf("%s: appear %v, value %v, want %v, %v", tt.name, result.AppearProbability, result.ValueProbability, tt.appear, tt.value)
+		}
+		if want := tt.appear * tt.value; math.Abs(result.Probability-want) > 1e-9 {
+			t.Errorf("%s: probability %v, want %v", tt.name, result.Probability, want)
+		}
+		if len(result.Details) != len(tt.requirements) {
+			t.Errorf("%s: %d details, want %d", tt.name, len(result.Details), len(tt.requirements))
+		}
+	}
+}
+
+func TestAffixValueProbabilityErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		slotCount    int
+		level        int
+		requirements []AffixValueRequirement
+		code         string
+	}{
+		{"no requirements", 4, 1, nil, ErrCodeRequired},
+		{"duplicate affix", 4, 1, []AffixValueRequirement{{AffixID: 1}, {AffixID: 1}}, ErrCodeDuplicate},
+		{"unknown affix", 4, 1, []AffixValueRequirement{{AffixID: 99}}, ErrCodeNoValidTargets},
+		{"level too high", 4, 6, []AffixValueRequirement{{AffixID: 1}}, ErrCodeOutOfRange},
+		{"too many slots", 11, 1, []AffixValueRequirement{{AffixID: 1}}, ErrCodeOutOfRange},
+	}
+	s := NewAffixValueProbabilityService()
+	for _, tt := range tests {
+		_, err := s.CalculateProbability(context.Background(), "1.0", tt.slotCount, tt.level, tt.requirements)
+		var serviceErr *Error
+		if !errors.As(err, &serviceErr) || serviceErr.Code != tt.code {
+			t.Errorf("%s: err = %v, want %s", tt.name, err, tt.code)
+		}
+	}
+}
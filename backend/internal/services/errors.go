@@ -0,0 +1,127 @@
+package services
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// 错误码，客户端按错误码而不是消息文本处理错误
+const (
+	ErrCodeOutOfRange         = "out_of_range"
+	ErrCodeInvalidCount       = "invalid_count"
+	ErrCodeRequired           = "required"
+	ErrCodeDuplicate          = "duplicate"
+	ErrCodeNoValidTargets     = "no_valid_targets"
+	ErrCodeUnknownRarity      = "unknown_rarity"
+	ErrCodeUnknownGameVersion = "unknown_game_version"
+	ErrCodeUnknownCategory    = "unknown_category"
+	ErrCodeRarityRequired     = "rarity_required"
+	ErrCodeTargetBelowInitial = "target_below_initial"
+	ErrCodeAffixNotFound      = "affix_not_found"
+	ErrCodeAffixAmbiguous     = "affix_ambiguous"
+	ErrCodeRateLimited        = "rate_limited"
+	ErrCodeJobNotFound        = "job_not_found"
+	ErrCodeJobQueueFull       = "job_queue_full"
+	ErrCodeJobNotFinished     = "job_not_finished"
+	ErrCodeJobFailed          = "job_failed"
+	ErrCodeJobCanceled        = "job_canceled"
+	ErrCodeJobTimeout         = "job_timeout"
+	ErrCodeAPIKeyRequired     = "api_key_required"
+	ErrCodeInvalidAPIKey      = "invalid_api_key"
+	ErrCodeInsufficientScope  = "insufficient_scope"
+	ErrCodeQuotaExceeded      = "quota_exceeded"
+	ErrCodeShuttingDown       = "shutting_down"
+	ErrCodeShareNotFound      = "share_not_found"
+	ErrCodeStorageUnavailable = "storage_unavailable"
+	ErrCodeModNotFound        = "mod_not_found"
+	ErrCodeInventoryFull      = "inventory_full"
+	ErrCodeAffixNotAllowed    = "affix_not_allowed"
+	ErrCodePresetNotFound     = "preset_not_found"
+	ErrCodeInvalidPresetName  = "invalid_preset_name"
+	ErrCodeToolNotFound       = "tool_not_found"
+	ErrCodeInvalidInput       = "invalid_input"
+	ErrCodeToolFailed         = "tool_failed"
+	ErrCodeToolTimeout        = "tool_timeout"
+	ErrCodeScriptError        = "script_error"
+	ErrCodeScriptStepLimit    = "script_step_limit"
+	ErrCodeScriptTimeout      = "script_timeout"
+	ErrCodeScriptMemoryLimit  = "script_memory_limit"
+
+	ErrCodeInvalidValue         = "invalid_value"
+	ErrCodeNotFound             = "not_found"
+	ErrCodeMethodNotAllowed     = "method_not_allowed"
+	ErrCodeUnsupportedMediaType = "unsupported_media_type"
+	ErrCodeNotAcceptable        = "not_acceptable"
+	ErrCodeGuildNotAllowed      = "guild_not_allowed"
+)
+
+// Error 服务错误，包含错误码、出错字段、允许范围和可本地化的消息
+type Error struct {
+	Code    string        `json:"code"`
+	Field   string        `json:"field,omitempty"`
+	Min     *int          `json:"min,omitempty"`
+	Max     *int          `json:"max,omitempty"`
+	Allowed []string      `json:"allowed,omitempty"`
+	Message *i18n.Message `json:"-"`
+}
+
+// NewError 创建服务错误
+func NewError(code, field, key string, args ...interface{}) *Error {
+	return &Error{
+		Code:    code,
+		Field:   field,
+		Message: i18n.NewMessage(key, args...),
+	}
+}
+
+// NewRangeError 创建超出允许范围的错误，消息参数为允许的最大值
+func NewRangeError(code, field string, min, max int, key string) *Error {
+	err := NewError(code, field, key, max)
+	err.Min = &min
+	err.Max = &max
+	return err
+}
+
+// WithAllowed 设置允许的取值
+func (e *Error) WithAllowed(allowed ...string) *Error {
+	e.Allowed = allowed
+	return e
+}
+
+// Error 实现error接口，按默认语言输出
+func (e *Error) Error() string {
+	return e.Message.Error()
+}
+
+// Localize 按指定语言输出错误消息
+func (e *Error) Localize(locale string) string {
+	return e.Message.Localize(locale)
+}
+
+// ErrorCode 获取错误码
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// Unwrap 返回可本地化的消息
+func (e *Error) Unwrap() error {
+	return e.Message
+}
+
+// NewUnknownGameVersionError 创建未知游戏版本的错误，附带可用的版本
+func NewUnknownGameVersionError(gameVersion string) *Error {
+	allowed := []string{models.GameVersionLatest}
+	for _, version := range models.GetGameVersions() {
+		allowed = append(allowed, version.ID)
+	}
+	return NewError(ErrCodeUnknownGameVersion, "gameVersion", MsgUnknownGameVersion, gameVersion).WithAllowed(allowed...)
+}
+
+// newUnknownRarityError 创建未知稀有度的错误，附带目录中可用的稀有度
+func newUnknownRarityError(catalog *models.Catalog, rarity string) *Error {
+	allowed := []string{models.RarityAny}
+	for _, r := range catalog.Rarities() {
+		allowed = append(allowed, r.ID)
+	}
+	return NewError(ErrCodeUnknownRarity, "rarity", MsgUnknownRarity, rarity).WithAllowed(allowed...)
+}
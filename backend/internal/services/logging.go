@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/metrics"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// logCalculation 记录一次计算的耗时和结果，日志带上调用方上下文中的请求ID，耗时同时记入指标
+func logCalculation(ctx context.Context, calculator, gameVersion string, start time.Time, probability float64, attrs ...slog.Attr) {
+	duration := time.Since(start)
+	metrics.ObserveCalculation(calculator, duration)
+
+	attrs = append([]slog.Attr{
+		slog.String("calculator", calculator),
+		slog.String("game_version", gameVersion),
+		slog.Float64("probability", probability),
+		slog.Float64("duration_ms", float64(duration.Microseconds())/1000),
+	}, attrs...)
+	logging.FromContext(ctx).LogAttrs(ctx, slog.LevelInfo, "calculation", attrs...)
+}
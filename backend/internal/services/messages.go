@@ -0,0 +1,81 @@
+package services
+
+import "github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+
+// 服务错误消息的翻译key
+const (
+	MsgSlotCountRange          = "error.slot_count_range"
+	MsgNoValidTargets          = "error.no_valid_targets"
+	MsgUnknownRarity           = "error.unknown_rarity"
+	MsgAffixLevelRange         = "error.affix_level_range"
+	MsgValueRequirementMissing = "error.value_requirement_missing"
+	MsgDuplicateRequirement    = "error.duplicate_requirement"
+	MsgRarityRequired          = "error.rarity_required"
+	MsgLevelCount              = "error.level_count"
+	MsgInitialLevelRange       = "error.initial_level_range"
+	MsgTargetLevelRange        = "error.target_level_range"
+	MsgTargetBelowInitial      = "error.target_below_initial"
+	MsgTargetCountRange        = "error.target_count_range"
+	MsgAffixNotMatched         = "error.affix_not_matched"
+	MsgAffixAmbiguous          = "error.affix_ambiguous"
+	MsgAffixMissing            = "error.affix_missing"
+	MsgUnknownGameVersion      = "error.unknown_game_version"
+	MsgAffixNotAllowed         = "error.affix_not_allowed"
+	MsgDuplicateAffix          = "error.duplicate_affix"
+	MsgEnhancementsUsedRange   = "error.enhancements_used_range"
+	MsgPresetNotFound          = "error.preset_not_found"
+	MsgInvalidPresetName       = "error.invalid_preset_name"
+	MsgPresetTargetRange       = "error.preset_target_range"
+)
+
+func init() {
+	i18n.Register("zh-CN", map[string]string{
+		MsgSlotCountRange:          "词条数量必须在1-%d之间",
+		MsgNoValidTargets:          "目标范围中没有有效的词条编号",
+		MsgUnknownRarity:           "未知的稀有度: %s",
+		MsgAffixLevelRange:         "词条等级必须在1-%d之间",
+		MsgValueRequirementMissing: "至少需要一个数值要求",
+		MsgDuplicateRequirement:    "数值要求中存在重复的词条",
+		MsgRarityRequired:          "指定初始等级时必须选择具体的稀有度",
+		MsgLevelCount:              "必须提供%d个词条的等级",
+		MsgInitialLevelRange:       "初始等级必须在1-%d之间",
+		MsgTargetLevelRange:        "目标等级必须在1-%d之间",
+		MsgTargetBelowInitial:      "目标等级不能低于初始等级",
+		MsgTargetCountRange:        "必须提供1-%d个词条的目标等级",
+		MsgAffixNotMatched:         "未找到匹配的词条: %s",
+		MsgAffixAmbiguous:          "词条 %s 存在多个匹配: %s",
+		MsgAffixMissing:            "未提供词条",
+		MsgUnknownGameVersion:      "未知的游戏版本: %s",
+		MsgAffixNotAllowed:         "词条 %d 不会出现在%s稀有度的模组上",
+		MsgDuplicateAffix:          "模组中存在重复的词条",
+		MsgEnhancementsUsedRange:   "已强化次数必须在0-%d之间",
+		MsgPresetNotFound:          "预设 %s 不存在",
+		MsgInvalidPresetName:       "预设名称只能包含小写字母、数字和-，长度为1-32",
+		MsgPresetTargetRange:       "预设必须包含1-%d个词条",
+	})
+
+	i18n.Register("en", map[string]string{
+		MsgSlotCountRange:          "Slot count must be between 1 and %d",
+		MsgNoValidTargets:          "No valid affix IDs in the target range",
+		MsgUnknownRarity:           "Unknown rarity: %s",
+		MsgAffixLevelRange:         "Affix level must be between 1 and %d",
+		MsgValueRequirementMissing: "At least one value requirement is required",
+		MsgDuplicateRequirement:    "Duplicate affix in value requirements",
+		MsgRarityRequired:          "A specific rarity is required when initial levels are given",
+		MsgLevelCount:              "Levels for exactly %d affixes are required",
+		MsgInitialLevelRange:       "Initial level must be between 1 and %d",
+		MsgTargetLevelRange:        "Target level must be between 1 and %d",
+		MsgTargetBelowInitial:      "Target level cannot be lower than the initial level",
+		MsgTargetCountRange:        "Target levels for 1 to %d affixes are required",
+		MsgAffixNotMatched:         "No affix matches: %s",
+		MsgAffixAmbiguous:          "Affix %s matches several entries: %s",
+		MsgAffixMissing:            "No affix given",
+		MsgUnknownGameVersion:      "Unknown game version: %s",
+		MsgAffixNotAllowed:         "Affix %d cannot appear on %s mods",
+		MsgDuplicateAffix:          "Duplicate affix on the mod",
+		MsgEnhancementsUsedRange:   "Enhancements used must be between 0 and %d",
+		MsgPresetNotFound:          "Preset %s does not exist",
+		MsgInvalidPresetName:       "Preset names may only contain lowercase letters, digits and -, 1-32 characters",
+		MsgPresetTargetRange:       "A preset must contain 1 to %d affixes",
+	})
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// 建议分解的原因
+const (
+	ScrapUnreachable = "unreachable" // 用剩余强化次数无法达到目标
+	ScrapDominated   = "dominated"   // 同类型中有概率和期望提升都不低于它的模组
+)
+
+// InventoryMod 背包中的模组，Levels为词条的当前等级，与AffixIDs一一对应
+type InventoryMod struct {
+	ID               string
+	Type             string
+	Rarity           string
+	AffixIDs         []int
+	Levels           []int
+	EnhancementsUsed int
+}
+
+// InventoryTarget 背包分析的目标
+type InventoryTarget struct {
+	TargetLevels     []int // 目标等级，5/5/x/x为[5, 5]
+	AffixIDs         []int // 计入目标和期望提升的词条，为空表示全部词条
+	OrderIndependent bool  // 目标等级可以由任意计入的词条达到
+}
+
+// ModOutlook 模组用剩余强化次数强化的结果
+type ModOutlook struct {
+	ModID            string
+	EnhancementsLeft int
+	Probability      float64   // 达到目标等级的概率
+	ExpectedLevels   []float64 // 各词条强化后的期望等级
+	ExpectedGain     float64   // 计入目标的词条期望提升的等级之和
+}
+
+// ScrapSuggestion 建议分解的模组
+type ScrapSuggestion struct {
+	ModID       string
+	Reason      string
+	BetterModID string // Reason为dominated时更好的模组
+}
+
+// InventoryAnalysis 背包分析结果
+type InventoryAnalysis struct {
+	GameVersion string
+	Mods        []ModOutlook // 按达成概率从高到低，概率相同时期望提升高的在前
+	Scrap       []ScrapSuggestion
+}
+
+// ValidateInventoryMod 按游戏版本的稀有度规则校验模组的词条、等级和已强化次数
+func ValidateInventoryMod(gameVersion string, mod InventoryMod) error {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return NewUnknownGameVersionError(gameVersion)
+	}
+	r := catalog.RarityByID(mod.Rarity)
+	if r == nil {
+		return newUnknownRarityError(catalog, mod.Rarity)
+	}
+
+	if len(mod.AffixIDs) != r.SlotCount || len(mod.Levels) != r.SlotCount {
+		return NewRangeError(ErrCodeInvalidCount, "affixes", r.SlotCount, r.SlotCount, MsgLevelCount)
+	}
+	seen := make(map[int]bool, len(mod.AffixIDs))
+	for i, id := range mod.AffixIDs {
+		if !containsInt(r.AffixPool, id) {
+			return NewError(ErrCodeAffixNotAllowed, "affixes", MsgAffixNotAllowed, id, r.ID)
+		}
+		if seen[id] {
+			return NewError(ErrCodeDuplicate, "affixes", MsgDuplicateAffix)
+		}
+		seen[id] = true
+		if mod.Levels[i] < 1 || mod.Levels[i] > r.MaxLevel {
+			return NewRangeError(ErrCodeOutOfRange, "affixes", 1, r.MaxLevel, MsgAffixLevelRange)
+		}
+	}
+	if mod.EnhancementsUsed < 0 || mod.EnhancementsUsed > r.MaxEnhancements {
+		return NewRangeError(ErrCodeOutOfRange, "enhancementsUsed", 0, r.MaxEnhancements, MsgEnhancementsUsedRange)
+	}
+	return nil
+}
+
+// AnalyzeInventory 按强化规则计算每个模组用剩余强化次数达到目标的概率和期望提升，并给出建议分解的模组
+// 每次强化在未满级的词条中随机选择一个，与强化概率计算使用相同的结果枚举
+func (s *StrengthenProbabilityService) AnalyzeInventory(ctx context.Context, gameVersion string, mods []InventoryMod, target InventoryTarget) (*InventoryAnalysis, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
+
+	maxLevel, maxSlots := 0, 0
+	for _, r := range catalog.Rarities() {
+		if r.MaxLevel > maxLevel {
+			maxLevel = r.MaxLevel
+		}
+		if r.SlotCount > maxSlots {
+			maxSlots = r.SlotCount
+		}
+	}
+	if len(target.TargetLevels) == 0 || len(target.TargetLevels) > maxSlots {
+		return nil, NewRangeError(ErrCodeInvalidCount, "targetLevels", 1, maxSlots, MsgTargetCountRange)
+	}
+	for _, level := range target.TargetLevels {
+		if level < 1 || level > maxLevel {
+			return nil, NewRangeError(ErrCodeOutOfRange, "targetLevels", 1, maxLevel, MsgTargetLevelRange)
+		}
+	}
+
+	start := time.Now()
+	result := &InventoryAnalysis{GameVersion: catalog.Version.ID}
+	types := make(map[string]string, len(mods))
+	for _, mod := range mods {
+		r := catalog.RarityByID(mod.Rarity)
+		if r == nil {
+			return nil, newUnknownRarityError(catalog, mod.Rarity)
+		}
+		result.Mods = append(result.Mods, outlookMod(ctx, r, mod, target))
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		types[mod.ID] = mod.Type
+	}
+
+	sort.SliceStable(result.Mods, func(i, j int) bool {
+		a, b := result.Mods[i], result.Mods[j]
+		if a.Probability != b.Probability {
+			return a.Probability > b.Probability
+		}
+		return a.ExpectedGain > b.ExpectedGain
+	})
+	result.Scrap = scrapSuggestions(result.Mods, types)
+
+	best := 0.0
+	if len(result.Mods) > 0 {
+		best = result.Mods[0].Probability
+	}
+	logCalculation(ctx, "inventory_analysis", result.GameVersion, start, best,
+		slog.Int("mods", len(mods)),
+		slog.Any("target_levels", target.TargetLevels),
+		slog.Any("affix_ids", target.AffixIDs))
+	return result, nil
+}
+
+// outlookMod 枚举模组剩余强化次数的所有强化结果，统计达成目标的比例和各词条的期望等级
+func outlookMod(ctx context.Context, r *models.Rarity, mod InventoryMod, target InventoryTarget) ModOutlook {
+	counted := make([]bool, len(mod.AffixIDs))
+	for i, id := range mod.AffixIDs {
+		counted[i] = len(target.AffixIDs) == 0 || containsInt(target.AffixIDs, id)
+	}
+
+	outlook := ModOutlook{
+		ModID:            mod.ID,
+		EnhancementsLeft: r.MaxEnhancements - mod.EnhancementsUsed,
+		ExpectedLevels:   make([]float64, len(mod.Levels)),
+	}
+	if outlook.EnhancementsLeft < 0 {
+		outlook.EnhancementsLeft = 0
+	}
+
+	levelSums := make([]int64, len(mod.Levels))
+	var successes int64
+	calculator := newStrengthenCalculator(ctx, r, target.OrderIndependent, false)
+	calculator.maxEnhancements = outlook.EnhancementsLeft
+	calculator.onOutcome = func(levels []int) {
+		for i, level := range levels {
+			levelSums[i] += int64(level)
+		}
+		if reachesTarget(levels, counted, target) {
+			successes++
+		}
+	}
+	// 达成判断由onOutcome完成，以当前等级为目标只用于枚举
+	enumerated := calculator.calculate(mod.Levels, mod.Levels)
+	if enumerated.TotalOutcomes == 0 {
+		return outlook
+	}
+
+	total := float64(enumerated.TotalOutcomes)
+	outlook.Probability = float64(successes) / total
+	for i, sum := range levelSums {
+		outlook.ExpectedLevels[i] = float64(sum) / total
+		if counted[i] {
+			outlook.ExpectedGain += outlook.ExpectedLevels[i] - float64(mod.Levels[i])
+		}
+	}
+	return outlook
+}
+
+// reachesTarget 检查计入的词条是否达到目标等级，顺序无关时最高的词条对应最高的目标
+func reachesTarget(levels []int, counted []bool, target InventoryTarget) bool {
+	var current []int
+	for i, level := range levels {
+		if counted[i] {
+			current = append(current, level)
+		}
+	}
+	targets := copyIntSlice(target.TargetLevels)
+	if target.OrderIndependent {
+		sort.Sort(sort.Reverse(sort.IntSlice(current)))
+		sort.Sort(sort.Reverse(sort.IntSlice(targets)))
+	}
+
+	for i, level := range targets {
+		if i >= len(current) {
+			// 计入的词条不足时，多出的1级目标视为无要求
+			if level > 1 {
+				return false
+			}
+			continue
+		}
+		if current[i] < level {
+			return false
+		}
+	}
+	return true
+}
+
+// scrapSuggestions 无法达成目标的模组，以及同类型中概率和期望提升都不高于另一个模组（至少一项更低）的模组建议分解
+// mods已按概率和期望提升排序，更好的模组取排在最前的一个
+func scrapSuggestions(mods []ModOutlook, types map[string]string) []ScrapSuggestion {
+	var suggestions []ScrapSuggestion
+	for _, mod := range mods {
+		if mod.Probability == 0 {
+			suggestions = append(suggestions, ScrapSuggestion{ModID: mod.ModID, Reason: ScrapUnreachable})
+			continue
+		}
+		for _, other := range mods {
+			if other.ModID == mod.ModID || types[other.ModID] != types[mod.ModID] {
+				continue
+			}
+			if other.Probability >= mod.Probability && other.ExpectedGain >= mod.ExpectedGain &&
+				(other.Probability > mod.Probability || other.ExpectedGain > mod.ExpectedGain) {
+				suggestions = append(suggestions, ScrapSuggestion{ModID: mod.ModID, Reason: ScrapDominated, BetterModID: other.ModID})
+				break
+			}
+		}
+	}
+	return suggestions
+}
+
+// containsInt 检查切片是否包含值
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
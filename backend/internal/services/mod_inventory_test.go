@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestReachesTarget(t *testing.T) {
+	all := []bool{true, true, true, true}
+	tests := []struct {
+		name             string
+		levels           []int
+		counted          []bool
+		targets          []int
+		orderIndependent bool
+		want             bool
+	}{
+		{"exact", []int{3, 1, 1, 1}, all, []int{3, 1, 1, 1}, false, true},
+		{"below target", []int{2, 3, 1, 1}, all, []int{3}, false, false},
+		{"order independent", []int{2, 3, 1, 1}, all, []int{3}, true, true},
+		// 只计入两个词条时多出的1级目标视为已满足，更高的目标无法达成
+		{"surplus level 1 targets", []int{3, 2, 5, 5}, []bool{true, true, false, false}, []int{3, 2, 1, 1}, false, true},
+		{"surplus level 2 target", []int{3, 2, 5, 5}, []bool{true, true, false, false}, []int{3, 2, 2}, false, false},
+		{"uncounted affix", []int{5, 1, 1, 1}, []bool{false, true, true, true}, []int{5}, true, false},
+	}
+	for _, tt := range tests {
+		target := InventoryTarget{TargetLevels: tt.targets, OrderIndependent: tt.orderIndependent}
+		if got := reachesTarget(tt.levels, tt.counted, target); got != tt.want {
+			t.Errorf("%s: reachesTarget() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// 金色模组4个词条都为1级时剩余5次强化，任何词条都不会超过5级，4个词条的期望提升之和为5，每个词条为1.25
+func TestAnalyzeInventoryOutlook(t *testing.T) {
+	mods := []InventoryMod{
+		{ID: "fresh", Type: "helmet", Rarity: "gold", AffixIDs: []int{1, 2, 3, 4}, Levels: []int{1, 1, 1, 1}},
+		{ID: "done", Type: "helmet", Rarity: "gold", AffixIDs: []int{1, 2, 3, 4}, Levels: []int{5, 2, 1, 1}, EnhancementsUsed: 5},
+	}
+	tests := []struct {
+		name     string
+		affixIDs []int
+		targets  []int
+		gain     map[string]float64
+		prob     map[string]float64
+	}{
+		{"all affixes", nil, []int{1}, map[string]float64{"fresh": 5, "done": 0}, map[string]float64{"fresh": 1, "done": 1}},
+		{"two affixes", []int{1, 2}, []int{1}, map[string]float64{"fresh": 2.5, "done": 0}, map[string]float64{"fresh": 1, "done": 1}},
+		{"unreachable without enhancements", nil, []int{5, 3}, map[string]float64{"fresh": 5, "done": 0}, map[string]float64{"done": 0}},
+	}
+	s := NewStrengthenProbabilityService()
+	for _, tt := range tests {
+		target := InventoryTarget{TargetLevels: tt.targets, AffixIDs: tt.affixIDs, OrderIndependent: true}
+		result, err := s.AnalyzeInventory(context.Background(), "1.0", mods, target)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		for _, outlook := range result.Mods {
+			if math.Abs(outlook.ExpectedGain-tt.gain[outlook.ModID]) > 1e-9 {
+				t.Errorf("%s: %s expected gain %v, want %v", tt.name, outlook.ModID, outlook.ExpectedGain, tt.gain[outlook.ModID])
+			}
+			if want, ok := tt.prob[outlook.ModID]; ok && math.Abs(outlook.Probability-want) > 1e-9 {
+				t.Errorf("%s: %s probability %v, want %v", tt.name, outlook.ModID, outlook.Probability, want)
+			}
+			if outlook.ModID != "done" {
+				continue
+			}
+			// 没有剩余强化次数时只有当前等级一种结果
+			if outlook.EnhancementsLeft != 0 || !reflect.DeepEqual(outlook.ExpectedLevels, []float64{5, 2, 1, 1}) {
+				t.Errorf("%s: done = %+v, want no enhancements left and the current levels", tt.name, outlook)
+			}
+		}
+	}
+}
+
+func TestScrapSuggestions(t *testing.T) {
+	types := map[string]string{"a": "helmet", "b": "helmet", "c": "helmet", "d": "gloves", "e": "helmet"}
+	tests := []struct {
+		name string
+		mods []ModOutlook
+		want []ScrapSuggestion
+	}{
+		{"tie", []ModOutlook{{ModID: "a", Probability: 0.5, ExpectedGain: 2}, {ModID: "b", Probability: 0.5, ExpectedGain: 2}}, nil},
+		{"same probability, lower gain",
+			[]ModOutlook{{ModID: "a", Probability: 0.5, ExpectedGain: 2}, {ModID: "b", Probability: 0.5, ExpectedGain: 1}},
+			[]ScrapSuggestion{{ModID: "b", Reason: ScrapDominated, BetterModID: "a"}}},
+		{"trade-off", []ModOutlook{{ModID: "a", Probability: 0.6, ExpectedGain: 1}, {ModID: "b", Probability: 0.5, ExpectedGain: 2}}, nil},
+		{"other type", []ModOutlook{{ModID: "a", Probability: 0.6, ExpectedGain: 2}, {ModID: "d", Probability: 0.5, ExpectedGain: 1}}, nil},
+		{"first better mod",
+			[]ModOutlook{{ModID: "a", Probability: 0.6, ExpectedGain: 2}, {ModID: "b", Probability: 0.6, ExpectedGain: 2}, {ModID: "c", Probability: 0.5, ExpectedGain: 1}},
+			[]ScrapSuggestion{{ModID: "c", Reason: ScrapDominated, BetterModID: "a"}}},
+		{"unreachable", []ModOutlook{{ModID: "a", Probability: 0.6, ExpectedGain: 2}, {ModID: "e", Probability: 0, ExpectedGain: 3}},
+			[]ScrapSuggestion{{ModID: "e", Reason: ScrapUnreachable}}},
+	}
+	for _, tt := range tests {
+		if got := scrapSuggestions(tt.mods, types); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: scrapSuggestions() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// presetNamePattern 保存的预设名称，与内置预设ID的格式相同
+var presetNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// PresetLookup 按名称查找用户或服务器保存的预设，不存在时ok为false
+type PresetLookup func(ctx context.Context, name string) (targets []int, ok bool, err error)
+
+// ResolvePreset 解析预设引用，先查找保存的预设，再查找最新目录的内置预设，lookup为nil时只查找内置预设
+func ResolvePreset(ctx context.Context, name string, lookup PresetLookup) ([]int, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if lookup != nil {
+		targets, ok, err := lookup(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return targets, nil
+		}
+	}
+	if preset := models.LatestCatalog().PresetByID(name); preset != nil {
+		return append([]int(nil), preset.Targets...), nil
+	}
+	return nil, NewError(ErrCodePresetNotFound, "targets", MsgPresetNotFound, name)
+}
+
+// ValidatePreset 校验保存的预设名称和目标词条，返回去重后的词条ID
+func ValidatePreset(name string, targets []int) ([]int, error) {
+	if !presetNamePattern.MatchString(name) {
+		return nil, NewError(ErrCodeInvalidPresetName, "name", MsgInvalidPresetName)
+	}
+
+	catalog := models.LatestCatalog()
+	maxTargets := len(catalog.Affixes())
+	seen := make(map[int]bool, len(targets))
+	var ids []int
+	for _, id := range targets {
+		if catalog.AffixByID(id) == nil {
+			return nil, NewError(ErrCodeAffixNotFound, "targets", MsgAffixNotMatched, strconv.Itoa(id))
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > maxTargets {
+		return nil, NewRangeError(ErrCodeInvalidCount, "targets", 1, maxTargets, MsgPresetTargetRange)
+	}
+	return ids, nil
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// StrengthenOutcome 用完强化次数后的一种词条等级及其概率
+type StrengthenOutcome struct {
+	Levels      []int   `json:"levels"`
+	Probability float64 `json:"probability"`
+}
+
+// CalculateOutcomes 枚举模组从当前等级用完剩余强化次数后的等级分布，每条强化路径的概率相同；
+// rarity为空时按金色模组规则计算，结果按概率从高到低排列
+func (s *StrengthenProbabilityService) CalculateOutcomes(ctx context.Context, gameVersion, rarity string, levels []int, enhancementsUsed int) ([]StrengthenOutcome, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
+	if rarity == "" {
+		rarity = models.RarityGold
+	}
+	if rarity == models.RarityAny {
+		return nil, NewError(ErrCodeRarityRequired, "rarity", MsgRarityRequired)
+	}
+	r := catalog.RarityByID(rarity)
+	if r == nil {
+		return nil, newUnknownRarityError(catalog, rarity)
+	}
+
+	if len(levels) != r.SlotCount {
+		return nil, NewRangeError(ErrCodeInvalidCount, "levels", r.SlotCount, r.SlotCount, MsgLevelCount)
+	}
+	for _, level := range levels {
+		if level < 1 || level > r.MaxLevel {
+			return nil, NewRangeError(ErrCodeOutOfRange, "levels", 1, r.MaxLevel, MsgInitialLevelRange)
+		}
+	}
+	if enhancementsUsed < 0 || enhancementsUsed > r.MaxEnhancements {
+		return nil, NewRangeError(ErrCodeOutOfRange, "enhancementsUsed", 0, r.MaxEnhancements, MsgEnhancementsUsedRange)
+	}
+
+	counts := make(map[string]int64)
+	finals := make(map[string][]int)
+	calculator := newStrengthenCalculator(ctx, r, false, false)
+	calculator.maxEnhancements = r.MaxEnhancements - enhancementsUsed
+	calculator.onOutcome = func(levels []int) {
+		key := fmt.Sprint(levels)
+		if _, ok := finals[key]; !ok {
+			finals[key] = copyIntSlice(levels)
+		}
+		counts[key]++
+	}
+	// 只需要枚举结果，以当前等级为目标
+	enumerated := calculator.calculate(levels, levels)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]StrengthenOutcome, 0, len(finals))
+	for key, final := range finals {
+		outcomes = append(outcomes, StrengthenOutcome{
+			Levels:      final,
+			Probability: float64(counts[key]) / float64(enumerated.TotalOutcomes),
+		})
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].Probability != outcomes[j].Probability {
+			return outcomes[i].Probability > outcomes[j].Probability
+		}
+		return fmt.Sprint(outcomes[i].Levels) < fmt.Sprint(outcomes[j].Levels)
+	})
+	return outcomes, nil
+}
@@ -1,63 +1,315 @@
 package services
 
 import (
+	"context"
+	"log/slog"
+	"math"
 	"sort"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/metrics"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/progress"
 )
 
 // StrengthenProbabilityService 强化概率计算服务
-type StrengthenProbabilityService struct{}
+type StrengthenProbabilityService struct {
+	cache *cache.Cache
+}
 
 // NewStrengthenProbabilityService 创建强化概率计算服务
 func NewStrengthenProbabilityService() *StrengthenProbabilityService {
 	return &StrengthenProbabilityService{}
 }
 
+// WithCache 设置计算结果缓存，nil表示不缓存
+func (s *StrengthenProbabilityService) WithCache(c *cache.Cache) *StrengthenProbabilityService {
+	s.cache = c
+	return s
+}
+
+// CacheKey 获取计算结果的缓存键，游戏版本不存在时返回空字符串
+func (s *StrengthenProbabilityService) CacheKey(gameVersion string, initialLevels, targetLevels []int, rarity string, orderIndependent bool, showPaths bool) string {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return ""
+	}
+	return strengthenProbabilityKey(catalog, initialLevels, targetLevels, rarity, orderIndependent, showPaths)
+}
+
+// strengthenProbabilityKey 生成缓存键，等级按槽位对应，保持原有顺序
+func strengthenProbabilityKey(catalog *models.Catalog, initialLevels, targetLevels []int, rarity string, orderIndependent bool, showPaths bool) string {
+	return cache.Key("strengthen_probability", catalog.Version.ID, catalog.Revision(),
+		initialLevels, targetLevels, rarity, orderIndependent, showPaths)
+}
+
 // CalculateProbability 计算强化成功概率
-func (s *StrengthenProbabilityService) CalculateProbability(initialLevels, targetLevels []int, orderIndependent bool, showPaths bool) *StrengthenProbabilityResult {
+// gameVersion为空时使用最新游戏版本的强化规则
+// rarity为空时按金色模组规则计算；指定稀有度且未提供初始等级时，按该稀有度的掉落等级分布积分，any按掉落权重对所有稀有度积分
+func (s *StrengthenProbabilityService) CalculateProbability(ctx context.Context, gameVersion string, initialLevels, targetLevels []int, rarity string, orderIndependent bool, showPaths bool) (*StrengthenProbabilityResult, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
+
+	key := strengthenProbabilityKey(catalog, initialLevels, targetLevels, rarity, orderIndependent, showPaths)
+	cached := &StrengthenProbabilityResult{}
+	if s.cache.Get(ctx, key, cached) {
+		return cached, nil
+	}
+
+	start := time.Now()
+	result, err := s.calculate(ctx, catalog, initialLevels, targetLevels, rarity, orderIndependent, showPaths)
+	if err != nil {
+		return nil, err
+	}
+	result.GameVersion = catalog.Version.ID
+	s.cache.Set(ctx, key, result)
+
+	logCalculation(ctx, "strengthen_probability", result.GameVersion, start, result.Probability,
+		slog.String("rarity", rarity),
+		slog.Any("initial_levels", initialLevels),
+		slog.Any("target_levels", targetLevels),
+		slog.Bool("show_paths", showPaths))
+	metrics.ObserveCombinations("strengthen_probability", result.TotalOutcomes)
+	if showPaths {
+		metrics.ObservePaths("strengthen_probability", len(result.Paths))
+	}
+	return result, nil
+}
+
+// calculate 按指定版本的强化规则计算强化成功概率，ctx取消时返回ctx的错误
+func (s *StrengthenProbabilityService) calculate(ctx context.Context, catalog *models.Catalog, initialLevels, targetLevels []int, rarity string, orderIndependent bool, showPaths bool) (*StrengthenProbabilityResult, error) {
+	if len(initialLevels) == 0 && rarity != "" {
+		return s.calculateByRarity(ctx, catalog, targetLevels, rarity, orderIndependent)
+	}
+
+	if rarity == "" {
+		rarity = models.RarityGold
+	}
+	if rarity == models.RarityAny {
+		return nil, NewError(ErrCodeRarityRequired, "rarity", MsgRarityRequired)
+	}
+	r := catalog.RarityByID(rarity)
+	if r == nil {
+		return nil, newUnknownRarityError(catalog, rarity)
+	}
+
 	// 参数验证
-	if len(initialLevels) != 4 || len(targetLevels) != 4 {
-		return &StrengthenProbabilityResult{
-			Error: "必须提供4个词条的等级",
-		}
+	if len(initialLevels) != r.SlotCount {
+		return nil, NewRangeError(ErrCodeInvalidCount, "initialLevels", r.SlotCount, r.SlotCount, MsgLevelCount)
+	}
+	if len(targetLevels) != r.SlotCount {
+		return nil, NewRangeError(ErrCodeInvalidCount, "targetLevels", r.SlotCount, r.SlotCount, MsgLevelCount)
 	}
 
 	// 验证等级范围
-	for i := 0; i < 4; i++ {
-		if initialLevels[i] < 1 || initialLevels[i] > 5 {
-			return &StrengthenProbabilityResult{
-				Error: "初始等级必须在1-5之间",
-			}
+	for i := 0; i < r.SlotCount; i++ {
+		if initialLevels[i] < 1 || initialLevels[i] > r.MaxLevel {
+			return nil, NewRangeError(ErrCodeOutOfRange, "initialLevels", 1, r.MaxLevel, MsgInitialLevelRange)
 		}
-		if targetLevels[i] < 1 || targetLevels[i] > 5 {
-			return &StrengthenProbabilityResult{
-				Error: "目标等级必须在1-5之间",
-			}
+		if targetLevels[i] < 1 || targetLevels[i] > r.MaxLevel {
+			return nil, NewRangeError(ErrCodeOutOfRange, "targetLevels", 1, r.MaxLevel, MsgTargetLevelRange)
 		}
 		if targetLevels[i] < initialLevels[i] {
-			return &StrengthenProbabilityResult{
-				Error: "目标等级不能低于初始等级",
+			return nil, NewError(ErrCodeTargetBelowInitial, "targetLevels", MsgTargetBelowInitial)
+		}
+	}
+
+	calculator := newStrengthenCalculator(ctx, r, orderIndependent, showPaths)
+	if progress.Enabled(ctx) {
+		calculator.onProgress = func(done float64) {
+			progress.ReportEstimate(ctx, done, calculator.estimate())
+		}
+	}
+	result := calculator.calculate(initialLevels, targetLevels)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result.Rarity = r.ID
+	return result, nil
+}
+
+// calculateByRarity 未指定初始等级时，按稀有度的掉落等级分布计算强化成功概率
+func (s *StrengthenProbabilityService) calculateByRarity(ctx context.Context, catalog *models.Catalog, targetLevels []int, rarity string, orderIndependent bool) (*StrengthenProbabilityResult, error) {
+	rarities := catalog.ResolveRarities(rarity)
+	if len(rarities) == 0 {
+		return nil, newUnknownRarityError(catalog, rarity)
+	}
+
+	// 目标等级需在至少一个稀有度的等级范围内
+	maxLevel, maxSlots := 0, 0
+	var totalWeight float64
+	for _, r := range rarities {
+		if r.MaxLevel > maxLevel {
+			maxLevel = r.MaxLevel
+		}
+		if r.SlotCount > maxSlots {
+			maxSlots = r.SlotCount
+		}
+		totalWeight += r.DropWeight
+	}
+	if len(targetLevels) == 0 || len(targetLevels) > maxSlots {
+		return nil, NewRangeError(ErrCodeInvalidCount, "targetLevels", 1, maxSlots, MsgTargetCountRange)
+	}
+	for _, level := range targetLevels {
+		if level < 1 || level > maxLevel {
+			return nil, NewRangeError(ErrCodeOutOfRange, "targetLevels", 1, maxLevel, MsgTargetLevelRange)
+		}
+	}
+
+	result := &StrengthenProbabilityResult{
+		Rarity: rarity,
+	}
+
+	tracker := &rarityProgress{ctx: ctx}
+	for _, r := range rarities {
+		if _, ok := fitTargetLevels(targetLevels, r, orderIndependent); ok {
+			tracker.totalStates += math.Pow(float64(r.MaxStartLevel-r.MinStartLevel+1), float64(r.SlotCount))
+		}
+	}
+
+	for _, r := range rarities {
+		weight := r.DropWeight / totalWeight
+		breakdown := RarityProbability{
+			Rarity:    r.ID,
+			Name:      r.Name,
+			Weight:    weight,
+			SlotCount: r.SlotCount,
+		}
+
+		targets, ok := fitTargetLevels(targetLevels, r, orderIndependent)
+		if ok {
+			calculator := newStrengthenCalculator(ctx, &r, orderIndependent, false)
+			if progress.Enabled(ctx) {
+				calculator.onProgress = tracker.report
+			}
+			startLevels := r.MaxStartLevel - r.MinStartLevel + 1
+			stateWeight := math.Pow(float64(startLevels), -float64(r.SlotCount))
+
+			// 枚举所有掉落等级组合，每个词条的掉落等级均匀分布
+			forEachStartLevels(r, func(initialLevels []int) {
+				stateResult := calculator.calculate(initialLevels, targets)
+				breakdown.Probability += stateWeight * stateResult.Probability
+				breakdown.ValidCombinations += stateResult.SuccessfulOutcomes
+				breakdown.TotalCombinations += stateResult.TotalOutcomes
+				result.SuccessfulOutcomes += stateResult.SuccessfulOutcomes
+				result.TotalOutcomes += stateResult.TotalOutcomes
+				tracker.stateDone(weight*stateWeight, stateResult.Probability)
+			})
+			if err := ctx.Err(); err != nil {
+				return nil, err
 			}
+		} else {
+			tracker.skip(weight)
 		}
+
+		result.Probability += weight * breakdown.Probability
+		result.RarityBreakdown = append(result.RarityBreakdown, breakdown)
 	}
 
-	calculator := &strengthenCalculator{
-		maxLevel:        5,
-		maxEnhancements: 5,
-		orderIndependent: orderIndependent,
-		showPaths:       showPaths,
+	result.ProbabilityPercent = result.Probability * 100
+	return result, nil
+}
+
+// fitTargetLevels 将目标等级适配到稀有度的词条数量，不足的词条视为无要求
+// 目标词条多于词条数量或目标等级超过最高等级时无法达成
+func fitTargetLevels(targetLevels []int, r models.Rarity, orderIndependent bool) ([]int, bool) {
+	targets := copyIntSlice(targetLevels)
+	if orderIndependent {
+		sort.Sort(sort.Reverse(sort.IntSlice(targets)))
+	}
+
+	for len(targets) > r.SlotCount {
+		// 顺序无关模式下多出的最低目标若为1级则视为无要求
+		if !orderIndependent || targets[len(targets)-1] > 1 {
+			return nil, false
+		}
+		targets = targets[:len(targets)-1]
+	}
+	for len(targets) < r.SlotCount {
+		targets = append(targets, 1)
+	}
+
+	for _, level := range targets {
+		if level > r.MaxLevel {
+			return nil, false
+		}
 	}
+	return targets, true
+}
+
+// rarityProgress 按掉落等级组合统计的进度和概率估计
+type rarityProgress struct {
+	ctx         context.Context
+	totalStates float64
+	doneStates  float64
+	weight      float64 // 已完成部分的掉落概率
+	probability float64 // 已完成部分的成功概率
+}
+
+// add 记录已完成部分的掉落概率和其中的成功率
+func (p *rarityProgress) add(weight, probability float64) {
+	p.weight += weight
+	p.probability += weight * probability
+}
 
-	return calculator.calculate(initialLevels, targetLevels)
+// skip 记录无法达成目标的稀有度，其掉落概率计入估计
+func (p *rarityProgress) skip(weight float64) {
+	p.add(weight, 0)
+	p.report(0)
+}
+
+// stateDone 记录一个掉落等级组合计算完成
+func (p *rarityProgress) stateDone(weight, probability float64) {
+	p.add(weight, probability)
+	p.doneStates++
+	p.report(0)
+}
+
+// report 报告进度，partial为当前组合的完成比例，估计值为已完成部分的成功率
+func (p *rarityProgress) report(partial float64) {
+	if p.totalStates == 0 {
+		return
+	}
+	done := (p.doneStates + partial) / p.totalStates
+	if p.weight == 0 {
+		progress.Report(p.ctx, done)
+		return
+	}
+	progress.ReportEstimate(p.ctx, done, p.probability/p.weight)
+}
+
+// forEachStartLevels 枚举稀有度所有可能的掉落等级组合
+func forEachStartLevels(r models.Rarity, fn func(levels []int)) {
+	levels := make([]int, r.SlotCount)
+
+	var generate func(depth int)
+	generate = func(depth int) {
+		if depth == r.SlotCount {
+			fn(copyIntSlice(levels))
+			return
+		}
+		for level := r.MinStartLevel; level <= r.MaxStartLevel; level++ {
+			levels[depth] = level
+			generate(depth + 1)
+		}
+	}
+
+	generate(0)
 }
 
 // StrengthenProbabilityResult 强化概率计算结果
 type StrengthenProbabilityResult struct {
-	Probability         float64            `json:"probability"`
-	ProbabilityPercent  float64            `json:"probabilityPercent"`
-	SuccessfulOutcomes  int64              `json:"successfulOutcomes"`
-	TotalOutcomes       int64              `json:"totalOutcomes"`
-	Paths               []StrengthenPath   `json:"paths,omitempty"`
-	Error               string             `json:"error,omitempty"`
+	Probability        float64             `json:"probability"`
+	ProbabilityPercent float64             `json:"probabilityPercent"`
+	SuccessfulOutcomes int64               `json:"successfulOutcomes"`
+	TotalOutcomes      int64               `json:"totalOutcomes"`
+	Paths              []StrengthenPath    `json:"paths,omitempty"`
+	Rarity             string              `json:"rarity,omitempty"`
+	RarityBreakdown    []RarityProbability `json:"rarityBreakdown,omitempty"`
+	GameVersion        string              `json:"gameVersion,omitempty"`
 }
 
 // StrengthenPath 强化路径
@@ -76,6 +328,11 @@ type StrengthenStep struct {
 
 // strengthenCalculator 强化计算器
 type strengthenCalculator struct {
+	ctx                context.Context
+	onProgress         func(done float64) // 报告本次计算的完成比例，nil表示不报告
+	onOutcome          func(levels []int) // 接收每个强化结果的最终等级，nil表示不回调
+	progressLo         float64            // 当前分支在本次计算中的起始进度
+	progressSpan       float64            // 当前分支占本次计算的比例
 	maxLevel           int
 	maxEnhancements    int
 	orderIndependent   bool
@@ -85,10 +342,22 @@ type strengthenCalculator struct {
 	paths              []StrengthenPath
 }
 
+// newStrengthenCalculator 按稀有度规则创建强化计算器，ctx取消后停止枚举
+func newStrengthenCalculator(ctx context.Context, r *models.Rarity, orderIndependent, showPaths bool) *strengthenCalculator {
+	return &strengthenCalculator{
+		ctx:              ctx,
+		maxLevel:         r.MaxLevel,
+		maxEnhancements:  r.MaxEnhancements,
+		orderIndependent: orderIndependent,
+		showPaths:        showPaths,
+	}
+}
+
 func (c *strengthenCalculator) calculate(initialLevels, targetLevels []int) *StrengthenProbabilityResult {
 	c.totalOutcomes = 0
 	c.successfulOutcomes = 0
 	c.paths = nil
+	c.progressLo, c.progressSpan = 0, 1
 
 	// 递归计算所有可能的强化路径
 	c.calculateRecursive(copyIntSlice(initialLevels), targetLevels, 0, nil)
@@ -108,9 +377,16 @@ func (c *strengthenCalculator) calculate(initialLevels, targetLevels []int) *Str
 }
 
 func (c *strengthenCalculator) calculateRecursive(currentLevels, targetLevels []int, enhancementCount int, path []StrengthenStep) {
+	if c.ctx.Err() != nil {
+		return
+	}
+
 	// 如果强化次数用完
 	if enhancementCount >= c.maxEnhancements {
 		c.totalOutcomes++
+		if c.onOutcome != nil {
+			c.onOutcome(currentLevels)
+		}
 		
 		isSuccess := c.checkSuccess(currentLevels, targetLevels)
 		if isSuccess {
@@ -134,6 +410,9 @@ func (c *strengthenCalculator) calculateRecursive(currentLevels, targetLevels []
 	if len(availableSlots) == 0 {
 		// 直接结束，不再继续强化
 		c.totalOutcomes++
+		if c.onOutcome != nil {
+			c.onOutcome(currentLevels)
+		}
 		isSuccess := c.checkSuccess(currentLevels, targetLevels)
 		if isSuccess {
 			c.successfulOutcomes++
@@ -141,8 +420,10 @@ func (c *strengthenCalculator) calculateRecursive(currentLevels, targetLevels []
 		return
 	}
 
-	// 对每个可能的强化选择进行递归
-	for _, slot := range availableSlots {
+	// 对每个可能的强化选择进行递归，前几层按分支数划分进度
+	trackProgress := c.onProgress != nil && enhancementCount < progressDepth
+	lo, span := c.progressLo, c.progressSpan/float64(len(availableSlots))
+	for i, slot := range availableSlots {
 		newLevels := copyIntSlice(currentLevels)
 		newLevels[slot]++
 		
@@ -152,8 +433,25 @@ func (c *strengthenCalculator) calculateRecursive(currentLevels, targetLevels []
 			NewLevel: newLevels[slot],
 		})
 		
+		if trackProgress {
+			c.progressLo, c.progressSpan = lo+span*float64(i), span
+		}
 		c.calculateRecursive(newLevels, targetLevels, enhancementCount+1, newPath)
+		if trackProgress {
+			c.onProgress(lo + span*float64(i+1))
+		}
+	}
+}
+
+// progressDepth 报告进度的递归层数，更深的分支不再细分进度
+const progressDepth = 3
+
+// estimate 根据已枚举的路径估计成功概率
+func (c *strengthenCalculator) estimate() float64 {
+	if c.totalOutcomes == 0 {
+		return 0
 	}
+	return float64(c.successfulOutcomes) / float64(c.totalOutcomes)
 }
 
 func (c *strengthenCalculator) getAvailableSlots(levels []int) []int {
@@ -174,7 +472,7 @@ func (c *strengthenCalculator) checkSuccess(currentLevels, targetLevels []int) b
 		sort.Sort(sort.Reverse(sort.IntSlice(sortedCurrent)))
 		sort.Sort(sort.Reverse(sort.IntSlice(sortedTarget)))
 		
-		for i := 0; i < 4; i++ {
+		for i := range sortedCurrent {
 			if sortedCurrent[i] < sortedTarget[i] {
 				return false
 			}
@@ -182,7 +480,7 @@ func (c *strengthenCalculator) checkSuccess(currentLevels, targetLevels []int) b
 		return true
 	} else {
 		// 位置对应：按位置严格比较
-		for i := 0; i < 4; i++ {
+		for i := range currentLevels {
 			if currentLevels[i] < targetLevels[i] {
 				return false
 			}
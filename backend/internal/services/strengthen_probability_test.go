@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// TestStrengthenRarityBreakdownCounts 按稀有度计算时每个稀有度统计自己的强化结果数
+// 蓝色2个词条、起始1级、最高3级、强化3次，共6种结果，第一个词条到3级的有3种
+func TestStrengthenRarityBreakdownCounts(t *testing.T) {
+	s := NewStrengthenProbabilityService()
+	result, err := s.CalculateProbability(context.Background(), "", nil, []int{3}, "any", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var valid, total int64
+	for _, b := range result.RarityBreakdown {
+		if b.TotalCombinations == 0 || b.ValidCombinations > b.TotalCombinations {
+			t.Errorf("%s: %d/%d outcomes", b.Rarity, b.ValidCombinations, b.TotalCombinations)
+		}
+		if b.Rarity == "blue" && (b.ValidCombinations != 3 || b.TotalCombinations != 6) {
+			t.Errorf("blue: %d/%d outcomes, want 3/6", b.ValidCombinations, b.TotalCombinations)
+		}
+		valid += b.ValidCombinations
+		total += b.TotalCombinations
+	}
+	if valid != result.SuccessfulOutcomes || total != result.TotalOutcomes {
+		t.Errorf("breakdown sums %d/%d, want %d/%d", valid, total, result.SuccessfulOutcomes, result.TotalOutcomes)
+	}
+}
+
+// TestStrengthenProbabilityByRarity any按掉落权重对各稀有度的结果加权求和
+// 稀有度的最高等级低于目标或词条数量少于目标时该稀有度的概率为0
+func TestStrengthenProbabilityByRarity(t *testing.T) {
+	tests := []struct {
+		name             string
+		targets          []int
+		orderIndependent bool
+		zero             []string // 概率为0的稀有度
+		blue             float64  // 蓝色的概率
+	}{
+		// 蓝色强化3次，6种结果中第一个词条到3级的有3种
+		{"blue max level", []int{3}, false, nil, 0.5},
+		{"above blue max level", []int{4}, false, []string{"blue"}, 0},
+		{"gold only", []int{5}, false, []string{"purple", "blue"}, 0},
+		// 蓝色只有2个词条位，顺序无关时多出的1级目标视为无要求；一个词条到3级后只能强化另一个，两个词条总能到2级
+		{"more targets than blue slots", []int{2, 2, 1}, false, []string{"blue"}, 0},
+		{"surplus level 1 target", []int{2, 2, 1}, true, nil, 1},
+		{"more targets than purple slots", []int{2, 2, 2, 2}, false, []string{"purple", "blue"}, 0},
+	}
+	s := NewStrengthenProbabilityService()
+	ctx := context.Background()
+	for _, tt := range tests {
+		mixed, err := s.CalculateProbability(ctx, "1.0", nil, tt.targets, "any", tt.orderIndependent, false)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		// 单独计算时目标等级需与稀有度的词条数量一致，按fitTargetLevels补齐或去掉多出的1级目标
+		var weighted float64
+		for _, b := range mixed.RarityBreakdown {
+			zero := false
+			for _, id := range tt.zero {
+				zero = zero || id == b.Rarity
+			}
+			if zero != (b.Probability == 0) {
+				t.Errorf("%s: %s probability %v", tt.name, b.Rarity, b.Probability)
+			}
+
+			targets, ok := fitTargetLevels(tt.targets, *models.GetCatalog("1.0").RarityByID(b.Rarity), tt.orderIndependent)
+			if ok == zero {
+				t.Errorf("%s: %s fits targets %v: %v", tt.name, b.Rarity, tt.targets, ok)
+			}
+			if !ok {
+				continue
+			}
+			tier, err := s.CalculateProbability(ctx, "1.0", nil, targets, b.Rarity, tt.orderIndependent, false)
+			if err != nil {
+				t.Errorf("%s: %s: %v", tt.name, b.Rarity, err)
+				continue
+			}
+			if math.Abs(tier.Probability-b.Probability) > 1e-12 {
+				t.Errorf("%s: %s probability %v, breakdown %v", tt.name, b.Rarity, tier.Probability, b.Probability)
+			}
+			weighted += b.Weight * tier.Probability
+
+			if b.Rarity == "blue" && math.Abs(b.Probability-tt.blue) > 1e-12 {
+				t.Errorf("%s: blue probability %v, want %v", tt.name, b.Probability, tt.blue)
+			}
+		}
+		if math.Abs(mixed.Probability-weighted) > 1e-12 {
+			t.Errorf("%s: probability %v, want weighted sum %v", tt.name, mixed.Probability, weighted)
+		}
+	}
+}
+
+func TestStrengthenProbabilityByRarityLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		rarity  string
+		targets []int
+		code    string
+	}{
+		{"above gold max level", "any", []int{6}, ErrCodeOutOfRange},
+		{"above blue max level", "blue", []int{4}, ErrCodeOutOfRange},
+		{"more targets than gold slots", "any", []int{1, 1, 1, 1, 1}, ErrCodeInvalidCount},
+		{"more targets than blue slots", "blue", []int{2, 2, 2}, ErrCodeInvalidCount},
+	}
+	s := NewStrengthenProbabilityService()
+	for _, tt := range tests {
+		_, err := s.CalculateProbability(context.Background(), "1.0", nil, tt.targets, tt.rarity, false, false)
+		var serviceErr *Error
+		if !errors.As(err, &serviceErr) || serviceErr.Code != tt.code {
+			t.Errorf("%s: err = %v, want %s", tt.name, err, tt.code)
+		}
+	}
+}
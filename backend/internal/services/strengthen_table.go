@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// StrengthenTable 单个词条从初始等级强化到目标等级的概率表，其余词条为掉落最低等级
+type StrengthenTable struct {
+	GameVersion   string
+	Rarity        string
+	Levels        []int       // 表头等级，从1到稀有度的最高等级
+	Probabilities [][]float64 // Probabilities[i][j]为初始等级Levels[i]强化到目标等级Levels[j]的概率
+}
+
+// CalculateTable 计算稀有度下全部初始等级×目标等级的强化概率表，rarity为空时按金色模组规则计算
+func (s *StrengthenProbabilityService) CalculateTable(ctx context.Context, gameVersion, rarity string) (*StrengthenTable, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, NewUnknownGameVersionError(gameVersion)
+	}
+	if rarity == "" {
+		rarity = models.RarityGold
+	}
+	if rarity == models.RarityAny {
+		return nil, NewError(ErrCodeRarityRequired, "rarity", MsgRarityRequired)
+	}
+	r := catalog.RarityByID(rarity)
+	if r == nil {
+		return nil, newUnknownRarityError(catalog, rarity)
+	}
+
+	key := cache.Key("strengthen_table", catalog.Version.ID, catalog.Revision(), r.ID)
+	cached := &StrengthenTable{}
+	if s.cache.Get(ctx, key, cached) {
+		return cached, nil
+	}
+
+	start := time.Now()
+	table := &StrengthenTable{
+		GameVersion:   catalog.Version.ID,
+		Rarity:        r.ID,
+		Levels:        make([]int, r.MaxLevel),
+		Probabilities: make([][]float64, r.MaxLevel),
+	}
+	for i := range table.Levels {
+		table.Levels[i] = i + 1
+	}
+
+	calculator := newStrengthenCalculator(ctx, r, false, false)
+	initialLevels := make([]int, r.SlotCount)
+	for i := range initialLevels {
+		initialLevels[i] = r.MinStartLevel
+	}
+	targetLevels := copyIntSlice(initialLevels)
+	for i, initial := range table.Levels {
+		row := make([]float64, len(table.Levels))
+		for j, target := range table.Levels {
+			// 目标不高于初始等级时无需强化
+			if target <= initial {
+				row[j] = 1
+				continue
+			}
+			initialLevels[0], targetLevels[0] = initial, target
+			row[j] = calculator.calculate(initialLevels, targetLevels).Probability
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		table.Probabilities[i] = row
+	}
+	s.cache.Set(ctx, key, table)
+
+	logCalculation(ctx, "strengthen_table", table.GameVersion, start, table.Probabilities[0][len(table.Levels)-1],
+		slog.String("rarity", r.ID))
+	return table, nil
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Build 保存的配装
+type Build struct {
+	ID        string
+	Owner     string // 所有者，如Discord用户ID或API key ID
+	Name      string
+	Tool      string          // 所属工具，如strengthen
+	Data      json.RawMessage // 工具相关的输入
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BuildRepository 配装仓库
+type BuildRepository interface {
+	// Get 获取配装，不存在时返回ErrNotFound
+	Get(ctx context.Context, id string) (Build, error)
+	// List 获取所有者的配装，最近更新的在前
+	List(ctx context.Context, owner string) ([]Build, error)
+	// Save 创建或更新配装，ID为空时生成新ID，返回保存后的配装
+	Save(ctx context.Context, build Build) (Build, error)
+	// Delete 删除配装，不存在时返回ErrNotFound
+	Delete(ctx context.Context, id string) error
+}
+
+type buildRepository struct {
+	db *DB
+}
+
+const buildColumns = "id, owner, name, tool, data, created_at, updated_at"
+
+func (r buildRepository) Get(ctx context.Context, id string) (Build, error) {
+	row := r.db.queryRow(ctx, "SELECT "+buildColumns+" FROM builds WHERE id = ?", id)
+	build, err := scanBuild(row)
+	return build, notFound(err)
+}
+
+func (r buildRepository) List(ctx context.Context, owner string) ([]Build, error) {
+	rows, err := r.db.query(ctx, "SELECT "+buildColumns+" FROM builds WHERE owner = ? ORDER BY updated_at DESC", owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var builds []Build
+	for rows.Next() {
+		build, err := scanBuild(rows)
+		if err != nil {
+			return nil, err
+		}
+		builds = append(builds, build)
+	}
+	return builds, rows.Err()
+}
+
+func (r buildRepository) Save(ctx context.Context, build Build) (Build, error) {
+	now := time.Now().UTC()
+	if build.ID == "" {
+		build.ID = NewID()
+	}
+	if build.CreatedAt.IsZero() {
+		build.CreatedAt = now
+	}
+	build.UpdatedAt = now
+
+	_, err := r.db.exec(ctx, `INSERT INTO builds (`+buildColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET owner = excluded.owner, name = excluded.name, tool = excluded.tool,
+	data = excluded.data, updated_at = excluded.updated_at`,
+		build.ID, build.Owner, build.Name, build.Tool, string(build.Data), build.CreatedAt.UTC(), build.UpdatedAt)
+	return build, err
+}
+
+func (r buildRepository) Delete(ctx context.Context, id string) error {
+	return affected(r.db.exec(ctx, "DELETE FROM builds WHERE id = ?", id))
+}
+
+func scanBuild(s scanner) (Build, error) {
+	var build Build
+	var data []byte
+	err := s.Scan(&build.ID, &build.Owner, &build.Name, &build.Tool, &data, &build.CreatedAt, &build.UpdatedAt)
+	build.Data = data
+	return build, err
+}
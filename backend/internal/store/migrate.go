@@ -0,0 +1,270 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFiles 各数据库的迁移脚本，文件名为<版本>_<名称>.up.sql和<版本>_<名称>.down.sql
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// Migration 一个schema版本
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+// MigrationStatus 迁移的应用状态，未应用时AppliedAt为nil
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Migrations 返回当前数据库的所有迁移，按版本排序
+func (d *DB) Migrations() ([]Migration, error) {
+	dir := path.Join("migrations", d.dialect.name)
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		name := entry.Name()
+		base, direction, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("无效的迁移文件名: %s", name)
+		}
+		prefix, label, _ := strings.Cut(base, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("无效的迁移版本: %s", name)
+		}
+
+		data, err := fs.ReadFile(migrationFiles, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: label}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("迁移%d缺少up脚本", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Status 返回所有迁移的应用状态
+func (d *DB) Status(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := d.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := d.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		status[i] = MigrationStatus{Version: m.Version, Name: m.Name}
+		if at, ok := applied[m.Version]; ok {
+			at := at
+			status[i].AppliedAt = &at
+		}
+	}
+	return status, nil
+}
+
+// Pending 返回未应用的迁移
+func (d *DB) Pending(ctx context.Context) ([]Migration, error) {
+	migrations, err := d.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := d.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate 按版本顺序应用未应用的迁移，直到target版本，target为0时应用全部，返回本次应用的迁移
+func (d *DB) Migrate(ctx context.Context, target int) ([]Migration, error) {
+	pending, err := d.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, m := range pending {
+		if target > 0 && m.Version > target {
+			break
+		}
+		ok, err := d.apply(ctx, m, true)
+		if err != nil {
+			return done, fmt.Errorf("应用迁移%d_%s失败: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			done = append(done, m)
+		}
+	}
+	return done, nil
+}
+
+// Rollback 按版本倒序回滚高于target版本的迁移，返回本次回滚的迁移
+func (d *DB) Rollback(ctx context.Context, target int) ([]Migration, error) {
+	status, err := d.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := d.Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version <= target || status[i].AppliedAt == nil {
+			continue
+		}
+		if m.down == "" {
+			return done, fmt.Errorf("迁移%d_%s不能回滚", m.Version, m.Name)
+		}
+		ok, err := d.apply(ctx, m, false)
+		if err != nil {
+			return done, fmt.Errorf("回滚迁移%d_%s失败: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			done = append(done, m)
+		}
+	}
+	return done, nil
+}
+
+// Version 返回已应用的最高版本，没有应用任何迁移时为0
+func (d *DB) Version(ctx context.Context) (int, error) {
+	applied, err := d.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	version := 0
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+// applied 返回已应用的版本及应用时间
+func (d *DB) applied(ctx context.Context) (map[int]time.Time, error) {
+	if err := d.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := d.query(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		applied[version] = at
+	}
+	return applied, rows.Err()
+}
+
+// ensureMigrationsTable 创建记录迁移版本的表
+func (d *DB) ensureMigrationsTable(ctx context.Context) error {
+	timestamp := "TIMESTAMP"
+	if d.dialect.name == DriverPostgres {
+		timestamp = "TIMESTAMPTZ"
+	}
+	_, err := d.exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at `+timestamp+` NOT NULL
+)`)
+	return err
+}
+
+// apply 在一个事务中执行迁移并更新版本记录
+// 加锁后重新检查版本，其他实例已完成同一迁移时跳过并返回false
+func (d *DB) apply(ctx context.Context, m Migration, up bool) (bool, error) {
+	done := false
+	err := d.withTx(ctx, func(tx *sql.Tx) error {
+		if d.dialect.lock != "" {
+			if _, err := tx.ExecContext(ctx, d.dialect.lock); err != nil {
+				return err
+			}
+		}
+
+		var count int
+		if err := tx.QueryRowContext(ctx, d.rebind("SELECT COUNT(*) FROM schema_migrations WHERE version = ?"), m.Version).Scan(&count); err != nil {
+			return err
+		}
+		if (count > 0) == up {
+			return nil
+		}
+
+		script := m.down
+		if up {
+			script = m.up
+		}
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return err
+		}
+
+		var err error
+		if up {
+			_, err = tx.ExecContext(ctx, d.rebind("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)"),
+				m.Version, m.Name, time.Now().UTC())
+		} else {
+			_, err = tx.ExecContext(ctx, d.rebind("DELETE FROM schema_migrations WHERE version = ?"), m.Version)
+		}
+		done = err == nil
+		return err
+	})
+	return done, err
+}
@@ -0,0 +1,5 @@
+DROP TABLE usage_stats;
+DROP TABLE shares;
+DROP TABLE presets;
+DROP TABLE sessions;
+DROP TABLE builds;
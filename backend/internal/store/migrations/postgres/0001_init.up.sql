@@ -0,0 +1,50 @@
+-- 保存的配装，data为工具相关的JSON
+CREATE TABLE builds (
+	id TEXT PRIMARY KEY,
+	owner TEXT NOT NULL,
+	name TEXT NOT NULL,
+	tool TEXT NOT NULL,
+	data JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX builds_owner_idx ON builds (owner, updated_at);
+
+-- 会话，过期后不再返回，由清理任务删除
+CREATE TABLE sessions (
+	id TEXT PRIMARY KEY,
+	subject TEXT NOT NULL,
+	data JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX sessions_expires_at_idx ON sessions (expires_at);
+
+-- 用户和服务器的目标词条预设
+CREATE TABLE presets (
+	scope TEXT NOT NULL,
+	owner TEXT NOT NULL,
+	name TEXT NOT NULL,
+	targets JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (scope, owner, name)
+);
+
+-- 分享的计算请求
+CREATE TABLE shares (
+	id TEXT PRIMARY KEY,
+	kind TEXT NOT NULL,
+	catalog_version TEXT NOT NULL,
+	request JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL
+);
+
+-- 按UTC日期、调用方和接口汇总的请求数
+CREATE TABLE usage_stats (
+	day TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	operation TEXT NOT NULL,
+	count BIGINT NOT NULL,
+	PRIMARY KEY (day, subject, operation)
+);
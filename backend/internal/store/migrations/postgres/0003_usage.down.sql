@@ -0,0 +1,3 @@
+DROP INDEX usage_stats_subject_idx;
+ALTER TABLE usage_stats DROP COLUMN last_used_at;
+
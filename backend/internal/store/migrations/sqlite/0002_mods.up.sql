@@ -0,0 +1,13 @@
+-- 玩家背包中的模组，affixes为词条ID和当前等级的JSON
+CREATE TABLE mods (
+	id TEXT PRIMARY KEY,
+	owner TEXT NOT NULL,
+	name TEXT NOT NULL,
+	mod_type TEXT NOT NULL,
+	rarity TEXT NOT NULL,
+	affixes TEXT NOT NULL,
+	enhancements_used INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+);
+CREATE INDEX mods_owner_idx ON mods (owner, updated_at);
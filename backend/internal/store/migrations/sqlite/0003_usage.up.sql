@@ -0,0 +1,3 @@
+-- API key用量改为保存在usage_stats，记录最近一次请求的时间
+ALTER TABLE usage_stats ADD COLUMN last_used_at TIMESTAMP;
+CREATE INDEX usage_stats_subject_idx ON usage_stats (subject, day);
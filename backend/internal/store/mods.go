@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Mod 玩家背包中的模组
+type Mod struct {
+	ID               string
+	Owner            string // 所有者，API key ID或anonymous加玩家ID
+	Name             string
+	Type             string // 模组类型（装备部位），如helmet
+	Rarity           string
+	Affixes          []ModAffix
+	EnhancementsUsed int // 已使用的强化次数
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// ModAffix 模组的词条和当前等级
+type ModAffix struct {
+	AffixID int `json:"affixId"`
+	Level   int `json:"level"`
+}
+
+// ModRepository 背包模组仓库，按所有者隔离
+type ModRepository interface {
+	// Get 获取所有者的模组，不存在时返回ErrNotFound
+	Get(ctx context.Context, owner, id string) (Mod, error)
+	// List 获取所有者的模组，最近更新的在前
+	List(ctx context.Context, owner string) ([]Mod, error)
+	// Count 获取所有者的模组数量
+	Count(ctx context.Context, owner string) (int, error)
+	// Save 创建或更新模组，ID为空时生成新ID，ID属于其他所有者时返回ErrNotFound
+	Save(ctx context.Context, mod Mod) (Mod, error)
+	// Delete 删除所有者的模组，不存在时返回ErrNotFound
+	Delete(ctx context.Context, owner, id string) error
+}
+
+type modRepository struct {
+	db *DB
+}
+
+const modColumns = "id, owner, name, mod_type, rarity, affixes, enhancements_used, created_at, updated_at"
+
+func (r modRepository) Get(ctx context.Context, owner, id string) (Mod, error) {
+	row := r.db.queryRow(ctx, "SELECT "+modColumns+" FROM mods WHERE owner = ? AND id = ?", owner, id)
+	mod, err := scanMod(row)
+	return mod, notFound(err)
+}
+
+func (r modRepository) List(ctx context.Context, owner string) ([]Mod, error) {
+	rows, err := r.db.query(ctx, "SELECT "+modColumns+" FROM mods WHERE owner = ? ORDER BY updated_at DESC", owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mods []Mod
+	for rows.Next() {
+		mod, err := scanMod(rows)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
+	}
+	return mods, rows.Err()
+}
+
+func (r modRepository) Count(ctx context.Context, owner string) (int, error) {
+	var count int
+	err := r.db.queryRow(ctx, "SELECT COUNT(*) FROM mods WHERE owner = ?", owner).Scan(&count)
+	return count, err
+}
+
+func (r modRepository) Save(ctx context.Context, mod Mod) (Mod, error) {
+	affixes, err := json.Marshal(mod.Affixes)
+	if err != nil {
+		return mod, err
+	}
+	now := time.Now().UTC()
+	if mod.ID == "" {
+		mod.ID = NewID()
+	}
+	if mod.CreatedAt.IsZero() {
+		mod.CreatedAt = now
+	}
+	mod.UpdatedAt = now
+
+	// 只更新同一所有者的模组
+	err = affected(r.db.exec(ctx, `INSERT INTO mods (`+modColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET name = excluded.name, mod_type = excluded.mod_type, rarity = excluded.rarity,
+	affixes = excluded.affixes, enhancements_used = excluded.enhancements_used, updated_at = excluded.updated_at
+	WHERE mods.owner = excluded.owner`,
+		mod.ID, mod.Owner, mod.Name, mod.Type, mod.Rarity, string(affixes), mod.EnhancementsUsed, mod.CreatedAt.UTC(), mod.UpdatedAt))
+	return mod, err
+}
+
+func (r modRepository) Delete(ctx context.Context, owner, id string) error {
+	return affected(r.db.exec(ctx, "DELETE FROM mods WHERE owner = ? AND id = ?", owner, id))
+}
+
+func scanMod(s scanner) (Mod, error) {
+	var mod Mod
+	var affixes []byte
+	if err := s.Scan(&mod.ID, &mod.Owner, &mod.Name, &mod.Type, &mod.Rarity, &affixes, &mod.EnhancementsUsed,
+		&mod.CreatedAt, &mod.UpdatedAt); err != nil {
+		return mod, err
+	}
+	return mod, json.Unmarshal(affixes, &mod.Affixes)
+}
@@ -0,0 +1,21 @@
+package store
+
+import (
+	"fmt"
+	"net/url"
+
+	// 注册postgres驱动
+	_ "github.com/lib/pq"
+)
+
+// PostgresDSN 生成PostgreSQL连接字符串
+func PostgresDSN(host string, port int, user, password, dbname, sslmode string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     fmt.Sprintf("%s:%d", host, port),
+		Path:     "/" + dbname,
+		RawQuery: url.Values{"sslmode": {sslmode}}.Encode(),
+	}
+	return u.String()
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// 预设的范围
+const (
+	PresetScopeUser  = "user"
+	PresetScopeGuild = "guild"
+)
+
+// Preset 目标词条预设，同一范围和所有者下按名称区分
+type Preset struct {
+	Scope     string // user或guild
+	Owner     string // 用户或服务器ID
+	Name      string
+	Targets   []int32 // 目标词条ID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PresetRepository 预设仓库
+type PresetRepository interface {
+	// Get 获取预设，不存在时返回ErrNotFound
+	Get(ctx context.Context, scope, owner, name string) (Preset, error)
+	// List 获取所有者的预设，按名称排序
+	List(ctx context.Context, scope, owner string) ([]Preset, error)
+	// Save 创建或更新预设，返回保存后的预设
+	Save(ctx context.Context, preset Preset) (Preset, error)
+	// Delete 删除预设，不存在时返回ErrNotFound
+	Delete(ctx context.Context, scope, owner, name string) error
+}
+
+type presetRepository struct {
+	db *DB
+}
+
+const presetColumns = "scope, owner, name, targets, created_at, updated_at"
+
+func (r presetRepository) Get(ctx context.Context, scope, owner, name string) (Preset, error) {
+	row := r.db.queryRow(ctx, "SELECT "+presetColumns+" FROM presets WHERE scope = ? AND owner = ? AND name = ?", scope, owner, name)
+	preset, err := scanPreset(row)
+	return preset, notFound(err)
+}
+
+func (r presetRepository) List(ctx context.Context, scope, owner string) ([]Preset, error) {
+	rows, err := r.db.query(ctx, "SELECT "+presetColumns+" FROM presets WHERE scope = ? AND owner = ? ORDER BY name", scope, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var presets []Preset
+	for rows.Next() {
+		preset, err := scanPreset(rows)
+		if err != nil {
+			return nil, err
+		}
+		presets = append(presets, preset)
+	}
+	return presets, rows.Err()
+}
+
+func (r presetRepository) Save(ctx context.Context, preset Preset) (Preset, error) {
+	targets, err := json.Marshal(preset.Targets)
+	if err != nil {
+		return preset, err
+	}
+	now := time.Now().UTC()
+	if preset.CreatedAt.IsZero() {
+		preset.CreatedAt = now
+	}
+	preset.UpdatedAt = now
+
+	_, err = r.db.exec(ctx, `INSERT INTO presets (`+presetColumns+`) VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (scope, owner, name) DO UPDATE SET targets = excluded.targets, updated_at = excluded.updated_at`,
+		preset.Scope, preset.Owner, preset.Name, string(targets), preset.CreatedAt.UTC(), preset.UpdatedAt)
+	return preset, err
+}
+
+func (r presetRepository) Delete(ctx context.Context, scope, owner, name string) error {
+	return affected(r.db.exec(ctx, "DELETE FROM presets WHERE scope = ? AND owner = ? AND name = ?", scope, owner, name))
+}
+
+func scanPreset(s scanner) (Preset, error) {
+	var preset Preset
+	var targets []byte
+	if err := s.Scan(&preset.Scope, &preset.Owner, &preset.Name, &targets, &preset.CreatedAt, &preset.UpdatedAt); err != nil {
+		return preset, err
+	}
+	return preset, json.Unmarshal(targets, &preset.Targets)
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Session 会话，如网页或机器人交互中的临时状态
+type Session struct {
+	ID        string
+	Subject   string          // 会话所属的用户
+	Data      json.RawMessage // 会话状态
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// SessionRepository 会话仓库
+type SessionRepository interface {
+	// Get 获取未过期的会话，不存在或已过期时返回ErrNotFound
+	Get(ctx context.Context, id string, now time.Time) (Session, error)
+	// Save 创建或更新会话，ID为空时生成新ID，返回保存后的会话
+	Save(ctx context.Context, session Session) (Session, error)
+	// Delete 删除会话，不存在时返回ErrNotFound
+	Delete(ctx context.Context, id string) error
+	// DeleteExpired 删除now之前过期的会话，返回删除的数量
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type sessionRepository struct {
+	db *DB
+}
+
+func (r sessionRepository) Get(ctx context.Context, id string, now time.Time) (Session, error) {
+	var session Session
+	var data []byte
+	err := r.db.queryRow(ctx, "SELECT id, subject, data, created_at, expires_at FROM sessions WHERE id = ? AND expires_at > ?", id, now.UTC()).
+		Scan(&session.ID, &session.Subject, &data, &session.CreatedAt, &session.ExpiresAt)
+	session.Data = data
+	return session, notFound(err)
+}
+
+func (r sessionRepository) Save(ctx context.Context, session Session) (Session, error) {
+	if session.ID == "" {
+		session.ID = NewID()
+	}
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := r.db.exec(ctx, `INSERT INTO sessions (id, subject, data, created_at, expires_at) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET subject = excluded.subject, data = excluded.data, expires_at = excluded.expires_at`,
+		session.ID, session.Subject, string(session.Data), session.CreatedAt.UTC(), session.ExpiresAt.UTC())
+	return session, err
+}
+
+func (r sessionRepository) Delete(ctx context.Context, id string) error {
+	return affected(r.db.exec(ctx, "DELETE FROM sessions WHERE id = ?", id))
+}
+
+func (r sessionRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.exec(ctx, "DELETE FROM sessions WHERE expires_at <= ?", now.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Share 分享的计算请求
+type Share struct {
+	ID             string
+	Kind           string          // 计算类型，如affixProbability或strengthenProbability
+	CatalogVersion string          // 创建时的游戏版本
+	Request        json.RawMessage // 规范化后的计算请求
+	CreatedAt      time.Time
+}
+
+// ShareRepository 分享仓库，分享创建后不再修改
+type ShareRepository interface {
+	// Get 获取分享，不存在时返回ErrNotFound
+	Get(ctx context.Context, id string) (Share, error)
+	// Create 保存分享，ID已存在时保留原有记录，created为false
+	Create(ctx context.Context, share Share) (created bool, err error)
+}
+
+type shareRepository struct {
+	db *DB
+}
+
+func (r shareRepository) Get(ctx context.Context, id string) (Share, error) {
+	var share Share
+	var request []byte
+	err := r.db.queryRow(ctx, "SELECT id, kind, catalog_version, request, created_at FROM shares WHERE id = ?", id).
+		Scan(&share.ID, &share.Kind, &share.CatalogVersion, &request, &share.CreatedAt)
+	share.Request = request
+	return share, notFound(err)
+}
+
+func (r shareRepository) Create(ctx context.Context, share Share) (bool, error) {
+	if share.CreatedAt.IsZero() {
+		share.CreatedAt = time.Now().UTC()
+	}
+	result, err := r.db.exec(ctx, `INSERT INTO shares (id, kind, catalog_version, request, created_at) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (id) DO NOTHING`,
+		share.ID, share.Kind, share.CatalogVersion, string(share.Request), share.CreatedAt.UTC())
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,6 @@
+package store
+
+import (
+	// 注册纯Go实现的sqlite驱动，不依赖cgo，默认构建即可使用单文件的本地数据库
+	_ "modernc.org/sqlite"
+)
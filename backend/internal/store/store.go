@@ -0,0 +1,268 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+)
+
+// 支持的数据库驱动
+const (
+	DriverNone     = "none"
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// ErrNotFound 记录不存在
+var ErrNotFound = errors.New("记录不存在")
+
+// Options 数据库连接选项
+type Options struct {
+	Driver       string // sqlite或postgres
+	DSN          string // SQLite为数据库文件路径，PostgreSQL为连接字符串
+	MaxOpenConns int    // PostgreSQL的最大连接数，SQLite固定使用单个连接
+}
+
+// ConfigOptions 根据数据库配置生成连接选项，服务、migrate命令和机器人共用
+func ConfigOptions(cfg config.DatabaseConfig) Options {
+	opts := Options{
+		Driver:       cfg.Driver,
+		MaxOpenConns: cfg.MaxOpenConns,
+	}
+	switch cfg.Driver {
+	case DriverSQLite:
+		opts.DSN = cfg.Path
+	case DriverPostgres:
+		opts.DSN = PostgresDSN(cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
+	}
+	return opts
+}
+
+// dialect 不同数据库在SQL上的差异，查询统一使用?占位符
+type dialect struct {
+	name string
+	// numbered 占位符是否为$1、$2形式
+	numbered bool
+	// lock 迁移事务开始时执行的加锁语句，避免多个实例同时迁移
+	lock string
+}
+
+var dialects = map[string]dialect{
+	DriverSQLite:   {name: DriverSQLite},
+	DriverPostgres: {name: DriverPostgres, numbered: true, lock: "SELECT pg_advisory_xact_lock(7469628513)"},
+}
+
+// DB 持久化存储，SQLite和PostgreSQL共用同一套仓库实现
+type DB struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+// Open 打开数据库并检查连接，SQLite数据库文件所在目录不存在时创建
+func Open(ctx context.Context, opts Options) (*DB, error) {
+	d, ok := dialects[opts.Driver]
+	if !ok {
+		return nil, fmt.Errorf("不支持的数据库驱动: %s", opts.Driver)
+	}
+	if !driverRegistered(opts.Driver) {
+		return nil, fmt.Errorf("程序编译时未包含%s驱动", opts.Driver)
+	}
+
+	dsn := opts.DSN
+	if d.name == DriverSQLite {
+		if dir := filepath.Dir(dsn); dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, err
+			}
+		}
+		dsn = sqliteDSN(dsn)
+	}
+
+	db, err := sql.Open(opts.Driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if d.name == DriverSQLite {
+		// SQLite同一时间只允许一个写入，使用单个连接避免database is locked
+		db.SetMaxOpenConns(1)
+	} else if opts.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(opts.MaxOpenConns)
+		db.SetMaxIdleConns(opts.MaxOpenConns)
+	}
+	db.SetConnMaxIdleTime(5 * time.Minute)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &DB{db: db, dialect: d}, nil
+}
+
+// driverRegistered 检查database/sql中是否注册了驱动
+func driverRegistered(name string) bool {
+	for _, driver := range sql.Drivers() {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sqliteDSN 为SQLite数据库文件加上连接参数：开启外键约束和WAL，锁等待5秒
+func sqliteDSN(path string) string {
+	return "file:" + path + "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+}
+
+// Driver 返回数据库驱动名
+func (d *DB) Driver() string {
+	return d.dialect.name
+}
+
+// Ping 检查数据库是否可用
+func (d *DB) Ping(ctx context.Context) error {
+	return d.db.PingContext(ctx)
+}
+
+// Close 关闭数据库
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// Builds 返回配装仓库
+func (d *DB) Builds() BuildRepository {
+	return buildRepository{d}
+}
+
+// Sessions 返回会话仓库
+func (d *DB) Sessions() SessionRepository {
+	return sessionRepository{d}
+}
+
+// Presets 返回预设仓库
+func (d *DB) Presets() PresetRepository {
+	return presetRepository{d}
+}
+
+// Shares 返回分享仓库
+func (d *DB) Shares() ShareRepository {
+	return shareRepository{d}
+}
+
+// Mods 返回背包模组仓库
+func (d *DB) Mods() ModRepository {
+	return modRepository{d}
+}
+
+// Usage 返回用量统计仓库
+func (d *DB) Usage() UsageRepository {
+	return usageRepository{d}
+}
+
+// rebind 把查询中的?占位符转换为数据库使用的形式，跳过字符串、带引号的标识符和注释中的?
+func (d *DB) rebind(query string) string {
+	if !d.dialect.numbered {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '-' && strings.HasPrefix(query[i:], "--"):
+			// 引号内的两个连续引号为转义，按两段相邻的引用处理结果相同；注释到行尾结束
+			end := strings.IndexByte(query[i+1:], c)
+			if c == '-' {
+				end = strings.IndexByte(query[i:], '\n') - 1
+			}
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// exec 执行语句
+func (d *DB) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.db.ExecContext(ctx, d.rebind(query), args...)
+}
+
+// query 执行查询
+func (d *DB) query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.db.QueryContext(ctx, d.rebind(query), args...)
+}
+
+// queryRow 执行只返回一行的查询
+func (d *DB) queryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.db.QueryRowContext(ctx, d.rebind(query), args...)
+}
+
+// withTx 在事务中执行fn，fn返回错误时回滚
+func (d *DB) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// scanner sql.Row和sql.Rows共有的Scan
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// affected 检查语句是否影响了记录，没有时返回ErrNotFound
+func affected(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// notFound 把sql.ErrNoRows转换为ErrNotFound
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// NewID 生成16位十六进制的随机ID
+func NewID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,275 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// openTestDB 打开临时目录中的SQLite数据库并应用全部迁移
+func openTestDB(t *testing.T) *DB {
+	t.Helper()
+	ctx := context.Background()
+	db, err := Open(ctx, Options{Driver: DriverSQLite, DSN: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Migrate(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = $1 AND b = $2"},
+		{"SELECT '?' FROM t WHERE a = ?", "SELECT '?' FROM t WHERE a = $1"},
+		{"SELECT 'it''s ?' FROM t WHERE a = ?", "SELECT 'it''s ?' FROM t WHERE a = $1"},
+		{`SELECT "col?" FROM t WHERE a = ?`, `SELECT "col?" FROM t WHERE a = $1`},
+		{"SELECT a -- why?\nFROM t WHERE a = ?", "SELECT a -- why?\nFROM t WHERE a = $1"},
+		{"SELECT a FROM t WHERE a = ? -- done?", "SELECT a FROM t WHERE a = $1 -- done?"},
+		{"SELECT a - ? FROM t", "SELECT a - $1 FROM t"},
+		{"SELECT 'unterminated ?", "SELECT 'unterminated ?"},
+	}
+	postgres := &DB{dialect: dialects[DriverPostgres]}
+	sqlite := &DB{dialect: dialects[DriverSQLite]}
+	for _, tt := range tests {
+		if got := postgres.rebind(tt.query); got != tt.want {
+			t.Errorf("rebind(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+		if got := sqlite.rebind(tt.query); got != tt.query {
+			t.Errorf("sqlite rebind(%q) = %q, want unchanged", tt.query, got)
+		}
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	migrations, err := db.Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest := migrations[len(migrations)-1].Version
+	if version, err := db.Version(ctx); err != nil || version != latest {
+		t.Fatalf("Version() = %d, %v, want %d", version, err, latest)
+	}
+	if pending, err := db.Pending(ctx); err != nil || len(pending) != 0 {
+		t.Fatalf("Pending() = %v, %v, want none", pending, err)
+	}
+
+	// 全部回滚后重新应用，验证down脚本可以执行且与up脚本对应
+	if _, err := db.Rollback(ctx, 0); err != nil {
+		t.Fatalf("Rollback(0) = %v", err)
+	}
+	if version, err := db.Version(ctx); err != nil || version != 0 {
+		t.Fatalf("Version() after rollback = %d, %v, want 0", version, err)
+	}
+	applied, err := db.Migrate(ctx, 0)
+	if err != nil || len(applied) != len(migrations) {
+		t.Fatalf("Migrate(0) applied %d, %v, want %d", len(applied), err, len(migrations))
+	}
+
+	status, err := db.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range status {
+		if s.AppliedAt == nil {
+			t.Errorf("migration %d not applied", s.Version)
+		}
+	}
+}
+
+func TestUsageRepository(t *testing.T) {
+	ctx := context.Background()
+	usage := openTestDB(t).Usage()
+
+	t1 := time.Date(2024, 7, 9, 10, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 7, 10, 8, 0, 0, 0, time.UTC)
+	batches := [][]UsageStat{
+		{
+			{Day: "2024-07-09", Subject: "k1", Operation: "listAffixes", Count: 3, LastUsedAt: t1},
+			{Day: "2024-07-09", Subject: "k2", Operation: "listAffixes", Count: 1, LastUsedAt: t1},
+		},
+		{
+			{Day: "2024-07-09", Subject: "k1", Operation: "listAffixes", Count: 2, LastUsedAt: t1.Add(time.Hour)},
+			{Day: "2024-07-10", Subject: "k1", Operation: "calculateAffixProbability", Count: 4, LastUsedAt: t2},
+		},
+	}
+	for _, stats := range batches {
+		if err := usage.Add(ctx, stats); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := usage.List(ctx, "2024-07-09", "2024-07-09")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 2 || stats[0].Subject != "k1" || stats[0].Count != 5 || !stats[0].LastUsedAt.Equal(t1.Add(time.Hour)) {
+		t.Errorf("List() = %+v, want k1 with 5 requests", stats)
+	}
+
+	tests := []struct {
+		subject string
+		day     string
+		today   int64
+		total   int64
+		last    *time.Time
+	}{
+		{"k1", "2024-07-10", 4, 9, &t2},
+		{"k1", "2024-07-11", 0, 9, &t2},
+		{"k2", "2024-07-09", 1, 1, &t1},
+		{"k3", "2024-07-09", 0, 0, nil},
+	}
+	for _, tt := range tests {
+		summary, err := usage.Summary(ctx, tt.subject, tt.day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.Today != tt.today || summary.Total != tt.total ||
+			(summary.LastUsedAt == nil) != (tt.last == nil) || tt.last != nil && !summary.LastUsedAt.Equal(*tt.last) {
+			t.Errorf("Summary(%s, %s) = %+v, want today=%d total=%d last=%v",
+				tt.subject, tt.day, summary, tt.today, tt.total, tt.last)
+		}
+	}
+}
+
+func TestShareRepository(t *testing.T) {
+	ctx := context.Background()
+	shares := openTestDB(t).Shares()
+
+	share := Share{ID: "abc", Kind: "affixProbability", CatalogVersion: "1.1", Request: []byte(`{"slotCount":4}`)}
+	for i, want := range []bool{true, false} {
+		created, err := shares.Create(ctx, share)
+		if err != nil || created != want {
+			t.Fatalf("Create() #%d = %v, %v, want %v", i+1, created, err, want)
+		}
+	}
+	got, err := shares.Get(ctx, "abc")
+	if err != nil || got.Kind != share.Kind || string(got.Request) != string(share.Request) {
+		t.Errorf("Get() = %+v, %v", got, err)
+	}
+	if _, err := shares.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestBuildRepository(t *testing.T) {
+	ctx := context.Background()
+	builds := openTestDB(t).Builds()
+
+	first, err := builds.Save(ctx, Build{Owner: "u1", Name: "dps", Tool: "strengthen", Data: []byte(`{"targetLevels":[5,5]}`)})
+	if err != nil || first.ID == "" {
+		t.Fatalf("Save() = %+v, %v", first, err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	second, err := builds.Save(ctx, Build{Owner: "u1", Name: "tank", Tool: "affix", Data: []byte(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := builds.Save(ctx, Build{Owner: "u2", Name: "other", Tool: "affix", Data: []byte(`{}`)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 更新保留创建时间，列表按更新时间倒序
+	time.Sleep(10 * time.Millisecond)
+	first.Name = "dps2"
+	updated, err := builds.Save(ctx, first)
+	if err != nil || !updated.CreatedAt.Equal(first.CreatedAt) {
+		t.Fatalf("Save(update) = %+v, %v", updated, err)
+	}
+	list, err := builds.List(ctx, "u1")
+	if err != nil || len(list) != 2 || list[0].ID != first.ID || list[1].ID != second.ID {
+		t.Fatalf("List(u1) = %+v, %v, want [%s %s]", list, err, first.ID, second.ID)
+	}
+
+	got, err := builds.Get(ctx, first.ID)
+	if err != nil || got.Name != "dps2" || string(got.Data) != `{"targetLevels":[5,5]}` {
+		t.Errorf("Get() = %+v, %v", got, err)
+	}
+	if err := builds.Delete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range []error{builds.Delete(ctx, first.ID), func() error { _, err := builds.Get(ctx, first.ID); return err }()} {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("after Delete: %v, want ErrNotFound", err)
+		}
+	}
+}
+
+func TestSessionRepository(t *testing.T) {
+	ctx := context.Background()
+	sessions := openTestDB(t).Sessions()
+	now := time.Now().UTC()
+
+	active, err := sessions.Save(ctx, Session{Subject: "u1", Data: []byte(`{"step":1}`), ExpiresAt: now.Add(time.Hour)})
+	if err != nil || active.ID == "" {
+		t.Fatalf("Save() = %+v, %v", active, err)
+	}
+	expired, err := sessions.Save(ctx, Session{Subject: "u1", Data: []byte(`{}`), ExpiresAt: now.Add(-time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr error
+	}{
+		{"active", active.ID, nil},
+		{"expired", expired.ID, ErrNotFound},
+		{"missing", "missing", ErrNotFound},
+	}
+	for _, tt := range tests {
+		got, err := sessions.Get(ctx, tt.id, now)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Get(%s) = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if tt.wantErr == nil && string(got.Data) != `{"step":1}` {
+			t.Errorf("Get(%s) data = %s", tt.name, got.Data)
+		}
+	}
+
+	deleted, err := sessions.DeleteExpired(ctx, now)
+	if err != nil || deleted != 1 {
+		t.Errorf("DeleteExpired() = %d, %v, want 1", deleted, err)
+	}
+	if err := sessions.Delete(ctx, active.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := sessions.Delete(ctx, active.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete(deleted) = %v, want ErrNotFound", err)
+	}
+}
+
+// TestMigrationsKeepData 升级到最新版本不删除已有的配装和会话
+func TestMigrationsKeepData(t *testing.T) {
+	ctx := context.Background()
+	db, err := Open(ctx, Options{Driver: DriverSQLite, DSN: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Migrate(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	build, err := db.Builds().Save(ctx, Build{Owner: "u1", Name: "dps", Tool: "strengthen", Data: []byte(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Migrate(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Builds().Get(ctx, build.ID); err != nil {
+		t.Errorf("build lost after migrating: %v", err)
+	}
+}
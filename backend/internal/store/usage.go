@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// UsageStat 按UTC日期、调用方和接口汇总的请求数
+type UsageStat struct {
+	Day        string // UTC日期，格式为2006-01-02
+	Subject    string // 调用方的API key ID
+	Operation  string // 接口的操作ID
+	Count      int64
+	LastUsedAt time.Time // 最近一次请求的时间
+}
+
+// UsageSummary 调用方的请求数汇总
+type UsageSummary struct {
+	Today      int64      // 指定日期的请求数
+	Total      int64      // 累计请求数
+	LastUsedAt *time.Time // 最近一次请求的时间，没有请求时为nil
+}
+
+// UsageRepository 用量统计仓库
+type UsageRepository interface {
+	// Add 在一个事务中累加请求数并更新最近请求时间
+	Add(ctx context.Context, stats []UsageStat) error
+	// List 获取from到to（含）之间的统计，按日期、调用方和接口排序
+	List(ctx context.Context, from, to string) ([]UsageStat, error)
+	// Summary 获取调用方在day当天和累计的请求数
+	Summary(ctx context.Context, subject, day string) (UsageSummary, error)
+}
+
+type usageRepository struct {
+	db *DB
+}
+
+func (r usageRepository) Add(ctx context.Context, stats []UsageStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	query := r.db.rebind(`INSERT INTO usage_stats (day, subject, operation, count, last_used_at) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (day, subject, operation) DO UPDATE SET count = usage_stats.count + excluded.count, last_used_at = excluded.last_used_at`)
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, stat := range stats {
+			if _, err := stmt.ExecContext(ctx, stat.Day, stat.Subject, stat.Operation, stat.Count, stat.LastUsedAt.UTC()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r usageRepository) List(ctx context.Context, from, to string) ([]UsageStat, error) {
+	rows, err := r.db.query(ctx, `SELECT day, subject, operation, count, last_used_at FROM usage_stats
+WHERE day >= ? AND day <= ? ORDER BY day, subject, operation`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []UsageStat
+	for rows.Next() {
+		var stat UsageStat
+		var lastUsedAt sql.NullTime
+		if err := rows.Scan(&stat.Day, &stat.Subject, &stat.Operation, &stat.Count, &lastUsedAt); err != nil {
+			return nil, err
+		}
+		stat.LastUsedAt = lastUsedAt.Time
+		stats = append(stats, stat)
+	}
+	return stats, rows.Err()
+}
+
+func (r usageRepository) Summary(ctx context.Context, subject, day string) (UsageSummary, error) {
+	var summary UsageSummary
+	err := r.db.queryRow(ctx, `SELECT COALESCE(SUM(CASE WHEN day = ? THEN count ELSE 0 END), 0), COALESCE(SUM(count), 0)
+FROM usage_stats WHERE subject = ?`, day, subject).Scan(&summary.Today, &summary.Total)
+	if err != nil {
+		return summary, err
+	}
+
+	// 聚合函数的结果在SQLite中没有列类型，不能扫描为时间，按时间排序取最近的一条
+	var lastUsedAt sql.NullTime
+	err = r.db.queryRow(ctx, `SELECT last_used_at FROM usage_stats WHERE subject = ? AND last_used_at IS NOT NULL
+ORDER BY last_used_at DESC LIMIT 1`, subject).Scan(&lastUsedAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return summary, err
+	case lastUsedAt.Valid:
+		t := lastUsedAt.Time.UTC()
+		summary.LastUsedAt = &t
+	}
+	return summary, nil
+}
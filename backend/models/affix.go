@@ -19,6 +19,10 @@ import (
 // swagger:model Affix
 type Affix struct {
 
+	// aliases
+	// Example: ["Status DMG","Status Damage"]
+	Aliases []string `json:"aliases"`
+
 	// category
 	// Example: damage
 	Category string `json:"category,omitempty"`
@@ -36,6 +40,10 @@ type Affix struct {
 	// Example: 异常伤害
 	// Required: true
 	Name *string `json:"name"`
+
+	// pinyin
+	// Example: yi chang shang hai
+	Pinyin string `json:"pinyin,omitempty"`
 }
 
 // Validate validates this affix
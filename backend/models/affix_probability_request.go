@@ -14,26 +14,35 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// AffixProbabilityRequest affix probability request
+// AffixProbabilityRequest targetAffixIds和targets至少提供一个，两者的词条合并计算
 //
 // swagger:model AffixProbabilityRequest
 type AffixProbabilityRequest struct {
 
+	// 游戏版本ID，不填或latest表示最新版本
+	// Example: latest
+	GameVersion string `json:"gameVersion,omitempty"`
+
+	// 稀有度ID，any表示按掉落权重积分所有稀有度
+	// Example: gold
+	Rarity string `json:"rarity,omitempty"`
+
 	// show combinations
 	ShowCombinations *bool `json:"showCombinations,omitempty"`
 
-	// slot count
+	// 词条数量，指定稀有度时忽略
 	// Example: 3
-	// Required: true
-	// Maximum: 10
 	// Minimum: 1
-	SlotCount *int32 `json:"slotCount"`
+	SlotCount int32 `json:"slotCount,omitempty"`
 
 	// target affix ids
 	// Example: [1,4,5,6]
-	// Required: true
-	// Min Items: 1
 	TargetAffixIds []int32 `json:"targetAffixIds"`
+
+	// 以逗号分隔的目标词条，可以是ID、名称、拼音或preset:<预设名称>
+	// Example: preset:elite-dps, 1
+	// Max Length: 200
+	Targets string `json:"targets,omitempty"`
 }
 
 // Validate validates this affix probability request
@@ -44,7 +53,7 @@ func (m *AffixProbabilityRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateTargetAffixIds(formats); err != nil {
+	if err := m.validateTargets(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -55,31 +64,23 @@ func (m *AffixProbabilityRequest) Validate(formats strfmt.Registry) error {
 }
 
 func (m *AffixProbabilityRequest) validateSlotCount(formats strfmt.Registry) error {
-
-	if err := validate.Required("slotCount", "body", m.SlotCount); err != nil {
-		return err
+	if swag.IsZero(m.SlotCount) { // not required
+		return nil
 	}
 
-	if err := validate.MinimumInt("slotCount", "body", int64(*m.SlotCount), 1, false); err != nil {
-		return err
-	}
-
-	if err := validate.MaximumInt("slotCount", "body", int64(*m.SlotCount), 10, false); err != nil {
+	if err := validate.MinimumInt("slotCount", "body", int64(m.SlotCount), 1, false); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *AffixProbabilityRequest) validateTargetAffixIds(formats strfmt.Registry) error {
-
-	if err := validate.Required("targetAffixIds", "body", m.TargetAffixIds); err != nil {
-		return err
+func (m *AffixProbabilityRequest) validateTargets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Targets) { // not required
+		return nil
 	}
 
-	iTargetAffixIdsSize := int64(len(m.TargetAffixIds))
-
-	if err := validate.MinItems("targetAffixIds", "body", iTargetAffixIdsSize, 1); err != nil {
+	if err := validate.MaxLength("targets", "body", m.Targets, 200); err != nil {
 		return err
 	}
 
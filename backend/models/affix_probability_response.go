@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -23,6 +24,10 @@ type AffixProbabilityResponse struct {
 	// Example: [[1,4,5],[1,4,6],[1,5,6],[4,5,6]]
 	Combinations [][]int32 `json:"combinations"`
 
+	// 计算使用的游戏版本
+	// Example: 1.0
+	GameVersion string `json:"gameVersion,omitempty"`
+
 	// probability
 	// Example: 0.0333
 	// Required: true
@@ -33,6 +38,13 @@ type AffixProbabilityResponse struct {
 	// Required: true
 	ProbabilityPercent *float64 `json:"probabilityPercent"`
 
+	// rarity
+	// Example: any
+	Rarity string `json:"rarity,omitempty"`
+
+	// rarity breakdown
+	RarityBreakdown []*RarityProbability `json:"rarityBreakdown"`
+
 	// slot count
 	// Example: 3
 	SlotCount int32 `json:"slotCount,omitempty"`
@@ -64,6 +76,10 @@ func (m *AffixProbabilityResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRarityBreakdown(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTotalCombinations(formats); err != nil {
 		res = append(res, err)
 	}
@@ -96,6 +112,32 @@ func (m *AffixProbabilityResponse) validateProbabilityPercent(formats strfmt.Reg
 	return nil
 }
 
+func (m *AffixProbabilityResponse) validateRarityBreakdown(formats strfmt.Registry) error {
+	if swag.IsZero(m.RarityBreakdown) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RarityBreakdown); i++ {
+		if swag.IsZero(m.RarityBreakdown[i]) { // not required
+			continue
+		}
+
+		if m.RarityBreakdown[i] != nil {
+			if err := m.RarityBreakdown[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AffixProbabilityResponse) validateTotalCombinations(formats strfmt.Registry) error {
 
 	if err := validate.Required("totalCombinations", "body", m.TotalCombinations); err != nil {
@@ -114,8 +156,42 @@ func (m *AffixProbabilityResponse) validateValidCombinations(formats strfmt.Regi
 	return nil
 }
 
-// ContextValidate validates this affix probability response based on context it is used
+// ContextValidate validate this affix probability response based on the context it is used
 func (m *AffixProbabilityResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRarityBreakdown(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixProbabilityResponse) contextValidateRarityBreakdown(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.RarityBreakdown); i++ {
+
+		if m.RarityBreakdown[i] != nil {
+
+			if swag.IsZero(m.RarityBreakdown[i]) { // not required
+				return nil
+			}
+
+			if err := m.RarityBreakdown[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AffixSearchResult affix search result
+//
+// swagger:model AffixSearchResult
+type AffixSearchResult struct {
+
+	// affix
+	// Required: true
+	Affix *Affix `json:"affix"`
+
+	// 匹配来源（id、name、pinyin、initials、alias）
+	// Example: initials
+	MatchedBy string `json:"matchedBy,omitempty"`
+
+	// 匹配得分，越高越精确
+	// Example: 85
+	Score int32 `json:"score,omitempty"`
+}
+
+// Validate validates this affix search result
+func (m *AffixSearchResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixSearchResult) validateAffix(formats strfmt.Registry) error {
+
+	if err := validate.Required("affix", "body", m.Affix); err != nil {
+		return err
+	}
+
+	if m.Affix != nil {
+		if err := m.Affix.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affix")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affix")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this affix search result based on the context it is used
+func (m *AffixSearchResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffix(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixSearchResult) contextValidateAffix(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Affix != nil {
+
+		if err := m.Affix.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affix")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affix")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixSearchResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixSearchResult) UnmarshalBinary(b []byte) error {
+	var res AffixSearchResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
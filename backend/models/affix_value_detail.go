@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AffixValueDetail affix value detail
+//
+// swagger:model AffixValueDetail
+type AffixValueDetail struct {
+
+	// affix Id
+	// Example: 5
+	AffixID int32 `json:"affixId,omitempty"`
+
+	// max
+	// Example: 10
+	Max float64 `json:"max,omitempty"`
+
+	// min
+	// Example: 4
+	Min float64 `json:"min,omitempty"`
+
+	// min tier
+	// Example: 0
+	MinTier int32 `json:"minTier,omitempty"`
+
+	// min value
+	// Example: 8
+	MinValue float64 `json:"minValue,omitempty"`
+
+	// probability
+	// Example: 0.15
+	Probability float64 `json:"probability,omitempty"`
+
+	// tiers
+	Tiers []*AffixValueTier `json:"tiers"`
+
+	// unit
+	// Example: %
+	Unit string `json:"unit,omitempty"`
+}
+
+// Validate validates this affix value detail
+func (m *AffixValueDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTiers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueDetail) validateTiers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tiers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Tiers); i++ {
+		if swag.IsZero(m.Tiers[i]) { // not required
+			continue
+		}
+
+		if m.Tiers[i] != nil {
+			if err := m.Tiers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this affix value detail based on the context it is used
+func (m *AffixValueDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTiers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueDetail) contextValidateTiers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Tiers); i++ {
+
+		if m.Tiers[i] != nil {
+
+			if swag.IsZero(m.Tiers[i]) { // not required
+				return nil
+			}
+
+			if err := m.Tiers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixValueDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixValueDetail) UnmarshalBinary(b []byte) error {
+	var res AffixValueDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AffixValueProbabilityRequest affix value probability request
+//
+// swagger:model AffixValueProbabilityRequest
+type AffixValueProbabilityRequest struct {
+
+	// 游戏版本ID，不填或latest表示最新版本
+	// Example: latest
+	GameVersion string `json:"gameVersion,omitempty"`
+
+	// 词条等级，上限取决于游戏版本
+	// Example: 1
+	// Minimum: 1
+	Level int32 `json:"level,omitempty"`
+
+	// requirements
+	// Required: true
+	// Min Items: 1
+	Requirements []*AffixValueRequirement `json:"requirements"`
+
+	// 词条数量，0表示只计算数值概率
+	// Example: 4
+	// Minimum: 0
+	SlotCount *int32 `json:"slotCount,omitempty"`
+}
+
+// Validate validates this affix value probability request
+func (m *AffixValueProbabilityRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequirements(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSlotCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueProbabilityRequest) validateLevel(formats strfmt.Registry) error {
+	if swag.IsZero(m.Level) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("level", "body", int64(m.Level), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AffixValueProbabilityRequest) validateRequirements(formats strfmt.Registry) error {
+
+	if err := validate.Required("requirements", "body", m.Requirements); err != nil {
+		return err
+	}
+
+	iRequirementsSize := int64(len(m.Requirements))
+
+	if err := validate.MinItems("requirements", "body", iRequirementsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Requirements); i++ {
+		if swag.IsZero(m.Requirements[i]) { // not required
+			continue
+		}
+
+		if m.Requirements[i] != nil {
+			if err := m.Requirements[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("requirements" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("requirements" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AffixValueProbabilityRequest) validateSlotCount(formats strfmt.Registry) error {
+	if swag.IsZero(m.SlotCount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("slotCount", "body", int64(*m.SlotCount), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this affix value probability request based on the context it is used
+func (m *AffixValueProbabilityRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRequirements(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueProbabilityRequest) contextValidateRequirements(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Requirements); i++ {
+
+		if m.Requirements[i] != nil {
+
+			if swag.IsZero(m.Requirements[i]) { // not required
+				return nil
+			}
+
+			if err := m.Requirements[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("requirements" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("requirements" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixValueProbabilityRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixValueProbabilityRequest) UnmarshalBinary(b []byte) error {
+	var res AffixValueProbabilityRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AffixValueProbabilityResponse affix value probability response
+//
+// swagger:model AffixValueProbabilityResponse
+type AffixValueProbabilityResponse struct {
+
+	// appear probability
+	// Example: 0.1333
+	AppearProbability float64 `json:"appearProbability,omitempty"`
+
+	// details
+	Details []*AffixValueDetail `json:"details"`
+
+	// 计算使用的游戏版本
+	// Example: 1.0
+	GameVersion string `json:"gameVersion,omitempty"`
+
+	// level
+	// Example: 1
+	Level int32 `json:"level,omitempty"`
+
+	// probability
+	// Example: 0.0033
+	// Required: true
+	Probability *float64 `json:"probability"`
+
+	// probability percent
+	// Example: 0.33
+	// Required: true
+	ProbabilityPercent *float64 `json:"probabilityPercent"`
+
+	// slot count
+	// Example: 4
+	SlotCount int32 `json:"slotCount,omitempty"`
+
+	// value probability
+	// Example: 0.0225
+	ValueProbability float64 `json:"valueProbability,omitempty"`
+}
+
+// Validate validates this affix value probability response
+func (m *AffixValueProbabilityResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbabilityPercent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueProbabilityResponse) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AffixValueProbabilityResponse) validateProbability(formats strfmt.Registry) error {
+
+	if err := validate.Required("probability", "body", m.Probability); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AffixValueProbabilityResponse) validateProbabilityPercent(formats strfmt.Registry) error {
+
+	if err := validate.Required("probabilityPercent", "body", m.ProbabilityPercent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this affix value probability response based on the context it is used
+func (m *AffixValueProbabilityResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueProbabilityResponse) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+
+			if swag.IsZero(m.Details[i]) { // not required
+				return nil
+			}
+
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixValueProbabilityResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixValueProbabilityResponse) UnmarshalBinary(b []byte) error {
+	var res AffixValueProbabilityResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AffixValueRequirement affix value requirement
+//
+// swagger:model AffixValueRequirement
+type AffixValueRequirement struct {
+
+	// affix Id
+	// Example: 5
+	// Required: true
+	AffixID *int32 `json:"affixId"`
+
+	// 档位下限，不填表示不限制
+	// Example: 3
+	// Minimum: 0
+	MinTier *int32 `json:"minTier,omitempty"`
+
+	// 数值下限，不填表示不限制
+	// Example: 8
+	MinValue float64 `json:"minValue,omitempty"`
+
+	// 是否要求最高档位，优先于minTier
+	TopTier *bool `json:"topTier,omitempty"`
+}
+
+// Validate validates this affix value requirement
+func (m *AffixValueRequirement) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinTier(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AffixValueRequirement) validateAffixID(formats strfmt.Registry) error {
+
+	if err := validate.Required("affixId", "body", m.AffixID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AffixValueRequirement) validateMinTier(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinTier) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("minTier", "body", int64(*m.MinTier), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this affix value requirement based on context it is used
+func (m *AffixValueRequirement) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixValueRequirement) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixValueRequirement) UnmarshalBinary(b []byte) error {
+	var res AffixValueRequirement
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
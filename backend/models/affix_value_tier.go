@@ -0,0 +1,63 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AffixValueTier affix value tier
+//
+// swagger:model AffixValueTier
+type AffixValueTier struct {
+
+	// max
+	// Example: 10
+	Max float64 `json:"max,omitempty"`
+
+	// min
+	// Example: 8
+	Min float64 `json:"min,omitempty"`
+
+	// tier
+	// Example: 3
+	Tier int32 `json:"tier,omitempty"`
+
+	// weight
+	// Example: 0.15
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// Validate validates this affix value tier
+func (m *AffixValueTier) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this affix value tier based on context it is used
+func (m *AffixValueTier) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AffixValueTier) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AffixValueTier) UnmarshalBinary(b []byte) error {
+	var res AffixValueTier
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,253 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIKey API key
+//
+// swagger:model APIKey
+type APIKey struct {
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// 每日请求数上限（UTC自然日），0表示不限
+	// Required: true
+	DailyQuota *int32 `json:"dailyQuota"`
+
+	// 可以修改服务器预设的服务器ID，*表示全部服务器
+	Guilds []string `json:"guilds"`
+
+	// key ID
+	// Example: 3f9a1c0b
+	// Required: true
+	ID *string `json:"id"`
+
+	// key名称
+	// Example: discord-bot
+	// Required: true
+	Name *string `json:"name"`
+
+	// 吊销时间，未吊销时为空
+	// Format: date-time
+	RevokedAt *strfmt.DateTime `json:"revokedAt,omitempty"`
+
+	// 权限范围，calculate包含catalog，admin包含全部权限
+	// Required: true
+	Scopes []string `json:"scopes"`
+
+	// usage
+	// Required: true
+	Usage *APIKeyUsage `json:"usage"`
+}
+
+// Validate validates this API key
+func (m *APIKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDailyQuota(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevokedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIKey) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateDailyQuota(formats strfmt.Registry) error {
+
+	if err := validate.Required("dailyQuota", "body", m.DailyQuota); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateRevokedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.RevokedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("revokedAt", "body", "date-time", m.RevokedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var apiKeyScopesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["catalog","calculate","admin"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiKeyScopesItemsEnum = append(apiKeyScopesItemsEnum, v)
+	}
+}
+
+func (m *APIKey) validateScopesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiKeyScopesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIKey) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		// value enum
+		if err := m.validateScopesItemsEnum("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateUsage(formats strfmt.Registry) error {
+
+	if err := validate.Required("usage", "body", m.Usage); err != nil {
+		return err
+	}
+
+	if m.Usage != nil {
+		if err := m.Usage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("usage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("usage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this API key based on the context it is used
+func (m *APIKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateUsage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIKey) contextValidateUsage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Usage != nil {
+
+		if err := m.Usage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("usage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("usage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIKey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIKey) UnmarshalBinary(b []byte) error {
+	var res APIKey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
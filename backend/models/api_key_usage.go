@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIKeyUsage API key usage
+//
+// swagger:model APIKeyUsage
+type APIKeyUsage struct {
+
+	// 最后使用时间
+	// Format: date-time
+	LastUsedAt *strfmt.DateTime `json:"lastUsedAt,omitempty"`
+
+	// 当天（UTC）的请求数
+	// Required: true
+	Today *int32 `json:"today"`
+
+	// 累计请求数
+	// Required: true
+	Total *int64 `json:"total"`
+}
+
+// Validate validates this API key usage
+func (m *APIKeyUsage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateToday(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIKeyUsage) validateLastUsedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastUsedAt", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKeyUsage) validateToday(formats strfmt.Registry) error {
+
+	if err := validate.Required("today", "body", m.Today); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKeyUsage) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this API key usage based on context it is used
+func (m *APIKeyUsage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIKeyUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIKeyUsage) UnmarshalBinary(b []byte) error {
+	var res APIKeyUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BuildInfo build info
+//
+// swagger:model BuildInfo
+type BuildInfo struct {
+
+	// 构建时间，未注入时为提交时间
+	// Example: 2026-10-19T12:00:00Z
+	BuildTime string `json:"buildTime,omitempty"`
+
+	// 提交哈希
+	// Example: abdae36c1f0e
+	Commit string `json:"commit,omitempty"`
+
+	// go version
+	// Example: go1.24.0
+	// Required: true
+	GoVersion *string `json:"goVersion"`
+
+	// 构建时工作区是否有未提交的修改
+	Modified bool `json:"modified,omitempty"`
+
+	// 版本号，构建时未注入且无法从模块信息读取时为dev
+	// Example: v1.2.0
+	// Required: true
+	Version *string `json:"version"`
+}
+
+// Validate validates this build info
+func (m *BuildInfo) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGoVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BuildInfo) validateGoVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("goVersion", "body", m.GoVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BuildInfo) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this build info based on context it is used
+func (m *BuildInfo) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BuildInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BuildInfo) UnmarshalBinary(b []byte) error {
+	var res BuildInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CatalogChange catalog change
+//
+// swagger:model CatalogChange
+type CatalogChange struct {
+
+	// action
+	// Required: true
+	// Enum: [added removed changed]
+	Action *string `json:"action"`
+
+	// field
+	// Example: dropWeight
+	Field string `json:"field,omitempty"`
+
+	// 词条ID、稀有度ID或词条等级
+	// Example: 5
+	// Required: true
+	ID *string `json:"id"`
+
+	// new
+	// Example: 0.12
+	New string `json:"new,omitempty"`
+
+	// old
+	// Example: 0.1
+	Old string `json:"old,omitempty"`
+
+	// 变动对象
+	// Required: true
+	// Enum: [affix rarity affixValue levelScale]
+	Target *string `json:"target"`
+}
+
+// Validate validates this catalog change
+func (m *CatalogChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTarget(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var catalogChangeTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["added","removed","changed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		catalogChangeTypeActionPropEnum = append(catalogChangeTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// CatalogChangeActionAdded captures enum value "added"
+	CatalogChangeActionAdded string = "added"
+
+	// CatalogChangeActionRemoved captures enum value "removed"
+	CatalogChangeActionRemoved string = "removed"
+
+	// CatalogChangeActionChanged captures enum value "changed"
+	CatalogChangeActionChanged string = "changed"
+)
+
+// prop value enum
+func (m *CatalogChange) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, catalogChangeTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CatalogChange) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CatalogChange) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var catalogChangeTypeTargetPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["affix","rarity","affixValue","levelScale"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		catalogChangeTypeTargetPropEnum = append(catalogChangeTypeTargetPropEnum, v)
+	}
+}
+
+const (
+
+	// CatalogChangeTargetAffix captures enum value "affix"
+	CatalogChangeTargetAffix string = "affix"
+
+	// CatalogChangeTargetRarity captures enum value "rarity"
+	CatalogChangeTargetRarity string = "rarity"
+
+	// CatalogChangeTargetAffixValue captures enum value "affixValue"
+	CatalogChangeTargetAffixValue string = "affixValue"
+
+	// CatalogChangeTargetLevelScale captures enum value "levelScale"
+	CatalogChangeTargetLevelScale string = "levelScale"
+)
+
+// prop value enum
+func (m *CatalogChange) validateTargetEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, catalogChangeTypeTargetPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CatalogChange) validateTarget(formats strfmt.Registry) error {
+
+	if err := validate.Required("target", "body", m.Target); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTargetEnum("target", "body", *m.Target); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this catalog change based on context it is used
+func (m *CatalogChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CatalogChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CatalogChange) UnmarshalBinary(b []byte) error {
+	var res CatalogChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ErrorDetails error details
+//
+// swagger:model ErrorDetails
+type ErrorDetails struct {
+
+	// 允许的取值
+	Allowed []string `json:"allowed,omitempty"`
+
+	// 出错的请求字段
+	// Example: slotCount
+	Field string `json:"field,omitempty"`
+
+	// 允许的最大值
+	// Example: 10
+	Max *int32 `json:"max,omitempty"`
+
+	// 允许的最小值
+	// Example: 1
+	Min *int32 `json:"min,omitempty"`
+}
+
+// Validate validates this error details
+func (m *ErrorDetails) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this error details based on context it is used
+func (m *ErrorDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ErrorDetails) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ErrorDetails) UnmarshalBinary(b []byte) error {
+	var res ErrorDetails
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
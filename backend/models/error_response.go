@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -14,21 +15,26 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// ErrorResponse error response
+// ErrorResponse 错误响应，请求头Accept为application/problem+json时按RFC 7807格式返回ProblemDetails
 //
 // swagger:model ErrorResponse
 type ErrorResponse struct {
 
+	// 机器可读的错误码，客户端应按错误码处理错误
+	// Example: out_of_range
+	// Enum: [out_of_range invalid_count required duplicate no_valid_targets unknown_rarity unknown_game_version unknown_category rarity_required target_below_initial affix_not_found affix_ambiguous rate_limited job_not_found job_queue_full job_not_finished job_failed job_canceled job_timeout api_key_required invalid_api_key insufficient_scope quota_exceeded shutting_down share_not_found storage_unavailable mod_not_found inventory_full affix_not_allowed preset_not_found invalid_preset_name tool_not_found invalid_input tool_failed tool_timeout script_error script_step_limit script_timeout script_memory_limit invalid_value not_found method_not_allowed unsupported_media_type not_acceptable guild_not_allowed]
+	Code string `json:"code,omitempty"`
+
 	// details
-	Details interface{} `json:"details,omitempty"`
+	Details *ErrorDetails `json:"details,omitempty"`
 
-	// error
+	// 错误类别，与HTTP状态码对应
 	// Example: bad_request
 	// Required: true
 	Error *string `json:"error"`
 
-	// message
-	// Example: Invalid request parameters
+	// 按请求语言本地化的错误消息
+	// Example: 词条数量必须在1-10之间
 	// Required: true
 	Message *string `json:"message"`
 }
@@ -37,6 +43,14 @@ type ErrorResponse struct {
 func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateError(formats); err != nil {
 		res = append(res, err)
 	}
@@ -51,6 +65,196 @@ func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var errorResponseTypeCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["out_of_range","invalid_count","required","duplicate","no_valid_targets","unknown_rarity","unknown_game_version","unknown_category","rarity_required","target_below_initial","affix_not_found","affix_ambiguous","rate_limited","job_not_found","job_queue_full","job_not_finished","job_failed","job_canceled","job_timeout","api_key_required","invalid_api_key","insufficient_scope","quota_exceeded","shutting_down","share_not_found","storage_unavailable","mod_not_found","inventory_full","affix_not_allowed","preset_not_found","invalid_preset_name","tool_not_found","invalid_input","tool_failed","tool_timeout","script_error","script_step_limit","script_timeout","script_memory_limit","invalid_value","not_found","method_not_allowed","unsupported_media_type","not_acceptable","guild_not_allowed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorResponseTypeCodePropEnum = append(errorResponseTypeCodePropEnum, v)
+	}
+}
+
+const (
+
+	// ErrorResponseCodeOutOfRange captures enum value "out_of_range"
+	ErrorResponseCodeOutOfRange string = "out_of_range"
+
+	// ErrorResponseCodeInvalidCount captures enum value "invalid_count"
+	ErrorResponseCodeInvalidCount string = "invalid_count"
+
+	// ErrorResponseCodeRequired captures enum value "required"
+	ErrorResponseCodeRequired string = "required"
+
+	// ErrorResponseCodeDuplicate captures enum value "duplicate"
+	ErrorResponseCodeDuplicate string = "duplicate"
+
+	// ErrorResponseCodeNoValidTargets captures enum value "no_valid_targets"
+	ErrorResponseCodeNoValidTargets string = "no_valid_targets"
+
+	// ErrorResponseCodeUnknownRarity captures enum value "unknown_rarity"
+	ErrorResponseCodeUnknownRarity string = "unknown_rarity"
+
+	// ErrorResponseCodeUnknownGameVersion captures enum value "unknown_game_version"
+	ErrorResponseCodeUnknownGameVersion string = "unknown_game_version"
+
+	// ErrorResponseCodeUnknownCategory captures enum value "unknown_category"
+	ErrorResponseCodeUnknownCategory string = "unknown_category"
+
+	// ErrorResponseCodeRarityRequired captures enum value "rarity_required"
+	ErrorResponseCodeRarityRequired string = "rarity_required"
+
+	// ErrorResponseCodeTargetBelowInitial captures enum value "target_below_initial"
+	ErrorResponseCodeTargetBelowInitial string = "target_below_initial"
+
+	// ErrorResponseCodeAffixNotFound captures enum value "affix_not_found"
+	ErrorResponseCodeAffixNotFound string = "affix_not_found"
+
+	// ErrorResponseCodeAffixAmbiguous captures enum value "affix_ambiguous"
+	ErrorResponseCodeAffixAmbiguous string = "affix_ambiguous"
+
+	// ErrorResponseCodeRateLimited captures enum value "rate_limited"
+	ErrorResponseCodeRateLimited string = "rate_limited"
+
+	// ErrorResponseCodeJobNotFound captures enum value "job_not_found"
+	ErrorResponseCodeJobNotFound string = "job_not_found"
+
+	// ErrorResponseCodeJobQueueFull captures enum value "job_queue_full"
+	ErrorResponseCodeJobQueueFull string = "job_queue_full"
+
+	// ErrorResponseCodeJobNotFinished captures enum value "job_not_finished"
+	ErrorResponseCodeJobNotFinished string = "job_not_finished"
+
+	// ErrorResponseCodeJobFailed captures enum value "job_failed"
+	ErrorResponseCodeJobFailed string = "job_failed"
+
+	// ErrorResponseCodeJobCanceled captures enum value "job_canceled"
+	ErrorResponseCodeJobCanceled string = "job_canceled"
+
+	// ErrorResponseCodeJobTimeout captures enum value "job_timeout"
+	ErrorResponseCodeJobTimeout string = "job_timeout"
+
+	// ErrorResponseCodeAPIKeyRequired captures enum value "api_key_required"
+	ErrorResponseCodeAPIKeyRequired string = "api_key_required"
+
+	// ErrorResponseCodeInvalidAPIKey captures enum value "invalid_api_key"
+	ErrorResponseCodeInvalidAPIKey string = "invalid_api_key"
+
+	// ErrorResponseCodeInsufficientScope captures enum value "insufficient_scope"
+	ErrorResponseCodeInsufficientScope string = "insufficient_scope"
+
+	// ErrorResponseCodeQuotaExceeded captures enum value "quota_exceeded"
+	ErrorResponseCodeQuotaExceeded string = "quota_exceeded"
+
+	// ErrorResponseCodeShuttingDown captures enum value "shutting_down"
+	ErrorResponseCodeShuttingDown string = "shutting_down"
+
+	// ErrorResponseCodeShareNotFound captures enum value "share_not_found"
+	ErrorResponseCodeShareNotFound string = "share_not_found"
+
+	// ErrorResponseCodeStorageUnavailable captures enum value "storage_unavailable"
+	ErrorResponseCodeStorageUnavailable string = "storage_unavailable"
+
+	// ErrorResponseCodeModNotFound captures enum value "mod_not_found"
+	ErrorResponseCodeModNotFound string = "mod_not_found"
+
+	// ErrorResponseCodeInventoryFull captures enum value "inventory_full"
+	ErrorResponseCodeInventoryFull string = "inventory_full"
+
+	// ErrorResponseCodeAffixNotAllowed captures enum value "affix_not_allowed"
+	ErrorResponseCodeAffixNotAllowed string = "affix_not_allowed"
+
+	// ErrorResponseCodePresetNotFound captures enum value "preset_not_found"
+	ErrorResponseCodePresetNotFound string = "preset_not_found"
+
+	// ErrorResponseCodeInvalidPresetName captures enum value "invalid_preset_name"
+	ErrorResponseCodeInvalidPresetName string = "invalid_preset_name"
+
+	// ErrorResponseCodeToolNotFound captures enum value "tool_not_found"
+	ErrorResponseCodeToolNotFound string = "tool_not_found"
+
+	// ErrorResponseCodeInvalidInput captures enum value "invalid_input"
+	ErrorResponseCodeInvalidInput string = "invalid_input"
+
+	// ErrorResponseCodeToolFailed captures enum value "tool_failed"
+	ErrorResponseCodeToolFailed string = "tool_failed"
+
+	// ErrorResponseCodeToolTimeout captures enum value "tool_timeout"
+	ErrorResponseCodeToolTimeout string = "tool_timeout"
+
+	// ErrorResponseCodeScriptError captures enum value "script_error"
+	ErrorResponseCodeScriptError string = "script_error"
+
+	// ErrorResponseCodeScriptStepLimit captures enum value "script_step_limit"
+	ErrorResponseCodeScriptStepLimit string = "script_step_limit"
+
+	// ErrorResponseCodeScriptTimeout captures enum value "script_timeout"
+	ErrorResponseCodeScriptTimeout string = "script_timeout"
+
+	// ErrorResponseCodeScriptMemoryLimit captures enum value "script_memory_limit"
+	ErrorResponseCodeScriptMemoryLimit string = "script_memory_limit"
+
+	// ErrorResponseCodeInvalidValue captures enum value "invalid_value"
+	ErrorResponseCodeInvalidValue string = "invalid_value"
+
+	// ErrorResponseCodeNotFound captures enum value "not_found"
+	ErrorResponseCodeNotFound string = "not_found"
+
+	// ErrorResponseCodeMethodNotAllowed captures enum value "method_not_allowed"
+	ErrorResponseCodeMethodNotAllowed string = "method_not_allowed"
+
+	// ErrorResponseCodeUnsupportedMediaType captures enum value "unsupported_media_type"
+	ErrorResponseCodeUnsupportedMediaType string = "unsupported_media_type"
+
+	// ErrorResponseCodeNotAcceptable captures enum value "not_acceptable"
+	ErrorResponseCodeNotAcceptable string = "not_acceptable"
+
+	// ErrorResponseCodeGuildNotAllowed captures enum value "guild_not_allowed"
+	ErrorResponseCodeGuildNotAllowed string = "guild_not_allowed"
+)
+
+// prop value enum
+func (m *ErrorResponse) validateCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, errorResponseTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ErrorResponse) validateCode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Code) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCodeEnum("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ErrorResponse) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	if m.Details != nil {
+		if err := m.Details.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("details")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("details")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ErrorResponse) validateError(formats strfmt.Registry) error {
 
 	if err := validate.Required("error", "body", m.Error); err != nil {
@@ -69,8 +273,38 @@ func (m *ErrorResponse) validateMessage(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this error response based on context it is used
+// ContextValidate validate this error response based on the context it is used
 func (m *ErrorResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ErrorResponse) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Details != nil {
+
+		if swag.IsZero(m.Details) { // not required
+			return nil
+		}
+
+		if err := m.Details.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("details")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("details")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HealthComponent health component
+//
+// swagger:model HealthComponent
+type HealthComponent struct {
+
+	// 不可用的原因
+	Error string `json:"error,omitempty"`
+
+	// 检查耗时（毫秒）
+	LatencyMs float64 `json:"latencyMs,omitempty"`
+
+	// 组件名称
+	// Example: cache
+	// Required: true
+	Name *string `json:"name"`
+
+	// ok表示正常，fail表示不可用，disabled表示未启用（不影响就绪状态）
+	// Required: true
+	// Enum: [ok fail disabled]
+	Status *string `json:"status"`
+}
+
+// Validate validates this health component
+func (m *HealthComponent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HealthComponent) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var healthComponentTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ok","fail","disabled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		healthComponentTypeStatusPropEnum = append(healthComponentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// HealthComponentStatusOk captures enum value "ok"
+	HealthComponentStatusOk string = "ok"
+
+	// HealthComponentStatusFail captures enum value "fail"
+	HealthComponentStatusFail string = "fail"
+
+	// HealthComponentStatusDisabled captures enum value "disabled"
+	HealthComponentStatusDisabled string = "disabled"
+)
+
+// prop value enum
+func (m *HealthComponent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, healthComponentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HealthComponent) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this health component based on context it is used
+func (m *HealthComponent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HealthComponent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HealthComponent) UnmarshalBinary(b []byte) error {
+	var res HealthComponent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
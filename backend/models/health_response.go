@@ -7,6 +7,8 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,9 +21,16 @@ import (
 // swagger:model HealthResponse
 type HealthResponse struct {
 
-	// status
+	// build
+	Build *BuildInfo `json:"build,omitempty"`
+
+	// 各组件的检查结果，只在就绪检查中返回
+	Components []*HealthComponent `json:"components,omitempty"`
+
+	// ok表示正常，unavailable表示有组件不可用，draining表示服务正在停止
 	// Example: ok
 	// Required: true
+	// Enum: [ok unavailable draining]
 	Status *string `json:"status"`
 
 	// timestamp
@@ -29,8 +38,8 @@ type HealthResponse struct {
 	// Format: date-time
 	Timestamp *strfmt.DateTime `json:"timestamp"`
 
-	// version
-	// Example: 1.0.0
+	// 服务版本，与build.version相同
+	// Example: v1.2.0
 	Version string `json:"version,omitempty"`
 }
 
@@ -38,6 +47,14 @@ type HealthResponse struct {
 func (m *HealthResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBuild(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateComponents(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -52,12 +69,94 @@ func (m *HealthResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HealthResponse) validateBuild(formats strfmt.Registry) error {
+	if swag.IsZero(m.Build) { // not required
+		return nil
+	}
+
+	if m.Build != nil {
+		if err := m.Build.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("build")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("build")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HealthResponse) validateComponents(formats strfmt.Registry) error {
+	if swag.IsZero(m.Components) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Components); i++ {
+		if swag.IsZero(m.Components[i]) { // not required
+			continue
+		}
+
+		if m.Components[i] != nil {
+			if err := m.Components[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("components" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("components" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var healthResponseTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ok","unavailable","draining"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		healthResponseTypeStatusPropEnum = append(healthResponseTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// HealthResponseStatusOk captures enum value "ok"
+	HealthResponseStatusOk string = "ok"
+
+	// HealthResponseStatusUnavailable captures enum value "unavailable"
+	HealthResponseStatusUnavailable string = "unavailable"
+
+	// HealthResponseStatusDraining captures enum value "draining"
+	HealthResponseStatusDraining string = "draining"
+)
+
+// prop value enum
+func (m *HealthResponse) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, healthResponseTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (m *HealthResponse) validateStatus(formats strfmt.Registry) error {
 
 	if err := validate.Required("status", "body", m.Status); err != nil {
 		return err
 	}
 
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,8 +173,67 @@ func (m *HealthResponse) validateTimestamp(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this health response based on context it is used
+// ContextValidate validate this health response based on the context it is used
 func (m *HealthResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBuild(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateComponents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HealthResponse) contextValidateBuild(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Build != nil {
+
+		if swag.IsZero(m.Build) { // not required
+			return nil
+		}
+
+		if err := m.Build.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("build")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("build")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HealthResponse) contextValidateComponents(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Components); i++ {
+
+		if m.Components[i] != nil {
+
+			if swag.IsZero(m.Components[i]) { // not required
+				return nil
+			}
+
+			if err := m.Components[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("components" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("components" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryAnalysis inventory analysis
+//
+// swagger:model InventoryAnalysis
+type InventoryAnalysis struct {
+
+	// 计入目标的词条，包括targets解析出的词条
+	AffixIds []int32 `json:"affixIds"`
+
+	// game version
+	// Required: true
+	GameVersion *string `json:"gameVersion"`
+
+	// 按达成概率从高到低排列，概率相同时期望提升高的在前
+	// Required: true
+	Mods []*InventoryModOutlook `json:"mods"`
+
+	// order independent
+	// Required: true
+	OrderIndependent *bool `json:"orderIndependent"`
+
+	// 建议分解的模组
+	// Required: true
+	Scrap []*ScrapSuggestion `json:"scrap"`
+
+	// target levels
+	// Required: true
+	TargetLevels []int32 `json:"targetLevels"`
+}
+
+// Validate validates this inventory analysis
+func (m *InventoryAnalysis) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGameVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMods(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOrderIndependent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScrap(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetLevels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryAnalysis) validateGameVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("gameVersion", "body", m.GameVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryAnalysis) validateMods(formats strfmt.Registry) error {
+
+	if err := validate.Required("mods", "body", m.Mods); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Mods); i++ {
+		if swag.IsZero(m.Mods[i]) { // not required
+			continue
+		}
+
+		if m.Mods[i] != nil {
+			if err := m.Mods[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("mods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("mods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryAnalysis) validateOrderIndependent(formats strfmt.Registry) error {
+
+	if err := validate.Required("orderIndependent", "body", m.OrderIndependent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryAnalysis) validateScrap(formats strfmt.Registry) error {
+
+	if err := validate.Required("scrap", "body", m.Scrap); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scrap); i++ {
+		if swag.IsZero(m.Scrap[i]) { // not required
+			continue
+		}
+
+		if m.Scrap[i] != nil {
+			if err := m.Scrap[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("scrap" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("scrap" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryAnalysis) validateTargetLevels(formats strfmt.Registry) error {
+
+	if err := validate.Required("targetLevels", "body", m.TargetLevels); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this inventory analysis based on the context it is used
+func (m *InventoryAnalysis) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMods(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateScrap(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryAnalysis) contextValidateMods(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Mods); i++ {
+
+		if m.Mods[i] != nil {
+
+			if swag.IsZero(m.Mods[i]) { // not required
+				return nil
+			}
+
+			if err := m.Mods[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("mods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("mods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryAnalysis) contextValidateScrap(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Scrap); i++ {
+
+		if m.Scrap[i] != nil {
+
+			if swag.IsZero(m.Scrap[i]) { // not required
+				return nil
+			}
+
+			if err := m.Scrap[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("scrap" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("scrap" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryAnalysis) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryAnalysis) UnmarshalBinary(b []byte) error {
+	var res InventoryAnalysis
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
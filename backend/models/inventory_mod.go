@@ -0,0 +1,294 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryMod inventory mod
+//
+// swagger:model InventoryMod
+type InventoryMod struct {
+
+	// affixes
+	// Required: true
+	Affixes []*InventoryModAffix `json:"affixes"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// enhancements used
+	// Required: true
+	EnhancementsUsed *int32 `json:"enhancementsUsed"`
+
+	// id
+	// Example: 3f9c2a1b7d4e6f80
+	// Required: true
+	ID *string `json:"id"`
+
+	// name
+	// Example: 暴击头
+	Name string `json:"name,omitempty"`
+
+	// rarity
+	// Example: gold
+	// Required: true
+	Rarity *string `json:"rarity"`
+
+	// type
+	// Example: helmet
+	// Required: true
+	// Enum: [weapon helmet mask top gloves bottoms shoes]
+	Type *string `json:"type"`
+
+	// updated at
+	// Required: true
+	// Format: date-time
+	UpdatedAt *strfmt.DateTime `json:"updatedAt"`
+}
+
+// Validate validates this inventory mod
+func (m *InventoryMod) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnhancementsUsed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRarity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryMod) validateAffixes(formats strfmt.Registry) error {
+
+	if err := validate.Required("affixes", "body", m.Affixes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Affixes); i++ {
+		if swag.IsZero(m.Affixes[i]) { // not required
+			continue
+		}
+
+		if m.Affixes[i] != nil {
+			if err := m.Affixes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("affixes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("affixes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryMod) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryMod) validateEnhancementsUsed(formats strfmt.Registry) error {
+
+	if err := validate.Required("enhancementsUsed", "body", m.EnhancementsUsed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryMod) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryMod) validateRarity(formats strfmt.Registry) error {
+
+	if err := validate.Required("rarity", "body", m.Rarity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var inventoryModTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["weapon","helmet","mask","top","gloves","bottoms","shoes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		inventoryModTypeTypePropEnum = append(inventoryModTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// InventoryModTypeWeapon captures enum value "weapon"
+	InventoryModTypeWeapon string = "weapon"
+
+	// InventoryModTypeHelmet captures enum value "helmet"
+	InventoryModTypeHelmet string = "helmet"
+
+	// InventoryModTypeMask captures enum value "mask"
+	InventoryModTypeMask string = "mask"
+
+	// InventoryModTypeTop captures enum value "top"
+	InventoryModTypeTop string = "top"
+
+	// InventoryModTypeGloves captures enum value "gloves"
+	InventoryModTypeGloves string = "gloves"
+
+	// InventoryModTypeBottoms captures enum value "bottoms"
+	InventoryModTypeBottoms string = "bottoms"
+
+	// InventoryModTypeShoes captures enum value "shoes"
+	InventoryModTypeShoes string = "shoes"
+)
+
+// prop value enum
+func (m *InventoryMod) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, inventoryModTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InventoryMod) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryMod) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("updatedAt", "body", m.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this inventory mod based on the context it is used
+func (m *InventoryMod) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryMod) contextValidateAffixes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Affixes); i++ {
+
+		if m.Affixes[i] != nil {
+
+			if swag.IsZero(m.Affixes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Affixes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("affixes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("affixes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryMod) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryMod) UnmarshalBinary(b []byte) error {
+	var res InventoryMod
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
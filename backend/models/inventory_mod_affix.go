@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryModAffix inventory mod affix
+//
+// swagger:model InventoryModAffix
+type InventoryModAffix struct {
+
+	// 词条ID
+	// Example: 1
+	// Required: true
+	AffixID *int32 `json:"affixId"`
+
+	// 当前等级
+	// Example: 3
+	// Required: true
+	// Minimum: 1
+	Level *int32 `json:"level"`
+}
+
+// Validate validates this inventory mod affix
+func (m *InventoryModAffix) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModAffix) validateAffixID(formats strfmt.Registry) error {
+
+	if err := validate.Required("affixId", "body", m.AffixID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModAffix) validateLevel(formats strfmt.Registry) error {
+
+	if err := validate.Required("level", "body", m.Level); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("level", "body", int64(*m.Level), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this inventory mod affix based on context it is used
+func (m *InventoryModAffix) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryModAffix) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryModAffix) UnmarshalBinary(b []byte) error {
+	var res InventoryModAffix
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
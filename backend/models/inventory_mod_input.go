@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryModInput inventory mod input
+//
+// swagger:model InventoryModInput
+type InventoryModInput struct {
+
+	// 词条和当前等级，数量与稀有度的词条数量一致
+	// Required: true
+	// Max Items: 10
+	// Min Items: 1
+	Affixes []*InventoryModAffix `json:"affixes"`
+
+	// 已使用的强化次数
+	// Minimum: 0
+	EnhancementsUsed *int32 `json:"enhancementsUsed,omitempty"`
+
+	// 备注名
+	// Example: 暴击头
+	// Max Length: 64
+	Name string `json:"name,omitempty"`
+
+	// 稀有度ID
+	// Example: gold
+	// Required: true
+	Rarity *string `json:"rarity"`
+
+	// 模组类型（装备部位）
+	// Example: helmet
+	// Required: true
+	// Enum: [weapon helmet mask top gloves bottoms shoes]
+	Type *string `json:"type"`
+}
+
+// Validate validates this inventory mod input
+func (m *InventoryModInput) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnhancementsUsed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRarity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModInput) validateAffixes(formats strfmt.Registry) error {
+
+	if err := validate.Required("affixes", "body", m.Affixes); err != nil {
+		return err
+	}
+
+	iAffixesSize := int64(len(m.Affixes))
+
+	if err := validate.MinItems("affixes", "body", iAffixesSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxItems("affixes", "body", iAffixesSize, 10); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Affixes); i++ {
+		if swag.IsZero(m.Affixes[i]) { // not required
+			continue
+		}
+
+		if m.Affixes[i] != nil {
+			if err := m.Affixes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("affixes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("affixes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryModInput) validateEnhancementsUsed(formats strfmt.Registry) error {
+	if swag.IsZero(m.EnhancementsUsed) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("enhancementsUsed", "body", int64(*m.EnhancementsUsed), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModInput) validateName(formats strfmt.Registry) error {
+	if swag.IsZero(m.Name) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("name", "body", m.Name, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModInput) validateRarity(formats strfmt.Registry) error {
+
+	if err := validate.Required("rarity", "body", m.Rarity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var inventoryModInputTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["weapon","helmet","mask","top","gloves","bottoms","shoes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		inventoryModInputTypeTypePropEnum = append(inventoryModInputTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// InventoryModInputTypeWeapon captures enum value "weapon"
+	InventoryModInputTypeWeapon string = "weapon"
+
+	// InventoryModInputTypeHelmet captures enum value "helmet"
+	InventoryModInputTypeHelmet string = "helmet"
+
+	// InventoryModInputTypeMask captures enum value "mask"
+	InventoryModInputTypeMask string = "mask"
+
+	// InventoryModInputTypeTop captures enum value "top"
+	InventoryModInputTypeTop string = "top"
+
+	// InventoryModInputTypeGloves captures enum value "gloves"
+	InventoryModInputTypeGloves string = "gloves"
+
+	// InventoryModInputTypeBottoms captures enum value "bottoms"
+	InventoryModInputTypeBottoms string = "bottoms"
+
+	// InventoryModInputTypeShoes captures enum value "shoes"
+	InventoryModInputTypeShoes string = "shoes"
+)
+
+// prop value enum
+func (m *InventoryModInput) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, inventoryModInputTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InventoryModInput) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this inventory mod input based on the context it is used
+func (m *InventoryModInput) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModInput) contextValidateAffixes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Affixes); i++ {
+
+		if m.Affixes[i] != nil {
+
+			if swag.IsZero(m.Affixes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Affixes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("affixes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("affixes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryModInput) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryModInput) UnmarshalBinary(b []byte) error {
+	var res InventoryModInput
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryModListResponse inventory mod list response
+//
+// swagger:model InventoryModListResponse
+type InventoryModListResponse struct {
+
+	// mods
+	// Required: true
+	Mods []*InventoryMod `json:"mods"`
+
+	// total
+	// Required: true
+	Total *int32 `json:"total"`
+}
+
+// Validate validates this inventory mod list response
+func (m *InventoryModListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMods(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModListResponse) validateMods(formats strfmt.Registry) error {
+
+	if err := validate.Required("mods", "body", m.Mods); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Mods); i++ {
+		if swag.IsZero(m.Mods[i]) { // not required
+			continue
+		}
+
+		if m.Mods[i] != nil {
+			if err := m.Mods[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("mods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("mods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InventoryModListResponse) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this inventory mod list response based on the context it is used
+func (m *InventoryModListResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMods(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModListResponse) contextValidateMods(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Mods); i++ {
+
+		if m.Mods[i] != nil {
+
+			if swag.IsZero(m.Mods[i]) { // not required
+				return nil
+			}
+
+			if err := m.Mods[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("mods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("mods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryModListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryModListResponse) UnmarshalBinary(b []byte) error {
+	var res InventoryModListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InventoryModOutlook 模组用剩余强化次数强化的结果
+//
+// swagger:model InventoryModOutlook
+type InventoryModOutlook struct {
+
+	// 剩余强化次数
+	// Required: true
+	EnhancementsLeft *int32 `json:"enhancementsLeft"`
+
+	// 计入目标的词条期望提升的等级之和
+	// Required: true
+	ExpectedGain *float64 `json:"expectedGain"`
+
+	// 各词条强化后的期望等级，与mod.affixes顺序一致
+	// Required: true
+	ExpectedLevels []float64 `json:"expectedLevels"`
+
+	// mod
+	// Required: true
+	Mod *InventoryMod `json:"mod"`
+
+	// 达到目标等级的概率
+	// Required: true
+	Probability *float64 `json:"probability"`
+
+	// probability percent
+	// Required: true
+	ProbabilityPercent *float64 `json:"probabilityPercent"`
+}
+
+// Validate validates this inventory mod outlook
+func (m *InventoryModOutlook) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEnhancementsLeft(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpectedGain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpectedLevels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbabilityPercent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModOutlook) validateEnhancementsLeft(formats strfmt.Registry) error {
+
+	if err := validate.Required("enhancementsLeft", "body", m.EnhancementsLeft); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModOutlook) validateExpectedGain(formats strfmt.Registry) error {
+
+	if err := validate.Required("expectedGain", "body", m.ExpectedGain); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModOutlook) validateExpectedLevels(formats strfmt.Registry) error {
+
+	if err := validate.Required("expectedLevels", "body", m.ExpectedLevels); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModOutlook) validateMod(formats strfmt.Registry) error {
+
+	if err := validate.Required("mod", "body", m.Mod); err != nil {
+		return err
+	}
+
+	if m.Mod != nil {
+		if err := m.Mod.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mod")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mod")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *InventoryModOutlook) validateProbability(formats strfmt.Registry) error {
+
+	if err := validate.Required("probability", "body", m.Probability); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InventoryModOutlook) validateProbabilityPercent(formats strfmt.Registry) error {
+
+	if err := validate.Required("probabilityPercent", "body", m.ProbabilityPercent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this inventory mod outlook based on the context it is used
+func (m *InventoryModOutlook) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMod(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InventoryModOutlook) contextValidateMod(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Mod != nil {
+
+		if err := m.Mod.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mod")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mod")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InventoryModOutlook) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InventoryModOutlook) UnmarshalBinary(b []byte) error {
+	var res InventoryModOutlook
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,313 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Job job
+//
+// swagger:model Job
+type Job struct {
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// error
+	Error *ErrorResponse `json:"error,omitempty"`
+
+	// 根据已完成部分得到的概率估计，随计算进行逐步收敛，只有部分计算类型提供
+	// Example: 0.0312
+	Estimate *float64 `json:"estimate,omitempty"`
+
+	// 任务结束后结果的过期时间
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt *strfmt.DateTime `json:"finishedAt,omitempty"`
+
+	// id
+	// Example: 3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d
+	// Required: true
+	ID *string `json:"id"`
+
+	// 任务进度，0到1
+	// Example: 0.42
+	// Required: true
+	Progress *float64 `json:"progress"`
+
+	// started at
+	// Format: date-time
+	StartedAt *strfmt.DateTime `json:"startedAt,omitempty"`
+
+	// 任务状态
+	// Example: running
+	// Required: true
+	// Enum: [queued running succeeded failed canceled]
+	Status *string `json:"status"`
+
+	// type
+	// Example: strengthenProbability
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this job
+func (m *Job) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProgress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Job) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateError(formats strfmt.Registry) error {
+	if swag.IsZero(m.Error) { // not required
+		return nil
+	}
+
+	if m.Error != nil {
+		if err := m.Error.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Job) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finishedAt", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateProgress(formats strfmt.Registry) error {
+
+	if err := validate.Required("progress", "body", m.Progress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var jobTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["queued","running","succeeded","failed","canceled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jobTypeStatusPropEnum = append(jobTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// JobStatusQueued captures enum value "queued"
+	JobStatusQueued string = "queued"
+
+	// JobStatusRunning captures enum value "running"
+	JobStatusRunning string = "running"
+
+	// JobStatusSucceeded captures enum value "succeeded"
+	JobStatusSucceeded string = "succeeded"
+
+	// JobStatusFailed captures enum value "failed"
+	JobStatusFailed string = "failed"
+
+	// JobStatusCanceled captures enum value "canceled"
+	JobStatusCanceled string = "canceled"
+)
+
+// prop value enum
+func (m *Job) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, jobTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Job) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Job) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this job based on the context it is used
+func (m *Job) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Job) contextValidateError(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Error != nil {
+
+		if swag.IsZero(m.Error) { // not required
+			return nil
+		}
+
+		if err := m.Error.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Job) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Job) UnmarshalBinary(b []byte) error {
+	var res Job
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,269 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// JobItem 批量任务中的一个计算，按type填写对应的计算参数
+//
+// swagger:model JobItem
+type JobItem struct {
+
+	// affix probability
+	AffixProbability *AffixProbabilityRequest `json:"affixProbability,omitempty"`
+
+	// affix value probability
+	AffixValueProbability *AffixValueProbabilityRequest `json:"affixValueProbability,omitempty"`
+
+	// strengthen probability
+	StrengthenProbability *StrengthenProbabilityRequest `json:"strengthenProbability,omitempty"`
+
+	// 计算类型
+	// Example: affixProbability
+	// Required: true
+	// Enum: [affixProbability affixValueProbability strengthenProbability]
+	Type *string `json:"type"`
+}
+
+// Validate validates this job item
+func (m *JobItem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAffixValueProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrengthenProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobItem) validateAffixProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixProbability) { // not required
+		return nil
+	}
+
+	if m.AffixProbability != nil {
+		if err := m.AffixProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItem) validateAffixValueProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixValueProbability) { // not required
+		return nil
+	}
+
+	if m.AffixValueProbability != nil {
+		if err := m.AffixValueProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItem) validateStrengthenProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.StrengthenProbability) { // not required
+		return nil
+	}
+
+	if m.StrengthenProbability != nil {
+		if err := m.StrengthenProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var jobItemTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["affixProbability","affixValueProbability","strengthenProbability"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jobItemTypeTypePropEnum = append(jobItemTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// JobItemTypeAffixProbability captures enum value "affixProbability"
+	JobItemTypeAffixProbability string = "affixProbability"
+
+	// JobItemTypeAffixValueProbability captures enum value "affixValueProbability"
+	JobItemTypeAffixValueProbability string = "affixValueProbability"
+
+	// JobItemTypeStrengthenProbability captures enum value "strengthenProbability"
+	JobItemTypeStrengthenProbability string = "strengthenProbability"
+)
+
+// prop value enum
+func (m *JobItem) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, jobItemTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *JobItem) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this job item based on the context it is used
+func (m *JobItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAffixValueProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStrengthenProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobItem) contextValidateAffixProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixProbability != nil {
+
+		if swag.IsZero(m.AffixProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItem) contextValidateAffixValueProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixValueProbability != nil {
+
+		if swag.IsZero(m.AffixValueProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixValueProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItem) contextValidateStrengthenProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StrengthenProbability != nil {
+
+		if swag.IsZero(m.StrengthenProbability) { // not required
+			return nil
+		}
+
+		if err := m.StrengthenProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *JobItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *JobItem) UnmarshalBinary(b []byte) error {
+	var res JobItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
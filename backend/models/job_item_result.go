@@ -0,0 +1,299 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// JobItemResult 批量任务中一个计算的结果，计算失败时只有error，不影响其余计算
+//
+// swagger:model JobItemResult
+type JobItemResult struct {
+
+	// affix probability
+	AffixProbability *AffixProbabilityResponse `json:"affixProbability,omitempty"`
+
+	// affix value probability
+	AffixValueProbability *AffixValueProbabilityResponse `json:"affixValueProbability,omitempty"`
+
+	// error
+	Error *ErrorResponse `json:"error,omitempty"`
+
+	// 在请求items中的位置，从0开始
+	// Example: 0
+	// Required: true
+	Index *int32 `json:"index"`
+
+	// strengthen probability
+	StrengthenProbability *StrengthenProbabilityResponse `json:"strengthenProbability,omitempty"`
+
+	// type
+	// Example: affixProbability
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this job item result
+func (m *JobItemResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAffixValueProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrengthenProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobItemResult) validateAffixProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixProbability) { // not required
+		return nil
+	}
+
+	if m.AffixProbability != nil {
+		if err := m.AffixProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) validateAffixValueProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixValueProbability) { // not required
+		return nil
+	}
+
+	if m.AffixValueProbability != nil {
+		if err := m.AffixValueProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) validateError(formats strfmt.Registry) error {
+	if swag.IsZero(m.Error) { // not required
+		return nil
+	}
+
+	if m.Error != nil {
+		if err := m.Error.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("index", "body", m.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) validateStrengthenProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.StrengthenProbability) { // not required
+		return nil
+	}
+
+	if m.StrengthenProbability != nil {
+		if err := m.StrengthenProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this job item result based on the context it is used
+func (m *JobItemResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAffixValueProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStrengthenProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobItemResult) contextValidateAffixProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixProbability != nil {
+
+		if swag.IsZero(m.AffixProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) contextValidateAffixValueProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixValueProbability != nil {
+
+		if swag.IsZero(m.AffixValueProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixValueProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) contextValidateError(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Error != nil {
+
+		if swag.IsZero(m.Error) { // not required
+			return nil
+		}
+
+		if err := m.Error.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobItemResult) contextValidateStrengthenProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StrengthenProbability != nil {
+
+		if swag.IsZero(m.StrengthenProbability) { // not required
+			return nil
+		}
+
+		if err := m.StrengthenProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *JobItemResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *JobItemResult) UnmarshalBinary(b []byte) error {
+	var res JobItemResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
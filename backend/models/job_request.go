@@ -0,0 +1,342 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// JobRequest 异步计算任务，按type填写对应的计算参数；batch在一个任务中依次计算items中的多个计算
+//
+// swagger:model JobRequest
+type JobRequest struct {
+
+	// affix probability
+	AffixProbability *AffixProbabilityRequest `json:"affixProbability,omitempty"`
+
+	// affix value probability
+	AffixValueProbability *AffixValueProbabilityRequest `json:"affixValueProbability,omitempty"`
+
+	// type为batch时的计算列表，结果按相同顺序返回
+	// Max Items: 100
+	Items []*JobItem `json:"items"`
+
+	// strengthen probability
+	StrengthenProbability *StrengthenProbabilityRequest `json:"strengthenProbability,omitempty"`
+
+	// 计算类型
+	// Example: strengthenProbability
+	// Required: true
+	// Enum: [affixProbability affixValueProbability strengthenProbability batch]
+	Type *string `json:"type"`
+}
+
+// Validate validates this job request
+func (m *JobRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAffixValueProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateItems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrengthenProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobRequest) validateAffixProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixProbability) { // not required
+		return nil
+	}
+
+	if m.AffixProbability != nil {
+		if err := m.AffixProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobRequest) validateAffixValueProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixValueProbability) { // not required
+		return nil
+	}
+
+	if m.AffixValueProbability != nil {
+		if err := m.AffixValueProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobRequest) validateItems(formats strfmt.Registry) error {
+	if swag.IsZero(m.Items) { // not required
+		return nil
+	}
+
+	iItemsSize := int64(len(m.Items))
+
+	if err := validate.MaxItems("items", "body", iItemsSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Items); i++ {
+		if swag.IsZero(m.Items[i]) { // not required
+			continue
+		}
+
+		if m.Items[i] != nil {
+			if err := m.Items[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("items" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *JobRequest) validateStrengthenProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.StrengthenProbability) { // not required
+		return nil
+	}
+
+	if m.StrengthenProbability != nil {
+		if err := m.StrengthenProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var jobRequestTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["affixProbability","affixValueProbability","strengthenProbability","batch"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jobRequestTypeTypePropEnum = append(jobRequestTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// JobRequestTypeAffixProbability captures enum value "affixProbability"
+	JobRequestTypeAffixProbability string = "affixProbability"
+
+	// JobRequestTypeAffixValueProbability captures enum value "affixValueProbability"
+	JobRequestTypeAffixValueProbability string = "affixValueProbability"
+
+	// JobRequestTypeStrengthenProbability captures enum value "strengthenProbability"
+	JobRequestTypeStrengthenProbability string = "strengthenProbability"
+
+	// JobRequestTypeBatch captures enum value "batch"
+	JobRequestTypeBatch string = "batch"
+)
+
+// prop value enum
+func (m *JobRequest) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, jobRequestTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *JobRequest) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this job request based on the context it is used
+func (m *JobRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAffixValueProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateItems(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStrengthenProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobRequest) contextValidateAffixProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixProbability != nil {
+
+		if swag.IsZero(m.AffixProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobRequest) contextValidateAffixValueProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixValueProbability != nil {
+
+		if swag.IsZero(m.AffixValueProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixValueProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobRequest) contextValidateItems(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Items); i++ {
+
+		if m.Items[i] != nil {
+
+			if swag.IsZero(m.Items[i]) { // not required
+				return nil
+			}
+
+			if err := m.Items[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("items" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *JobRequest) contextValidateStrengthenProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StrengthenProbability != nil {
+
+		if swag.IsZero(m.StrengthenProbability) { // not required
+			return nil
+		}
+
+		if err := m.StrengthenProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *JobRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *JobRequest) UnmarshalBinary(b []byte) error {
+	var res JobRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
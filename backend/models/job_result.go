@@ -0,0 +1,311 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// JobResult 任务结果，只包含与任务类型对应的字段
+//
+// swagger:model JobResult
+type JobResult struct {
+
+	// affix probability
+	AffixProbability *AffixProbabilityResponse `json:"affixProbability,omitempty"`
+
+	// affix value probability
+	AffixValueProbability *AffixValueProbabilityResponse `json:"affixValueProbability,omitempty"`
+
+	// id
+	// Example: 3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d
+	// Required: true
+	ID *string `json:"id"`
+
+	// 批量任务每个计算的结果，与请求的items顺序相同
+	Items []*JobItemResult `json:"items,omitempty"`
+
+	// strengthen probability
+	StrengthenProbability *StrengthenProbabilityResponse `json:"strengthenProbability,omitempty"`
+
+	// type
+	// Example: strengthenProbability
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this job result
+func (m *JobResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAffixProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAffixValueProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateItems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrengthenProbability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobResult) validateAffixProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixProbability) { // not required
+		return nil
+	}
+
+	if m.AffixProbability != nil {
+		if err := m.AffixProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobResult) validateAffixValueProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.AffixValueProbability) { // not required
+		return nil
+	}
+
+	if m.AffixValueProbability != nil {
+		if err := m.AffixValueProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobResult) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JobResult) validateItems(formats strfmt.Registry) error {
+	if swag.IsZero(m.Items) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Items); i++ {
+		if swag.IsZero(m.Items[i]) { // not required
+			continue
+		}
+
+		if m.Items[i] != nil {
+			if err := m.Items[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("items" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *JobResult) validateStrengthenProbability(formats strfmt.Registry) error {
+	if swag.IsZero(m.StrengthenProbability) { // not required
+		return nil
+	}
+
+	if m.StrengthenProbability != nil {
+		if err := m.StrengthenProbability.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobResult) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this job result based on the context it is used
+func (m *JobResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAffixProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAffixValueProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateItems(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStrengthenProbability(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *JobResult) contextValidateAffixProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixProbability != nil {
+
+		if swag.IsZero(m.AffixProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobResult) contextValidateAffixValueProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AffixValueProbability != nil {
+
+		if swag.IsZero(m.AffixValueProbability) { // not required
+			return nil
+		}
+
+		if err := m.AffixValueProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("affixValueProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("affixValueProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobResult) contextValidateItems(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Items); i++ {
+
+		if m.Items[i] != nil {
+
+			if swag.IsZero(m.Items[i]) { // not required
+				return nil
+			}
+
+			if err := m.Items[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("items" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *JobResult) contextValidateStrengthenProbability(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StrengthenProbability != nil {
+
+		if swag.IsZero(m.StrengthenProbability) { // not required
+			return nil
+		}
+
+		if err := m.StrengthenProbability.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("strengthenProbability")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("strengthenProbability")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *JobResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *JobResult) UnmarshalBinary(b []byte) error {
+	var res JobResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
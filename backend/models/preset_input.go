@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PresetInput targetAffixIds和targets至少提供一个，两者的词条合并保存
+//
+// swagger:model PresetInput
+type PresetInput struct {
+
+	// target affix ids
+	// Example: [4,5,6]
+	// Max Items: 10
+	TargetAffixIds []int32 `json:"targetAffixIds"`
+
+	// 以逗号分隔的目标词条，可以是ID、名称、拼音或preset:<预设名称>
+	// Example: 暴击伤害, 4
+	// Max Length: 200
+	Targets string `json:"targets,omitempty"`
+}
+
+// Validate validates this preset input
+func (m *PresetInput) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTargetAffixIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PresetInput) validateTargetAffixIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.TargetAffixIds) { // not required
+		return nil
+	}
+
+	iTargetAffixIdsSize := int64(len(m.TargetAffixIds))
+
+	if err := validate.MaxItems("targetAffixIds", "body", iTargetAffixIdsSize, 10); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PresetInput) validateTargets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Targets) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("targets", "body", m.Targets, 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this preset input based on context it is used
+func (m *PresetInput) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PresetInput) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PresetInput) UnmarshalBinary(b []byte) error {
+	var res PresetInput
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
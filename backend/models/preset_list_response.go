@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PresetListResponse preset list response
+//
+// swagger:model PresetListResponse
+type PresetListResponse struct {
+
+	// 依次为玩家、服务器和内置预设，同一范围内按名称排序
+	// Required: true
+	Presets []*Preset `json:"presets"`
+
+	// total
+	// Required: true
+	Total *int32 `json:"total"`
+}
+
+// Validate validates this preset list response
+func (m *PresetListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePresets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PresetListResponse) validatePresets(formats strfmt.Registry) error {
+
+	if err := validate.Required("presets", "body", m.Presets); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Presets); i++ {
+		if swag.IsZero(m.Presets[i]) { // not required
+			continue
+		}
+
+		if m.Presets[i] != nil {
+			if err := m.Presets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("presets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("presets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PresetListResponse) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this preset list response based on the context it is used
+func (m *PresetListResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePresets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PresetListResponse) contextValidatePresets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Presets); i++ {
+
+		if m.Presets[i] != nil {
+
+			if swag.IsZero(m.Presets[i]) { // not required
+				return nil
+			}
+
+			if err := m.Presets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("presets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("presets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PresetListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PresetListResponse) UnmarshalBinary(b []byte) error {
+	var res PresetListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
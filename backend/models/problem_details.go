@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ProblemDetails RFC 7807 问题详情
+//
+// swagger:model ProblemDetails
+type ProblemDetails struct {
+
+	// allowed
+	Allowed []string `json:"allowed,omitempty"`
+
+	// code
+	// Example: out_of_range
+	Code string `json:"code,omitempty"`
+
+	// detail
+	// Example: 词条数量必须在1-10之间
+	Detail string `json:"detail,omitempty"`
+
+	// field
+	// Example: slotCount
+	Field string `json:"field,omitempty"`
+
+	// instance
+	// Example: /api/v1/mod/affix/probability
+	Instance string `json:"instance,omitempty"`
+
+	// max
+	Max *int32 `json:"max,omitempty"`
+
+	// min
+	Min *int32 `json:"min,omitempty"`
+
+	// status
+	// Example: 400
+	// Required: true
+	Status *int32 `json:"status"`
+
+	// title
+	// Example: bad_request
+	// Required: true
+	Title *string `json:"title"`
+
+	// type
+	// Example: https://oncehuman.tools/problems/out_of_range
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this problem details
+func (m *ProblemDetails) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProblemDetails) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this problem details based on context it is used
+func (m *ProblemDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ProblemDetails) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ProblemDetails) UnmarshalBinary(b []byte) error {
+	var res ProblemDetails
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Rarity rarity
+//
+// swagger:model Rarity
+type Rarity struct {
+
+	// affix pool
+	// Example: [1,2,3,4,5,6,7,8,9,10]
+	AffixPool []int32 `json:"affixPool"`
+
+	// drop weight
+	// Example: 0.1
+	DropWeight float64 `json:"dropWeight,omitempty"`
+
+	// id
+	// Example: gold
+	// Required: true
+	ID *string `json:"id"`
+
+	// max enhancements
+	// Example: 5
+	MaxEnhancements int32 `json:"maxEnhancements,omitempty"`
+
+	// max level
+	// Example: 5
+	MaxLevel int32 `json:"maxLevel,omitempty"`
+
+	// max start level
+	// Example: 2
+	MaxStartLevel int32 `json:"maxStartLevel,omitempty"`
+
+	// min start level
+	// Example: 1
+	MinStartLevel int32 `json:"minStartLevel,omitempty"`
+
+	// name
+	// Example: 金色
+	// Required: true
+	Name *string `json:"name"`
+
+	// slot count
+	// Example: 4
+	SlotCount int32 `json:"slotCount,omitempty"`
+}
+
+// Validate validates this rarity
+func (m *Rarity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Rarity) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rarity) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rarity based on context it is used
+func (m *Rarity) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Rarity) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Rarity) UnmarshalBinary(b []byte) error {
+	var res Rarity
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RarityListResponse rarity list response
+//
+// swagger:model RarityListResponse
+type RarityListResponse struct {
+
+	// rarities
+	// Required: true
+	Rarities []*Rarity `json:"rarities"`
+
+	// total
+	// Example: 3
+	Total int32 `json:"total,omitempty"`
+}
+
+// Validate validates this rarity list response
+func (m *RarityListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRarities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RarityListResponse) validateRarities(formats strfmt.Registry) error {
+
+	if err := validate.Required("rarities", "body", m.Rarities); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Rarities); i++ {
+		if swag.IsZero(m.Rarities[i]) { // not required
+			continue
+		}
+
+		if m.Rarities[i] != nil {
+			if err := m.Rarities[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rarity list response based on the context it is used
+func (m *RarityListResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRarities(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RarityListResponse) contextValidateRarities(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Rarities); i++ {
+
+		if m.Rarities[i] != nil {
+
+			if swag.IsZero(m.Rarities[i]) { // not required
+				return nil
+			}
+
+			if err := m.Rarities[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RarityListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RarityListResponse) UnmarshalBinary(b []byte) error {
+	var res RarityListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
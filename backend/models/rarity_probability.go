@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RarityProbability rarity probability
+//
+// swagger:model RarityProbability
+type RarityProbability struct {
+
+	// name
+	// Example: 金色
+	Name string `json:"name,omitempty"`
+
+	// probability
+	// Example: 0.0048
+	Probability float64 `json:"probability,omitempty"`
+
+	// rarity
+	// Example: gold
+	Rarity string `json:"rarity,omitempty"`
+
+	// slot count
+	// Example: 4
+	SlotCount int32 `json:"slotCount,omitempty"`
+
+	// 词条概率为该稀有度的组合总数；强化概率为各掉落等级的强化结果数之和
+	// Example: 210
+	TotalCombinations int64 `json:"totalCombinations,omitempty"`
+
+	// 其中满足目标的组合数或强化结果数
+	// Example: 1
+	ValidCombinations int64 `json:"validCombinations,omitempty"`
+
+	// 归一化后的掉落权重
+	// Example: 0.1
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// Validate validates this rarity probability
+func (m *RarityProbability) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this rarity probability based on context it is used
+func (m *RarityProbability) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RarityProbability) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RarityProbability) UnmarshalBinary(b []byte) error {
+	var res RarityProbability
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,127 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ScrapSuggestion scrap suggestion
+//
+// swagger:model ScrapSuggestion
+type ScrapSuggestion struct {
+
+	// reason为dominated时更好的模组
+	BetterModID string `json:"betterModId,omitempty"`
+
+	// mod Id
+	// Required: true
+	ModID *string `json:"modId"`
+
+	// unreachable表示无法达到目标，dominated表示同类型中有概率和期望提升都不低于它的模组
+	// Required: true
+	// Enum: [unreachable dominated]
+	Reason *string `json:"reason"`
+}
+
+// Validate validates this scrap suggestion
+func (m *ScrapSuggestion) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateModID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ScrapSuggestion) validateModID(formats strfmt.Registry) error {
+
+	if err := validate.Required("modId", "body", m.ModID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var scrapSuggestionTypeReasonPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["unreachable","dominated"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		scrapSuggestionTypeReasonPropEnum = append(scrapSuggestionTypeReasonPropEnum, v)
+	}
+}
+
+const (
+
+	// ScrapSuggestionReasonUnreachable captures enum value "unreachable"
+	ScrapSuggestionReasonUnreachable string = "unreachable"
+
+	// ScrapSuggestionReasonDominated captures enum value "dominated"
+	ScrapSuggestionReasonDominated string = "dominated"
+)
+
+// prop value enum
+func (m *ScrapSuggestion) validateReasonEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, scrapSuggestionTypeReasonPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ScrapSuggestion) validateReason(formats strfmt.Registry) error {
+
+	if err := validate.Required("reason", "body", m.Reason); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateReasonEnum("reason", "body", *m.Reason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this scrap suggestion based on context it is used
+func (m *ScrapSuggestion) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ScrapSuggestion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ScrapSuggestion) UnmarshalBinary(b []byte) error {
+	var res ScrapSuggestion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
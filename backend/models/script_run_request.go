@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ScriptRunRequest script run request
+//
+// swagger:model ScriptRunRequest
+type ScriptRunRequest struct {
+
+	// 脚本中affix和strengthen使用的游戏版本，省略或latest时为最新版本
+	// Example: latest
+	GameVersion string `json:"gameVersion,omitempty"`
+
+	// 传给main的参数，省略时为空字典
+	// Example: {"k":4}
+	Input interface{} `json:"input,omitempty"`
+
+	// Starlark脚本源码，需要定义main(input)函数
+	// Example: def main(input):\n    return choose(10, input[\"k\"])\n
+	// Required: true
+	// Max Length: 65536
+	// Min Length: 1
+	Source *string `json:"source"`
+}
+
+// Validate validates this script run request
+func (m *ScriptRunRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ScriptRunRequest) validateSource(formats strfmt.Registry) error {
+
+	if err := validate.Required("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("source", "body", *m.Source, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("source", "body", *m.Source, 65536); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this script run request based on context it is used
+func (m *ScriptRunRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ScriptRunRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ScriptRunRequest) UnmarshalBinary(b []byte) error {
+	var res ScriptRunRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
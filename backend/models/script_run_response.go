@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ScriptRunResponse script run response
+//
+// swagger:model ScriptRunResponse
+type ScriptRunResponse struct {
+
+	// 脚本实际使用的游戏版本
+	// Example: 1.0
+	// Required: true
+	GameVersion *string `json:"gameVersion"`
+
+	// 脚本print的输出，最多100行
+	Output []string `json:"output"`
+
+	// main的返回值
+	// Example: 210
+	// Required: true
+	Result interface{} `json:"result"`
+
+	// 执行步数
+	// Example: 1024
+	// Required: true
+	Steps *int64 `json:"steps"`
+}
+
+// Validate validates this script run response
+func (m *ScriptRunResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGameVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ScriptRunResponse) validateGameVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("gameVersion", "body", m.GameVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScriptRunResponse) validateResult(formats strfmt.Registry) error {
+
+	if m.Result == nil {
+		return errors.Required("result", "body", nil)
+	}
+
+	return nil
+}
+
+func (m *ScriptRunResponse) validateSteps(formats strfmt.Registry) error {
+
+	if err := validate.Required("steps", "body", m.Steps); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this script run response based on context it is used
+func (m *ScriptRunResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ScriptRunResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ScriptRunResponse) UnmarshalBinary(b []byte) error {
+	var res ScriptRunResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
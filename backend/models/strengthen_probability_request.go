@@ -20,24 +20,31 @@ import (
 // swagger:model StrengthenProbabilityRequest
 type StrengthenProbabilityRequest struct {
 
-	// initial levels
+	// 游戏版本ID，不填或latest表示最新版本
+	// Example: latest
+	GameVersion string `json:"gameVersion,omitempty"`
+
+	// 初始等级，数量与稀有度的词条数量一致；指定稀有度时可不填，按掉落等级分布积分
 	// Example: [1,2,3,1]
-	// Required: true
-	// Max Items: 4
-	// Min Items: 4
 	InitialLevels []int32 `json:"initialLevels"`
 
 	// true表示顺序无关模式，false表示位置对应模式
 	OrderIndependent *bool `json:"orderIndependent,omitempty"`
 
+	// 稀有度ID，不填按金色规则计算，any表示按掉落权重积分所有稀有度
+	// Example: gold
+	Rarity string `json:"rarity,omitempty"`
+
 	// show paths
 	ShowPaths *bool `json:"showPaths,omitempty"`
 
+	// 同时返回强化概率表：单个词条从各初始等级强化到各目标等级的概率，导出CSV和Markdown时输出该表；只用于同步接口
+	Table *bool `json:"table,omitempty"`
+
 	// target levels
 	// Example: [3,4,5,2]
 	// Required: true
-	// Max Items: 4
-	// Min Items: 4
+	// Min Items: 1
 	TargetLevels []int32 `json:"targetLevels"`
 }
 
@@ -60,19 +67,8 @@ func (m *StrengthenProbabilityRequest) Validate(formats strfmt.Registry) error {
 }
 
 func (m *StrengthenProbabilityRequest) validateInitialLevels(formats strfmt.Registry) error {
-
-	if err := validate.Required("initialLevels", "body", m.InitialLevels); err != nil {
-		return err
-	}
-
-	iInitialLevelsSize := int64(len(m.InitialLevels))
-
-	if err := validate.MinItems("initialLevels", "body", iInitialLevelsSize, 4); err != nil {
-		return err
-	}
-
-	if err := validate.MaxItems("initialLevels", "body", iInitialLevelsSize, 4); err != nil {
-		return err
+	if swag.IsZero(m.InitialLevels) { // not required
+		return nil
 	}
 
 	for i := 0; i < len(m.InitialLevels); i++ {
@@ -81,10 +77,6 @@ func (m *StrengthenProbabilityRequest) validateInitialLevels(formats strfmt.Regi
 			return err
 		}
 
-		if err := validate.MaximumInt("initialLevels"+"."+strconv.Itoa(i), "body", int64(m.InitialLevels[i]), 5, false); err != nil {
-			return err
-		}
-
 	}
 
 	return nil
@@ -98,11 +90,7 @@ func (m *StrengthenProbabilityRequest) validateTargetLevels(formats strfmt.Regis
 
 	iTargetLevelsSize := int64(len(m.TargetLevels))
 
-	if err := validate.MinItems("targetLevels", "body", iTargetLevelsSize, 4); err != nil {
-		return err
-	}
-
-	if err := validate.MaxItems("targetLevels", "body", iTargetLevelsSize, 4); err != nil {
+	if err := validate.MinItems("targetLevels", "body", iTargetLevelsSize, 1); err != nil {
 		return err
 	}
 
@@ -112,10 +100,6 @@ func (m *StrengthenProbabilityRequest) validateTargetLevels(formats strfmt.Regis
 			return err
 		}
 
-		if err := validate.MaximumInt("targetLevels"+"."+strconv.Itoa(i), "body", int64(m.TargetLevels[i]), 5, false); err != nil {
-			return err
-		}
-
 	}
 
 	return nil
@@ -20,6 +20,10 @@ import (
 // swagger:model StrengthenProbabilityResponse
 type StrengthenProbabilityResponse struct {
 
+	// 计算使用的游戏版本
+	// Example: 1.0
+	GameVersion string `json:"gameVersion,omitempty"`
+
 	// paths
 	Paths []*StrengthenPath `json:"paths"`
 
@@ -33,11 +37,21 @@ type StrengthenProbabilityResponse struct {
 	// Required: true
 	ProbabilityPercent *float64 `json:"probabilityPercent"`
 
+	// rarity
+	// Example: gold
+	Rarity string `json:"rarity,omitempty"`
+
+	// rarity breakdown
+	RarityBreakdown []*RarityProbability `json:"rarityBreakdown"`
+
 	// successful outcomes
 	// Example: 768
 	// Required: true
 	SuccessfulOutcomes *int64 `json:"successfulOutcomes"`
 
+	// table
+	Table *StrengthenTable `json:"table,omitempty"`
+
 	// total outcomes
 	// Example: 1024
 	// Required: true
@@ -60,10 +74,18 @@ func (m *StrengthenProbabilityResponse) Validate(formats strfmt.Registry) error
 		res = append(res, err)
 	}
 
+	if err := m.validateRarityBreakdown(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSuccessfulOutcomes(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateTable(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTotalOutcomes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -118,6 +140,32 @@ func (m *StrengthenProbabilityResponse) validateProbabilityPercent(formats strfm
 	return nil
 }
 
+func (m *StrengthenProbabilityResponse) validateRarityBreakdown(formats strfmt.Registry) error {
+	if swag.IsZero(m.RarityBreakdown) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RarityBreakdown); i++ {
+		if swag.IsZero(m.RarityBreakdown[i]) { // not required
+			continue
+		}
+
+		if m.RarityBreakdown[i] != nil {
+			if err := m.RarityBreakdown[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *StrengthenProbabilityResponse) validateSuccessfulOutcomes(formats strfmt.Registry) error {
 
 	if err := validate.Required("successfulOutcomes", "body", m.SuccessfulOutcomes); err != nil {
@@ -127,6 +175,25 @@ func (m *StrengthenProbabilityResponse) validateSuccessfulOutcomes(formats strfm
 	return nil
 }
 
+func (m *StrengthenProbabilityResponse) validateTable(formats strfmt.Registry) error {
+	if swag.IsZero(m.Table) { // not required
+		return nil
+	}
+
+	if m.Table != nil {
+		if err := m.Table.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("table")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("table")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StrengthenProbabilityResponse) validateTotalOutcomes(formats strfmt.Registry) error {
 
 	if err := validate.Required("totalOutcomes", "body", m.TotalOutcomes); err != nil {
@@ -144,6 +211,14 @@ func (m *StrengthenProbabilityResponse) ContextValidate(ctx context.Context, for
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRarityBreakdown(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTable(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -175,6 +250,52 @@ func (m *StrengthenProbabilityResponse) contextValidatePaths(ctx context.Context
 	return nil
 }
 
+func (m *StrengthenProbabilityResponse) contextValidateRarityBreakdown(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.RarityBreakdown); i++ {
+
+		if m.RarityBreakdown[i] != nil {
+
+			if swag.IsZero(m.RarityBreakdown[i]) { // not required
+				return nil
+			}
+
+			if err := m.RarityBreakdown[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("rarityBreakdown" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StrengthenProbabilityResponse) contextValidateTable(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Table != nil {
+
+		if swag.IsZero(m.Table) { // not required
+			return nil
+		}
+
+		if err := m.Table.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("table")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("table")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *StrengthenProbabilityResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,89 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StrengthenTableRow strengthen table row
+//
+// swagger:model StrengthenTableRow
+type StrengthenTableRow struct {
+
+	// initial level
+	// Example: 1
+	// Required: true
+	InitialLevel *int32 `json:"initialLevel"`
+
+	// 用全部强化次数强化到不低于各目标等级的概率
+	// Required: true
+	Probabilities []float64 `json:"probabilities"`
+}
+
+// Validate validates this strengthen table row
+func (m *StrengthenTableRow) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInitialLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbabilities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StrengthenTableRow) validateInitialLevel(formats strfmt.Registry) error {
+
+	if err := validate.Required("initialLevel", "body", m.InitialLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StrengthenTableRow) validateProbabilities(formats strfmt.Registry) error {
+
+	if err := validate.Required("probabilities", "body", m.Probabilities); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this strengthen table row based on context it is used
+func (m *StrengthenTableRow) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StrengthenTableRow) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StrengthenTableRow) UnmarshalBinary(b []byte) error {
+	var res StrengthenTableRow
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -37,10 +38,18 @@ type Tool struct {
 	// Required: true
 	ID *string `json:"id"`
 
+	// 工具输入的JSON Schema，内置工具为对应计算接口的请求体
+	InputSchema interface{} `json:"inputSchema,omitempty"`
+
 	// name
 	// Example: 模组词条概率计算器
 	// Required: true
 	Name *string `json:"name"`
+
+	// 工具来源，builtin为内置工具，plugin为插件目录中的外部插件，script为脚本目录中的Starlark脚本
+	// Example: builtin
+	// Enum: [builtin plugin script]
+	Source string `json:"source,omitempty"`
 }
 
 // Validate validates this tool
@@ -59,6 +68,10 @@ func (m *Tool) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -92,6 +105,51 @@ func (m *Tool) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+var toolTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["builtin","plugin","script"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		toolTypeSourcePropEnum = append(toolTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// ToolSourceBuiltin captures enum value "builtin"
+	ToolSourceBuiltin string = "builtin"
+
+	// ToolSourcePlugin captures enum value "plugin"
+	ToolSourcePlugin string = "plugin"
+
+	// ToolSourceScript captures enum value "script"
+	ToolSourceScript string = "script"
+)
+
+// prop value enum
+func (m *Tool) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, toolTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Tool) validateSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this tool based on context it is used
 func (m *Tool) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
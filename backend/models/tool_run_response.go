@@ -0,0 +1,89 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ToolRunResponse tool run response
+//
+// swagger:model ToolRunResponse
+type ToolRunResponse struct {
+
+	// 工具的运行结果，内置工具为对应计算接口的响应体
+	// Required: true
+	Result interface{} `json:"result"`
+
+	// tool Id
+	// Example: affix-probability
+	// Required: true
+	ToolID *string `json:"toolId"`
+}
+
+// Validate validates this tool run response
+func (m *ToolRunResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateToolID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ToolRunResponse) validateResult(formats strfmt.Registry) error {
+
+	if m.Result == nil {
+		return errors.Required("result", "body", nil)
+	}
+
+	return nil
+}
+
+func (m *ToolRunResponse) validateToolID(formats strfmt.Registry) error {
+
+	if err := validate.Required("toolId", "body", m.ToolID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this tool run response based on context it is used
+func (m *ToolRunResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ToolRunResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ToolRunResponse) UnmarshalBinary(b []byte) error {
+	var res ToolRunResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -20,8 +20,8 @@ import (
 // swagger:model ToolsListResponse
 type ToolsListResponse struct {
 
-	// categories
-	// Example: ["mod","weapon","character"]
+	// 启用的工具所属的分类，按首次出现的顺序排列
+	// Example: ["mod"]
 	Categories []string `json:"categories"`
 
 	// tools
@@ -0,0 +1,365 @@
+// Package calc OnceHuman工具集的计算库，提供与HTTP接口相同的词条概率、词条数值概率、强化概率和背包分析计算，
+// 以及各游戏版本的词条、稀有度和预设目录
+//
+// 本包和pkg/client、pkg/i18n、pkg/logging是对外支持的接口，backend/internal下的包只供服务内部使用，可能随时变化；
+// 本包的类型与内部类型分开定义，内部实现变化时保持不变。计算不使用服务的结果缓存；ctx取消时停止计算并返回ctx的错误。
+package calc
+
+import (
+	"context"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// 建议分解的原因
+const (
+	ScrapUnreachable = "unreachable" // 用剩余强化次数无法达到目标
+	ScrapDominated   = "dominated"   // 同类型中有概率和期望提升都不低于它的模组
+)
+
+// AffixProbabilityRequest 词条概率计算参数
+type AffixProbabilityRequest struct {
+	GameVersion      string // 游戏版本，为空或latest时使用最新版本
+	SlotCount        int    // 词条数量，Rarity为空时必填
+	Rarity           string // 稀有度，any按掉落权重对所有稀有度积分
+	TargetAffixIDs   []int  // 目标词条
+	ShowCombinations bool   // 是否返回满足条件的组合
+}
+
+// AffixProbabilityResult 词条概率计算结果
+type AffixProbabilityResult struct {
+	Probability        float64             `json:"probability"`
+	ProbabilityPercent float64             `json:"probabilityPercent"`
+	TotalCombinations  int64               `json:"totalCombinations"`
+	ValidCombinations  int64               `json:"validCombinations"`
+	SlotCount          int                 `json:"slotCount"`
+	TargetRange        []int               `json:"targetRange"`
+	Combinations       [][]int             `json:"combinations,omitempty"`
+	Rarity             string              `json:"rarity,omitempty"`
+	RarityBreakdown    []RarityProbability `json:"rarityBreakdown,omitempty"`
+	GameVersion        string              `json:"gameVersion,omitempty"`
+}
+
+// RarityProbability 按稀有度计算时各稀有度的概率
+type RarityProbability struct {
+	Rarity            string  `json:"rarity"`
+	Name              string  `json:"name"`
+	Weight            float64 `json:"weight"`
+	SlotCount         int     `json:"slotCount"`
+	Probability       float64 `json:"probability"`
+	TotalCombinations int64   `json:"totalCombinations,omitempty"`
+	ValidCombinations int64   `json:"validCombinations,omitempty"`
+}
+
+// AffixProbability 计算抽取的词条全部在目标词条中的概率
+func AffixProbability(ctx context.Context, req AffixProbabilityRequest) (*AffixProbabilityResult, error) {
+	result, err := services.NewAffixProbabilityService().CalculateProbability(ctx, req.GameVersion,
+		req.SlotCount, req.Rarity, req.TargetAffixIDs, req.ShowCombinations)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &AffixProbabilityResult{
+		Probability:        result.Probability,
+		ProbabilityPercent: result.ProbabilityPercent,
+		TotalCombinations:  result.TotalCombinations,
+		ValidCombinations:  result.ValidCombinations,
+		SlotCount:          result.SlotCount,
+		TargetRange:        result.TargetRange,
+		Combinations:       result.Combinations,
+		Rarity:             result.Rarity,
+		RarityBreakdown:    convertRarityProbabilities(result.RarityBreakdown),
+		GameVersion:        result.GameVersion,
+	}, nil
+}
+
+// AffixValueRequirement 目标词条的数值要求
+type AffixValueRequirement struct {
+	AffixID  int     `json:"affixId"`
+	MinValue float64 `json:"minValue,omitempty"` // 数值下限，0表示不限制
+	MinTier  int     `json:"minTier,omitempty"`  // 档位下限，0表示不限制
+	TopTier  bool    `json:"topTier,omitempty"`  // 是否要求最高档位
+}
+
+// AffixValueProbabilityRequest 词条数值概率计算参数
+type AffixValueProbabilityRequest struct {
+	GameVersion  string
+	SlotCount    int // 词条数量，0表示只计算数值概率（假定词条已出现）
+	Level        int // 词条等级
+	Requirements []AffixValueRequirement
+}
+
+// AffixValueProbabilityResult 词条数值概率计算结果
+type AffixValueProbabilityResult struct {
+	Probability        float64            `json:"probability"`
+	ProbabilityPercent float64            `json:"probabilityPercent"`
+	AppearProbability  float64            `json:"appearProbability"`
+	ValueProbability   float64            `json:"valueProbability"`
+	SlotCount          int                `json:"slotCount"`
+	Level              int                `json:"level"`
+	Details            []AffixValueDetail `json:"details"`
+	GameVersion        string             `json:"gameVersion,omitempty"`
+}
+
+// AffixValueDetail 单个词条的数值概率
+type AffixValueDetail struct {
+	AffixID     int              `json:"affixId"`
+	MinValue    float64          `json:"minValue,omitempty"`
+	MinTier     int              `json:"minTier,omitempty"`
+	Probability float64          `json:"probability"`
+	Range       *AffixValueRange `json:"range"`
+}
+
+// AffixValueProbability 计算词条出现且数值满足要求的概率
+func AffixValueProbability(ctx context.Context, req AffixValueProbabilityRequest) (*AffixValueProbabilityResult, error) {
+	requirements := make([]services.AffixValueRequirement, len(req.Requirements))
+	for i, r := range req.Requirements {
+		requirements[i] = services.AffixValueRequirement{AffixID: r.AffixID, MinValue: r.MinValue, MinTier: r.MinTier, TopTier: r.TopTier}
+	}
+	result, err := services.NewAffixValueProbabilityService().CalculateProbability(ctx, req.GameVersion,
+		req.SlotCount, req.Level, requirements)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	details := make([]AffixValueDetail, len(result.Details))
+	for i, d := range result.Details {
+		details[i] = AffixValueDetail{
+			AffixID:     d.AffixID,
+			MinValue:    d.MinValue,
+			MinTier:     d.MinTier,
+			Probability: d.Probability,
+			Range:       convertAffixValueRange(d.Range),
+		}
+	}
+	return &AffixValueProbabilityResult{
+		Probability:        result.Probability,
+		ProbabilityPercent: result.ProbabilityPercent,
+		AppearProbability:  result.AppearProbability,
+		ValueProbability:   result.ValueProbability,
+		SlotCount:          result.SlotCount,
+		Level:              result.Level,
+		Details:            details,
+		GameVersion:        result.GameVersion,
+	}, nil
+}
+
+// StrengthenProbabilityRequest 强化概率计算参数
+type StrengthenProbabilityRequest struct {
+	GameVersion      string
+	InitialLevels    []int  // 初始等级，Rarity为any或指定稀有度且为空时按掉落时的初始等级分布计算
+	TargetLevels     []int  // 目标等级
+	Rarity           string // 稀有度，为空时按金色模组规则计算
+	OrderIndependent bool   // 目标等级是否与词条位置无关
+	ShowPaths        bool   // 是否返回强化路径
+}
+
+// StrengthenProbabilityResult 强化概率计算结果
+type StrengthenProbabilityResult struct {
+	Probability        float64             `json:"probability"`
+	ProbabilityPercent float64             `json:"probabilityPercent"`
+	SuccessfulOutcomes int64               `json:"successfulOutcomes"`
+	TotalOutcomes      int64               `json:"totalOutcomes"`
+	Paths              []StrengthenPath    `json:"paths,omitempty"`
+	Rarity             string              `json:"rarity,omitempty"`
+	RarityBreakdown    []RarityProbability `json:"rarityBreakdown,omitempty"`
+	GameVersion        string              `json:"gameVersion,omitempty"`
+}
+
+// StrengthenPath 一条强化路径
+type StrengthenPath struct {
+	Success     bool             `json:"success"`
+	FinalLevels []int            `json:"finalLevels"`
+	Steps       []StrengthenStep `json:"steps"`
+}
+
+// StrengthenStep 强化路径中的一次强化
+type StrengthenStep struct {
+	Step     int `json:"step"`
+	Slot     int `json:"slot"`
+	NewLevel int `json:"newLevel"`
+}
+
+// StrengthenProbability 计算强化到目标等级的概率
+func StrengthenProbability(ctx context.Context, req StrengthenProbabilityRequest) (*StrengthenProbabilityResult, error) {
+	result, err := services.NewStrengthenProbabilityService().CalculateProbability(ctx, req.GameVersion,
+		req.InitialLevels, req.TargetLevels, req.Rarity, req.OrderIndependent, req.ShowPaths)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	var paths []StrengthenPath
+	for _, p := range result.Paths {
+		steps := make([]StrengthenStep, len(p.Steps))
+		for i, s := range p.Steps {
+			steps[i] = StrengthenStep{Step: s.Step, Slot: s.Slot, NewLevel: s.NewLevel}
+		}
+		paths = append(paths, StrengthenPath{Success: p.Success, FinalLevels: p.FinalLevels, Steps: steps})
+	}
+	return &StrengthenProbabilityResult{
+		Probability:        result.Probability,
+		ProbabilityPercent: result.ProbabilityPercent,
+		SuccessfulOutcomes: result.SuccessfulOutcomes,
+		TotalOutcomes:      result.TotalOutcomes,
+		Paths:              paths,
+		Rarity:             result.Rarity,
+		RarityBreakdown:    convertRarityProbabilities(result.RarityBreakdown),
+		GameVersion:        result.GameVersion,
+	}, nil
+}
+
+// StrengthenTable 强化概率表
+type StrengthenTable struct {
+	GameVersion   string
+	Rarity        string
+	Levels        []int       // 表头等级，从1到稀有度的最高等级
+	Probabilities [][]float64 // Probabilities[i][j]为初始等级Levels[i]强化到目标等级Levels[j]的概率
+}
+
+// StrengthenProbabilityTable 计算稀有度的强化概率表：各初始等级强化到各目标等级的概率
+func StrengthenProbabilityTable(ctx context.Context, gameVersion, rarity string) (*StrengthenTable, error) {
+	table, err := services.NewStrengthenProbabilityService().CalculateTable(ctx, gameVersion, rarity)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &StrengthenTable{
+		GameVersion:   table.GameVersion,
+		Rarity:        table.Rarity,
+		Levels:        table.Levels,
+		Probabilities: table.Probabilities,
+	}, nil
+}
+
+// StrengthenOutcomesRequest 强化结果分布计算参数
+type StrengthenOutcomesRequest struct {
+	GameVersion      string
+	Rarity           string // 稀有度，为空时按金色模组规则计算
+	Levels           []int  // 各词条的当前等级
+	EnhancementsUsed int    // 已用的强化次数
+}
+
+// StrengthenOutcome 用完强化次数后的一种等级组合及其概率
+type StrengthenOutcome struct {
+	Levels      []int   `json:"levels"`
+	Probability float64 `json:"probability"`
+}
+
+// StrengthenOutcomes 计算用完剩余强化次数后的等级分布，按概率从高到低排列
+func StrengthenOutcomes(ctx context.Context, req StrengthenOutcomesRequest) ([]StrengthenOutcome, error) {
+	outcomes, err := services.NewStrengthenProbabilityService().CalculateOutcomes(ctx, req.GameVersion,
+		req.Rarity, req.Levels, req.EnhancementsUsed)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	result := make([]StrengthenOutcome, len(outcomes))
+	for i, o := range outcomes {
+		result[i] = StrengthenOutcome{Levels: o.Levels, Probability: o.Probability}
+	}
+	return result, nil
+}
+
+// InventoryMod 背包中的模组
+type InventoryMod struct {
+	ID               string
+	Type             string
+	Rarity           string
+	AffixIDs         []int
+	Levels           []int
+	EnhancementsUsed int
+}
+
+// InventoryTarget 背包分析的目标
+type InventoryTarget struct {
+	TargetLevels     []int // 目标等级，5/5/x/x为[5, 5]
+	AffixIDs         []int // 计入目标和期望提升的词条，为空表示全部词条
+	OrderIndependent bool  // 目标等级可以由任意计入的词条达到
+}
+
+// InventoryAnalysis 背包分析结果
+type InventoryAnalysis struct {
+	GameVersion string
+	Mods        []ModOutlook // 按达成概率从高到低，概率相同时期望提升高的在前
+	Scrap       []ScrapSuggestion
+}
+
+// ModOutlook 模组用剩余强化次数的前景
+type ModOutlook struct {
+	ModID            string
+	EnhancementsLeft int
+	Probability      float64   // 达到目标等级的概率
+	ExpectedLevels   []float64 // 各词条强化后的期望等级
+	ExpectedGain     float64   // 计入目标的词条期望提升的等级之和
+}
+
+// ScrapSuggestion 分解建议
+type ScrapSuggestion struct {
+	ModID       string
+	Reason      string // ScrapUnreachable或ScrapDominated
+	BetterModID string // Reason为dominated时更好的模组
+}
+
+// ValidateInventoryMod 按游戏版本的稀有度规则校验模组的词条、等级和已强化次数
+func ValidateInventoryMod(gameVersion string, mod InventoryMod) error {
+	return convertError(services.ValidateInventoryMod(gameVersion, mod.internal()))
+}
+
+// AnalyzeInventory 计算背包中各模组用剩余强化次数达到目标的概率和期望提升，并给出分解建议
+func AnalyzeInventory(ctx context.Context, gameVersion string, mods []InventoryMod, target InventoryTarget) (*InventoryAnalysis, error) {
+	internalMods := make([]services.InventoryMod, len(mods))
+	for i, mod := range mods {
+		internalMods[i] = mod.internal()
+	}
+	analysis, err := services.NewStrengthenProbabilityService().AnalyzeInventory(ctx, gameVersion, internalMods, services.InventoryTarget{
+		TargetLevels:     target.TargetLevels,
+		AffixIDs:         target.AffixIDs,
+		OrderIndependent: target.OrderIndependent,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	result := &InventoryAnalysis{GameVersion: analysis.GameVersion}
+	for _, m := range analysis.Mods {
+		result.Mods = append(result.Mods, ModOutlook{
+			ModID:            m.ModID,
+			EnhancementsLeft: m.EnhancementsLeft,
+			Probability:      m.Probability,
+			ExpectedLevels:   m.ExpectedLevels,
+			ExpectedGain:     m.ExpectedGain,
+		})
+	}
+	for _, s := range analysis.Scrap {
+		result.Scrap = append(result.Scrap, ScrapSuggestion{ModID: s.ModID, Reason: s.Reason, BetterModID: s.BetterModID})
+	}
+	return result, nil
+}
+
+// internal 转换为内部的模组类型
+func (m InventoryMod) internal() services.InventoryMod {
+	return services.InventoryMod{
+		ID:               m.ID,
+		Type:             m.Type,
+		Rarity:           m.Rarity,
+		AffixIDs:         m.AffixIDs,
+		Levels:           m.Levels,
+		EnhancementsUsed: m.EnhancementsUsed,
+	}
+}
+
+func convertRarityProbabilities(breakdown []services.RarityProbability) []RarityProbability {
+	if breakdown == nil {
+		return nil
+	}
+	result := make([]RarityProbability, len(breakdown))
+	for i, r := range breakdown {
+		result[i] = RarityProbability{
+			Rarity:            r.Rarity,
+			Name:              r.Name,
+			Weight:            r.Weight,
+			SlotCount:         r.SlotCount,
+			Probability:       r.Probability,
+			TotalCombinations: r.TotalCombinations,
+			ValidCombinations: r.ValidCombinations,
+		}
+	}
+	return result
+}
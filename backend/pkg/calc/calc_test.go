@@ -0,0 +1,101 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+func TestAffixProbabilityMatchesService(t *testing.T) {
+	ctx := context.Background()
+	targets := []int{1, 2, 3, 4, 5, 6}
+	for _, slots := range []int{1, 3, 4} {
+		got, err := AffixProbability(ctx, AffixProbabilityRequest{SlotCount: slots, TargetAffixIDs: targets})
+		if err != nil {
+			t.Fatalf("slots %d: %v", slots, err)
+		}
+		want, err := services.NewAffixProbabilityService().CalculateProbability(ctx, "", slots, "", targets, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Probability != want.Probability || got.TotalCombinations != want.TotalCombinations ||
+			got.ValidCombinations != want.ValidCombinations || got.GameVersion != want.GameVersion {
+			t.Errorf("slots %d: got %+v, want %+v", slots, got, want)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode string
+		wantErr  error
+	}{
+		{"unknown game version", func() error {
+			_, err := Affixes("0.0")
+			return err
+		}, ErrCodeUnknownGameVersion, nil},
+		{"out of range", func() error {
+			_, err := AffixProbability(context.Background(), AffixProbabilityRequest{SlotCount: 99, TargetAffixIDs: []int{1}})
+			return err
+		}, ErrCodeOutOfRange, nil},
+		{"unknown affix", func() error {
+			_, err := ResolveTargets(context.Background(), "不存在的词条", nil)
+			return err
+		}, ErrCodeAffixNotFound, nil},
+		{"lookup error", func() error {
+			_, err := ResolveTargets(context.Background(), PresetPrefix+"x", func(context.Context, string) ([]int, bool, error) {
+				return nil, false, context.DeadlineExceeded
+			})
+			return err
+		}, "", context.DeadlineExceeded},
+		{"canceled", func() error {
+			_, err := StrengthenProbability(canceled, StrengthenProbabilityRequest{InitialLevels: []int{1, 1, 1, 1}, TargetLevels: []int{5, 5, 1, 1}})
+			return err
+		}, "", context.Canceled},
+	}
+	for _, tt := range tests {
+		err := tt.call()
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: err = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		var calcErr *Error
+		if !errors.As(err, &calcErr) {
+			t.Errorf("%s: err = %#v, want *calc.Error", tt.name, err)
+			continue
+		}
+		if calcErr.ErrorCode() != tt.wantCode {
+			t.Errorf("%s: code = %s, want %s", tt.name, calcErr.ErrorCode(), tt.wantCode)
+		}
+		if calcErr.Localize("en") == "" || calcErr.Localize("en") == calcErr.Localize("zh-CN") {
+			t.Errorf("%s: message not localized: %q", tt.name, calcErr.Localize("en"))
+		}
+	}
+}
+
+func TestCatalogCopies(t *testing.T) {
+	rarities, err := Rarities(GameVersionLatest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rarities[0].AffixPool[0] = -1
+	again, _ := Rarities(GameVersionLatest)
+	if again[0].AffixPool[0] == -1 {
+		t.Error("modifying a returned rarity changed the catalog")
+	}
+}
+
+func TestScrapReasons(t *testing.T) {
+	if ScrapUnreachable != services.ScrapUnreachable || ScrapDominated != services.ScrapDominated {
+		t.Error("scrap reasons differ from the service")
+	}
+}
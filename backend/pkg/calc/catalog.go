@@ -0,0 +1,217 @@
+package calc
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// Affix 词条，名称和描述为中文，Localize按语言输出
+type Affix struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Category    string   `json:"category,omitempty"`
+	Pinyin      string   `json:"pinyin,omitempty"`  // 名称拼音，音节以空格分隔
+	Aliases     []string `json:"aliases,omitempty"` // 英文别名
+}
+
+// AffixCategory 词条分类
+type AffixCategory string
+
+// 词条分类
+const (
+	AffixCategoryDamage  AffixCategory = "damage"  // 伤害类
+	AffixCategoryDefense AffixCategory = "defense" // 防御类
+	AffixCategoryUtility AffixCategory = "utility" // 功能类
+)
+
+// Rarity 模组稀有度
+type Rarity struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	DropWeight      float64 `json:"dropWeight"`      // 掉落权重
+	SlotCount       int     `json:"slotCount"`       // 词条数量
+	AffixPool       []int   `json:"affixPool"`       // 可出现的词条ID
+	MinStartLevel   int     `json:"minStartLevel"`   // 掉落时词条最低等级
+	MaxStartLevel   int     `json:"maxStartLevel"`   // 掉落时词条最高等级
+	MaxLevel        int     `json:"maxLevel"`        // 词条最高等级
+	MaxEnhancements int     `json:"maxEnhancements"` // 强化次数
+}
+
+// Preset 内置目标词条预设
+type Preset struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Targets []int  `json:"targets"` // 目标词条ID
+}
+
+// GameVersion 游戏版本
+type GameVersion struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Notes       string `json:"notes,omitempty"`
+}
+
+// AffixValueRange 词条在某一等级的数值范围和档位
+type AffixValueRange struct {
+	AffixID int              `json:"affixId"`
+	Level   int              `json:"level"`
+	Unit    string           `json:"unit,omitempty"`
+	Min     float64          `json:"min"`
+	Max     float64          `json:"max"`
+	Tiers   []AffixValueTier `json:"tiers"`
+}
+
+// AffixValueTier 数值档位
+type AffixValueTier struct {
+	Tier   int     `json:"tier"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Weight float64 `json:"weight"`
+}
+
+const (
+	RarityAny         = models.RarityAny         // 任意稀有度，按掉落权重对所有稀有度积分
+	RarityGold        = models.RarityGold        // 金色稀有度，未指定稀有度时的强化规则
+	GameVersionLatest = models.GameVersionLatest // 最新游戏版本
+	PresetPrefix      = models.PresetPrefix      // 目标词条中引用预设的前缀，preset:<名称>
+)
+
+// GameVersions 获取全部游戏版本，按发布顺序排列
+func GameVersions() []GameVersion {
+	versions := models.GetGameVersions()
+	result := make([]GameVersion, len(versions))
+	for i, v := range versions {
+		result[i] = convertGameVersion(v)
+	}
+	return result
+}
+
+// Affixes 获取游戏版本的全部词条，游戏版本不存在时返回错误
+func Affixes(gameVersion string) ([]Affix, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, convertError(services.NewUnknownGameVersionError(gameVersion))
+	}
+	affixes := catalog.Affixes()
+	result := make([]Affix, len(affixes))
+	for i, a := range affixes {
+		result[i] = convertAffix(a)
+	}
+	return result, nil
+}
+
+// Rarities 获取游戏版本的全部稀有度，按稀有度从高到低排列
+func Rarities(gameVersion string) ([]Rarity, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, convertError(services.NewUnknownGameVersionError(gameVersion))
+	}
+	rarities := catalog.Rarities()
+	result := make([]Rarity, len(rarities))
+	for i, r := range rarities {
+		result[i] = convertRarity(r)
+	}
+	return result, nil
+}
+
+// Presets 获取游戏版本的内置目标词条预设
+func Presets(gameVersion string) ([]Preset, error) {
+	catalog := models.GetCatalog(gameVersion)
+	if catalog == nil {
+		return nil, convertError(services.NewUnknownGameVersionError(gameVersion))
+	}
+	presets := catalog.Presets()
+	result := make([]Preset, len(presets))
+	for i, p := range presets {
+		result[i] = convertPreset(p)
+	}
+	return result, nil
+}
+
+// AffixName 获取最新游戏版本中词条的本地化名称，词条不存在时返回空字符串
+func AffixName(id int, locale string) string {
+	return models.GetAffixName(id, locale)
+}
+
+// RarityName 获取最新游戏版本中稀有度的本地化名称，稀有度不存在时返回ID
+func RarityName(id, locale string) string {
+	return models.GetRarityName(id, locale)
+}
+
+// AffixCategoryName 获取词条分类的本地化名称
+func AffixCategoryName(category AffixCategory, locale string) string {
+	return models.LocalizeAffixCategory(models.AffixCategory(category), locale)
+}
+
+// Localize 返回指定语言的词条副本，缺少翻译时沿用目录定义
+func (a Affix) Localize(locale string) Affix {
+	localized := models.Affix{ID: a.ID, Name: a.Name, Description: a.Description}.Localize(locale)
+	a.Name, a.Description = localized.Name, localized.Description
+	return a
+}
+
+// Localize 返回指定语言的稀有度副本，缺少翻译时沿用目录定义
+func (r Rarity) Localize(locale string) Rarity {
+	r.Name = models.Rarity{ID: r.ID, Name: r.Name}.Localize(locale).Name
+	return r
+}
+
+// Localize 返回指定语言的预设副本，缺少翻译时沿用目录定义
+func (p Preset) Localize(locale string) Preset {
+	p.Name = models.Preset{ID: p.ID, Name: p.Name}.Localize(locale).Name
+	return p
+}
+
+// Localize 返回指定语言的游戏版本副本，缺少翻译时沿用版本定义
+func (v GameVersion) Localize(locale string) GameVersion {
+	localized := models.GameVersion{ID: v.ID, Name: v.Name, Notes: v.Notes}.Localize(locale)
+	v.Name, v.Notes = localized.Name, localized.Notes
+	return v
+}
+
+// convertAffix 转换目录中的词条，切片复制一份，调用方修改时不影响目录
+func convertAffix(a models.Affix) Affix {
+	return Affix{
+		ID:          a.ID,
+		Name:        a.Name,
+		Description: a.Description,
+		Category:    a.Category,
+		Pinyin:      a.Pinyin,
+		Aliases:     append([]string(nil), a.Aliases...),
+	}
+}
+
+func convertRarity(r models.Rarity) Rarity {
+	return Rarity{
+		ID:              r.ID,
+		Name:            r.Name,
+		DropWeight:      r.DropWeight,
+		SlotCount:       r.SlotCount,
+		AffixPool:       append([]int(nil), r.AffixPool...),
+		MinStartLevel:   r.MinStartLevel,
+		MaxStartLevel:   r.MaxStartLevel,
+		MaxLevel:        r.MaxLevel,
+		MaxEnhancements: r.MaxEnhancements,
+	}
+}
+
+func convertPreset(p models.Preset) Preset {
+	return Preset{ID: p.ID, Name: p.Name, Targets: append([]int(nil), p.Targets...)}
+}
+
+func convertGameVersion(v models.GameVersion) GameVersion {
+	return GameVersion{ID: v.ID, Name: v.Name, ReleaseDate: v.ReleaseDate, Notes: v.Notes}
+}
+
+func convertAffixValueRange(r *models.AffixValueRange) *AffixValueRange {
+	if r == nil {
+		return nil
+	}
+	tiers := make([]AffixValueTier, len(r.Tiers))
+	for i, t := range r.Tiers {
+		tiers[i] = AffixValueTier{Tier: t.Tier, Min: t.Min, Max: t.Max, Weight: t.Weight}
+	}
+	return &AffixValueRange{AffixID: r.AffixID, Level: r.Level, Unit: r.Unit, Min: r.Min, Max: r.Max, Tiers: tiers}
+}
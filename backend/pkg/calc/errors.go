@@ -0,0 +1,104 @@
+package calc
+
+import (
+	"errors"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+)
+
+// Error 计算和HTTP接口的错误，包含错误码、出错字段、允许范围和可本地化的消息，用errors.As获取
+type Error struct {
+	Code    string        `json:"code"`
+	Field   string        `json:"field,omitempty"`
+	Min     *int          `json:"min,omitempty"`
+	Max     *int          `json:"max,omitempty"`
+	Allowed []string      `json:"allowed,omitempty"`
+	Message *i18n.Message `json:"-"`
+}
+
+// NewError 创建可本地化的错误，key为i18n消息的键，args为消息参数
+func NewError(code, field, key string, args ...interface{}) *Error {
+	return &Error{Code: code, Field: field, Message: i18n.NewMessage(key, args...)}
+}
+
+// Error 实现error接口，按默认语言输出
+func (e *Error) Error() string {
+	return e.Message.Error()
+}
+
+// Localize 按指定语言输出错误消息
+func (e *Error) Localize(locale string) string {
+	return e.Message.Localize(locale)
+}
+
+// ErrorCode 获取错误码
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// convertError 转换计算返回的服务错误，其余错误（如ctx的错误）原样返回
+func convertError(err error) error {
+	var serviceErr *services.Error
+	if !errors.As(err, &serviceErr) {
+		return err
+	}
+	return &Error{
+		Code:    serviceErr.Code,
+		Field:   serviceErr.Field,
+		Min:     serviceErr.Min,
+		Max:     serviceErr.Max,
+		Allowed: serviceErr.Allowed,
+		Message: serviceErr.Message,
+	}
+}
+
+// 错误码，与HTTP接口ErrorResponse.code相同，调用方按错误码而不是消息文本处理错误
+const (
+	ErrCodeOutOfRange         = services.ErrCodeOutOfRange
+	ErrCodeInvalidCount       = services.ErrCodeInvalidCount
+	ErrCodeRequired           = services.ErrCodeRequired
+	ErrCodeDuplicate          = services.ErrCodeDuplicate
+	ErrCodeNoValidTargets     = services.ErrCodeNoValidTargets
+	ErrCodeUnknownRarity      = services.ErrCodeUnknownRarity
+	ErrCodeUnknownGameVersion = services.ErrCodeUnknownGameVersion
+	ErrCodeUnknownCategory    = services.ErrCodeUnknownCategory
+	ErrCodeRarityRequired     = services.ErrCodeRarityRequired
+	ErrCodeTargetBelowInitial = services.ErrCodeTargetBelowInitial
+	ErrCodeAffixNotFound      = services.ErrCodeAffixNotFound
+	ErrCodeAffixAmbiguous     = services.ErrCodeAffixAmbiguous
+	ErrCodeRateLimited        = services.ErrCodeRateLimited
+	ErrCodeJobNotFound        = services.ErrCodeJobNotFound
+	ErrCodeJobQueueFull       = services.ErrCodeJobQueueFull
+	ErrCodeJobNotFinished     = services.ErrCodeJobNotFinished
+	ErrCodeJobFailed          = services.ErrCodeJobFailed
+	ErrCodeJobCanceled        = services.ErrCodeJobCanceled
+	ErrCodeJobTimeout         = services.ErrCodeJobTimeout
+	ErrCodeAPIKeyRequired     = services.ErrCodeAPIKeyRequired
+	ErrCodeInvalidAPIKey      = services.ErrCodeInvalidAPIKey
+	ErrCodeInsufficientScope  = services.ErrCodeInsufficientScope
+	ErrCodeQuotaExceeded      = services.ErrCodeQuotaExceeded
+	ErrCodeShuttingDown       = services.ErrCodeShuttingDown
+	ErrCodeShareNotFound      = services.ErrCodeShareNotFound
+	ErrCodeStorageUnavailable = services.ErrCodeStorageUnavailable
+	ErrCodeModNotFound        = services.ErrCodeModNotFound
+	ErrCodeInventoryFull      = services.ErrCodeInventoryFull
+	ErrCodeAffixNotAllowed    = services.ErrCodeAffixNotAllowed
+	ErrCodePresetNotFound     = services.ErrCodePresetNotFound
+	ErrCodeInvalidPresetName  = services.ErrCodeInvalidPresetName
+	ErrCodeToolNotFound       = services.ErrCodeToolNotFound
+	ErrCodeInvalidInput       = services.ErrCodeInvalidInput
+	ErrCodeToolFailed         = services.ErrCodeToolFailed
+	ErrCodeToolTimeout        = services.ErrCodeToolTimeout
+	ErrCodeScriptError        = services.ErrCodeScriptError
+	ErrCodeScriptStepLimit    = services.ErrCodeScriptStepLimit
+	ErrCodeScriptTimeout      = services.ErrCodeScriptTimeout
+	ErrCodeScriptMemoryLimit  = services.ErrCodeScriptMemoryLimit
+
+	ErrCodeInvalidValue         = services.ErrCodeInvalidValue
+	ErrCodeNotFound             = services.ErrCodeNotFound
+	ErrCodeMethodNotAllowed     = services.ErrCodeMethodNotAllowed
+	ErrCodeUnsupportedMediaType = services.ErrCodeUnsupportedMediaType
+	ErrCodeNotAcceptable        = services.ErrCodeNotAcceptable
+	ErrCodeGuildNotAllowed      = services.ErrCodeGuildNotAllowed
+)
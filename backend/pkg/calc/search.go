@@ -0,0 +1,50 @@
+package calc
+
+import (
+	"context"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// AffixMatch 词条搜索结果
+type AffixMatch struct {
+	Affix     Affix  `json:"affix"`
+	Score     int    `json:"score"`
+	MatchedBy string `json:"matchedBy"`
+}
+
+// PresetLookup 按名称查找用户或服务器保存的预设，不存在时ok为false
+type PresetLookup func(ctx context.Context, name string) (targets []int, ok bool, err error)
+
+// SearchAffixes 按中文名、拼音、拼音首字母和英文别名模糊搜索最新游戏版本的词条，category为空时不限分类
+func SearchAffixes(query, category string, limit int) []AffixMatch {
+	matches := services.NewAffixSearchService().Search(query, category, limit)
+	result := make([]AffixMatch, len(matches))
+	for i, m := range matches {
+		result[i] = AffixMatch{Affix: convertAffix(m.Affix), Score: m.Score, MatchedBy: m.MatchedBy}
+	}
+	return result
+}
+
+// ResolveTargets 解析以逗号分隔的目标词条，支持ID、名称和preset:<名称>，返回去重后的词条ID；
+// lookup查找保存的预设，为nil时只能使用内置预设，lookup返回的错误原样返回
+func ResolveTargets(ctx context.Context, input string, lookup PresetLookup) ([]int, error) {
+	var serviceLookup services.PresetLookup
+	if lookup != nil {
+		serviceLookup = services.PresetLookup(lookup)
+	}
+	ids, err := services.NewAffixSearchService().ResolveTargets(ctx, input, serviceLookup)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return ids, nil
+}
+
+// ValidatePreset 校验保存的预设名称和目标词条，返回去重后的词条ID
+func ValidatePreset(name string, targets []int) ([]int, error) {
+	ids, err := services.ValidatePreset(name, targets)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new admin API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for admin API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ListAPIKeys(params *ListAPIKeysParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAPIKeysOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ListAPIKeys 获取s API key列表
+
+获取所有API key及其用量，不返回key明文和摘要
+*/
+func (a *Client) ListAPIKeys(params *ListAPIKeysParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAPIKeysOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAPIKeysParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listApiKeys",
+		Method:             "GET",
+		PathPattern:        "/admin/keys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListAPIKeysReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAPIKeysOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listApiKeys: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
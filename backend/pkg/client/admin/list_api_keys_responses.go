@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListAPIKeysReader is a Reader for the ListAPIKeys structure.
+type ListAPIKeysReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAPIKeysReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAPIKeysOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, runtime.NewAPIError("[GET /admin/keys] listApiKeys", response, response.Code())
+	}
+}
+
+// NewListAPIKeysOK creates a ListAPIKeysOK with default headers values
+func NewListAPIKeysOK() *ListAPIKeysOK {
+	return &ListAPIKeysOK{}
+}
+
+/*
+ListAPIKeysOK describes a response with status code 200, with default header values.
+
+成功获取API key列表
+*/
+type ListAPIKeysOK struct {
+	Payload *models.APIKeyListResponse
+}
+
+// IsSuccess returns true when this list Api keys o k response has a 2xx status code
+func (o *ListAPIKeysOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list Api keys o k response has a 3xx status code
+func (o *ListAPIKeysOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list Api keys o k response has a 4xx status code
+func (o *ListAPIKeysOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list Api keys o k response has a 5xx status code
+func (o *ListAPIKeysOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list Api keys o k response a status code equal to that given
+func (o *ListAPIKeysOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list Api keys o k response
+func (o *ListAPIKeysOK) Code() int {
+	return 200
+}
+
+func (o *ListAPIKeysOK) Error() string {
+	return fmt.Sprintf("[GET /admin/keys][%d] listApiKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAPIKeysOK) String() string {
+	return fmt.Sprintf("[GET /admin/keys][%d] listApiKeysOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAPIKeysOK) GetPayload() *models.APIKeyListResponse {
+	return o.Payload
+}
+
+func (o *ListAPIKeysOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIKeyListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
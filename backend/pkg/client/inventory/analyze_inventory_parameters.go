@@ -0,0 +1,478 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewAnalyzeInventoryParams creates a new AnalyzeInventoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAnalyzeInventoryParams() *AnalyzeInventoryParams {
+	return &AnalyzeInventoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAnalyzeInventoryParamsWithTimeout creates a new AnalyzeInventoryParams object
+// with the ability to set a timeout on a request.
+func NewAnalyzeInventoryParamsWithTimeout(timeout time.Duration) *AnalyzeInventoryParams {
+	return &AnalyzeInventoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewAnalyzeInventoryParamsWithContext creates a new AnalyzeInventoryParams object
+// with the ability to set a context for a request.
+func NewAnalyzeInventoryParamsWithContext(ctx context.Context) *AnalyzeInventoryParams {
+	return &AnalyzeInventoryParams{
+		Context: ctx,
+	}
+}
+
+// NewAnalyzeInventoryParamsWithHTTPClient creates a new AnalyzeInventoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAnalyzeInventoryParamsWithHTTPClient(client *http.Client) *AnalyzeInventoryParams {
+	return &AnalyzeInventoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AnalyzeInventoryParams contains all the parameters to send to the API endpoint
+
+	for the analyze inventory operation.
+
+	Typically these are written to a http.Request.
+*/
+type AnalyzeInventoryParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	/* AffixIds.
+
+	   只有这些词条计入目标和期望提升，与targets合并；都不填表示全部词条
+	*/
+	AffixIds []int32
+
+	/* GameVersion.
+
+	   游戏版本ID，不填或latest表示最新版本
+	*/
+	GameVersion *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	/* OrderIndependent.
+
+	   true表示目标等级可以由任意词条达到，false表示按词条顺序对应
+
+	   Default: true
+	*/
+	OrderIndependent *bool
+
+	/* TargetLevels.
+
+	   目标等级，如5/5/x/x填5,5
+	*/
+	TargetLevels []int32
+
+	/* Targets.
+
+	   以逗号分隔的目标词条，可以是ID、名称、拼音或preset:<预设名称>，与affixIds合并
+	*/
+	Targets *string
+
+	/* Type.
+
+	   只分析该类型的模组
+	*/
+	Type *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the analyze inventory params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AnalyzeInventoryParams) WithDefaults() *AnalyzeInventoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the analyze inventory params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AnalyzeInventoryParams) SetDefaults() {
+	var (
+		orderIndependentDefault = bool(true)
+	)
+
+	val := AnalyzeInventoryParams{
+		OrderIndependent: &orderIndependentDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithTimeout(timeout time.Duration) *AnalyzeInventoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithContext(ctx context.Context) *AnalyzeInventoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithHTTPClient(client *http.Client) *AnalyzeInventoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithAcceptLanguage(acceptLanguage *string) *AnalyzeInventoryParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXGuildID adds the xGuildID to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithXGuildID(xGuildID *string) *AnalyzeInventoryParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithXPlayerID(xPlayerID *string) *AnalyzeInventoryParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithAffixIds adds the affixIds to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithAffixIds(affixIds []int32) *AnalyzeInventoryParams {
+	o.SetAffixIds(affixIds)
+	return o
+}
+
+// SetAffixIds adds the affixIds to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetAffixIds(affixIds []int32) {
+	o.AffixIds = affixIds
+}
+
+// WithGameVersion adds the gameVersion to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithGameVersion(gameVersion *string) *AnalyzeInventoryParams {
+	o.SetGameVersion(gameVersion)
+	return o
+}
+
+// SetGameVersion adds the gameVersion to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetGameVersion(gameVersion *string) {
+	o.GameVersion = gameVersion
+}
+
+// WithLang adds the lang to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithLang(lang *string) *AnalyzeInventoryParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithOrderIndependent adds the orderIndependent to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithOrderIndependent(orderIndependent *bool) *AnalyzeInventoryParams {
+	o.SetOrderIndependent(orderIndependent)
+	return o
+}
+
+// SetOrderIndependent adds the orderIndependent to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetOrderIndependent(orderIndependent *bool) {
+	o.OrderIndependent = orderIndependent
+}
+
+// WithTargetLevels adds the targetLevels to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithTargetLevels(targetLevels []int32) *AnalyzeInventoryParams {
+	o.SetTargetLevels(targetLevels)
+	return o
+}
+
+// SetTargetLevels adds the targetLevels to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetTargetLevels(targetLevels []int32) {
+	o.TargetLevels = targetLevels
+}
+
+// WithTargets adds the targets to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithTargets(targets *string) *AnalyzeInventoryParams {
+	o.SetTargets(targets)
+	return o
+}
+
+// SetTargets adds the targets to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetTargets(targets *string) {
+	o.Targets = targets
+}
+
+// WithType adds the typeVar to the analyze inventory params
+func (o *AnalyzeInventoryParams) WithType(typeVar *string) *AnalyzeInventoryParams {
+	o.SetType(typeVar)
+	return o
+}
+
+// SetType adds the type to the analyze inventory params
+func (o *AnalyzeInventoryParams) SetType(typeVar *string) {
+	o.Type = typeVar
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AnalyzeInventoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+
+	if o.AffixIds != nil {
+
+		// binding items for affixIds
+		joinedAffixIds := o.bindParamAffixIds(reg)
+
+		// query array param affixIds
+		if err := r.SetQueryParam("affixIds", joinedAffixIds...); err != nil {
+			return err
+		}
+	}
+
+	if o.GameVersion != nil {
+
+		// query param gameVersion
+		var qrGameVersion string
+
+		if o.GameVersion != nil {
+			qrGameVersion = *o.GameVersion
+		}
+		qGameVersion := qrGameVersion
+		if qGameVersion != "" {
+
+			if err := r.SetQueryParam("gameVersion", qGameVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.OrderIndependent != nil {
+
+		// query param orderIndependent
+		var qrOrderIndependent bool
+
+		if o.OrderIndependent != nil {
+			qrOrderIndependent = *o.OrderIndependent
+		}
+		qOrderIndependent := swag.FormatBool(qrOrderIndependent)
+		if qOrderIndependent != "" {
+
+			if err := r.SetQueryParam("orderIndependent", qOrderIndependent); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TargetLevels != nil {
+
+		// binding items for targetLevels
+		joinedTargetLevels := o.bindParamTargetLevels(reg)
+
+		// query array param targetLevels
+		if err := r.SetQueryParam("targetLevels", joinedTargetLevels...); err != nil {
+			return err
+		}
+	}
+
+	if o.Targets != nil {
+
+		// query param targets
+		var qrTargets string
+
+		if o.Targets != nil {
+			qrTargets = *o.Targets
+		}
+		qTargets := qrTargets
+		if qTargets != "" {
+
+			if err := r.SetQueryParam("targets", qTargets); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Type != nil {
+
+		// query param type
+		var qrType string
+
+		if o.Type != nil {
+			qrType = *o.Type
+		}
+		qType := qrType
+		if qType != "" {
+
+			if err := r.SetQueryParam("type", qType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamAnalyzeInventory binds the parameter affixIds
+func (o *AnalyzeInventoryParams) bindParamAffixIds(formats strfmt.Registry) []string {
+	affixIdsIR := o.AffixIds
+
+	var affixIdsIC []string
+	for _, affixIdsIIR := range affixIdsIR { // explode []int32
+
+		affixIdsIIV := swag.FormatInt32(affixIdsIIR) // int32 as string
+		affixIdsIC = append(affixIdsIC, affixIdsIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	affixIdsIS := swag.JoinByFormat(affixIdsIC, "csv")
+
+	return affixIdsIS
+}
+
+// bindParamAnalyzeInventory binds the parameter targetLevels
+func (o *AnalyzeInventoryParams) bindParamTargetLevels(formats strfmt.Registry) []string {
+	targetLevelsIR := o.TargetLevels
+
+	var targetLevelsIC []string
+	for _, targetLevelsIIR := range targetLevelsIR { // explode []int32
+
+		targetLevelsIIV := swag.FormatInt32(targetLevelsIIR) // int32 as string
+		targetLevelsIC = append(targetLevelsIC, targetLevelsIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	targetLevelsIS := swag.JoinByFormat(targetLevelsIC, "csv")
+
+	return targetLevelsIS
+}
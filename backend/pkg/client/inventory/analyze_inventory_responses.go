@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// AnalyzeInventoryReader is a Reader for the AnalyzeInventory structure.
+type AnalyzeInventoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AnalyzeInventoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAnalyzeInventoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAnalyzeInventoryBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewAnalyzeInventoryServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /inventory/analysis] analyzeInventory", response, response.Code())
+	}
+}
+
+// NewAnalyzeInventoryOK creates a AnalyzeInventoryOK with default headers values
+func NewAnalyzeInventoryOK() *AnalyzeInventoryOK {
+	return &AnalyzeInventoryOK{}
+}
+
+/*
+AnalyzeInventoryOK describes a response with status code 200, with default header values.
+
+分析结果
+*/
+type AnalyzeInventoryOK struct {
+	Payload *models.InventoryAnalysis
+}
+
+// IsSuccess returns true when this analyze inventory o k response has a 2xx status code
+func (o *AnalyzeInventoryOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this analyze inventory o k response has a 3xx status code
+func (o *AnalyzeInventoryOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this analyze inventory o k response has a 4xx status code
+func (o *AnalyzeInventoryOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this analyze inventory o k response has a 5xx status code
+func (o *AnalyzeInventoryOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this analyze inventory o k response a status code equal to that given
+func (o *AnalyzeInventoryOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the analyze inventory o k response
+func (o *AnalyzeInventoryOK) Code() int {
+	return 200
+}
+
+func (o *AnalyzeInventoryOK) Error() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryOK  %+v", 200, o.Payload)
+}
+
+func (o *AnalyzeInventoryOK) String() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryOK  %+v", 200, o.Payload)
+}
+
+func (o *AnalyzeInventoryOK) GetPayload() *models.InventoryAnalysis {
+	return o.Payload
+}
+
+func (o *AnalyzeInventoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InventoryAnalysis)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAnalyzeInventoryBadRequest creates a AnalyzeInventoryBadRequest with default headers values
+func NewAnalyzeInventoryBadRequest() *AnalyzeInventoryBadRequest {
+	return &AnalyzeInventoryBadRequest{}
+}
+
+/*
+AnalyzeInventoryBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type AnalyzeInventoryBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this analyze inventory bad request response has a 2xx status code
+func (o *AnalyzeInventoryBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this analyze inventory bad request response has a 3xx status code
+func (o *AnalyzeInventoryBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this analyze inventory bad request response has a 4xx status code
+func (o *AnalyzeInventoryBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this analyze inventory bad request response has a 5xx status code
+func (o *AnalyzeInventoryBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this analyze inventory bad request response a status code equal to that given
+func (o *AnalyzeInventoryBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the analyze inventory bad request response
+func (o *AnalyzeInventoryBadRequest) Code() int {
+	return 400
+}
+
+func (o *AnalyzeInventoryBadRequest) Error() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AnalyzeInventoryBadRequest) String() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AnalyzeInventoryBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AnalyzeInventoryBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAnalyzeInventoryServiceUnavailable creates a AnalyzeInventoryServiceUnavailable with default headers values
+func NewAnalyzeInventoryServiceUnavailable() *AnalyzeInventoryServiceUnavailable {
+	return &AnalyzeInventoryServiceUnavailable{}
+}
+
+/*
+AnalyzeInventoryServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库或服务正在停止
+*/
+type AnalyzeInventoryServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this analyze inventory service unavailable response has a 2xx status code
+func (o *AnalyzeInventoryServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this analyze inventory service unavailable response has a 3xx status code
+func (o *AnalyzeInventoryServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this analyze inventory service unavailable response has a 4xx status code
+func (o *AnalyzeInventoryServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this analyze inventory service unavailable response has a 5xx status code
+func (o *AnalyzeInventoryServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this analyze inventory service unavailable response a status code equal to that given
+func (o *AnalyzeInventoryServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the analyze inventory service unavailable response
+func (o *AnalyzeInventoryServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *AnalyzeInventoryServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *AnalyzeInventoryServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /inventory/analysis][%d] analyzeInventoryServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *AnalyzeInventoryServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AnalyzeInventoryServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
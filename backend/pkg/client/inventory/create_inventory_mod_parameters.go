@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewCreateInventoryModParams creates a new CreateInventoryModParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateInventoryModParams() *CreateInventoryModParams {
+	return &CreateInventoryModParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateInventoryModParamsWithTimeout creates a new CreateInventoryModParams object
+// with the ability to set a timeout on a request.
+func NewCreateInventoryModParamsWithTimeout(timeout time.Duration) *CreateInventoryModParams {
+	return &CreateInventoryModParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateInventoryModParamsWithContext creates a new CreateInventoryModParams object
+// with the ability to set a context for a request.
+func NewCreateInventoryModParamsWithContext(ctx context.Context) *CreateInventoryModParams {
+	return &CreateInventoryModParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateInventoryModParamsWithHTTPClient creates a new CreateInventoryModParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateInventoryModParamsWithHTTPClient(client *http.Client) *CreateInventoryModParams {
+	return &CreateInventoryModParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateInventoryModParams contains all the parameters to send to the API endpoint
+
+	for the create inventory mod operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateInventoryModParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.InventoryModInput
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateInventoryModParams) WithDefaults() *CreateInventoryModParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateInventoryModParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create inventory mod params
+func (o *CreateInventoryModParams) WithTimeout(timeout time.Duration) *CreateInventoryModParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create inventory mod params
+func (o *CreateInventoryModParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create inventory mod params
+func (o *CreateInventoryModParams) WithContext(ctx context.Context) *CreateInventoryModParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create inventory mod params
+func (o *CreateInventoryModParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create inventory mod params
+func (o *CreateInventoryModParams) WithHTTPClient(client *http.Client) *CreateInventoryModParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create inventory mod params
+func (o *CreateInventoryModParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the create inventory mod params
+func (o *CreateInventoryModParams) WithAcceptLanguage(acceptLanguage *string) *CreateInventoryModParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the create inventory mod params
+func (o *CreateInventoryModParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXPlayerID adds the xPlayerID to the create inventory mod params
+func (o *CreateInventoryModParams) WithXPlayerID(xPlayerID *string) *CreateInventoryModParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the create inventory mod params
+func (o *CreateInventoryModParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the create inventory mod params
+func (o *CreateInventoryModParams) WithBody(body *models.InventoryModInput) *CreateInventoryModParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create inventory mod params
+func (o *CreateInventoryModParams) SetBody(body *models.InventoryModInput) {
+	o.Body = body
+}
+
+// WithLang adds the lang to the create inventory mod params
+func (o *CreateInventoryModParams) WithLang(lang *string) *CreateInventoryModParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the create inventory mod params
+func (o *CreateInventoryModParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateInventoryModParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
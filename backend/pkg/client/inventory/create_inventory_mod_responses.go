@@ -0,0 +1,337 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CreateInventoryModReader is a Reader for the CreateInventoryMod structure.
+type CreateInventoryModReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateInventoryModReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateInventoryModCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateInventoryModBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCreateInventoryModConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewCreateInventoryModServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /inventory/mods] createInventoryMod", response, response.Code())
+	}
+}
+
+// NewCreateInventoryModCreated creates a CreateInventoryModCreated with default headers values
+func NewCreateInventoryModCreated() *CreateInventoryModCreated {
+	return &CreateInventoryModCreated{}
+}
+
+/*
+CreateInventoryModCreated describes a response with status code 201, with default header values.
+
+已添加模组
+*/
+type CreateInventoryModCreated struct {
+
+	/* 模组的地址
+	 */
+	Location string
+
+	Payload *models.InventoryMod
+}
+
+// IsSuccess returns true when this create inventory mod created response has a 2xx status code
+func (o *CreateInventoryModCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create inventory mod created response has a 3xx status code
+func (o *CreateInventoryModCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create inventory mod created response has a 4xx status code
+func (o *CreateInventoryModCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create inventory mod created response has a 5xx status code
+func (o *CreateInventoryModCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create inventory mod created response a status code equal to that given
+func (o *CreateInventoryModCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the create inventory mod created response
+func (o *CreateInventoryModCreated) Code() int {
+	return 201
+}
+
+func (o *CreateInventoryModCreated) Error() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateInventoryModCreated) String() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateInventoryModCreated) GetPayload() *models.InventoryMod {
+	return o.Payload
+}
+
+func (o *CreateInventoryModCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Location
+	hdrLocation := response.GetHeader("Location")
+
+	if hdrLocation != "" {
+		o.Location = hdrLocation
+	}
+
+	o.Payload = new(models.InventoryMod)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateInventoryModBadRequest creates a CreateInventoryModBadRequest with default headers values
+func NewCreateInventoryModBadRequest() *CreateInventoryModBadRequest {
+	return &CreateInventoryModBadRequest{}
+}
+
+/*
+CreateInventoryModBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type CreateInventoryModBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create inventory mod bad request response has a 2xx status code
+func (o *CreateInventoryModBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create inventory mod bad request response has a 3xx status code
+func (o *CreateInventoryModBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create inventory mod bad request response has a 4xx status code
+func (o *CreateInventoryModBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create inventory mod bad request response has a 5xx status code
+func (o *CreateInventoryModBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create inventory mod bad request response a status code equal to that given
+func (o *CreateInventoryModBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create inventory mod bad request response
+func (o *CreateInventoryModBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateInventoryModBadRequest) Error() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateInventoryModBadRequest) String() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateInventoryModBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateInventoryModBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateInventoryModConflict creates a CreateInventoryModConflict with default headers values
+func NewCreateInventoryModConflict() *CreateInventoryModConflict {
+	return &CreateInventoryModConflict{}
+}
+
+/*
+CreateInventoryModConflict describes a response with status code 409, with default header values.
+
+背包已满
+*/
+type CreateInventoryModConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create inventory mod conflict response has a 2xx status code
+func (o *CreateInventoryModConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create inventory mod conflict response has a 3xx status code
+func (o *CreateInventoryModConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create inventory mod conflict response has a 4xx status code
+func (o *CreateInventoryModConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create inventory mod conflict response has a 5xx status code
+func (o *CreateInventoryModConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create inventory mod conflict response a status code equal to that given
+func (o *CreateInventoryModConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create inventory mod conflict response
+func (o *CreateInventoryModConflict) Code() int {
+	return 409
+}
+
+func (o *CreateInventoryModConflict) Error() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateInventoryModConflict) String() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateInventoryModConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateInventoryModConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateInventoryModServiceUnavailable creates a CreateInventoryModServiceUnavailable with default headers values
+func NewCreateInventoryModServiceUnavailable() *CreateInventoryModServiceUnavailable {
+	return &CreateInventoryModServiceUnavailable{}
+}
+
+/*
+CreateInventoryModServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type CreateInventoryModServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create inventory mod service unavailable response has a 2xx status code
+func (o *CreateInventoryModServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create inventory mod service unavailable response has a 3xx status code
+func (o *CreateInventoryModServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create inventory mod service unavailable response has a 4xx status code
+func (o *CreateInventoryModServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create inventory mod service unavailable response has a 5xx status code
+func (o *CreateInventoryModServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create inventory mod service unavailable response a status code equal to that given
+func (o *CreateInventoryModServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the create inventory mod service unavailable response
+func (o *CreateInventoryModServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *CreateInventoryModServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CreateInventoryModServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /inventory/mods][%d] createInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CreateInventoryModServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateInventoryModServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DeleteInventoryModReader is a Reader for the DeleteInventoryMod structure.
+type DeleteInventoryModReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteInventoryModReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteInventoryModNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteInventoryModNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewDeleteInventoryModServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /inventory/mods/{id}] deleteInventoryMod", response, response.Code())
+	}
+}
+
+// NewDeleteInventoryModNoContent creates a DeleteInventoryModNoContent with default headers values
+func NewDeleteInventoryModNoContent() *DeleteInventoryModNoContent {
+	return &DeleteInventoryModNoContent{}
+}
+
+/*
+DeleteInventoryModNoContent describes a response with status code 204, with default header values.
+
+已删除模组
+*/
+type DeleteInventoryModNoContent struct {
+}
+
+// IsSuccess returns true when this delete inventory mod no content response has a 2xx status code
+func (o *DeleteInventoryModNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete inventory mod no content response has a 3xx status code
+func (o *DeleteInventoryModNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete inventory mod no content response has a 4xx status code
+func (o *DeleteInventoryModNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete inventory mod no content response has a 5xx status code
+func (o *DeleteInventoryModNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete inventory mod no content response a status code equal to that given
+func (o *DeleteInventoryModNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete inventory mod no content response
+func (o *DeleteInventoryModNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteInventoryModNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModNoContent ", 204)
+}
+
+func (o *DeleteInventoryModNoContent) String() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModNoContent ", 204)
+}
+
+func (o *DeleteInventoryModNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteInventoryModNotFound creates a DeleteInventoryModNotFound with default headers values
+func NewDeleteInventoryModNotFound() *DeleteInventoryModNotFound {
+	return &DeleteInventoryModNotFound{}
+}
+
+/*
+DeleteInventoryModNotFound describes a response with status code 404, with default header values.
+
+模组不存在
+*/
+type DeleteInventoryModNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete inventory mod not found response has a 2xx status code
+func (o *DeleteInventoryModNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete inventory mod not found response has a 3xx status code
+func (o *DeleteInventoryModNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete inventory mod not found response has a 4xx status code
+func (o *DeleteInventoryModNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete inventory mod not found response has a 5xx status code
+func (o *DeleteInventoryModNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete inventory mod not found response a status code equal to that given
+func (o *DeleteInventoryModNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete inventory mod not found response
+func (o *DeleteInventoryModNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteInventoryModNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteInventoryModNotFound) String() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteInventoryModNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteInventoryModNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteInventoryModServiceUnavailable creates a DeleteInventoryModServiceUnavailable with default headers values
+func NewDeleteInventoryModServiceUnavailable() *DeleteInventoryModServiceUnavailable {
+	return &DeleteInventoryModServiceUnavailable{}
+}
+
+/*
+DeleteInventoryModServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type DeleteInventoryModServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete inventory mod service unavailable response has a 2xx status code
+func (o *DeleteInventoryModServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete inventory mod service unavailable response has a 3xx status code
+func (o *DeleteInventoryModServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete inventory mod service unavailable response has a 4xx status code
+func (o *DeleteInventoryModServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete inventory mod service unavailable response has a 5xx status code
+func (o *DeleteInventoryModServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete inventory mod service unavailable response a status code equal to that given
+func (o *DeleteInventoryModServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the delete inventory mod service unavailable response
+func (o *DeleteInventoryModServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *DeleteInventoryModServiceUnavailable) Error() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *DeleteInventoryModServiceUnavailable) String() string {
+	return fmt.Sprintf("[DELETE /inventory/mods/{id}][%d] deleteInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *DeleteInventoryModServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteInventoryModServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
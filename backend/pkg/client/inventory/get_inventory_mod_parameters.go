@@ -0,0 +1,235 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetInventoryModParams creates a new GetInventoryModParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetInventoryModParams() *GetInventoryModParams {
+	return &GetInventoryModParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetInventoryModParamsWithTimeout creates a new GetInventoryModParams object
+// with the ability to set a timeout on a request.
+func NewGetInventoryModParamsWithTimeout(timeout time.Duration) *GetInventoryModParams {
+	return &GetInventoryModParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetInventoryModParamsWithContext creates a new GetInventoryModParams object
+// with the ability to set a context for a request.
+func NewGetInventoryModParamsWithContext(ctx context.Context) *GetInventoryModParams {
+	return &GetInventoryModParams{
+		Context: ctx,
+	}
+}
+
+// NewGetInventoryModParamsWithHTTPClient creates a new GetInventoryModParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetInventoryModParamsWithHTTPClient(client *http.Client) *GetInventoryModParams {
+	return &GetInventoryModParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetInventoryModParams contains all the parameters to send to the API endpoint
+
+	for the get inventory mod operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetInventoryModParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	/* ID.
+
+	   模组ID
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetInventoryModParams) WithDefaults() *GetInventoryModParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetInventoryModParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get inventory mod params
+func (o *GetInventoryModParams) WithTimeout(timeout time.Duration) *GetInventoryModParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get inventory mod params
+func (o *GetInventoryModParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get inventory mod params
+func (o *GetInventoryModParams) WithContext(ctx context.Context) *GetInventoryModParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get inventory mod params
+func (o *GetInventoryModParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get inventory mod params
+func (o *GetInventoryModParams) WithHTTPClient(client *http.Client) *GetInventoryModParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get inventory mod params
+func (o *GetInventoryModParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the get inventory mod params
+func (o *GetInventoryModParams) WithAcceptLanguage(acceptLanguage *string) *GetInventoryModParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the get inventory mod params
+func (o *GetInventoryModParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXPlayerID adds the xPlayerID to the get inventory mod params
+func (o *GetInventoryModParams) WithXPlayerID(xPlayerID *string) *GetInventoryModParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the get inventory mod params
+func (o *GetInventoryModParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithID adds the id to the get inventory mod params
+func (o *GetInventoryModParams) WithID(id string) *GetInventoryModParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get inventory mod params
+func (o *GetInventoryModParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the get inventory mod params
+func (o *GetInventoryModParams) WithLang(lang *string) *GetInventoryModParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the get inventory mod params
+func (o *GetInventoryModParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetInventoryModParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetInventoryModReader is a Reader for the GetInventoryMod structure.
+type GetInventoryModReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetInventoryModReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetInventoryModOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetInventoryModNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewGetInventoryModServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /inventory/mods/{id}] getInventoryMod", response, response.Code())
+	}
+}
+
+// NewGetInventoryModOK creates a GetInventoryModOK with default headers values
+func NewGetInventoryModOK() *GetInventoryModOK {
+	return &GetInventoryModOK{}
+}
+
+/*
+GetInventoryModOK describes a response with status code 200, with default header values.
+
+成功获取模组
+*/
+type GetInventoryModOK struct {
+	Payload *models.InventoryMod
+}
+
+// IsSuccess returns true when this get inventory mod o k response has a 2xx status code
+func (o *GetInventoryModOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get inventory mod o k response has a 3xx status code
+func (o *GetInventoryModOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get inventory mod o k response has a 4xx status code
+func (o *GetInventoryModOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get inventory mod o k response has a 5xx status code
+func (o *GetInventoryModOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get inventory mod o k response a status code equal to that given
+func (o *GetInventoryModOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get inventory mod o k response
+func (o *GetInventoryModOK) Code() int {
+	return 200
+}
+
+func (o *GetInventoryModOK) Error() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModOK  %+v", 200, o.Payload)
+}
+
+func (o *GetInventoryModOK) String() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModOK  %+v", 200, o.Payload)
+}
+
+func (o *GetInventoryModOK) GetPayload() *models.InventoryMod {
+	return o.Payload
+}
+
+func (o *GetInventoryModOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InventoryMod)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInventoryModNotFound creates a GetInventoryModNotFound with default headers values
+func NewGetInventoryModNotFound() *GetInventoryModNotFound {
+	return &GetInventoryModNotFound{}
+}
+
+/*
+GetInventoryModNotFound describes a response with status code 404, with default header values.
+
+模组不存在
+*/
+type GetInventoryModNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get inventory mod not found response has a 2xx status code
+func (o *GetInventoryModNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get inventory mod not found response has a 3xx status code
+func (o *GetInventoryModNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get inventory mod not found response has a 4xx status code
+func (o *GetInventoryModNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get inventory mod not found response has a 5xx status code
+func (o *GetInventoryModNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get inventory mod not found response a status code equal to that given
+func (o *GetInventoryModNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get inventory mod not found response
+func (o *GetInventoryModNotFound) Code() int {
+	return 404
+}
+
+func (o *GetInventoryModNotFound) Error() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetInventoryModNotFound) String() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetInventoryModNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetInventoryModNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInventoryModServiceUnavailable creates a GetInventoryModServiceUnavailable with default headers values
+func NewGetInventoryModServiceUnavailable() *GetInventoryModServiceUnavailable {
+	return &GetInventoryModServiceUnavailable{}
+}
+
+/*
+GetInventoryModServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type GetInventoryModServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get inventory mod service unavailable response has a 2xx status code
+func (o *GetInventoryModServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get inventory mod service unavailable response has a 3xx status code
+func (o *GetInventoryModServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get inventory mod service unavailable response has a 4xx status code
+func (o *GetInventoryModServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get inventory mod service unavailable response has a 5xx status code
+func (o *GetInventoryModServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get inventory mod service unavailable response a status code equal to that given
+func (o *GetInventoryModServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the get inventory mod service unavailable response
+func (o *GetInventoryModServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *GetInventoryModServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetInventoryModServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /inventory/mods/{id}][%d] getInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetInventoryModServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetInventoryModServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new inventory API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for inventory API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	AnalyzeInventory(params *AnalyzeInventoryParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AnalyzeInventoryOK, error)
+
+	CreateInventoryMod(params *CreateInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateInventoryModCreated, error)
+
+	DeleteInventoryMod(params *DeleteInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteInventoryModNoContent, error)
+
+	GetInventoryMod(params *GetInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetInventoryModOK, error)
+
+	ListInventoryMods(params *ListInventoryModsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListInventoryModsOK, error)
+
+	UpdateInventoryMod(params *UpdateInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateInventoryModOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+	AnalyzeInventory 分析背包中的模组s
+
+	按强化规则计算背包中每个模组用剩余强化次数达到目标等级的概率和目标词条的期望提升，
+
+按达成概率从高到低排列，并给出建议分解的模组：无法达到目标的模组，以及同类型中概率和期望提升都不如另一个模组的模组。
+*/
+func (a *Client) AnalyzeInventory(params *AnalyzeInventoryParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AnalyzeInventoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAnalyzeInventoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "analyzeInventory",
+		Method:             "GET",
+		PathPattern:        "/inventory/analysis",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &AnalyzeInventoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AnalyzeInventoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for analyzeInventory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateInventoryMod 添加模组s
+
+把模组添加到玩家背包，词条和等级按最新游戏版本的稀有度规则校验
+*/
+func (a *Client) CreateInventoryMod(params *CreateInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateInventoryModCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateInventoryModParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createInventoryMod",
+		Method:             "POST",
+		PathPattern:        "/inventory/mods",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateInventoryModReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateInventoryModCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createInventoryMod: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteInventoryMod 删除模组s
+*/
+func (a *Client) DeleteInventoryMod(params *DeleteInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteInventoryModNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteInventoryModParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteInventoryMod",
+		Method:             "DELETE",
+		PathPattern:        "/inventory/mods/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteInventoryModReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteInventoryModNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteInventoryMod: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetInventoryMod 获取模组s
+*/
+func (a *Client) GetInventoryMod(params *GetInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetInventoryModOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetInventoryModParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getInventoryMod",
+		Method:             "GET",
+		PathPattern:        "/inventory/mods/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetInventoryModReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetInventoryModOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getInventoryMod: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListInventoryMods 获取背包中的模组s
+
+获取玩家背包中的模组，最近更新的在前
+*/
+func (a *Client) ListInventoryMods(params *ListInventoryModsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListInventoryModsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListInventoryModsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listInventoryMods",
+		Method:             "GET",
+		PathPattern:        "/inventory/mods",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListInventoryModsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListInventoryModsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listInventoryMods: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateInventoryMod 更新模组s
+
+替换模组的全部字段，通常在强化后更新词条等级和已强化次数
+*/
+func (a *Client) UpdateInventoryMod(params *UpdateInventoryModParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateInventoryModOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateInventoryModParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateInventoryMod",
+		Method:             "PUT",
+		PathPattern:        "/inventory/mods/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateInventoryModReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateInventoryModOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for updateInventoryMod: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
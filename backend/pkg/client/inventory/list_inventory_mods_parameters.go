@@ -0,0 +1,247 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListInventoryModsParams creates a new ListInventoryModsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListInventoryModsParams() *ListInventoryModsParams {
+	return &ListInventoryModsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListInventoryModsParamsWithTimeout creates a new ListInventoryModsParams object
+// with the ability to set a timeout on a request.
+func NewListInventoryModsParamsWithTimeout(timeout time.Duration) *ListInventoryModsParams {
+	return &ListInventoryModsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListInventoryModsParamsWithContext creates a new ListInventoryModsParams object
+// with the ability to set a context for a request.
+func NewListInventoryModsParamsWithContext(ctx context.Context) *ListInventoryModsParams {
+	return &ListInventoryModsParams{
+		Context: ctx,
+	}
+}
+
+// NewListInventoryModsParamsWithHTTPClient creates a new ListInventoryModsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListInventoryModsParamsWithHTTPClient(client *http.Client) *ListInventoryModsParams {
+	return &ListInventoryModsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListInventoryModsParams contains all the parameters to send to the API endpoint
+
+	for the list inventory mods operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListInventoryModsParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	/* Type.
+
+	   只返回该类型的模组
+	*/
+	Type *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list inventory mods params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListInventoryModsParams) WithDefaults() *ListInventoryModsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list inventory mods params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListInventoryModsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list inventory mods params
+func (o *ListInventoryModsParams) WithTimeout(timeout time.Duration) *ListInventoryModsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list inventory mods params
+func (o *ListInventoryModsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list inventory mods params
+func (o *ListInventoryModsParams) WithContext(ctx context.Context) *ListInventoryModsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list inventory mods params
+func (o *ListInventoryModsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list inventory mods params
+func (o *ListInventoryModsParams) WithHTTPClient(client *http.Client) *ListInventoryModsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list inventory mods params
+func (o *ListInventoryModsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the list inventory mods params
+func (o *ListInventoryModsParams) WithAcceptLanguage(acceptLanguage *string) *ListInventoryModsParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the list inventory mods params
+func (o *ListInventoryModsParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXPlayerID adds the xPlayerID to the list inventory mods params
+func (o *ListInventoryModsParams) WithXPlayerID(xPlayerID *string) *ListInventoryModsParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the list inventory mods params
+func (o *ListInventoryModsParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithLang adds the lang to the list inventory mods params
+func (o *ListInventoryModsParams) WithLang(lang *string) *ListInventoryModsParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the list inventory mods params
+func (o *ListInventoryModsParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithType adds the typeVar to the list inventory mods params
+func (o *ListInventoryModsParams) WithType(typeVar *string) *ListInventoryModsParams {
+	o.SetType(typeVar)
+	return o
+}
+
+// SetType adds the type to the list inventory mods params
+func (o *ListInventoryModsParams) SetType(typeVar *string) {
+	o.Type = typeVar
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListInventoryModsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Type != nil {
+
+		// query param type
+		var qrType string
+
+		if o.Type != nil {
+			qrType = *o.Type
+		}
+		qType := qrType
+		if qType != "" {
+
+			if err := r.SetQueryParam("type", qType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
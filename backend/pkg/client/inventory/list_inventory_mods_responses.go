@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListInventoryModsReader is a Reader for the ListInventoryMods structure.
+type ListInventoryModsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListInventoryModsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListInventoryModsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 503:
+		result := NewListInventoryModsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /inventory/mods] listInventoryMods", response, response.Code())
+	}
+}
+
+// NewListInventoryModsOK creates a ListInventoryModsOK with default headers values
+func NewListInventoryModsOK() *ListInventoryModsOK {
+	return &ListInventoryModsOK{}
+}
+
+/*
+ListInventoryModsOK describes a response with status code 200, with default header values.
+
+成功获取模组列表
+*/
+type ListInventoryModsOK struct {
+	Payload *models.InventoryModListResponse
+}
+
+// IsSuccess returns true when this list inventory mods o k response has a 2xx status code
+func (o *ListInventoryModsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list inventory mods o k response has a 3xx status code
+func (o *ListInventoryModsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list inventory mods o k response has a 4xx status code
+func (o *ListInventoryModsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list inventory mods o k response has a 5xx status code
+func (o *ListInventoryModsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list inventory mods o k response a status code equal to that given
+func (o *ListInventoryModsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list inventory mods o k response
+func (o *ListInventoryModsOK) Code() int {
+	return 200
+}
+
+func (o *ListInventoryModsOK) Error() string {
+	return fmt.Sprintf("[GET /inventory/mods][%d] listInventoryModsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListInventoryModsOK) String() string {
+	return fmt.Sprintf("[GET /inventory/mods][%d] listInventoryModsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListInventoryModsOK) GetPayload() *models.InventoryModListResponse {
+	return o.Payload
+}
+
+func (o *ListInventoryModsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InventoryModListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListInventoryModsServiceUnavailable creates a ListInventoryModsServiceUnavailable with default headers values
+func NewListInventoryModsServiceUnavailable() *ListInventoryModsServiceUnavailable {
+	return &ListInventoryModsServiceUnavailable{}
+}
+
+/*
+ListInventoryModsServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type ListInventoryModsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list inventory mods service unavailable response has a 2xx status code
+func (o *ListInventoryModsServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list inventory mods service unavailable response has a 3xx status code
+func (o *ListInventoryModsServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list inventory mods service unavailable response has a 4xx status code
+func (o *ListInventoryModsServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list inventory mods service unavailable response has a 5xx status code
+func (o *ListInventoryModsServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list inventory mods service unavailable response a status code equal to that given
+func (o *ListInventoryModsServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the list inventory mods service unavailable response
+func (o *ListInventoryModsServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ListInventoryModsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /inventory/mods][%d] listInventoryModsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ListInventoryModsServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /inventory/mods][%d] listInventoryModsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ListInventoryModsServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListInventoryModsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
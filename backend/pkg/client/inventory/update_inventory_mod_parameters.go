@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewUpdateInventoryModParams creates a new UpdateInventoryModParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateInventoryModParams() *UpdateInventoryModParams {
+	return &UpdateInventoryModParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateInventoryModParamsWithTimeout creates a new UpdateInventoryModParams object
+// with the ability to set a timeout on a request.
+func NewUpdateInventoryModParamsWithTimeout(timeout time.Duration) *UpdateInventoryModParams {
+	return &UpdateInventoryModParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateInventoryModParamsWithContext creates a new UpdateInventoryModParams object
+// with the ability to set a context for a request.
+func NewUpdateInventoryModParamsWithContext(ctx context.Context) *UpdateInventoryModParams {
+	return &UpdateInventoryModParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateInventoryModParamsWithHTTPClient creates a new UpdateInventoryModParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateInventoryModParamsWithHTTPClient(client *http.Client) *UpdateInventoryModParams {
+	return &UpdateInventoryModParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateInventoryModParams contains all the parameters to send to the API endpoint
+
+	for the update inventory mod operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateInventoryModParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.InventoryModInput
+
+	/* ID.
+
+	   模组ID
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateInventoryModParams) WithDefaults() *UpdateInventoryModParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update inventory mod params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateInventoryModParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update inventory mod params
+func (o *UpdateInventoryModParams) WithTimeout(timeout time.Duration) *UpdateInventoryModParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update inventory mod params
+func (o *UpdateInventoryModParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update inventory mod params
+func (o *UpdateInventoryModParams) WithContext(ctx context.Context) *UpdateInventoryModParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update inventory mod params
+func (o *UpdateInventoryModParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update inventory mod params
+func (o *UpdateInventoryModParams) WithHTTPClient(client *http.Client) *UpdateInventoryModParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update inventory mod params
+func (o *UpdateInventoryModParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the update inventory mod params
+func (o *UpdateInventoryModParams) WithAcceptLanguage(acceptLanguage *string) *UpdateInventoryModParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the update inventory mod params
+func (o *UpdateInventoryModParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXPlayerID adds the xPlayerID to the update inventory mod params
+func (o *UpdateInventoryModParams) WithXPlayerID(xPlayerID *string) *UpdateInventoryModParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the update inventory mod params
+func (o *UpdateInventoryModParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the update inventory mod params
+func (o *UpdateInventoryModParams) WithBody(body *models.InventoryModInput) *UpdateInventoryModParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update inventory mod params
+func (o *UpdateInventoryModParams) SetBody(body *models.InventoryModInput) {
+	o.Body = body
+}
+
+// WithID adds the id to the update inventory mod params
+func (o *UpdateInventoryModParams) WithID(id string) *UpdateInventoryModParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update inventory mod params
+func (o *UpdateInventoryModParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the update inventory mod params
+func (o *UpdateInventoryModParams) WithLang(lang *string) *UpdateInventoryModParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the update inventory mod params
+func (o *UpdateInventoryModParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateInventoryModParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
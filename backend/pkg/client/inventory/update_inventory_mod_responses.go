@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// UpdateInventoryModReader is a Reader for the UpdateInventoryMod structure.
+type UpdateInventoryModReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateInventoryModReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateInventoryModOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateInventoryModBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateInventoryModNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewUpdateInventoryModServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /inventory/mods/{id}] updateInventoryMod", response, response.Code())
+	}
+}
+
+// NewUpdateInventoryModOK creates a UpdateInventoryModOK with default headers values
+func NewUpdateInventoryModOK() *UpdateInventoryModOK {
+	return &UpdateInventoryModOK{}
+}
+
+/*
+UpdateInventoryModOK describes a response with status code 200, with default header values.
+
+已更新模组
+*/
+type UpdateInventoryModOK struct {
+	Payload *models.InventoryMod
+}
+
+// IsSuccess returns true when this update inventory mod o k response has a 2xx status code
+func (o *UpdateInventoryModOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update inventory mod o k response has a 3xx status code
+func (o *UpdateInventoryModOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update inventory mod o k response has a 4xx status code
+func (o *UpdateInventoryModOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update inventory mod o k response has a 5xx status code
+func (o *UpdateInventoryModOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update inventory mod o k response a status code equal to that given
+func (o *UpdateInventoryModOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update inventory mod o k response
+func (o *UpdateInventoryModOK) Code() int {
+	return 200
+}
+
+func (o *UpdateInventoryModOK) Error() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateInventoryModOK) String() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateInventoryModOK) GetPayload() *models.InventoryMod {
+	return o.Payload
+}
+
+func (o *UpdateInventoryModOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InventoryMod)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateInventoryModBadRequest creates a UpdateInventoryModBadRequest with default headers values
+func NewUpdateInventoryModBadRequest() *UpdateInventoryModBadRequest {
+	return &UpdateInventoryModBadRequest{}
+}
+
+/*
+UpdateInventoryModBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type UpdateInventoryModBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update inventory mod bad request response has a 2xx status code
+func (o *UpdateInventoryModBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update inventory mod bad request response has a 3xx status code
+func (o *UpdateInventoryModBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update inventory mod bad request response has a 4xx status code
+func (o *UpdateInventoryModBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update inventory mod bad request response has a 5xx status code
+func (o *UpdateInventoryModBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update inventory mod bad request response a status code equal to that given
+func (o *UpdateInventoryModBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the update inventory mod bad request response
+func (o *UpdateInventoryModBadRequest) Code() int {
+	return 400
+}
+
+func (o *UpdateInventoryModBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateInventoryModBadRequest) String() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateInventoryModBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateInventoryModBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateInventoryModNotFound creates a UpdateInventoryModNotFound with default headers values
+func NewUpdateInventoryModNotFound() *UpdateInventoryModNotFound {
+	return &UpdateInventoryModNotFound{}
+}
+
+/*
+UpdateInventoryModNotFound describes a response with status code 404, with default header values.
+
+模组不存在
+*/
+type UpdateInventoryModNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update inventory mod not found response has a 2xx status code
+func (o *UpdateInventoryModNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update inventory mod not found response has a 3xx status code
+func (o *UpdateInventoryModNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update inventory mod not found response has a 4xx status code
+func (o *UpdateInventoryModNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update inventory mod not found response has a 5xx status code
+func (o *UpdateInventoryModNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update inventory mod not found response a status code equal to that given
+func (o *UpdateInventoryModNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update inventory mod not found response
+func (o *UpdateInventoryModNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateInventoryModNotFound) Error() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateInventoryModNotFound) String() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateInventoryModNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateInventoryModNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateInventoryModServiceUnavailable creates a UpdateInventoryModServiceUnavailable with default headers values
+func NewUpdateInventoryModServiceUnavailable() *UpdateInventoryModServiceUnavailable {
+	return &UpdateInventoryModServiceUnavailable{}
+}
+
+/*
+UpdateInventoryModServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type UpdateInventoryModServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update inventory mod service unavailable response has a 2xx status code
+func (o *UpdateInventoryModServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update inventory mod service unavailable response has a 3xx status code
+func (o *UpdateInventoryModServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update inventory mod service unavailable response has a 4xx status code
+func (o *UpdateInventoryModServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update inventory mod service unavailable response has a 5xx status code
+func (o *UpdateInventoryModServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this update inventory mod service unavailable response a status code equal to that given
+func (o *UpdateInventoryModServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the update inventory mod service unavailable response
+func (o *UpdateInventoryModServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *UpdateInventoryModServiceUnavailable) Error() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *UpdateInventoryModServiceUnavailable) String() string {
+	return fmt.Sprintf("[PUT /inventory/mods/{id}][%d] updateInventoryModServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *UpdateInventoryModServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateInventoryModServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
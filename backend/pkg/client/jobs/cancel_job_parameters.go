@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCancelJobParams creates a new CancelJobParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCancelJobParams() *CancelJobParams {
+	return &CancelJobParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelJobParamsWithTimeout creates a new CancelJobParams object
+// with the ability to set a timeout on a request.
+func NewCancelJobParamsWithTimeout(timeout time.Duration) *CancelJobParams {
+	return &CancelJobParams{
+		timeout: timeout,
+	}
+}
+
+// NewCancelJobParamsWithContext creates a new CancelJobParams object
+// with the ability to set a context for a request.
+func NewCancelJobParamsWithContext(ctx context.Context) *CancelJobParams {
+	return &CancelJobParams{
+		Context: ctx,
+	}
+}
+
+// NewCancelJobParamsWithHTTPClient creates a new CancelJobParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCancelJobParamsWithHTTPClient(client *http.Client) *CancelJobParams {
+	return &CancelJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CancelJobParams contains all the parameters to send to the API endpoint
+
+	for the cancel job operation.
+
+	Typically these are written to a http.Request.
+*/
+type CancelJobParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* ID.
+
+	   任务ID，只有提交任务的API key可以访问
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cancel job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelJobParams) WithDefaults() *CancelJobParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cancel job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelJobParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cancel job params
+func (o *CancelJobParams) WithTimeout(timeout time.Duration) *CancelJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel job params
+func (o *CancelJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel job params
+func (o *CancelJobParams) WithContext(ctx context.Context) *CancelJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel job params
+func (o *CancelJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel job params
+func (o *CancelJobParams) WithHTTPClient(client *http.Client) *CancelJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel job params
+func (o *CancelJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the cancel job params
+func (o *CancelJobParams) WithAcceptLanguage(acceptLanguage *string) *CancelJobParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the cancel job params
+func (o *CancelJobParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithID adds the id to the cancel job params
+func (o *CancelJobParams) WithID(id string) *CancelJobParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the cancel job params
+func (o *CancelJobParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the cancel job params
+func (o *CancelJobParams) WithLang(lang *string) *CancelJobParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the cancel job params
+func (o *CancelJobParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CancelJobReader is a Reader for the CancelJob structure.
+type CancelJobReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CancelJobReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCancelJobOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewCancelJobNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /jobs/{id}] cancelJob", response, response.Code())
+	}
+}
+
+// NewCancelJobOK creates a CancelJobOK with default headers values
+func NewCancelJobOK() *CancelJobOK {
+	return &CancelJobOK{}
+}
+
+/*
+CancelJobOK describes a response with status code 200, with default header values.
+
+已请求取消，返回当前任务状态，执行中的任务稍后变为canceled
+*/
+type CancelJobOK struct {
+	Payload *models.Job
+}
+
+// IsSuccess returns true when this cancel job o k response has a 2xx status code
+func (o *CancelJobOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cancel job o k response has a 3xx status code
+func (o *CancelJobOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel job o k response has a 4xx status code
+func (o *CancelJobOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cancel job o k response has a 5xx status code
+func (o *CancelJobOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel job o k response a status code equal to that given
+func (o *CancelJobOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cancel job o k response
+func (o *CancelJobOK) Code() int {
+	return 200
+}
+
+func (o *CancelJobOK) Error() string {
+	return fmt.Sprintf("[DELETE /jobs/{id}][%d] cancelJobOK  %+v", 200, o.Payload)
+}
+
+func (o *CancelJobOK) String() string {
+	return fmt.Sprintf("[DELETE /jobs/{id}][%d] cancelJobOK  %+v", 200, o.Payload)
+}
+
+func (o *CancelJobOK) GetPayload() *models.Job {
+	return o.Payload
+}
+
+func (o *CancelJobOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Job)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCancelJobNotFound creates a CancelJobNotFound with default headers values
+func NewCancelJobNotFound() *CancelJobNotFound {
+	return &CancelJobNotFound{}
+}
+
+/*
+CancelJobNotFound describes a response with status code 404, with default header values.
+
+任务不存在、已过期或由其他API key提交
+*/
+type CancelJobNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cancel job not found response has a 2xx status code
+func (o *CancelJobNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cancel job not found response has a 3xx status code
+func (o *CancelJobNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel job not found response has a 4xx status code
+func (o *CancelJobNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cancel job not found response has a 5xx status code
+func (o *CancelJobNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel job not found response a status code equal to that given
+func (o *CancelJobNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cancel job not found response
+func (o *CancelJobNotFound) Code() int {
+	return 404
+}
+
+func (o *CancelJobNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /jobs/{id}][%d] cancelJobNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CancelJobNotFound) String() string {
+	return fmt.Sprintf("[DELETE /jobs/{id}][%d] cancelJobNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CancelJobNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CancelJobNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
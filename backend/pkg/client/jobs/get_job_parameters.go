@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetJobParams creates a new GetJobParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetJobParams() *GetJobParams {
+	return &GetJobParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetJobParamsWithTimeout creates a new GetJobParams object
+// with the ability to set a timeout on a request.
+func NewGetJobParamsWithTimeout(timeout time.Duration) *GetJobParams {
+	return &GetJobParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetJobParamsWithContext creates a new GetJobParams object
+// with the ability to set a context for a request.
+func NewGetJobParamsWithContext(ctx context.Context) *GetJobParams {
+	return &GetJobParams{
+		Context: ctx,
+	}
+}
+
+// NewGetJobParamsWithHTTPClient creates a new GetJobParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetJobParamsWithHTTPClient(client *http.Client) *GetJobParams {
+	return &GetJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetJobParams contains all the parameters to send to the API endpoint
+
+	for the get job operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetJobParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* ID.
+
+	   任务ID，只有提交任务的API key可以访问
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetJobParams) WithDefaults() *GetJobParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetJobParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get job params
+func (o *GetJobParams) WithTimeout(timeout time.Duration) *GetJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get job params
+func (o *GetJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get job params
+func (o *GetJobParams) WithContext(ctx context.Context) *GetJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get job params
+func (o *GetJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get job params
+func (o *GetJobParams) WithHTTPClient(client *http.Client) *GetJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get job params
+func (o *GetJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the get job params
+func (o *GetJobParams) WithAcceptLanguage(acceptLanguage *string) *GetJobParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the get job params
+func (o *GetJobParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithID adds the id to the get job params
+func (o *GetJobParams) WithID(id string) *GetJobParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get job params
+func (o *GetJobParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the get job params
+func (o *GetJobParams) WithLang(lang *string) *GetJobParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the get job params
+func (o *GetJobParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetJobResultParams creates a new GetJobResultParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetJobResultParams() *GetJobResultParams {
+	return &GetJobResultParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetJobResultParamsWithTimeout creates a new GetJobResultParams object
+// with the ability to set a timeout on a request.
+func NewGetJobResultParamsWithTimeout(timeout time.Duration) *GetJobResultParams {
+	return &GetJobResultParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetJobResultParamsWithContext creates a new GetJobResultParams object
+// with the ability to set a context for a request.
+func NewGetJobResultParamsWithContext(ctx context.Context) *GetJobResultParams {
+	return &GetJobResultParams{
+		Context: ctx,
+	}
+}
+
+// NewGetJobResultParamsWithHTTPClient creates a new GetJobResultParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetJobResultParamsWithHTTPClient(client *http.Client) *GetJobResultParams {
+	return &GetJobResultParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetJobResultParams contains all the parameters to send to the API endpoint
+
+	for the get job result operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetJobResultParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* Format.
+
+	     结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、
+	jsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON
+
+	*/
+	Format *string
+
+	/* ID.
+
+	   任务ID，只有提交任务的API key可以访问
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get job result params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetJobResultParams) WithDefaults() *GetJobResultParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get job result params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetJobResultParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get job result params
+func (o *GetJobResultParams) WithTimeout(timeout time.Duration) *GetJobResultParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get job result params
+func (o *GetJobResultParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get job result params
+func (o *GetJobResultParams) WithContext(ctx context.Context) *GetJobResultParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get job result params
+func (o *GetJobResultParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get job result params
+func (o *GetJobResultParams) WithHTTPClient(client *http.Client) *GetJobResultParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get job result params
+func (o *GetJobResultParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the get job result params
+func (o *GetJobResultParams) WithAcceptLanguage(acceptLanguage *string) *GetJobResultParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the get job result params
+func (o *GetJobResultParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithFormat adds the format to the get job result params
+func (o *GetJobResultParams) WithFormat(format *string) *GetJobResultParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the get job result params
+func (o *GetJobResultParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithID adds the id to the get job result params
+func (o *GetJobResultParams) WithID(id string) *GetJobResultParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get job result params
+func (o *GetJobResultParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the get job result params
+func (o *GetJobResultParams) WithLang(lang *string) *GetJobResultParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the get job result params
+func (o *GetJobResultParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetJobResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetJobResultReader is a Reader for the GetJobResult structure.
+type GetJobResultReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetJobResultReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetJobResultOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetJobResultNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewGetJobResultConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /jobs/{id}/result] getJobResult", response, response.Code())
+	}
+}
+
+// NewGetJobResultOK creates a GetJobResultOK with default headers values
+func NewGetJobResultOK() *GetJobResultOK {
+	return &GetJobResultOK{}
+}
+
+/*
+GetJobResultOK describes a response with status code 200, with default header values.
+
+成功获取任务结果
+*/
+type GetJobResultOK struct {
+	Payload *models.JobResult
+}
+
+// IsSuccess returns true when this get job result o k response has a 2xx status code
+func (o *GetJobResultOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get job result o k response has a 3xx status code
+func (o *GetJobResultOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get job result o k response has a 4xx status code
+func (o *GetJobResultOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get job result o k response has a 5xx status code
+func (o *GetJobResultOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get job result o k response a status code equal to that given
+func (o *GetJobResultOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get job result o k response
+func (o *GetJobResultOK) Code() int {
+	return 200
+}
+
+func (o *GetJobResultOK) Error() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultOK  %+v", 200, o.Payload)
+}
+
+func (o *GetJobResultOK) String() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultOK  %+v", 200, o.Payload)
+}
+
+func (o *GetJobResultOK) GetPayload() *models.JobResult {
+	return o.Payload
+}
+
+func (o *GetJobResultOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.JobResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetJobResultNotFound creates a GetJobResultNotFound with default headers values
+func NewGetJobResultNotFound() *GetJobResultNotFound {
+	return &GetJobResultNotFound{}
+}
+
+/*
+GetJobResultNotFound describes a response with status code 404, with default header values.
+
+任务不存在、已过期或由其他API key提交
+*/
+type GetJobResultNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get job result not found response has a 2xx status code
+func (o *GetJobResultNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get job result not found response has a 3xx status code
+func (o *GetJobResultNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get job result not found response has a 4xx status code
+func (o *GetJobResultNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get job result not found response has a 5xx status code
+func (o *GetJobResultNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get job result not found response a status code equal to that given
+func (o *GetJobResultNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get job result not found response
+func (o *GetJobResultNotFound) Code() int {
+	return 404
+}
+
+func (o *GetJobResultNotFound) Error() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetJobResultNotFound) String() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetJobResultNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetJobResultNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetJobResultConflict creates a GetJobResultConflict with default headers values
+func NewGetJobResultConflict() *GetJobResultConflict {
+	return &GetJobResultConflict{}
+}
+
+/*
+GetJobResultConflict describes a response with status code 409, with default header values.
+
+任务尚未完成、执行失败或已取消
+*/
+type GetJobResultConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get job result conflict response has a 2xx status code
+func (o *GetJobResultConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get job result conflict response has a 3xx status code
+func (o *GetJobResultConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get job result conflict response has a 4xx status code
+func (o *GetJobResultConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get job result conflict response has a 5xx status code
+func (o *GetJobResultConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get job result conflict response a status code equal to that given
+func (o *GetJobResultConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the get job result conflict response
+func (o *GetJobResultConflict) Code() int {
+	return 409
+}
+
+func (o *GetJobResultConflict) Error() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultConflict  %+v", 409, o.Payload)
+}
+
+func (o *GetJobResultConflict) String() string {
+	return fmt.Sprintf("[GET /jobs/{id}/result][%d] getJobResultConflict  %+v", 409, o.Payload)
+}
+
+func (o *GetJobResultConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetJobResultConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
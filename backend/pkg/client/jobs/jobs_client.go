@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new jobs API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for jobs API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	CancelJob(params *CancelJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CancelJobOK, error)
+
+	GetJob(params *GetJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetJobOK, error)
+
+	GetJobResult(params *GetJobResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetJobResultOK, error)
+
+	StreamJobEvents(params *StreamJobEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StreamJobEventsOK, error)
+
+	SubmitJob(params *SubmitJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SubmitJobAccepted, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+CancelJob 取消任务s
+
+取消排队中或执行中的任务，已结束的任务不受影响
+*/
+func (a *Client) CancelJob(params *CancelJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CancelJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelJobParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cancelJob",
+		Method:             "DELETE",
+		PathPattern:        "/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CancelJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CancelJobOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cancelJob: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetJob 获取任务状态s
+
+获取任务状态和进度，任务结束后保留一段时间，过期后返回404
+*/
+func (a *Client) GetJob(params *GetJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetJobParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getJob",
+		Method:             "GET",
+		PathPattern:        "/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetJobOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getJob: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetJobResult 获取任务结果s
+
+	获取已完成任务的计算结果，结果按本次请求的语言输出。
+
+CSV、Markdown和JSON Lines与对应的同步计算接口格式相同
+*/
+func (a *Client) GetJobResult(params *GetJobResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetJobResultOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetJobResultParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getJobResult",
+		Method:             "GET",
+		PathPattern:        "/jobs/{id}/result",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/csv", "text/markdown"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetJobResultReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetJobResultOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getJobResult: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	StreamJobEvents 订阅任务进度s
+
+	以Server-Sent Events推送任务状态。每个事件的data为Job：
+
+progress事件在任务开始执行、进度和概率估计变化时推送；
+completed事件在任务结束时推送一次，之后连接关闭，结果通过getJobResult获取。
+连接空闲时定期发送注释行保持连接。
+*/
+func (a *Client) StreamJobEvents(params *StreamJobEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StreamJobEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamJobEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "streamJobEvents",
+		Method:             "GET",
+		PathPattern:        "/jobs/{id}/events",
+		ProducesMediaTypes: []string{"application/json", "text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &StreamJobEventsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StreamJobEventsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for streamJobEvents: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SubmitJob 提交异步计算任务s
+
+提交耗时较长的计算任务，立即返回任务ID，之后轮询任务状态并获取结果
+*/
+func (a *Client) SubmitJob(params *SubmitJobParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SubmitJobAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSubmitJobParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "submitJob",
+		Method:             "POST",
+		PathPattern:        "/jobs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SubmitJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SubmitJobAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for submitJob: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
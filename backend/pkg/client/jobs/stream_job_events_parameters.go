@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewStreamJobEventsParams creates a new StreamJobEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStreamJobEventsParams() *StreamJobEventsParams {
+	return &StreamJobEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamJobEventsParamsWithTimeout creates a new StreamJobEventsParams object
+// with the ability to set a timeout on a request.
+func NewStreamJobEventsParamsWithTimeout(timeout time.Duration) *StreamJobEventsParams {
+	return &StreamJobEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewStreamJobEventsParamsWithContext creates a new StreamJobEventsParams object
+// with the ability to set a context for a request.
+func NewStreamJobEventsParamsWithContext(ctx context.Context) *StreamJobEventsParams {
+	return &StreamJobEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewStreamJobEventsParamsWithHTTPClient creates a new StreamJobEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStreamJobEventsParamsWithHTTPClient(client *http.Client) *StreamJobEventsParams {
+	return &StreamJobEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StreamJobEventsParams contains all the parameters to send to the API endpoint
+
+	for the stream job events operation.
+
+	Typically these are written to a http.Request.
+*/
+type StreamJobEventsParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* ID.
+
+	   任务ID，只有提交任务的API key可以访问
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the stream job events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamJobEventsParams) WithDefaults() *StreamJobEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the stream job events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamJobEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the stream job events params
+func (o *StreamJobEventsParams) WithTimeout(timeout time.Duration) *StreamJobEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream job events params
+func (o *StreamJobEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream job events params
+func (o *StreamJobEventsParams) WithContext(ctx context.Context) *StreamJobEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream job events params
+func (o *StreamJobEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream job events params
+func (o *StreamJobEventsParams) WithHTTPClient(client *http.Client) *StreamJobEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream job events params
+func (o *StreamJobEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the stream job events params
+func (o *StreamJobEventsParams) WithAcceptLanguage(acceptLanguage *string) *StreamJobEventsParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the stream job events params
+func (o *StreamJobEventsParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithID adds the id to the stream job events params
+func (o *StreamJobEventsParams) WithID(id string) *StreamJobEventsParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the stream job events params
+func (o *StreamJobEventsParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the stream job events params
+func (o *StreamJobEventsParams) WithLang(lang *string) *StreamJobEventsParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the stream job events params
+func (o *StreamJobEventsParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamJobEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// StreamJobEventsReader is a Reader for the StreamJobEvents structure.
+type StreamJobEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamJobEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStreamJobEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewStreamJobEventsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /jobs/{id}/events] streamJobEvents", response, response.Code())
+	}
+}
+
+// NewStreamJobEventsOK creates a StreamJobEventsOK with default headers values
+func NewStreamJobEventsOK() *StreamJobEventsOK {
+	return &StreamJobEventsOK{}
+}
+
+/*
+StreamJobEventsOK describes a response with status code 200, with default header values.
+
+事件流，每个事件的data为Job
+*/
+type StreamJobEventsOK struct {
+	Payload *models.Job
+}
+
+// IsSuccess returns true when this stream job events o k response has a 2xx status code
+func (o *StreamJobEventsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this stream job events o k response has a 3xx status code
+func (o *StreamJobEventsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream job events o k response has a 4xx status code
+func (o *StreamJobEventsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream job events o k response has a 5xx status code
+func (o *StreamJobEventsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream job events o k response a status code equal to that given
+func (o *StreamJobEventsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the stream job events o k response
+func (o *StreamJobEventsOK) Code() int {
+	return 200
+}
+
+func (o *StreamJobEventsOK) Error() string {
+	return fmt.Sprintf("[GET /jobs/{id}/events][%d] streamJobEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamJobEventsOK) String() string {
+	return fmt.Sprintf("[GET /jobs/{id}/events][%d] streamJobEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamJobEventsOK) GetPayload() *models.Job {
+	return o.Payload
+}
+
+func (o *StreamJobEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Job)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamJobEventsNotFound creates a StreamJobEventsNotFound with default headers values
+func NewStreamJobEventsNotFound() *StreamJobEventsNotFound {
+	return &StreamJobEventsNotFound{}
+}
+
+/*
+StreamJobEventsNotFound describes a response with status code 404, with default header values.
+
+任务不存在、已过期或由其他API key提交
+*/
+type StreamJobEventsNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this stream job events not found response has a 2xx status code
+func (o *StreamJobEventsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream job events not found response has a 3xx status code
+func (o *StreamJobEventsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream job events not found response has a 4xx status code
+func (o *StreamJobEventsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream job events not found response has a 5xx status code
+func (o *StreamJobEventsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream job events not found response a status code equal to that given
+func (o *StreamJobEventsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the stream job events not found response
+func (o *StreamJobEventsNotFound) Code() int {
+	return 404
+}
+
+func (o *StreamJobEventsNotFound) Error() string {
+	return fmt.Sprintf("[GET /jobs/{id}/events][%d] streamJobEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StreamJobEventsNotFound) String() string {
+	return fmt.Sprintf("[GET /jobs/{id}/events][%d] streamJobEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StreamJobEventsNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *StreamJobEventsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
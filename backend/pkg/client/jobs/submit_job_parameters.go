@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewSubmitJobParams creates a new SubmitJobParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSubmitJobParams() *SubmitJobParams {
+	return &SubmitJobParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSubmitJobParamsWithTimeout creates a new SubmitJobParams object
+// with the ability to set a timeout on a request.
+func NewSubmitJobParamsWithTimeout(timeout time.Duration) *SubmitJobParams {
+	return &SubmitJobParams{
+		timeout: timeout,
+	}
+}
+
+// NewSubmitJobParamsWithContext creates a new SubmitJobParams object
+// with the ability to set a context for a request.
+func NewSubmitJobParamsWithContext(ctx context.Context) *SubmitJobParams {
+	return &SubmitJobParams{
+		Context: ctx,
+	}
+}
+
+// NewSubmitJobParamsWithHTTPClient creates a new SubmitJobParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSubmitJobParamsWithHTTPClient(client *http.Client) *SubmitJobParams {
+	return &SubmitJobParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SubmitJobParams contains all the parameters to send to the API endpoint
+
+	for the submit job operation.
+
+	Typically these are written to a http.Request.
+*/
+type SubmitJobParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.JobRequest
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the submit job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SubmitJobParams) WithDefaults() *SubmitJobParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the submit job params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SubmitJobParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the submit job params
+func (o *SubmitJobParams) WithTimeout(timeout time.Duration) *SubmitJobParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the submit job params
+func (o *SubmitJobParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the submit job params
+func (o *SubmitJobParams) WithContext(ctx context.Context) *SubmitJobParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the submit job params
+func (o *SubmitJobParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the submit job params
+func (o *SubmitJobParams) WithHTTPClient(client *http.Client) *SubmitJobParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the submit job params
+func (o *SubmitJobParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the submit job params
+func (o *SubmitJobParams) WithAcceptLanguage(acceptLanguage *string) *SubmitJobParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the submit job params
+func (o *SubmitJobParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXGuildID adds the xGuildID to the submit job params
+func (o *SubmitJobParams) WithXGuildID(xGuildID *string) *SubmitJobParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the submit job params
+func (o *SubmitJobParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the submit job params
+func (o *SubmitJobParams) WithXPlayerID(xPlayerID *string) *SubmitJobParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the submit job params
+func (o *SubmitJobParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the submit job params
+func (o *SubmitJobParams) WithBody(body *models.JobRequest) *SubmitJobParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the submit job params
+func (o *SubmitJobParams) SetBody(body *models.JobRequest) {
+	o.Body = body
+}
+
+// WithLang adds the lang to the submit job params
+func (o *SubmitJobParams) WithLang(lang *string) *SubmitJobParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the submit job params
+func (o *SubmitJobParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SubmitJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SubmitJobReader is a Reader for the SubmitJob structure.
+type SubmitJobReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SubmitJobReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewSubmitJobAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSubmitJobBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewSubmitJobServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /jobs] submitJob", response, response.Code())
+	}
+}
+
+// NewSubmitJobAccepted creates a SubmitJobAccepted with default headers values
+func NewSubmitJobAccepted() *SubmitJobAccepted {
+	return &SubmitJobAccepted{}
+}
+
+/*
+SubmitJobAccepted describes a response with status code 202, with default header values.
+
+任务已提交
+*/
+type SubmitJobAccepted struct {
+
+	/* 任务状态的地址
+	 */
+	Location string
+
+	Payload *models.Job
+}
+
+// IsSuccess returns true when this submit job accepted response has a 2xx status code
+func (o *SubmitJobAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this submit job accepted response has a 3xx status code
+func (o *SubmitJobAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this submit job accepted response has a 4xx status code
+func (o *SubmitJobAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this submit job accepted response has a 5xx status code
+func (o *SubmitJobAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this submit job accepted response a status code equal to that given
+func (o *SubmitJobAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the submit job accepted response
+func (o *SubmitJobAccepted) Code() int {
+	return 202
+}
+
+func (o *SubmitJobAccepted) Error() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SubmitJobAccepted) String() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SubmitJobAccepted) GetPayload() *models.Job {
+	return o.Payload
+}
+
+func (o *SubmitJobAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Location
+	hdrLocation := response.GetHeader("Location")
+
+	if hdrLocation != "" {
+		o.Location = hdrLocation
+	}
+
+	o.Payload = new(models.Job)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSubmitJobBadRequest creates a SubmitJobBadRequest with default headers values
+func NewSubmitJobBadRequest() *SubmitJobBadRequest {
+	return &SubmitJobBadRequest{}
+}
+
+/*
+SubmitJobBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type SubmitJobBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this submit job bad request response has a 2xx status code
+func (o *SubmitJobBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this submit job bad request response has a 3xx status code
+func (o *SubmitJobBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this submit job bad request response has a 4xx status code
+func (o *SubmitJobBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this submit job bad request response has a 5xx status code
+func (o *SubmitJobBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this submit job bad request response a status code equal to that given
+func (o *SubmitJobBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the submit job bad request response
+func (o *SubmitJobBadRequest) Code() int {
+	return 400
+}
+
+func (o *SubmitJobBadRequest) Error() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SubmitJobBadRequest) String() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SubmitJobBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SubmitJobBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSubmitJobServiceUnavailable creates a SubmitJobServiceUnavailable with default headers values
+func NewSubmitJobServiceUnavailable() *SubmitJobServiceUnavailable {
+	return &SubmitJobServiceUnavailable{}
+}
+
+/*
+SubmitJobServiceUnavailable describes a response with status code 503, with default header values.
+
+任务队列已满或服务正在停止，稍后重试
+*/
+type SubmitJobServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this submit job service unavailable response has a 2xx status code
+func (o *SubmitJobServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this submit job service unavailable response has a 3xx status code
+func (o *SubmitJobServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this submit job service unavailable response has a 4xx status code
+func (o *SubmitJobServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this submit job service unavailable response has a 5xx status code
+func (o *SubmitJobServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this submit job service unavailable response a status code equal to that given
+func (o *SubmitJobServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the submit job service unavailable response
+func (o *SubmitJobServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *SubmitJobServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *SubmitJobServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /jobs][%d] submitJobServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *SubmitJobServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SubmitJobServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,320 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewCalculateAffixProbabilityParams creates a new CalculateAffixProbabilityParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCalculateAffixProbabilityParams() *CalculateAffixProbabilityParams {
+	return &CalculateAffixProbabilityParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCalculateAffixProbabilityParamsWithTimeout creates a new CalculateAffixProbabilityParams object
+// with the ability to set a timeout on a request.
+func NewCalculateAffixProbabilityParamsWithTimeout(timeout time.Duration) *CalculateAffixProbabilityParams {
+	return &CalculateAffixProbabilityParams{
+		timeout: timeout,
+	}
+}
+
+// NewCalculateAffixProbabilityParamsWithContext creates a new CalculateAffixProbabilityParams object
+// with the ability to set a context for a request.
+func NewCalculateAffixProbabilityParamsWithContext(ctx context.Context) *CalculateAffixProbabilityParams {
+	return &CalculateAffixProbabilityParams{
+		Context: ctx,
+	}
+}
+
+// NewCalculateAffixProbabilityParamsWithHTTPClient creates a new CalculateAffixProbabilityParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCalculateAffixProbabilityParamsWithHTTPClient(client *http.Client) *CalculateAffixProbabilityParams {
+	return &CalculateAffixProbabilityParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CalculateAffixProbabilityParams contains all the parameters to send to the API endpoint
+
+	for the calculate affix probability operation.
+
+	Typically these are written to a http.Request.
+*/
+type CalculateAffixProbabilityParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* IfNoneMatch.
+
+	   之前响应的ETag，结果未变化时返回304
+	*/
+	IfNoneMatch *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.AffixProbabilityRequest
+
+	/* Format.
+
+	     结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、
+	jsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON
+
+	*/
+	Format *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the calculate affix probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateAffixProbabilityParams) WithDefaults() *CalculateAffixProbabilityParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the calculate affix probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateAffixProbabilityParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithTimeout(timeout time.Duration) *CalculateAffixProbabilityParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithContext(ctx context.Context) *CalculateAffixProbabilityParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithHTTPClient(client *http.Client) *CalculateAffixProbabilityParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithAcceptLanguage(acceptLanguage *string) *CalculateAffixProbabilityParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithIfNoneMatch(ifNoneMatch *string) *CalculateAffixProbabilityParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithXGuildID adds the xGuildID to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithXGuildID(xGuildID *string) *CalculateAffixProbabilityParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithXPlayerID(xPlayerID *string) *CalculateAffixProbabilityParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithBody(body *models.AffixProbabilityRequest) *CalculateAffixProbabilityParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetBody(body *models.AffixProbabilityRequest) {
+	o.Body = body
+}
+
+// WithFormat adds the format to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithFormat(format *string) *CalculateAffixProbabilityParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithLang adds the lang to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) WithLang(lang *string) *CalculateAffixProbabilityParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the calculate affix probability params
+func (o *CalculateAffixProbabilityParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CalculateAffixProbabilityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
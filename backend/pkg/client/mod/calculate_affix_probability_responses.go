@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CalculateAffixProbabilityReader is a Reader for the CalculateAffixProbability structure.
+type CalculateAffixProbabilityReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CalculateAffixProbabilityReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCalculateAffixProbabilityOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewCalculateAffixProbabilityNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewCalculateAffixProbabilityBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /mod/affix/probability] calculateAffixProbability", response, response.Code())
+	}
+}
+
+// NewCalculateAffixProbabilityOK creates a CalculateAffixProbabilityOK with default headers values
+func NewCalculateAffixProbabilityOK() *CalculateAffixProbabilityOK {
+	return &CalculateAffixProbabilityOK{}
+}
+
+/*
+CalculateAffixProbabilityOK describes a response with status code 200, with default header values.
+
+计算成功
+*/
+type CalculateAffixProbabilityOK struct {
+
+	/* 计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+	 */
+	ETag string
+
+	Payload *models.AffixProbabilityResponse
+}
+
+// IsSuccess returns true when this calculate affix probability o k response has a 2xx status code
+func (o *CalculateAffixProbabilityOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this calculate affix probability o k response has a 3xx status code
+func (o *CalculateAffixProbabilityOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate affix probability o k response has a 4xx status code
+func (o *CalculateAffixProbabilityOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate affix probability o k response has a 5xx status code
+func (o *CalculateAffixProbabilityOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix probability o k response a status code equal to that given
+func (o *CalculateAffixProbabilityOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the calculate affix probability o k response
+func (o *CalculateAffixProbabilityOK) Code() int {
+	return 200
+}
+
+func (o *CalculateAffixProbabilityOK) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateAffixProbabilityOK) String() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateAffixProbabilityOK) GetPayload() *models.AffixProbabilityResponse {
+	return o.Payload
+}
+
+func (o *CalculateAffixProbabilityOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.AffixProbabilityResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCalculateAffixProbabilityNotModified creates a CalculateAffixProbabilityNotModified with default headers values
+func NewCalculateAffixProbabilityNotModified() *CalculateAffixProbabilityNotModified {
+	return &CalculateAffixProbabilityNotModified{}
+}
+
+/*
+CalculateAffixProbabilityNotModified describes a response with status code 304, with default header values.
+
+结果与If-None-Match中的ETag相同，不返回响应体
+*/
+type CalculateAffixProbabilityNotModified struct {
+
+	/* 计算结果的实体标签
+	 */
+	ETag string
+}
+
+// IsSuccess returns true when this calculate affix probability not modified response has a 2xx status code
+func (o *CalculateAffixProbabilityNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate affix probability not modified response has a 3xx status code
+func (o *CalculateAffixProbabilityNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this calculate affix probability not modified response has a 4xx status code
+func (o *CalculateAffixProbabilityNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate affix probability not modified response has a 5xx status code
+func (o *CalculateAffixProbabilityNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix probability not modified response a status code equal to that given
+func (o *CalculateAffixProbabilityNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the calculate affix probability not modified response
+func (o *CalculateAffixProbabilityNotModified) Code() int {
+	return 304
+}
+
+func (o *CalculateAffixProbabilityNotModified) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityNotModified ", 304)
+}
+
+func (o *CalculateAffixProbabilityNotModified) String() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityNotModified ", 304)
+}
+
+func (o *CalculateAffixProbabilityNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewCalculateAffixProbabilityBadRequest creates a CalculateAffixProbabilityBadRequest with default headers values
+func NewCalculateAffixProbabilityBadRequest() *CalculateAffixProbabilityBadRequest {
+	return &CalculateAffixProbabilityBadRequest{}
+}
+
+/*
+CalculateAffixProbabilityBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type CalculateAffixProbabilityBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this calculate affix probability bad request response has a 2xx status code
+func (o *CalculateAffixProbabilityBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate affix probability bad request response has a 3xx status code
+func (o *CalculateAffixProbabilityBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate affix probability bad request response has a 4xx status code
+func (o *CalculateAffixProbabilityBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this calculate affix probability bad request response has a 5xx status code
+func (o *CalculateAffixProbabilityBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix probability bad request response a status code equal to that given
+func (o *CalculateAffixProbabilityBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the calculate affix probability bad request response
+func (o *CalculateAffixProbabilityBadRequest) Code() int {
+	return 400
+}
+
+func (o *CalculateAffixProbabilityBadRequest) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateAffixProbabilityBadRequest) String() string {
+	return fmt.Sprintf("[POST /mod/affix/probability][%d] calculateAffixProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateAffixProbabilityBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CalculateAffixProbabilityBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewCalculateAffixValueProbabilityParams creates a new CalculateAffixValueProbabilityParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCalculateAffixValueProbabilityParams() *CalculateAffixValueProbabilityParams {
+	return &CalculateAffixValueProbabilityParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCalculateAffixValueProbabilityParamsWithTimeout creates a new CalculateAffixValueProbabilityParams object
+// with the ability to set a timeout on a request.
+func NewCalculateAffixValueProbabilityParamsWithTimeout(timeout time.Duration) *CalculateAffixValueProbabilityParams {
+	return &CalculateAffixValueProbabilityParams{
+		timeout: timeout,
+	}
+}
+
+// NewCalculateAffixValueProbabilityParamsWithContext creates a new CalculateAffixValueProbabilityParams object
+// with the ability to set a context for a request.
+func NewCalculateAffixValueProbabilityParamsWithContext(ctx context.Context) *CalculateAffixValueProbabilityParams {
+	return &CalculateAffixValueProbabilityParams{
+		Context: ctx,
+	}
+}
+
+// NewCalculateAffixValueProbabilityParamsWithHTTPClient creates a new CalculateAffixValueProbabilityParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCalculateAffixValueProbabilityParamsWithHTTPClient(client *http.Client) *CalculateAffixValueProbabilityParams {
+	return &CalculateAffixValueProbabilityParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CalculateAffixValueProbabilityParams contains all the parameters to send to the API endpoint
+
+	for the calculate affix value probability operation.
+
+	Typically these are written to a http.Request.
+*/
+type CalculateAffixValueProbabilityParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* IfNoneMatch.
+
+	   之前响应的ETag，结果未变化时返回304
+	*/
+	IfNoneMatch *string
+
+	// Body.
+	Body *models.AffixValueProbabilityRequest
+
+	/* Format.
+
+	     结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、
+	jsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON
+
+	*/
+	Format *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the calculate affix value probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateAffixValueProbabilityParams) WithDefaults() *CalculateAffixValueProbabilityParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the calculate affix value probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateAffixValueProbabilityParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithTimeout(timeout time.Duration) *CalculateAffixValueProbabilityParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithContext(ctx context.Context) *CalculateAffixValueProbabilityParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithHTTPClient(client *http.Client) *CalculateAffixValueProbabilityParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithAcceptLanguage(acceptLanguage *string) *CalculateAffixValueProbabilityParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithIfNoneMatch(ifNoneMatch *string) *CalculateAffixValueProbabilityParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithBody adds the body to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithBody(body *models.AffixValueProbabilityRequest) *CalculateAffixValueProbabilityParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetBody(body *models.AffixValueProbabilityRequest) {
+	o.Body = body
+}
+
+// WithFormat adds the format to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithFormat(format *string) *CalculateAffixValueProbabilityParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithLang adds the lang to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) WithLang(lang *string) *CalculateAffixValueProbabilityParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the calculate affix value probability params
+func (o *CalculateAffixValueProbabilityParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CalculateAffixValueProbabilityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
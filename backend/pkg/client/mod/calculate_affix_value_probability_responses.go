@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CalculateAffixValueProbabilityReader is a Reader for the CalculateAffixValueProbability structure.
+type CalculateAffixValueProbabilityReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CalculateAffixValueProbabilityReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCalculateAffixValueProbabilityOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewCalculateAffixValueProbabilityNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewCalculateAffixValueProbabilityBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /mod/affix/value/probability] calculateAffixValueProbability", response, response.Code())
+	}
+}
+
+// NewCalculateAffixValueProbabilityOK creates a CalculateAffixValueProbabilityOK with default headers values
+func NewCalculateAffixValueProbabilityOK() *CalculateAffixValueProbabilityOK {
+	return &CalculateAffixValueProbabilityOK{}
+}
+
+/*
+CalculateAffixValueProbabilityOK describes a response with status code 200, with default header values.
+
+计算成功
+*/
+type CalculateAffixValueProbabilityOK struct {
+
+	/* 计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+	 */
+	ETag string
+
+	Payload *models.AffixValueProbabilityResponse
+}
+
+// IsSuccess returns true when this calculate affix value probability o k response has a 2xx status code
+func (o *CalculateAffixValueProbabilityOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this calculate affix value probability o k response has a 3xx status code
+func (o *CalculateAffixValueProbabilityOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate affix value probability o k response has a 4xx status code
+func (o *CalculateAffixValueProbabilityOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate affix value probability o k response has a 5xx status code
+func (o *CalculateAffixValueProbabilityOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix value probability o k response a status code equal to that given
+func (o *CalculateAffixValueProbabilityOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the calculate affix value probability o k response
+func (o *CalculateAffixValueProbabilityOK) Code() int {
+	return 200
+}
+
+func (o *CalculateAffixValueProbabilityOK) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateAffixValueProbabilityOK) String() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateAffixValueProbabilityOK) GetPayload() *models.AffixValueProbabilityResponse {
+	return o.Payload
+}
+
+func (o *CalculateAffixValueProbabilityOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.AffixValueProbabilityResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCalculateAffixValueProbabilityNotModified creates a CalculateAffixValueProbabilityNotModified with default headers values
+func NewCalculateAffixValueProbabilityNotModified() *CalculateAffixValueProbabilityNotModified {
+	return &CalculateAffixValueProbabilityNotModified{}
+}
+
+/*
+CalculateAffixValueProbabilityNotModified describes a response with status code 304, with default header values.
+
+结果与If-None-Match中的ETag相同，不返回响应体
+*/
+type CalculateAffixValueProbabilityNotModified struct {
+
+	/* 计算结果的实体标签
+	 */
+	ETag string
+}
+
+// IsSuccess returns true when this calculate affix value probability not modified response has a 2xx status code
+func (o *CalculateAffixValueProbabilityNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate affix value probability not modified response has a 3xx status code
+func (o *CalculateAffixValueProbabilityNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this calculate affix value probability not modified response has a 4xx status code
+func (o *CalculateAffixValueProbabilityNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate affix value probability not modified response has a 5xx status code
+func (o *CalculateAffixValueProbabilityNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix value probability not modified response a status code equal to that given
+func (o *CalculateAffixValueProbabilityNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the calculate affix value probability not modified response
+func (o *CalculateAffixValueProbabilityNotModified) Code() int {
+	return 304
+}
+
+func (o *CalculateAffixValueProbabilityNotModified) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityNotModified ", 304)
+}
+
+func (o *CalculateAffixValueProbabilityNotModified) String() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityNotModified ", 304)
+}
+
+func (o *CalculateAffixValueProbabilityNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewCalculateAffixValueProbabilityBadRequest creates a CalculateAffixValueProbabilityBadRequest with default headers values
+func NewCalculateAffixValueProbabilityBadRequest() *CalculateAffixValueProbabilityBadRequest {
+	return &CalculateAffixValueProbabilityBadRequest{}
+}
+
+/*
+CalculateAffixValueProbabilityBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type CalculateAffixValueProbabilityBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this calculate affix value probability bad request response has a 2xx status code
+func (o *CalculateAffixValueProbabilityBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate affix value probability bad request response has a 3xx status code
+func (o *CalculateAffixValueProbabilityBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate affix value probability bad request response has a 4xx status code
+func (o *CalculateAffixValueProbabilityBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this calculate affix value probability bad request response has a 5xx status code
+func (o *CalculateAffixValueProbabilityBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate affix value probability bad request response a status code equal to that given
+func (o *CalculateAffixValueProbabilityBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the calculate affix value probability bad request response
+func (o *CalculateAffixValueProbabilityBadRequest) Code() int {
+	return 400
+}
+
+func (o *CalculateAffixValueProbabilityBadRequest) Error() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateAffixValueProbabilityBadRequest) String() string {
+	return fmt.Sprintf("[POST /mod/affix/value/probability][%d] calculateAffixValueProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateAffixValueProbabilityBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CalculateAffixValueProbabilityBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
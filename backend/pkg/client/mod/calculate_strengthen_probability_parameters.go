@@ -0,0 +1,270 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewCalculateStrengthenProbabilityParams creates a new CalculateStrengthenProbabilityParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCalculateStrengthenProbabilityParams() *CalculateStrengthenProbabilityParams {
+	return &CalculateStrengthenProbabilityParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCalculateStrengthenProbabilityParamsWithTimeout creates a new CalculateStrengthenProbabilityParams object
+// with the ability to set a timeout on a request.
+func NewCalculateStrengthenProbabilityParamsWithTimeout(timeout time.Duration) *CalculateStrengthenProbabilityParams {
+	return &CalculateStrengthenProbabilityParams{
+		timeout: timeout,
+	}
+}
+
+// NewCalculateStrengthenProbabilityParamsWithContext creates a new CalculateStrengthenProbabilityParams object
+// with the ability to set a context for a request.
+func NewCalculateStrengthenProbabilityParamsWithContext(ctx context.Context) *CalculateStrengthenProbabilityParams {
+	return &CalculateStrengthenProbabilityParams{
+		Context: ctx,
+	}
+}
+
+// NewCalculateStrengthenProbabilityParamsWithHTTPClient creates a new CalculateStrengthenProbabilityParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCalculateStrengthenProbabilityParamsWithHTTPClient(client *http.Client) *CalculateStrengthenProbabilityParams {
+	return &CalculateStrengthenProbabilityParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CalculateStrengthenProbabilityParams contains all the parameters to send to the API endpoint
+
+	for the calculate strengthen probability operation.
+
+	Typically these are written to a http.Request.
+*/
+type CalculateStrengthenProbabilityParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* IfNoneMatch.
+
+	   之前响应的ETag，结果未变化时返回304
+	*/
+	IfNoneMatch *string
+
+	// Body.
+	Body *models.StrengthenProbabilityRequest
+
+	/* Format.
+
+	     结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、
+	jsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON
+
+	*/
+	Format *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the calculate strengthen probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateStrengthenProbabilityParams) WithDefaults() *CalculateStrengthenProbabilityParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the calculate strengthen probability params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CalculateStrengthenProbabilityParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithTimeout(timeout time.Duration) *CalculateStrengthenProbabilityParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithContext(ctx context.Context) *CalculateStrengthenProbabilityParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithHTTPClient(client *http.Client) *CalculateStrengthenProbabilityParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithAcceptLanguage(acceptLanguage *string) *CalculateStrengthenProbabilityParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithIfNoneMatch(ifNoneMatch *string) *CalculateStrengthenProbabilityParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithBody adds the body to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithBody(body *models.StrengthenProbabilityRequest) *CalculateStrengthenProbabilityParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetBody(body *models.StrengthenProbabilityRequest) {
+	o.Body = body
+}
+
+// WithFormat adds the format to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithFormat(format *string) *CalculateStrengthenProbabilityParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithLang adds the lang to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) WithLang(lang *string) *CalculateStrengthenProbabilityParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the calculate strengthen probability params
+func (o *CalculateStrengthenProbabilityParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CalculateStrengthenProbabilityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CalculateStrengthenProbabilityReader is a Reader for the CalculateStrengthenProbability structure.
+type CalculateStrengthenProbabilityReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CalculateStrengthenProbabilityReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCalculateStrengthenProbabilityOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewCalculateStrengthenProbabilityNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewCalculateStrengthenProbabilityBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /mod/strengthen/probability] calculateStrengthenProbability", response, response.Code())
+	}
+}
+
+// NewCalculateStrengthenProbabilityOK creates a CalculateStrengthenProbabilityOK with default headers values
+func NewCalculateStrengthenProbabilityOK() *CalculateStrengthenProbabilityOK {
+	return &CalculateStrengthenProbabilityOK{}
+}
+
+/*
+CalculateStrengthenProbabilityOK describes a response with status code 200, with default header values.
+
+计算成功
+*/
+type CalculateStrengthenProbabilityOK struct {
+
+	/* 计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+	 */
+	ETag string
+
+	Payload *models.StrengthenProbabilityResponse
+}
+
+// IsSuccess returns true when this calculate strengthen probability o k response has a 2xx status code
+func (o *CalculateStrengthenProbabilityOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this calculate strengthen probability o k response has a 3xx status code
+func (o *CalculateStrengthenProbabilityOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate strengthen probability o k response has a 4xx status code
+func (o *CalculateStrengthenProbabilityOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate strengthen probability o k response has a 5xx status code
+func (o *CalculateStrengthenProbabilityOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate strengthen probability o k response a status code equal to that given
+func (o *CalculateStrengthenProbabilityOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the calculate strengthen probability o k response
+func (o *CalculateStrengthenProbabilityOK) Code() int {
+	return 200
+}
+
+func (o *CalculateStrengthenProbabilityOK) Error() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateStrengthenProbabilityOK) String() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityOK  %+v", 200, o.Payload)
+}
+
+func (o *CalculateStrengthenProbabilityOK) GetPayload() *models.StrengthenProbabilityResponse {
+	return o.Payload
+}
+
+func (o *CalculateStrengthenProbabilityOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.StrengthenProbabilityResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCalculateStrengthenProbabilityNotModified creates a CalculateStrengthenProbabilityNotModified with default headers values
+func NewCalculateStrengthenProbabilityNotModified() *CalculateStrengthenProbabilityNotModified {
+	return &CalculateStrengthenProbabilityNotModified{}
+}
+
+/*
+CalculateStrengthenProbabilityNotModified describes a response with status code 304, with default header values.
+
+结果与If-None-Match中的ETag相同，不返回响应体
+*/
+type CalculateStrengthenProbabilityNotModified struct {
+
+	/* 计算结果的实体标签
+	 */
+	ETag string
+}
+
+// IsSuccess returns true when this calculate strengthen probability not modified response has a 2xx status code
+func (o *CalculateStrengthenProbabilityNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate strengthen probability not modified response has a 3xx status code
+func (o *CalculateStrengthenProbabilityNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this calculate strengthen probability not modified response has a 4xx status code
+func (o *CalculateStrengthenProbabilityNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this calculate strengthen probability not modified response has a 5xx status code
+func (o *CalculateStrengthenProbabilityNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate strengthen probability not modified response a status code equal to that given
+func (o *CalculateStrengthenProbabilityNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the calculate strengthen probability not modified response
+func (o *CalculateStrengthenProbabilityNotModified) Code() int {
+	return 304
+}
+
+func (o *CalculateStrengthenProbabilityNotModified) Error() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityNotModified ", 304)
+}
+
+func (o *CalculateStrengthenProbabilityNotModified) String() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityNotModified ", 304)
+}
+
+func (o *CalculateStrengthenProbabilityNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewCalculateStrengthenProbabilityBadRequest creates a CalculateStrengthenProbabilityBadRequest with default headers values
+func NewCalculateStrengthenProbabilityBadRequest() *CalculateStrengthenProbabilityBadRequest {
+	return &CalculateStrengthenProbabilityBadRequest{}
+}
+
+/*
+CalculateStrengthenProbabilityBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type CalculateStrengthenProbabilityBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this calculate strengthen probability bad request response has a 2xx status code
+func (o *CalculateStrengthenProbabilityBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this calculate strengthen probability bad request response has a 3xx status code
+func (o *CalculateStrengthenProbabilityBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this calculate strengthen probability bad request response has a 4xx status code
+func (o *CalculateStrengthenProbabilityBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this calculate strengthen probability bad request response has a 5xx status code
+func (o *CalculateStrengthenProbabilityBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this calculate strengthen probability bad request response a status code equal to that given
+func (o *CalculateStrengthenProbabilityBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the calculate strengthen probability bad request response
+func (o *CalculateStrengthenProbabilityBadRequest) Code() int {
+	return 400
+}
+
+func (o *CalculateStrengthenProbabilityBadRequest) Error() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateStrengthenProbabilityBadRequest) String() string {
+	return fmt.Sprintf("[POST /mod/strengthen/probability][%d] calculateStrengthenProbabilityBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CalculateStrengthenProbabilityBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CalculateStrengthenProbabilityBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
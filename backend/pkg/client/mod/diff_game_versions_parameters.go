@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDiffGameVersionsParams creates a new DiffGameVersionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDiffGameVersionsParams() *DiffGameVersionsParams {
+	return &DiffGameVersionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDiffGameVersionsParamsWithTimeout creates a new DiffGameVersionsParams object
+// with the ability to set a timeout on a request.
+func NewDiffGameVersionsParamsWithTimeout(timeout time.Duration) *DiffGameVersionsParams {
+	return &DiffGameVersionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewDiffGameVersionsParamsWithContext creates a new DiffGameVersionsParams object
+// with the ability to set a context for a request.
+func NewDiffGameVersionsParamsWithContext(ctx context.Context) *DiffGameVersionsParams {
+	return &DiffGameVersionsParams{
+		Context: ctx,
+	}
+}
+
+// NewDiffGameVersionsParamsWithHTTPClient creates a new DiffGameVersionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDiffGameVersionsParamsWithHTTPClient(client *http.Client) *DiffGameVersionsParams {
+	return &DiffGameVersionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DiffGameVersionsParams contains all the parameters to send to the API endpoint
+
+	for the diff game versions operation.
+
+	Typically these are written to a http.Request.
+*/
+type DiffGameVersionsParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* From.
+
+	   起始游戏版本ID
+	*/
+	From string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	/* To.
+
+	   目标游戏版本ID，不填或latest表示最新版本
+	*/
+	To *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the diff game versions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DiffGameVersionsParams) WithDefaults() *DiffGameVersionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the diff game versions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DiffGameVersionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the diff game versions params
+func (o *DiffGameVersionsParams) WithTimeout(timeout time.Duration) *DiffGameVersionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the diff game versions params
+func (o *DiffGameVersionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the diff game versions params
+func (o *DiffGameVersionsParams) WithContext(ctx context.Context) *DiffGameVersionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the diff game versions params
+func (o *DiffGameVersionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the diff game versions params
+func (o *DiffGameVersionsParams) WithHTTPClient(client *http.Client) *DiffGameVersionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the diff game versions params
+func (o *DiffGameVersionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the diff game versions params
+func (o *DiffGameVersionsParams) WithAcceptLanguage(acceptLanguage *string) *DiffGameVersionsParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the diff game versions params
+func (o *DiffGameVersionsParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithFrom adds the from to the diff game versions params
+func (o *DiffGameVersionsParams) WithFrom(from string) *DiffGameVersionsParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the diff game versions params
+func (o *DiffGameVersionsParams) SetFrom(from string) {
+	o.From = from
+}
+
+// WithLang adds the lang to the diff game versions params
+func (o *DiffGameVersionsParams) WithLang(lang *string) *DiffGameVersionsParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the diff game versions params
+func (o *DiffGameVersionsParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithTo adds the to to the diff game versions params
+func (o *DiffGameVersionsParams) WithTo(to *string) *DiffGameVersionsParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the diff game versions params
+func (o *DiffGameVersionsParams) SetTo(to *string) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DiffGameVersionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	// query param from
+	qrFrom := o.From
+	qFrom := qrFrom
+	if qFrom != "" {
+
+		if err := r.SetQueryParam("from", qFrom); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo string
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
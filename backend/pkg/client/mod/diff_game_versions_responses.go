@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DiffGameVersionsReader is a Reader for the DiffGameVersions structure.
+type DiffGameVersionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DiffGameVersionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDiffGameVersionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDiffGameVersionsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/versions/diff] diffGameVersions", response, response.Code())
+	}
+}
+
+// NewDiffGameVersionsOK creates a DiffGameVersionsOK with default headers values
+func NewDiffGameVersionsOK() *DiffGameVersionsOK {
+	return &DiffGameVersionsOK{}
+}
+
+/*
+DiffGameVersionsOK describes a response with status code 200, with default header values.
+
+比较成功
+*/
+type DiffGameVersionsOK struct {
+	Payload *models.GameVersionDiffResponse
+}
+
+// IsSuccess returns true when this diff game versions o k response has a 2xx status code
+func (o *DiffGameVersionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this diff game versions o k response has a 3xx status code
+func (o *DiffGameVersionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff game versions o k response has a 4xx status code
+func (o *DiffGameVersionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this diff game versions o k response has a 5xx status code
+func (o *DiffGameVersionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this diff game versions o k response a status code equal to that given
+func (o *DiffGameVersionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the diff game versions o k response
+func (o *DiffGameVersionsOK) Code() int {
+	return 200
+}
+
+func (o *DiffGameVersionsOK) Error() string {
+	return fmt.Sprintf("[GET /mod/versions/diff][%d] diffGameVersionsOK  %+v", 200, o.Payload)
+}
+
+func (o *DiffGameVersionsOK) String() string {
+	return fmt.Sprintf("[GET /mod/versions/diff][%d] diffGameVersionsOK  %+v", 200, o.Payload)
+}
+
+func (o *DiffGameVersionsOK) GetPayload() *models.GameVersionDiffResponse {
+	return o.Payload
+}
+
+func (o *DiffGameVersionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GameVersionDiffResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDiffGameVersionsBadRequest creates a DiffGameVersionsBadRequest with default headers values
+func NewDiffGameVersionsBadRequest() *DiffGameVersionsBadRequest {
+	return &DiffGameVersionsBadRequest{}
+}
+
+/*
+DiffGameVersionsBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type DiffGameVersionsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this diff game versions bad request response has a 2xx status code
+func (o *DiffGameVersionsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this diff game versions bad request response has a 3xx status code
+func (o *DiffGameVersionsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this diff game versions bad request response has a 4xx status code
+func (o *DiffGameVersionsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this diff game versions bad request response has a 5xx status code
+func (o *DiffGameVersionsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this diff game versions bad request response a status code equal to that given
+func (o *DiffGameVersionsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the diff game versions bad request response
+func (o *DiffGameVersionsBadRequest) Code() int {
+	return 400
+}
+
+func (o *DiffGameVersionsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /mod/versions/diff][%d] diffGameVersionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DiffGameVersionsBadRequest) String() string {
+	return fmt.Sprintf("[GET /mod/versions/diff][%d] diffGameVersionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DiffGameVersionsBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DiffGameVersionsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
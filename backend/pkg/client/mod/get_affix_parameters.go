@@ -0,0 +1,244 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAffixParams creates a new GetAffixParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAffixParams() *GetAffixParams {
+	return &GetAffixParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAffixParamsWithTimeout creates a new GetAffixParams object
+// with the ability to set a timeout on a request.
+func NewGetAffixParamsWithTimeout(timeout time.Duration) *GetAffixParams {
+	return &GetAffixParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAffixParamsWithContext creates a new GetAffixParams object
+// with the ability to set a context for a request.
+func NewGetAffixParamsWithContext(ctx context.Context) *GetAffixParams {
+	return &GetAffixParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAffixParamsWithHTTPClient creates a new GetAffixParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAffixParamsWithHTTPClient(client *http.Client) *GetAffixParams {
+	return &GetAffixParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetAffixParams contains all the parameters to send to the API endpoint
+
+	for the get affix operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetAffixParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* GameVersion.
+
+	   游戏版本ID，不填或latest表示最新版本
+	*/
+	GameVersion *string
+
+	// ID.
+	//
+	// Format: int32
+	ID int32
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get affix params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAffixParams) WithDefaults() *GetAffixParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get affix params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAffixParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get affix params
+func (o *GetAffixParams) WithTimeout(timeout time.Duration) *GetAffixParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get affix params
+func (o *GetAffixParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get affix params
+func (o *GetAffixParams) WithContext(ctx context.Context) *GetAffixParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get affix params
+func (o *GetAffixParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get affix params
+func (o *GetAffixParams) WithHTTPClient(client *http.Client) *GetAffixParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get affix params
+func (o *GetAffixParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the get affix params
+func (o *GetAffixParams) WithAcceptLanguage(acceptLanguage *string) *GetAffixParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the get affix params
+func (o *GetAffixParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithGameVersion adds the gameVersion to the get affix params
+func (o *GetAffixParams) WithGameVersion(gameVersion *string) *GetAffixParams {
+	o.SetGameVersion(gameVersion)
+	return o
+}
+
+// SetGameVersion adds the gameVersion to the get affix params
+func (o *GetAffixParams) SetGameVersion(gameVersion *string) {
+	o.GameVersion = gameVersion
+}
+
+// WithID adds the id to the get affix params
+func (o *GetAffixParams) WithID(id int32) *GetAffixParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get affix params
+func (o *GetAffixParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the get affix params
+func (o *GetAffixParams) WithLang(lang *string) *GetAffixParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the get affix params
+func (o *GetAffixParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAffixParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.GameVersion != nil {
+
+		// query param gameVersion
+		var qrGameVersion string
+
+		if o.GameVersion != nil {
+			qrGameVersion = *o.GameVersion
+		}
+		qGameVersion := qrGameVersion
+		if qGameVersion != "" {
+
+			if err := r.SetQueryParam("gameVersion", qGameVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetAffixReader is a Reader for the GetAffix structure.
+type GetAffixReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAffixReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAffixOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetAffixBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetAffixNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/affix/{id}] getAffix", response, response.Code())
+	}
+}
+
+// NewGetAffixOK creates a GetAffixOK with default headers values
+func NewGetAffixOK() *GetAffixOK {
+	return &GetAffixOK{}
+}
+
+/*
+GetAffixOK describes a response with status code 200, with default header values.
+
+成功获取词条
+*/
+type GetAffixOK struct {
+	Payload *models.Affix
+}
+
+// IsSuccess returns true when this get affix o k response has a 2xx status code
+func (o *GetAffixOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get affix o k response has a 3xx status code
+func (o *GetAffixOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get affix o k response has a 4xx status code
+func (o *GetAffixOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get affix o k response has a 5xx status code
+func (o *GetAffixOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get affix o k response a status code equal to that given
+func (o *GetAffixOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get affix o k response
+func (o *GetAffixOK) Code() int {
+	return 200
+}
+
+func (o *GetAffixOK) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAffixOK) String() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAffixOK) GetPayload() *models.Affix {
+	return o.Payload
+}
+
+func (o *GetAffixOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Affix)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAffixBadRequest creates a GetAffixBadRequest with default headers values
+func NewGetAffixBadRequest() *GetAffixBadRequest {
+	return &GetAffixBadRequest{}
+}
+
+/*
+GetAffixBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type GetAffixBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get affix bad request response has a 2xx status code
+func (o *GetAffixBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get affix bad request response has a 3xx status code
+func (o *GetAffixBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get affix bad request response has a 4xx status code
+func (o *GetAffixBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get affix bad request response has a 5xx status code
+func (o *GetAffixBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get affix bad request response a status code equal to that given
+func (o *GetAffixBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get affix bad request response
+func (o *GetAffixBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetAffixBadRequest) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAffixBadRequest) String() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAffixBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetAffixBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAffixNotFound creates a GetAffixNotFound with default headers values
+func NewGetAffixNotFound() *GetAffixNotFound {
+	return &GetAffixNotFound{}
+}
+
+/*
+GetAffixNotFound describes a response with status code 404, with default header values.
+
+词条不存在
+*/
+type GetAffixNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get affix not found response has a 2xx status code
+func (o *GetAffixNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get affix not found response has a 3xx status code
+func (o *GetAffixNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get affix not found response has a 4xx status code
+func (o *GetAffixNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get affix not found response has a 5xx status code
+func (o *GetAffixNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get affix not found response a status code equal to that given
+func (o *GetAffixNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get affix not found response
+func (o *GetAffixNotFound) Code() int {
+	return 404
+}
+
+func (o *GetAffixNotFound) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetAffixNotFound) String() string {
+	return fmt.Sprintf("[GET /mod/affix/{id}][%d] getAffixNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetAffixNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetAffixNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
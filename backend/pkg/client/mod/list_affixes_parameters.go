@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAffixesParams creates a new ListAffixesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAffixesParams() *ListAffixesParams {
+	return &ListAffixesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAffixesParamsWithTimeout creates a new ListAffixesParams object
+// with the ability to set a timeout on a request.
+func NewListAffixesParamsWithTimeout(timeout time.Duration) *ListAffixesParams {
+	return &ListAffixesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAffixesParamsWithContext creates a new ListAffixesParams object
+// with the ability to set a context for a request.
+func NewListAffixesParamsWithContext(ctx context.Context) *ListAffixesParams {
+	return &ListAffixesParams{
+		Context: ctx,
+	}
+}
+
+// NewListAffixesParamsWithHTTPClient creates a new ListAffixesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAffixesParamsWithHTTPClient(client *http.Client) *ListAffixesParams {
+	return &ListAffixesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListAffixesParams contains all the parameters to send to the API endpoint
+
+	for the list affixes operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListAffixesParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* Category.
+
+	   词条分类（damage、defense、utility），不填返回全部
+	*/
+	Category *string
+
+	/* GameVersion.
+
+	   游戏版本ID，不填或latest表示最新版本
+	*/
+	GameVersion *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list affixes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAffixesParams) WithDefaults() *ListAffixesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list affixes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAffixesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list affixes params
+func (o *ListAffixesParams) WithTimeout(timeout time.Duration) *ListAffixesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list affixes params
+func (o *ListAffixesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list affixes params
+func (o *ListAffixesParams) WithContext(ctx context.Context) *ListAffixesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list affixes params
+func (o *ListAffixesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list affixes params
+func (o *ListAffixesParams) WithHTTPClient(client *http.Client) *ListAffixesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list affixes params
+func (o *ListAffixesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the list affixes params
+func (o *ListAffixesParams) WithAcceptLanguage(acceptLanguage *string) *ListAffixesParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the list affixes params
+func (o *ListAffixesParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithCategory adds the category to the list affixes params
+func (o *ListAffixesParams) WithCategory(category *string) *ListAffixesParams {
+	o.SetCategory(category)
+	return o
+}
+
+// SetCategory adds the category to the list affixes params
+func (o *ListAffixesParams) SetCategory(category *string) {
+	o.Category = category
+}
+
+// WithGameVersion adds the gameVersion to the list affixes params
+func (o *ListAffixesParams) WithGameVersion(gameVersion *string) *ListAffixesParams {
+	o.SetGameVersion(gameVersion)
+	return o
+}
+
+// SetGameVersion adds the gameVersion to the list affixes params
+func (o *ListAffixesParams) SetGameVersion(gameVersion *string) {
+	o.GameVersion = gameVersion
+}
+
+// WithLang adds the lang to the list affixes params
+func (o *ListAffixesParams) WithLang(lang *string) *ListAffixesParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the list affixes params
+func (o *ListAffixesParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAffixesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.Category != nil {
+
+		// query param category
+		var qrCategory string
+
+		if o.Category != nil {
+			qrCategory = *o.Category
+		}
+		qCategory := qrCategory
+		if qCategory != "" {
+
+			if err := r.SetQueryParam("category", qCategory); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.GameVersion != nil {
+
+		// query param gameVersion
+		var qrGameVersion string
+
+		if o.GameVersion != nil {
+			qrGameVersion = *o.GameVersion
+		}
+		qGameVersion := qrGameVersion
+		if qGameVersion != "" {
+
+			if err := r.SetQueryParam("gameVersion", qGameVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
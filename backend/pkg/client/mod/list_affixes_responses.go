@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListAffixesReader is a Reader for the ListAffixes structure.
+type ListAffixesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAffixesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAffixesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListAffixesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/affix/list] listAffixes", response, response.Code())
+	}
+}
+
+// NewListAffixesOK creates a ListAffixesOK with default headers values
+func NewListAffixesOK() *ListAffixesOK {
+	return &ListAffixesOK{}
+}
+
+/*
+ListAffixesOK describes a response with status code 200, with default header values.
+
+成功获取词条列表
+*/
+type ListAffixesOK struct {
+	Payload *models.AffixListResponse
+}
+
+// IsSuccess returns true when this list affixes o k response has a 2xx status code
+func (o *ListAffixesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list affixes o k response has a 3xx status code
+func (o *ListAffixesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list affixes o k response has a 4xx status code
+func (o *ListAffixesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list affixes o k response has a 5xx status code
+func (o *ListAffixesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list affixes o k response a status code equal to that given
+func (o *ListAffixesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list affixes o k response
+func (o *ListAffixesOK) Code() int {
+	return 200
+}
+
+func (o *ListAffixesOK) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/list][%d] listAffixesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAffixesOK) String() string {
+	return fmt.Sprintf("[GET /mod/affix/list][%d] listAffixesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAffixesOK) GetPayload() *models.AffixListResponse {
+	return o.Payload
+}
+
+func (o *ListAffixesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AffixListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAffixesBadRequest creates a ListAffixesBadRequest with default headers values
+func NewListAffixesBadRequest() *ListAffixesBadRequest {
+	return &ListAffixesBadRequest{}
+}
+
+/*
+ListAffixesBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type ListAffixesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list affixes bad request response has a 2xx status code
+func (o *ListAffixesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list affixes bad request response has a 3xx status code
+func (o *ListAffixesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list affixes bad request response has a 4xx status code
+func (o *ListAffixesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list affixes bad request response has a 5xx status code
+func (o *ListAffixesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list affixes bad request response a status code equal to that given
+func (o *ListAffixesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list affixes bad request response
+func (o *ListAffixesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListAffixesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/list][%d] listAffixesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListAffixesBadRequest) String() string {
+	return fmt.Sprintf("[GET /mod/affix/list][%d] listAffixesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListAffixesBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListAffixesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
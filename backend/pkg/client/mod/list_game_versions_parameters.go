@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListGameVersionsParams creates a new ListGameVersionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListGameVersionsParams() *ListGameVersionsParams {
+	return &ListGameVersionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListGameVersionsParamsWithTimeout creates a new ListGameVersionsParams object
+// with the ability to set a timeout on a request.
+func NewListGameVersionsParamsWithTimeout(timeout time.Duration) *ListGameVersionsParams {
+	return &ListGameVersionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListGameVersionsParamsWithContext creates a new ListGameVersionsParams object
+// with the ability to set a context for a request.
+func NewListGameVersionsParamsWithContext(ctx context.Context) *ListGameVersionsParams {
+	return &ListGameVersionsParams{
+		Context: ctx,
+	}
+}
+
+// NewListGameVersionsParamsWithHTTPClient creates a new ListGameVersionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListGameVersionsParamsWithHTTPClient(client *http.Client) *ListGameVersionsParams {
+	return &ListGameVersionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListGameVersionsParams contains all the parameters to send to the API endpoint
+
+	for the list game versions operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListGameVersionsParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list game versions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListGameVersionsParams) WithDefaults() *ListGameVersionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list game versions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListGameVersionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list game versions params
+func (o *ListGameVersionsParams) WithTimeout(timeout time.Duration) *ListGameVersionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list game versions params
+func (o *ListGameVersionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list game versions params
+func (o *ListGameVersionsParams) WithContext(ctx context.Context) *ListGameVersionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list game versions params
+func (o *ListGameVersionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list game versions params
+func (o *ListGameVersionsParams) WithHTTPClient(client *http.Client) *ListGameVersionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list game versions params
+func (o *ListGameVersionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the list game versions params
+func (o *ListGameVersionsParams) WithAcceptLanguage(acceptLanguage *string) *ListGameVersionsParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the list game versions params
+func (o *ListGameVersionsParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithLang adds the lang to the list game versions params
+func (o *ListGameVersionsParams) WithLang(lang *string) *ListGameVersionsParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the list game versions params
+func (o *ListGameVersionsParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListGameVersionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListGameVersionsReader is a Reader for the ListGameVersions structure.
+type ListGameVersionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListGameVersionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListGameVersionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/versions] listGameVersions", response, response.Code())
+	}
+}
+
+// NewListGameVersionsOK creates a ListGameVersionsOK with default headers values
+func NewListGameVersionsOK() *ListGameVersionsOK {
+	return &ListGameVersionsOK{}
+}
+
+/*
+ListGameVersionsOK describes a response with status code 200, with default header values.
+
+成功获取游戏版本列表
+*/
+type ListGameVersionsOK struct {
+	Payload *models.GameVersionListResponse
+}
+
+// IsSuccess returns true when this list game versions o k response has a 2xx status code
+func (o *ListGameVersionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list game versions o k response has a 3xx status code
+func (o *ListGameVersionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list game versions o k response has a 4xx status code
+func (o *ListGameVersionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list game versions o k response has a 5xx status code
+func (o *ListGameVersionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list game versions o k response a status code equal to that given
+func (o *ListGameVersionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list game versions o k response
+func (o *ListGameVersionsOK) Code() int {
+	return 200
+}
+
+func (o *ListGameVersionsOK) Error() string {
+	return fmt.Sprintf("[GET /mod/versions][%d] listGameVersionsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListGameVersionsOK) String() string {
+	return fmt.Sprintf("[GET /mod/versions][%d] listGameVersionsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListGameVersionsOK) GetPayload() *models.GameVersionListResponse {
+	return o.Payload
+}
+
+func (o *ListGameVersionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GameVersionListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
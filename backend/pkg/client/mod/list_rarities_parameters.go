@@ -0,0 +1,222 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListRaritiesParams creates a new ListRaritiesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListRaritiesParams() *ListRaritiesParams {
+	return &ListRaritiesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListRaritiesParamsWithTimeout creates a new ListRaritiesParams object
+// with the ability to set a timeout on a request.
+func NewListRaritiesParamsWithTimeout(timeout time.Duration) *ListRaritiesParams {
+	return &ListRaritiesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListRaritiesParamsWithContext creates a new ListRaritiesParams object
+// with the ability to set a context for a request.
+func NewListRaritiesParamsWithContext(ctx context.Context) *ListRaritiesParams {
+	return &ListRaritiesParams{
+		Context: ctx,
+	}
+}
+
+// NewListRaritiesParamsWithHTTPClient creates a new ListRaritiesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListRaritiesParamsWithHTTPClient(client *http.Client) *ListRaritiesParams {
+	return &ListRaritiesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListRaritiesParams contains all the parameters to send to the API endpoint
+
+	for the list rarities operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListRaritiesParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* GameVersion.
+
+	   游戏版本ID，不填或latest表示最新版本
+	*/
+	GameVersion *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list rarities params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListRaritiesParams) WithDefaults() *ListRaritiesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list rarities params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListRaritiesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list rarities params
+func (o *ListRaritiesParams) WithTimeout(timeout time.Duration) *ListRaritiesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list rarities params
+func (o *ListRaritiesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list rarities params
+func (o *ListRaritiesParams) WithContext(ctx context.Context) *ListRaritiesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list rarities params
+func (o *ListRaritiesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list rarities params
+func (o *ListRaritiesParams) WithHTTPClient(client *http.Client) *ListRaritiesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list rarities params
+func (o *ListRaritiesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the list rarities params
+func (o *ListRaritiesParams) WithAcceptLanguage(acceptLanguage *string) *ListRaritiesParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the list rarities params
+func (o *ListRaritiesParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithGameVersion adds the gameVersion to the list rarities params
+func (o *ListRaritiesParams) WithGameVersion(gameVersion *string) *ListRaritiesParams {
+	o.SetGameVersion(gameVersion)
+	return o
+}
+
+// SetGameVersion adds the gameVersion to the list rarities params
+func (o *ListRaritiesParams) SetGameVersion(gameVersion *string) {
+	o.GameVersion = gameVersion
+}
+
+// WithLang adds the lang to the list rarities params
+func (o *ListRaritiesParams) WithLang(lang *string) *ListRaritiesParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the list rarities params
+func (o *ListRaritiesParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListRaritiesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.GameVersion != nil {
+
+		// query param gameVersion
+		var qrGameVersion string
+
+		if o.GameVersion != nil {
+			qrGameVersion = *o.GameVersion
+		}
+		qGameVersion := qrGameVersion
+		if qGameVersion != "" {
+
+			if err := r.SetQueryParam("gameVersion", qGameVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
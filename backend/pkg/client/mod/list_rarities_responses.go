@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListRaritiesReader is a Reader for the ListRarities structure.
+type ListRaritiesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListRaritiesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListRaritiesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListRaritiesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/rarity/list] listRarities", response, response.Code())
+	}
+}
+
+// NewListRaritiesOK creates a ListRaritiesOK with default headers values
+func NewListRaritiesOK() *ListRaritiesOK {
+	return &ListRaritiesOK{}
+}
+
+/*
+ListRaritiesOK describes a response with status code 200, with default header values.
+
+成功获取稀有度列表
+*/
+type ListRaritiesOK struct {
+	Payload *models.RarityListResponse
+}
+
+// IsSuccess returns true when this list rarities o k response has a 2xx status code
+func (o *ListRaritiesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list rarities o k response has a 3xx status code
+func (o *ListRaritiesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list rarities o k response has a 4xx status code
+func (o *ListRaritiesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list rarities o k response has a 5xx status code
+func (o *ListRaritiesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list rarities o k response a status code equal to that given
+func (o *ListRaritiesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list rarities o k response
+func (o *ListRaritiesOK) Code() int {
+	return 200
+}
+
+func (o *ListRaritiesOK) Error() string {
+	return fmt.Sprintf("[GET /mod/rarity/list][%d] listRaritiesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListRaritiesOK) String() string {
+	return fmt.Sprintf("[GET /mod/rarity/list][%d] listRaritiesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListRaritiesOK) GetPayload() *models.RarityListResponse {
+	return o.Payload
+}
+
+func (o *ListRaritiesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RarityListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListRaritiesBadRequest creates a ListRaritiesBadRequest with default headers values
+func NewListRaritiesBadRequest() *ListRaritiesBadRequest {
+	return &ListRaritiesBadRequest{}
+}
+
+/*
+ListRaritiesBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type ListRaritiesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list rarities bad request response has a 2xx status code
+func (o *ListRaritiesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list rarities bad request response has a 3xx status code
+func (o *ListRaritiesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list rarities bad request response has a 4xx status code
+func (o *ListRaritiesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list rarities bad request response has a 5xx status code
+func (o *ListRaritiesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list rarities bad request response a status code equal to that given
+func (o *ListRaritiesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list rarities bad request response
+func (o *ListRaritiesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListRaritiesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /mod/rarity/list][%d] listRaritiesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListRaritiesBadRequest) String() string {
+	return fmt.Sprintf("[GET /mod/rarity/list][%d] listRaritiesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListRaritiesBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListRaritiesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
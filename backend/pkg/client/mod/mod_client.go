@@ -0,0 +1,426 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new mod API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for mod API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	CalculateAffixProbability(params *CalculateAffixProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateAffixProbabilityOK, error)
+
+	CalculateAffixValueProbability(params *CalculateAffixValueProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateAffixValueProbabilityOK, error)
+
+	CalculateStrengthenProbability(params *CalculateStrengthenProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateStrengthenProbabilityOK, error)
+
+	DiffGameVersions(params *DiffGameVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DiffGameVersionsOK, error)
+
+	GetAffix(params *GetAffixParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAffixOK, error)
+
+	ListAffixes(params *ListAffixesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAffixesOK, error)
+
+	ListGameVersions(params *ListGameVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListGameVersionsOK, error)
+
+	ListRarities(params *ListRaritiesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListRaritiesOK, error)
+
+	SearchAffixes(params *SearchAffixesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SearchAffixesOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+CalculateAffixProbability 计算模组词条概率s
+
+计算指定词条组合出现的概率
+*/
+func (a *Client) CalculateAffixProbability(params *CalculateAffixProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateAffixProbabilityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCalculateAffixProbabilityParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "calculateAffixProbability",
+		Method:             "POST",
+		PathPattern:        "/mod/affix/probability",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/csv", "text/markdown"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CalculateAffixProbabilityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CalculateAffixProbabilityOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for calculateAffixProbability: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CalculateAffixValueProbability 计算词条数值概率s
+
+计算指定词条出现且数值达到阈值或档位的概率
+*/
+func (a *Client) CalculateAffixValueProbability(params *CalculateAffixValueProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateAffixValueProbabilityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCalculateAffixValueProbabilityParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "calculateAffixValueProbability",
+		Method:             "POST",
+		PathPattern:        "/mod/affix/value/probability",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/csv", "text/markdown"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CalculateAffixValueProbabilityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CalculateAffixValueProbabilityOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for calculateAffixValueProbability: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CalculateStrengthenProbability 计算强化成功概率s
+
+计算模组词条强化到目标等级的概率
+*/
+func (a *Client) CalculateStrengthenProbability(params *CalculateStrengthenProbabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CalculateStrengthenProbabilityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCalculateStrengthenProbabilityParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "calculateStrengthenProbability",
+		Method:             "POST",
+		PathPattern:        "/mod/strengthen/probability",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/csv", "text/markdown"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CalculateStrengthenProbabilityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CalculateStrengthenProbabilityOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for calculateStrengthenProbability: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DiffGameVersions 比较游戏版本s
+
+比较任意两个游戏版本之间的词条、数值、掉落权重和强化规则变动
+*/
+func (a *Client) DiffGameVersions(params *DiffGameVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DiffGameVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDiffGameVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "diffGameVersions",
+		Method:             "GET",
+		PathPattern:        "/mod/versions/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DiffGameVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DiffGameVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for diffGameVersions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetAffix 获取词条详情s
+
+根据ID获取词条
+*/
+func (a *Client) GetAffix(params *GetAffixParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAffixOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAffixParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAffix",
+		Method:             "GET",
+		PathPattern:        "/mod/affix/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetAffixReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAffixOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAffix: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListAffixes 获取词条列表s
+
+获取所有可用的模组词条
+*/
+func (a *Client) ListAffixes(params *ListAffixesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAffixesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAffixesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listAffixes",
+		Method:             "GET",
+		PathPattern:        "/mod/affix/list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListAffixesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAffixesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listAffixes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListGameVersions 获取游戏版本列表s
+
+获取所有游戏版本及其相对上一版本的词条、数值、掉落权重和强化规则变动
+*/
+func (a *Client) ListGameVersions(params *ListGameVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListGameVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListGameVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listGameVersions",
+		Method:             "GET",
+		PathPattern:        "/mod/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListGameVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListGameVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listGameVersions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListRarities 获取稀有度列表s
+
+获取所有模组稀有度及其词条数量、词条池、等级和掉落权重
+*/
+func (a *Client) ListRarities(params *ListRaritiesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListRaritiesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRaritiesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listRarities",
+		Method:             "GET",
+		PathPattern:        "/mod/rarity/list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListRaritiesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListRaritiesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listRarities: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SearchAffixes 搜索词条s
+
+按中文名、拼音、拼音首字母或英文别名模糊搜索词条，可按分类过滤
+*/
+func (a *Client) SearchAffixes(params *SearchAffixesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SearchAffixesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchAffixesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "searchAffixes",
+		Method:             "GET",
+		PathPattern:        "/mod/affix/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SearchAffixesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchAffixesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for searchAffixes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
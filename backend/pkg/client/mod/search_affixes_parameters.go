@@ -0,0 +1,302 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSearchAffixesParams creates a new SearchAffixesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSearchAffixesParams() *SearchAffixesParams {
+	return &SearchAffixesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSearchAffixesParamsWithTimeout creates a new SearchAffixesParams object
+// with the ability to set a timeout on a request.
+func NewSearchAffixesParamsWithTimeout(timeout time.Duration) *SearchAffixesParams {
+	return &SearchAffixesParams{
+		timeout: timeout,
+	}
+}
+
+// NewSearchAffixesParamsWithContext creates a new SearchAffixesParams object
+// with the ability to set a context for a request.
+func NewSearchAffixesParamsWithContext(ctx context.Context) *SearchAffixesParams {
+	return &SearchAffixesParams{
+		Context: ctx,
+	}
+}
+
+// NewSearchAffixesParamsWithHTTPClient creates a new SearchAffixesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSearchAffixesParamsWithHTTPClient(client *http.Client) *SearchAffixesParams {
+	return &SearchAffixesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SearchAffixesParams contains all the parameters to send to the API endpoint
+
+	for the search affixes operation.
+
+	Typically these are written to a http.Request.
+*/
+type SearchAffixesParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* Category.
+
+	   词条分类（damage、defense、utility）
+	*/
+	Category *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	// Limit.
+	//
+	// Format: int32
+	// Default: 10
+	Limit *int32
+
+	/* Q.
+
+	   搜索关键字，不填返回分类下的全部词条
+	*/
+	Q *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the search affixes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchAffixesParams) WithDefaults() *SearchAffixesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the search affixes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchAffixesParams) SetDefaults() {
+	var (
+		limitDefault = int32(10)
+	)
+
+	val := SearchAffixesParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the search affixes params
+func (o *SearchAffixesParams) WithTimeout(timeout time.Duration) *SearchAffixesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the search affixes params
+func (o *SearchAffixesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the search affixes params
+func (o *SearchAffixesParams) WithContext(ctx context.Context) *SearchAffixesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the search affixes params
+func (o *SearchAffixesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the search affixes params
+func (o *SearchAffixesParams) WithHTTPClient(client *http.Client) *SearchAffixesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the search affixes params
+func (o *SearchAffixesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the search affixes params
+func (o *SearchAffixesParams) WithAcceptLanguage(acceptLanguage *string) *SearchAffixesParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the search affixes params
+func (o *SearchAffixesParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithCategory adds the category to the search affixes params
+func (o *SearchAffixesParams) WithCategory(category *string) *SearchAffixesParams {
+	o.SetCategory(category)
+	return o
+}
+
+// SetCategory adds the category to the search affixes params
+func (o *SearchAffixesParams) SetCategory(category *string) {
+	o.Category = category
+}
+
+// WithLang adds the lang to the search affixes params
+func (o *SearchAffixesParams) WithLang(lang *string) *SearchAffixesParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the search affixes params
+func (o *SearchAffixesParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithLimit adds the limit to the search affixes params
+func (o *SearchAffixesParams) WithLimit(limit *int32) *SearchAffixesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the search affixes params
+func (o *SearchAffixesParams) SetLimit(limit *int32) {
+	o.Limit = limit
+}
+
+// WithQ adds the q to the search affixes params
+func (o *SearchAffixesParams) WithQ(q *string) *SearchAffixesParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the search affixes params
+func (o *SearchAffixesParams) SetQ(q *string) {
+	o.Q = q
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SearchAffixesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.Category != nil {
+
+		// query param category
+		var qrCategory string
+
+		if o.Category != nil {
+			qrCategory = *o.Category
+		}
+		qCategory := qrCategory
+		if qCategory != "" {
+
+			if err := r.SetQueryParam("category", qCategory); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int32
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt32(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Q != nil {
+
+		// query param q
+		var qrQ string
+
+		if o.Q != nil {
+			qrQ = *o.Q
+		}
+		qQ := qrQ
+		if qQ != "" {
+
+			if err := r.SetQueryParam("q", qQ); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SearchAffixesReader is a Reader for the SearchAffixes structure.
+type SearchAffixesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchAffixesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchAffixesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSearchAffixesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /mod/affix/search] searchAffixes", response, response.Code())
+	}
+}
+
+// NewSearchAffixesOK creates a SearchAffixesOK with default headers values
+func NewSearchAffixesOK() *SearchAffixesOK {
+	return &SearchAffixesOK{}
+}
+
+/*
+SearchAffixesOK describes a response with status code 200, with default header values.
+
+搜索成功
+*/
+type SearchAffixesOK struct {
+	Payload *models.AffixSearchResponse
+}
+
+// IsSuccess returns true when this search affixes o k response has a 2xx status code
+func (o *SearchAffixesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search affixes o k response has a 3xx status code
+func (o *SearchAffixesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search affixes o k response has a 4xx status code
+func (o *SearchAffixesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search affixes o k response has a 5xx status code
+func (o *SearchAffixesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search affixes o k response a status code equal to that given
+func (o *SearchAffixesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the search affixes o k response
+func (o *SearchAffixesOK) Code() int {
+	return 200
+}
+
+func (o *SearchAffixesOK) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/search][%d] searchAffixesOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchAffixesOK) String() string {
+	return fmt.Sprintf("[GET /mod/affix/search][%d] searchAffixesOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchAffixesOK) GetPayload() *models.AffixSearchResponse {
+	return o.Payload
+}
+
+func (o *SearchAffixesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AffixSearchResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchAffixesBadRequest creates a SearchAffixesBadRequest with default headers values
+func NewSearchAffixesBadRequest() *SearchAffixesBadRequest {
+	return &SearchAffixesBadRequest{}
+}
+
+/*
+SearchAffixesBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type SearchAffixesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this search affixes bad request response has a 2xx status code
+func (o *SearchAffixesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search affixes bad request response has a 3xx status code
+func (o *SearchAffixesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search affixes bad request response has a 4xx status code
+func (o *SearchAffixesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this search affixes bad request response has a 5xx status code
+func (o *SearchAffixesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search affixes bad request response a status code equal to that given
+func (o *SearchAffixesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the search affixes bad request response
+func (o *SearchAffixesBadRequest) Code() int {
+	return 400
+}
+
+func (o *SearchAffixesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /mod/affix/search][%d] searchAffixesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchAffixesBadRequest) String() string {
+	return fmt.Sprintf("[GET /mod/affix/search][%d] searchAffixesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchAffixesBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SearchAffixesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package client
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/admin"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/inventory"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/jobs"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/mod"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/preset"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/scripts"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/share"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/system"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/tools"
+)
+
+// Default oncehuman tools HTTP client.
+var Default = NewHTTPClient(nil)
+
+const (
+	// DefaultHost is the default Host
+	// found in Meta (info) section of spec file
+	DefaultHost string = "localhost"
+	// DefaultBasePath is the default BasePath
+	// found in Meta (info) section of spec file
+	DefaultBasePath string = "/api/v1"
+)
+
+// DefaultSchemes are the default schemes found in Meta (info) section of spec file
+var DefaultSchemes = []string{"http", "https"}
+
+// NewHTTPClient creates a new oncehuman tools HTTP client.
+func NewHTTPClient(formats strfmt.Registry) *OncehumanTools {
+	return NewHTTPClientWithConfig(formats, nil)
+}
+
+// NewHTTPClientWithConfig creates a new oncehuman tools HTTP client,
+// using a customizable transport config.
+func NewHTTPClientWithConfig(formats strfmt.Registry, cfg *TransportConfig) *OncehumanTools {
+	// ensure nullable parameters have default
+	if cfg == nil {
+		cfg = DefaultTransportConfig()
+	}
+
+	// create transport and client
+	transport := httptransport.New(cfg.Host, cfg.BasePath, cfg.Schemes)
+	return New(transport, formats)
+}
+
+// New creates a new oncehuman tools client
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *OncehumanTools {
+	// ensure nullable parameters have default
+	if formats == nil {
+		formats = strfmt.Default
+	}
+
+	cli := new(OncehumanTools)
+	cli.Transport = transport
+	cli.Admin = admin.New(transport, formats)
+	cli.Inventory = inventory.New(transport, formats)
+	cli.Jobs = jobs.New(transport, formats)
+	cli.Mod = mod.New(transport, formats)
+	cli.Preset = preset.New(transport, formats)
+	cli.Scripts = scripts.New(transport, formats)
+	cli.Share = share.New(transport, formats)
+	cli.System = system.New(transport, formats)
+	cli.Tools = tools.New(transport, formats)
+	return cli
+}
+
+// DefaultTransportConfig creates a TransportConfig with the
+// default settings taken from the meta section of the spec file.
+func DefaultTransportConfig() *TransportConfig {
+	return &TransportConfig{
+		Host:     DefaultHost,
+		BasePath: DefaultBasePath,
+		Schemes:  DefaultSchemes,
+	}
+}
+
+// TransportConfig contains the transport related info,
+// found in the meta section of the spec file.
+type TransportConfig struct {
+	Host     string
+	BasePath string
+	Schemes  []string
+}
+
+// WithHost overrides the default host,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithHost(host string) *TransportConfig {
+	cfg.Host = host
+	return cfg
+}
+
+// WithBasePath overrides the default basePath,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithBasePath(basePath string) *TransportConfig {
+	cfg.BasePath = basePath
+	return cfg
+}
+
+// WithSchemes overrides the default schemes,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
+	cfg.Schemes = schemes
+	return cfg
+}
+
+// OncehumanTools is a client for oncehuman tools
+type OncehumanTools struct {
+	Admin admin.ClientService
+
+	Inventory inventory.ClientService
+
+	Jobs jobs.ClientService
+
+	Mod mod.ClientService
+
+	Preset preset.ClientService
+
+	Scripts scripts.ClientService
+
+	Share share.ClientService
+
+	System system.ClientService
+
+	Tools tools.ClientService
+
+	Transport runtime.ClientTransport
+}
+
+// SetTransport changes the transport on the client and all its subresources
+func (c *OncehumanTools) SetTransport(transport runtime.ClientTransport) {
+	c.Transport = transport
+	c.Admin.SetTransport(transport)
+	c.Inventory.SetTransport(transport)
+	c.Jobs.SetTransport(transport)
+	c.Mod.SetTransport(transport)
+	c.Preset.SetTransport(transport)
+	c.Scripts.SetTransport(transport)
+	c.Share.SetTransport(transport)
+	c.System.SetTransport(transport)
+	c.Tools.SetTransport(transport)
+}
@@ -0,0 +1,248 @@
+package client
+
+// 本文件不是生成的代码，重新生成客户端时保留
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// 客户端选项的默认值
+const (
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 2
+	DefaultRetryWait  = 500 * time.Millisecond
+	maxRetryWait      = 30 * time.Second
+)
+
+// Options HTTP客户端选项
+type Options struct {
+	BaseURL    string        // 服务地址，如https://tools.example.com，路径为空时使用/api/v1
+	APIKey     string        // 请求携带的API key，为空时匿名访问
+	Timeout    time.Duration // 单次调用（包括重试）的时间上限，0表示DefaultTimeout，负数表示不限制；任务事件流不受限制
+	MaxRetries int           // 失败后的最大重试次数，0表示DefaultMaxRetries，负数表示不重试
+	RetryWait  time.Duration // 首次重试前的等待时间，之后每次加倍；响应带Retry-After时按其等待
+	HTTPClient *http.Client  // 发送请求的HTTP客户端，为nil时使用http.DefaultTransport；其Timeout不生效，由Timeout按请求限制
+}
+
+// NewWithOptions 创建HTTP客户端，调用参数的WithContext设置取消和截止时间，ctx没有截止时间时按Timeout限制
+//
+// 限流（429）和服务暂时不可用（503）的请求按退避时间重试；连接失败、502和504只重试GET、PUT、DELETE等幂等请求，
+// 避免重复提交任务或分享。
+func NewWithOptions(opts Options) (*OncehumanTools, error) {
+	u, err := url.Parse(opts.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("client: invalid base url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("client: base url must be http(s)://host, got %q", opts.BaseURL)
+	}
+	basePath := u.Path
+	if basePath == "" || basePath == "/" {
+		basePath = DefaultBasePath
+	}
+
+	httpClient := &http.Client{}
+	if opts.HTTPClient != nil {
+		*httpClient = *opts.HTTPClient
+	}
+	// http.Client的超时包括读取响应体，会断开任务事件流，改为按请求的ctx限制
+	httpClient.Timeout = 0
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	retries := &retryTransport{next: httpClient.Transport, maxRetries: opts.MaxRetries, wait: opts.RetryWait}
+	if retries.next == nil {
+		retries.next = http.DefaultTransport
+	}
+	if retries.maxRetries == 0 {
+		retries.maxRetries = DefaultMaxRetries
+	}
+	if retries.wait <= 0 {
+		retries.wait = DefaultRetryWait
+	}
+	httpClient.Transport = retries
+
+	rt := httptransport.NewWithClient(u.Host, basePath, []string{u.Scheme}, httpClient)
+	if opts.APIKey != "" {
+		rt.DefaultAuthentication = httptransport.APIKeyAuth("X-API-Key", "header", opts.APIKey)
+	}
+	rt.Consumers[eventStreamMime] = eventStreamConsumer()
+	return New(&transport{ClientTransport: rt, timeout: timeout}, strfmt.Default), nil
+}
+
+// ErrorResponse 获取接口返回的错误响应，err不是接口错误（如连接失败）时返回nil
+func ErrorResponse(err error) *models.ErrorResponse {
+	var apiErr interface {
+		error
+		GetPayload() *models.ErrorResponse
+	}
+	if errors.As(err, &apiErr) {
+		return apiErr.GetPayload()
+	}
+	return nil
+}
+
+// eventStreamMime Server-Sent Events的媒体类型
+const eventStreamMime = "text/event-stream"
+
+// transport 设置每次调用的超时；计算接口还支持CSV等导出格式，客户端只请求JSON
+type transport struct {
+	runtime.ClientTransport
+	timeout time.Duration // 小于0表示不限制
+}
+
+// Submit 提交请求，可以返回JSON的接口只接受JSON；事件流持续到任务结束，只按调用参数的ctx取消
+func (t *transport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !isEventStream(op) {
+		for _, mediaType := range op.ProducesMediaTypes {
+			if mediaType == runtime.JSONMime {
+				op.ProducesMediaTypes = []string{runtime.JSONMime}
+				break
+			}
+		}
+		if _, ok := ctx.Deadline(); !ok && t.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, t.timeout)
+			defer cancel()
+		}
+	}
+	// 设置ctx后runtime不再使用调用参数的默认超时
+	op.Context = ctx
+	return t.ClientTransport.Submit(op)
+}
+
+// isEventStream 检查接口是否返回事件流
+func isEventStream(op *runtime.ClientOperation) bool {
+	for _, mediaType := range op.ProducesMediaTypes {
+		if mediaType == eventStreamMime {
+			return true
+		}
+	}
+	return false
+}
+
+// eventStreamConsumer 读取事件流直到连接关闭，把最后一个事件的数据按JSON解码，任务事件流返回结束时的任务
+func eventStreamConsumer() runtime.Consumer {
+	return runtime.ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 0, 64<<10), 4<<20)
+		var last, current []byte
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			switch {
+			case len(line) == 0:
+				// 空行结束一个事件，只有注释的事件没有数据
+				if current != nil {
+					last, current = current, nil
+				}
+			case bytes.HasPrefix(line, []byte("data:")):
+				value := bytes.TrimPrefix(bytes.TrimPrefix(line, []byte("data:")), []byte(" "))
+				if current != nil {
+					current = append(current, '\n')
+				}
+				current = append(current, value...)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+		if current != nil {
+			last = current
+		}
+		if last == nil {
+			return io.ErrUnexpectedEOF
+		}
+		return json.Unmarshal(last, data)
+	})
+}
+
+// retryTransport 按退避时间重试暂时失败的请求
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	wait       time.Duration
+}
+
+// RoundTrip 发送请求，可以重试时等待后重新发送，请求取消时停止等待
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable 检查请求是否可以重试：429和503未处理请求，都可以重试；连接失败、502和504只重试幂等请求；请求体无法重新读取时不重试
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil || req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+	if err != nil {
+		return idempotent
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+// backoff 第attempt次失败后的等待时间，响应带Retry-After（秒）时按其等待，最长30秒
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.wait << attempt
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	if wait > maxRetryWait || wait < 0 {
+		wait = maxRetryWait
+	}
+	return wait
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/jobs"
+)
+
+const testJob = `{"id":"%s","type":"affix_probability","status":"%s","progress":%g,"createdAt":"2024-01-01T00:00:00Z"}`
+
+// newTestServer 模拟后端：任务事件流分两次推送，间隔delay；查询任务在delay后返回
+func newTestServer(delay time.Duration) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/jobs/{id}/events", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", eventStreamMime)
+		fmt.Fprintf(w, "event: progress\ndata: "+testJob+"\n\n", r.PathValue("id"), models.JobStatusRunning, 0.5)
+		w.(http.Flusher).Flush()
+		time.Sleep(delay)
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprintf(w, "event: completed\ndata: "+testJob+"\n\n", r.PathValue("id"), models.JobStatusSucceeded, 1.0)
+	})
+	mux.HandleFunc("/api/v1/jobs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, testJob, r.PathValue("id"), models.JobStatusSucceeded, 1.0)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestTimeout(t *testing.T) {
+	server := newTestServer(300 * time.Millisecond)
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		ctx     func() (context.Context, context.CancelFunc)
+		stream  bool
+		wantErr error
+	}{
+		{"stream longer than timeout", 100 * time.Millisecond, nil, true, nil},
+		{"stream canceled by ctx", time.Minute, func() (context.Context, context.CancelFunc) {
+			return context.WithTimeout(context.Background(), 100*time.Millisecond)
+		}, true, context.DeadlineExceeded},
+		{"request timeout", 100 * time.Millisecond, nil, false, context.DeadlineExceeded},
+		{"ctx deadline overrides timeout", 100 * time.Millisecond, func() (context.Context, context.CancelFunc) {
+			return context.WithTimeout(context.Background(), time.Minute)
+		}, false, nil},
+		{"unlimited", -1, nil, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, err := NewWithOptions(Options{BaseURL: server.URL, Timeout: tt.timeout, MaxRetries: -1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			if tt.ctx != nil {
+				var cancel context.CancelFunc
+				ctx, cancel = tt.ctx()
+				defer cancel()
+			}
+
+			var job *models.Job
+			if tt.stream {
+				var resp *jobs.StreamJobEventsOK
+				resp, err = api.Jobs.StreamJobEvents(jobs.NewStreamJobEventsParamsWithContext(ctx).WithID("j1"), nil)
+				if resp != nil {
+					job = resp.Payload
+				}
+			} else {
+				var resp *jobs.GetJobOK
+				resp, err = api.Jobs.GetJob(jobs.NewGetJobParamsWithContext(ctx).WithID("j1"), nil)
+				if resp != nil {
+					job = resp.Payload
+				}
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if job.Status == nil || *job.Status != models.JobStatusSucceeded {
+				t.Errorf("job = %+v, want the final succeeded job", job)
+			}
+		})
+	}
+}
+
+func TestEventStreamConsumer(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{"last event", "event: progress\ndata: {\"id\":\"a\"}\n\nevent: completed\ndata: {\"id\":\"b\"}\n\n", "b", false},
+		{"without trailing blank line", "data: {\"id\":\"a\"}\n\ndata: {\"id\":\"b\"}", "b", false},
+		{"comments ignored", "data: {\"id\":\"a\"}\n\n: keep-alive\n\n", "a", false},
+		{"multiline data", "data: {\"id\":\ndata: \"c\"}\n\n", "c", false},
+		{"no events", ": keep-alive\n\n", "", true},
+	}
+	for _, tt := range tests {
+		var job models.Job
+		err := eventStreamConsumer().Consume(strings.NewReader(tt.body), &job)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && (job.ID == nil || *job.ID != tt.want) {
+			t.Errorf("%s: id = %v, want %s", tt.name, job.ID, tt.want)
+		}
+	}
+}
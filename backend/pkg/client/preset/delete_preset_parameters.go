@@ -0,0 +1,282 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeletePresetParams creates a new DeletePresetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeletePresetParams() *DeletePresetParams {
+	return &DeletePresetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeletePresetParamsWithTimeout creates a new DeletePresetParams object
+// with the ability to set a timeout on a request.
+func NewDeletePresetParamsWithTimeout(timeout time.Duration) *DeletePresetParams {
+	return &DeletePresetParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeletePresetParamsWithContext creates a new DeletePresetParams object
+// with the ability to set a context for a request.
+func NewDeletePresetParamsWithContext(ctx context.Context) *DeletePresetParams {
+	return &DeletePresetParams{
+		Context: ctx,
+	}
+}
+
+// NewDeletePresetParamsWithHTTPClient creates a new DeletePresetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeletePresetParamsWithHTTPClient(client *http.Client) *DeletePresetParams {
+	return &DeletePresetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeletePresetParams contains all the parameters to send to the API endpoint
+
+	for the delete preset operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeletePresetParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	/* Name.
+
+	   预设名称，只能包含小写字母、数字和-
+	*/
+	Name string
+
+	/* Scope.
+
+	   预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设
+	*/
+	Scope string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete preset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeletePresetParams) WithDefaults() *DeletePresetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete preset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeletePresetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete preset params
+func (o *DeletePresetParams) WithTimeout(timeout time.Duration) *DeletePresetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete preset params
+func (o *DeletePresetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete preset params
+func (o *DeletePresetParams) WithContext(ctx context.Context) *DeletePresetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete preset params
+func (o *DeletePresetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete preset params
+func (o *DeletePresetParams) WithHTTPClient(client *http.Client) *DeletePresetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete preset params
+func (o *DeletePresetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the delete preset params
+func (o *DeletePresetParams) WithAcceptLanguage(acceptLanguage *string) *DeletePresetParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the delete preset params
+func (o *DeletePresetParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXGuildID adds the xGuildID to the delete preset params
+func (o *DeletePresetParams) WithXGuildID(xGuildID *string) *DeletePresetParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the delete preset params
+func (o *DeletePresetParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the delete preset params
+func (o *DeletePresetParams) WithXPlayerID(xPlayerID *string) *DeletePresetParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the delete preset params
+func (o *DeletePresetParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithLang adds the lang to the delete preset params
+func (o *DeletePresetParams) WithLang(lang *string) *DeletePresetParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the delete preset params
+func (o *DeletePresetParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithName adds the name to the delete preset params
+func (o *DeletePresetParams) WithName(name string) *DeletePresetParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the delete preset params
+func (o *DeletePresetParams) SetName(name string) {
+	o.Name = name
+}
+
+// WithScope adds the scope to the delete preset params
+func (o *DeletePresetParams) WithScope(scope string) *DeletePresetParams {
+	o.SetScope(scope)
+	return o
+}
+
+// SetScope adds the scope to the delete preset params
+func (o *DeletePresetParams) SetScope(scope string) {
+	o.Scope = scope
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeletePresetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	// path param scope
+	if err := r.SetPathParam("scope", o.Scope); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
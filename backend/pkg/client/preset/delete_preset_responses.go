@@ -0,0 +1,387 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DeletePresetReader is a Reader for the DeletePreset structure.
+type DeletePresetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeletePresetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeletePresetNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDeletePresetBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeletePresetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeletePresetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewDeletePresetServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /presets/{scope}/{name}] deletePreset", response, response.Code())
+	}
+}
+
+// NewDeletePresetNoContent creates a DeletePresetNoContent with default headers values
+func NewDeletePresetNoContent() *DeletePresetNoContent {
+	return &DeletePresetNoContent{}
+}
+
+/*
+DeletePresetNoContent describes a response with status code 204, with default header values.
+
+已删除预设
+*/
+type DeletePresetNoContent struct {
+}
+
+// IsSuccess returns true when this delete preset no content response has a 2xx status code
+func (o *DeletePresetNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete preset no content response has a 3xx status code
+func (o *DeletePresetNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete preset no content response has a 4xx status code
+func (o *DeletePresetNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete preset no content response has a 5xx status code
+func (o *DeletePresetNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete preset no content response a status code equal to that given
+func (o *DeletePresetNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete preset no content response
+func (o *DeletePresetNoContent) Code() int {
+	return 204
+}
+
+func (o *DeletePresetNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetNoContent ", 204)
+}
+
+func (o *DeletePresetNoContent) String() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetNoContent ", 204)
+}
+
+func (o *DeletePresetNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeletePresetBadRequest creates a DeletePresetBadRequest with default headers values
+func NewDeletePresetBadRequest() *DeletePresetBadRequest {
+	return &DeletePresetBadRequest{}
+}
+
+/*
+DeletePresetBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type DeletePresetBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete preset bad request response has a 2xx status code
+func (o *DeletePresetBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete preset bad request response has a 3xx status code
+func (o *DeletePresetBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete preset bad request response has a 4xx status code
+func (o *DeletePresetBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete preset bad request response has a 5xx status code
+func (o *DeletePresetBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete preset bad request response a status code equal to that given
+func (o *DeletePresetBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the delete preset bad request response
+func (o *DeletePresetBadRequest) Code() int {
+	return 400
+}
+
+func (o *DeletePresetBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeletePresetBadRequest) String() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeletePresetBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeletePresetBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeletePresetForbidden creates a DeletePresetForbidden with default headers values
+func NewDeletePresetForbidden() *DeletePresetForbidden {
+	return &DeletePresetForbidden{}
+}
+
+/*
+DeletePresetForbidden describes a response with status code 403, with default header values.
+
+API key不能管理该服务器的预设
+*/
+type DeletePresetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete preset forbidden response has a 2xx status code
+func (o *DeletePresetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete preset forbidden response has a 3xx status code
+func (o *DeletePresetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete preset forbidden response has a 4xx status code
+func (o *DeletePresetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete preset forbidden response has a 5xx status code
+func (o *DeletePresetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete preset forbidden response a status code equal to that given
+func (o *DeletePresetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete preset forbidden response
+func (o *DeletePresetForbidden) Code() int {
+	return 403
+}
+
+func (o *DeletePresetForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeletePresetForbidden) String() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeletePresetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeletePresetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeletePresetNotFound creates a DeletePresetNotFound with default headers values
+func NewDeletePresetNotFound() *DeletePresetNotFound {
+	return &DeletePresetNotFound{}
+}
+
+/*
+DeletePresetNotFound describes a response with status code 404, with default header values.
+
+预设不存在
+*/
+type DeletePresetNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete preset not found response has a 2xx status code
+func (o *DeletePresetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete preset not found response has a 3xx status code
+func (o *DeletePresetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete preset not found response has a 4xx status code
+func (o *DeletePresetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete preset not found response has a 5xx status code
+func (o *DeletePresetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete preset not found response a status code equal to that given
+func (o *DeletePresetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete preset not found response
+func (o *DeletePresetNotFound) Code() int {
+	return 404
+}
+
+func (o *DeletePresetNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeletePresetNotFound) String() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeletePresetNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeletePresetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeletePresetServiceUnavailable creates a DeletePresetServiceUnavailable with default headers values
+func NewDeletePresetServiceUnavailable() *DeletePresetServiceUnavailable {
+	return &DeletePresetServiceUnavailable{}
+}
+
+/*
+DeletePresetServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type DeletePresetServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete preset service unavailable response has a 2xx status code
+func (o *DeletePresetServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete preset service unavailable response has a 3xx status code
+func (o *DeletePresetServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete preset service unavailable response has a 4xx status code
+func (o *DeletePresetServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete preset service unavailable response has a 5xx status code
+func (o *DeletePresetServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete preset service unavailable response a status code equal to that given
+func (o *DeletePresetServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the delete preset service unavailable response
+func (o *DeletePresetServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *DeletePresetServiceUnavailable) Error() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *DeletePresetServiceUnavailable) String() string {
+	return fmt.Sprintf("[DELETE /presets/{scope}/{name}][%d] deletePresetServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *DeletePresetServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeletePresetServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListPresetsReader is a Reader for the ListPresets structure.
+type ListPresetsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListPresetsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListPresetsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 503:
+		result := NewListPresetsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /presets] listPresets", response, response.Code())
+	}
+}
+
+// NewListPresetsOK creates a ListPresetsOK with default headers values
+func NewListPresetsOK() *ListPresetsOK {
+	return &ListPresetsOK{}
+}
+
+/*
+ListPresetsOK describes a response with status code 200, with default header values.
+
+成功获取预设列表
+*/
+type ListPresetsOK struct {
+	Payload *models.PresetListResponse
+}
+
+// IsSuccess returns true when this list presets o k response has a 2xx status code
+func (o *ListPresetsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list presets o k response has a 3xx status code
+func (o *ListPresetsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list presets o k response has a 4xx status code
+func (o *ListPresetsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list presets o k response has a 5xx status code
+func (o *ListPresetsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list presets o k response a status code equal to that given
+func (o *ListPresetsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list presets o k response
+func (o *ListPresetsOK) Code() int {
+	return 200
+}
+
+func (o *ListPresetsOK) Error() string {
+	return fmt.Sprintf("[GET /presets][%d] listPresetsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPresetsOK) String() string {
+	return fmt.Sprintf("[GET /presets][%d] listPresetsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPresetsOK) GetPayload() *models.PresetListResponse {
+	return o.Payload
+}
+
+func (o *ListPresetsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PresetListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListPresetsServiceUnavailable creates a ListPresetsServiceUnavailable with default headers values
+func NewListPresetsServiceUnavailable() *ListPresetsServiceUnavailable {
+	return &ListPresetsServiceUnavailable{}
+}
+
+/*
+ListPresetsServiceUnavailable describes a response with status code 503, with default header values.
+
+数据库不可用
+*/
+type ListPresetsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list presets service unavailable response has a 2xx status code
+func (o *ListPresetsServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list presets service unavailable response has a 3xx status code
+func (o *ListPresetsServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list presets service unavailable response has a 4xx status code
+func (o *ListPresetsServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list presets service unavailable response has a 5xx status code
+func (o *ListPresetsServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list presets service unavailable response a status code equal to that given
+func (o *ListPresetsServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the list presets service unavailable response
+func (o *ListPresetsServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ListPresetsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /presets][%d] listPresetsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ListPresetsServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /presets][%d] listPresetsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ListPresetsServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListPresetsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
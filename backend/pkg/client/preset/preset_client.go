@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new preset API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for preset API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	DeletePreset(params *DeletePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeletePresetNoContent, error)
+
+	ListPresets(params *ListPresetsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPresetsOK, error)
+
+	SavePreset(params *SavePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SavePresetOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+DeletePreset 删除预设s
+
+需要API key，服务器预设的权限要求与保存时相同
+*/
+func (a *Client) DeletePreset(params *DeletePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeletePresetNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeletePresetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deletePreset",
+		Method:             "DELETE",
+		PathPattern:        "/presets/{scope}/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeletePresetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeletePresetNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deletePreset: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	ListPresets 获取目标词条预设s
+
+	获取内置预设、玩家保存的预设和X-Guild-ID指定的服务器保存的预设。
+
+需要目标词条的地方可以用preset:<名称>引用预设，查找顺序为玩家、服务器、内置。未启用数据库时只有内置预设。
+*/
+func (a *Client) ListPresets(params *ListPresetsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPresetsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListPresetsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listPresets",
+		Method:             "GET",
+		PathPattern:        "/presets",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListPresetsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListPresetsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listPresets: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	SavePreset 保存预设s
+
+	创建或更新玩家或服务器的预设，与内置预设同名时优先使用保存的预设。
+
+需要API key；服务器预设还需要key可以管理X-Guild-ID指定的服务器（创建key时的-guilds，admin权限可以管理全部服务器），否则返回403 guild_not_allowed。
+*/
+func (a *Client) SavePreset(params *SavePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SavePresetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSavePresetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "savePreset",
+		Method:             "PUT",
+		PathPattern:        "/presets/{scope}/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SavePresetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SavePresetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for savePreset: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
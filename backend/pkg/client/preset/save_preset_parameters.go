@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewSavePresetParams creates a new SavePresetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSavePresetParams() *SavePresetParams {
+	return &SavePresetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSavePresetParamsWithTimeout creates a new SavePresetParams object
+// with the ability to set a timeout on a request.
+func NewSavePresetParamsWithTimeout(timeout time.Duration) *SavePresetParams {
+	return &SavePresetParams{
+		timeout: timeout,
+	}
+}
+
+// NewSavePresetParamsWithContext creates a new SavePresetParams object
+// with the ability to set a context for a request.
+func NewSavePresetParamsWithContext(ctx context.Context) *SavePresetParams {
+	return &SavePresetParams{
+		Context: ctx,
+	}
+}
+
+// NewSavePresetParamsWithHTTPClient creates a new SavePresetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSavePresetParamsWithHTTPClient(client *http.Client) *SavePresetParams {
+	return &SavePresetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SavePresetParams contains all the parameters to send to the API endpoint
+
+	for the save preset operation.
+
+	Typically these are written to a http.Request.
+*/
+type SavePresetParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.PresetInput
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	/* Name.
+
+	   预设名称，只能包含小写字母、数字和-
+	*/
+	Name string
+
+	/* Scope.
+
+	   预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设
+	*/
+	Scope string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the save preset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SavePresetParams) WithDefaults() *SavePresetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the save preset params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SavePresetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the save preset params
+func (o *SavePresetParams) WithTimeout(timeout time.Duration) *SavePresetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the save preset params
+func (o *SavePresetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the save preset params
+func (o *SavePresetParams) WithContext(ctx context.Context) *SavePresetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the save preset params
+func (o *SavePresetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the save preset params
+func (o *SavePresetParams) WithHTTPClient(client *http.Client) *SavePresetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the save preset params
+func (o *SavePresetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the save preset params
+func (o *SavePresetParams) WithAcceptLanguage(acceptLanguage *string) *SavePresetParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the save preset params
+func (o *SavePresetParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXGuildID adds the xGuildID to the save preset params
+func (o *SavePresetParams) WithXGuildID(xGuildID *string) *SavePresetParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the save preset params
+func (o *SavePresetParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the save preset params
+func (o *SavePresetParams) WithXPlayerID(xPlayerID *string) *SavePresetParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the save preset params
+func (o *SavePresetParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the save preset params
+func (o *SavePresetParams) WithBody(body *models.PresetInput) *SavePresetParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the save preset params
+func (o *SavePresetParams) SetBody(body *models.PresetInput) {
+	o.Body = body
+}
+
+// WithLang adds the lang to the save preset params
+func (o *SavePresetParams) WithLang(lang *string) *SavePresetParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the save preset params
+func (o *SavePresetParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WithName adds the name to the save preset params
+func (o *SavePresetParams) WithName(name string) *SavePresetParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the save preset params
+func (o *SavePresetParams) SetName(name string) {
+	o.Name = name
+}
+
+// WithScope adds the scope to the save preset params
+func (o *SavePresetParams) WithScope(scope string) *SavePresetParams {
+	o.SetScope(scope)
+	return o
+}
+
+// SetScope adds the scope to the save preset params
+func (o *SavePresetParams) SetScope(scope string) {
+	o.Scope = scope
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SavePresetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	// path param scope
+	if err := r.SetPathParam("scope", o.Scope); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
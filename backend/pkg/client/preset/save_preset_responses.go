@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SavePresetReader is a Reader for the SavePreset structure.
+type SavePresetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SavePresetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSavePresetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSavePresetBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSavePresetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewSavePresetServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /presets/{scope}/{name}] savePreset", response, response.Code())
+	}
+}
+
+// NewSavePresetOK creates a SavePresetOK with default headers values
+func NewSavePresetOK() *SavePresetOK {
+	return &SavePresetOK{}
+}
+
+/*
+SavePresetOK describes a response with status code 200, with default header values.
+
+已保存预设
+*/
+type SavePresetOK struct {
+	Payload *models.Preset
+}
+
+// IsSuccess returns true when this save preset o k response has a 2xx status code
+func (o *SavePresetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this save preset o k response has a 3xx status code
+func (o *SavePresetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this save preset o k response has a 4xx status code
+func (o *SavePresetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this save preset o k response has a 5xx status code
+func (o *SavePresetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this save preset o k response a status code equal to that given
+func (o *SavePresetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the save preset o k response
+func (o *SavePresetOK) Code() int {
+	return 200
+}
+
+func (o *SavePresetOK) Error() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetOK  %+v", 200, o.Payload)
+}
+
+func (o *SavePresetOK) String() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetOK  %+v", 200, o.Payload)
+}
+
+func (o *SavePresetOK) GetPayload() *models.Preset {
+	return o.Payload
+}
+
+func (o *SavePresetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Preset)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSavePresetBadRequest creates a SavePresetBadRequest with default headers values
+func NewSavePresetBadRequest() *SavePresetBadRequest {
+	return &SavePresetBadRequest{}
+}
+
+/*
+SavePresetBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type SavePresetBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this save preset bad request response has a 2xx status code
+func (o *SavePresetBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this save preset bad request response has a 3xx status code
+func (o *SavePresetBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this save preset bad request response has a 4xx status code
+func (o *SavePresetBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this save preset bad request response has a 5xx status code
+func (o *SavePresetBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this save preset bad request response a status code equal to that given
+func (o *SavePresetBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the save preset bad request response
+func (o *SavePresetBadRequest) Code() int {
+	return 400
+}
+
+func (o *SavePresetBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SavePresetBadRequest) String() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SavePresetBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SavePresetBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSavePresetForbidden creates a SavePresetForbidden with default headers values
+func NewSavePresetForbidden() *SavePresetForbidden {
+	return &SavePresetForbidden{}
+}
+
+/*
+SavePresetForbidden describes a response with status code 403, with default header values.
+
+API key不能管理该服务器的预设
+*/
+type SavePresetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this save preset forbidden response has a 2xx status code
+func (o *SavePresetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this save preset forbidden response has a 3xx status code
+func (o *SavePresetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this save preset forbidden response has a 4xx status code
+func (o *SavePresetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this save preset forbidden response has a 5xx status code
+func (o *SavePresetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this save preset forbidden response a status code equal to that given
+func (o *SavePresetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the save preset forbidden response
+func (o *SavePresetForbidden) Code() int {
+	return 403
+}
+
+func (o *SavePresetForbidden) Error() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SavePresetForbidden) String() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SavePresetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SavePresetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSavePresetServiceUnavailable creates a SavePresetServiceUnavailable with default headers values
+func NewSavePresetServiceUnavailable() *SavePresetServiceUnavailable {
+	return &SavePresetServiceUnavailable{}
+}
+
+/*
+SavePresetServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库
+*/
+type SavePresetServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this save preset service unavailable response has a 2xx status code
+func (o *SavePresetServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this save preset service unavailable response has a 3xx status code
+func (o *SavePresetServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this save preset service unavailable response has a 4xx status code
+func (o *SavePresetServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this save preset service unavailable response has a 5xx status code
+func (o *SavePresetServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this save preset service unavailable response a status code equal to that given
+func (o *SavePresetServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the save preset service unavailable response
+func (o *SavePresetServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *SavePresetServiceUnavailable) Error() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *SavePresetServiceUnavailable) String() string {
+	return fmt.Sprintf("[PUT /presets/{scope}/{name}][%d] savePresetServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *SavePresetServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SavePresetServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scripts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewRunScriptParams creates a new RunScriptParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRunScriptParams() *RunScriptParams {
+	return &RunScriptParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRunScriptParamsWithTimeout creates a new RunScriptParams object
+// with the ability to set a timeout on a request.
+func NewRunScriptParamsWithTimeout(timeout time.Duration) *RunScriptParams {
+	return &RunScriptParams{
+		timeout: timeout,
+	}
+}
+
+// NewRunScriptParamsWithContext creates a new RunScriptParams object
+// with the ability to set a context for a request.
+func NewRunScriptParamsWithContext(ctx context.Context) *RunScriptParams {
+	return &RunScriptParams{
+		Context: ctx,
+	}
+}
+
+// NewRunScriptParamsWithHTTPClient creates a new RunScriptParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRunScriptParamsWithHTTPClient(client *http.Client) *RunScriptParams {
+	return &RunScriptParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RunScriptParams contains all the parameters to send to the API endpoint
+
+	for the run script operation.
+
+	Typically these are written to a http.Request.
+*/
+type RunScriptParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	// Body.
+	Body *models.ScriptRunRequest
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the run script params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RunScriptParams) WithDefaults() *RunScriptParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the run script params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RunScriptParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the run script params
+func (o *RunScriptParams) WithTimeout(timeout time.Duration) *RunScriptParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the run script params
+func (o *RunScriptParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the run script params
+func (o *RunScriptParams) WithContext(ctx context.Context) *RunScriptParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the run script params
+func (o *RunScriptParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the run script params
+func (o *RunScriptParams) WithHTTPClient(client *http.Client) *RunScriptParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the run script params
+func (o *RunScriptParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the run script params
+func (o *RunScriptParams) WithAcceptLanguage(acceptLanguage *string) *RunScriptParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the run script params
+func (o *RunScriptParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithBody adds the body to the run script params
+func (o *RunScriptParams) WithBody(body *models.ScriptRunRequest) *RunScriptParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the run script params
+func (o *RunScriptParams) SetBody(body *models.ScriptRunRequest) {
+	o.Body = body
+}
+
+// WithLang adds the lang to the run script params
+func (o *RunScriptParams) WithLang(lang *string) *RunScriptParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the run script params
+func (o *RunScriptParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RunScriptParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scripts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// RunScriptReader is a Reader for the RunScript structure.
+type RunScriptReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RunScriptReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRunScriptOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRunScriptBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRunScriptUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewRunScriptServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /scripts/run] runScript", response, response.Code())
+	}
+}
+
+// NewRunScriptOK creates a RunScriptOK with default headers values
+func NewRunScriptOK() *RunScriptOK {
+	return &RunScriptOK{}
+}
+
+/*
+RunScriptOK describes a response with status code 200, with default header values.
+
+执行成功
+*/
+type RunScriptOK struct {
+	Payload *models.ScriptRunResponse
+}
+
+// IsSuccess returns true when this run script o k response has a 2xx status code
+func (o *RunScriptOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this run script o k response has a 3xx status code
+func (o *RunScriptOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run script o k response has a 4xx status code
+func (o *RunScriptOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run script o k response has a 5xx status code
+func (o *RunScriptOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run script o k response a status code equal to that given
+func (o *RunScriptOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the run script o k response
+func (o *RunScriptOK) Code() int {
+	return 200
+}
+
+func (o *RunScriptOK) Error() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptOK  %+v", 200, o.Payload)
+}
+
+func (o *RunScriptOK) String() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptOK  %+v", 200, o.Payload)
+}
+
+func (o *RunScriptOK) GetPayload() *models.ScriptRunResponse {
+	return o.Payload
+}
+
+func (o *RunScriptOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ScriptRunResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunScriptBadRequest creates a RunScriptBadRequest with default headers values
+func NewRunScriptBadRequest() *RunScriptBadRequest {
+	return &RunScriptBadRequest{}
+}
+
+/*
+RunScriptBadRequest describes a response with status code 400, with default header values.
+
+脚本出错或游戏版本不存在
+*/
+type RunScriptBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run script bad request response has a 2xx status code
+func (o *RunScriptBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run script bad request response has a 3xx status code
+func (o *RunScriptBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run script bad request response has a 4xx status code
+func (o *RunScriptBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this run script bad request response has a 5xx status code
+func (o *RunScriptBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run script bad request response a status code equal to that given
+func (o *RunScriptBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the run script bad request response
+func (o *RunScriptBadRequest) Code() int {
+	return 400
+}
+
+func (o *RunScriptBadRequest) Error() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RunScriptBadRequest) String() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RunScriptBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunScriptBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunScriptUnprocessableEntity creates a RunScriptUnprocessableEntity with default headers values
+func NewRunScriptUnprocessableEntity() *RunScriptUnprocessableEntity {
+	return &RunScriptUnprocessableEntity{}
+}
+
+/*
+RunScriptUnprocessableEntity describes a response with status code 422, with default header values.
+
+脚本超过执行步数、时间或内存上限
+*/
+type RunScriptUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run script unprocessable entity response has a 2xx status code
+func (o *RunScriptUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run script unprocessable entity response has a 3xx status code
+func (o *RunScriptUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run script unprocessable entity response has a 4xx status code
+func (o *RunScriptUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this run script unprocessable entity response has a 5xx status code
+func (o *RunScriptUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run script unprocessable entity response a status code equal to that given
+func (o *RunScriptUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the run script unprocessable entity response
+func (o *RunScriptUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *RunScriptUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RunScriptUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RunScriptUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunScriptUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunScriptServiceUnavailable creates a RunScriptServiceUnavailable with default headers values
+func NewRunScriptServiceUnavailable() *RunScriptServiceUnavailable {
+	return &RunScriptServiceUnavailable{}
+}
+
+/*
+RunScriptServiceUnavailable describes a response with status code 503, with default header values.
+
+服务正在停止
+*/
+type RunScriptServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run script service unavailable response has a 2xx status code
+func (o *RunScriptServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run script service unavailable response has a 3xx status code
+func (o *RunScriptServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run script service unavailable response has a 4xx status code
+func (o *RunScriptServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run script service unavailable response has a 5xx status code
+func (o *RunScriptServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this run script service unavailable response a status code equal to that given
+func (o *RunScriptServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the run script service unavailable response
+func (o *RunScriptServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *RunScriptServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *RunScriptServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /scripts/run][%d] runScriptServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *RunScriptServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunScriptServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
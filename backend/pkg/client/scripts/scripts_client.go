@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scripts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new scripts API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for scripts API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	RunScript(params *RunScriptParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunScriptOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+	RunScript 运行s starlark脚本
+
+	在沙箱中执行Starlark脚本并调用main(input)，返回值编码为JSON作为结果。
+
+脚本可以使用math、json、choose、combinations、Random，以及访问游戏版本目录的affix和strengthen模块；
+不能加载其他文件或访问文件、网络。执行步数超过SCRIPTS_MAX_STEPS时返回422和script_step_limit，
+执行时间超过SCRIPTS_TIMEOUT时返回422和script_timeout，字符串、列表等分配的内存超过SCRIPTS_MAX_ALLOC_MB时返回422和script_memory_limit；
+语法错误和运行时错误（包括内置函数的元素个数超过上限）返回400和script_error，消息带出错位置。
+*/
+func (a *Client) RunScript(params *RunScriptParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunScriptOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunScriptParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "runScript",
+		Method:             "POST",
+		PathPattern:        "/scripts/run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunScriptReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunScriptOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for runScript: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// NewCreateShareParams creates a new CreateShareParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateShareParams() *CreateShareParams {
+	return &CreateShareParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateShareParamsWithTimeout creates a new CreateShareParams object
+// with the ability to set a timeout on a request.
+func NewCreateShareParamsWithTimeout(timeout time.Duration) *CreateShareParams {
+	return &CreateShareParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateShareParamsWithContext creates a new CreateShareParams object
+// with the ability to set a context for a request.
+func NewCreateShareParamsWithContext(ctx context.Context) *CreateShareParams {
+	return &CreateShareParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateShareParamsWithHTTPClient creates a new CreateShareParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateShareParamsWithHTTPClient(client *http.Client) *CreateShareParams {
+	return &CreateShareParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateShareParams contains all the parameters to send to the API endpoint
+
+	for the create share operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateShareParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* XGuildID.
+
+	   服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	*/
+	XGuildID *string
+
+	/* XPlayerID.
+
+	   玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	*/
+	XPlayerID *string
+
+	// Body.
+	Body *models.ShareRequest
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create share params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateShareParams) WithDefaults() *CreateShareParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create share params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateShareParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create share params
+func (o *CreateShareParams) WithTimeout(timeout time.Duration) *CreateShareParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create share params
+func (o *CreateShareParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create share params
+func (o *CreateShareParams) WithContext(ctx context.Context) *CreateShareParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create share params
+func (o *CreateShareParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create share params
+func (o *CreateShareParams) WithHTTPClient(client *http.Client) *CreateShareParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create share params
+func (o *CreateShareParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the create share params
+func (o *CreateShareParams) WithAcceptLanguage(acceptLanguage *string) *CreateShareParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the create share params
+func (o *CreateShareParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithXGuildID adds the xGuildID to the create share params
+func (o *CreateShareParams) WithXGuildID(xGuildID *string) *CreateShareParams {
+	o.SetXGuildID(xGuildID)
+	return o
+}
+
+// SetXGuildID adds the xGuildId to the create share params
+func (o *CreateShareParams) SetXGuildID(xGuildID *string) {
+	o.XGuildID = xGuildID
+}
+
+// WithXPlayerID adds the xPlayerID to the create share params
+func (o *CreateShareParams) WithXPlayerID(xPlayerID *string) *CreateShareParams {
+	o.SetXPlayerID(xPlayerID)
+	return o
+}
+
+// SetXPlayerID adds the xPlayerId to the create share params
+func (o *CreateShareParams) SetXPlayerID(xPlayerID *string) {
+	o.XPlayerID = xPlayerID
+}
+
+// WithBody adds the body to the create share params
+func (o *CreateShareParams) WithBody(body *models.ShareRequest) *CreateShareParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create share params
+func (o *CreateShareParams) SetBody(body *models.ShareRequest) {
+	o.Body = body
+}
+
+// WithLang adds the lang to the create share params
+func (o *CreateShareParams) WithLang(lang *string) *CreateShareParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the create share params
+func (o *CreateShareParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateShareParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.XGuildID != nil {
+
+		// header param X-Guild-ID
+		if err := r.SetHeaderParam("X-Guild-ID", *o.XGuildID); err != nil {
+			return err
+		}
+	}
+
+	if o.XPlayerID != nil {
+
+		// header param X-Player-ID
+		if err := r.SetHeaderParam("X-Player-ID", *o.XPlayerID); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CreateShareReader is a Reader for the CreateShare structure.
+type CreateShareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateShareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateShareOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 201:
+		result := NewCreateShareCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateShareBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewCreateShareServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /share] createShare", response, response.Code())
+	}
+}
+
+// NewCreateShareOK creates a CreateShareOK with default headers values
+func NewCreateShareOK() *CreateShareOK {
+	return &CreateShareOK{}
+}
+
+/*
+CreateShareOK describes a response with status code 200, with default header values.
+
+相同的计算已经分享过，返回已有的分享
+*/
+type CreateShareOK struct {
+	Payload *models.Share
+}
+
+// IsSuccess returns true when this create share o k response has a 2xx status code
+func (o *CreateShareOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create share o k response has a 3xx status code
+func (o *CreateShareOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create share o k response has a 4xx status code
+func (o *CreateShareOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create share o k response has a 5xx status code
+func (o *CreateShareOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create share o k response a status code equal to that given
+func (o *CreateShareOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create share o k response
+func (o *CreateShareOK) Code() int {
+	return 200
+}
+
+func (o *CreateShareOK) Error() string {
+	return fmt.Sprintf("[POST /share][%d] createShareOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateShareOK) String() string {
+	return fmt.Sprintf("[POST /share][%d] createShareOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateShareOK) GetPayload() *models.Share {
+	return o.Payload
+}
+
+func (o *CreateShareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Share)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateShareCreated creates a CreateShareCreated with default headers values
+func NewCreateShareCreated() *CreateShareCreated {
+	return &CreateShareCreated{}
+}
+
+/*
+CreateShareCreated describes a response with status code 201, with default header values.
+
+已创建分享
+*/
+type CreateShareCreated struct {
+
+	/* 分享的地址
+	 */
+	Location string
+
+	Payload *models.Share
+}
+
+// IsSuccess returns true when this create share created response has a 2xx status code
+func (o *CreateShareCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create share created response has a 3xx status code
+func (o *CreateShareCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create share created response has a 4xx status code
+func (o *CreateShareCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create share created response has a 5xx status code
+func (o *CreateShareCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create share created response a status code equal to that given
+func (o *CreateShareCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the create share created response
+func (o *CreateShareCreated) Code() int {
+	return 201
+}
+
+func (o *CreateShareCreated) Error() string {
+	return fmt.Sprintf("[POST /share][%d] createShareCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateShareCreated) String() string {
+	return fmt.Sprintf("[POST /share][%d] createShareCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateShareCreated) GetPayload() *models.Share {
+	return o.Payload
+}
+
+func (o *CreateShareCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Location
+	hdrLocation := response.GetHeader("Location")
+
+	if hdrLocation != "" {
+		o.Location = hdrLocation
+	}
+
+	o.Payload = new(models.Share)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateShareBadRequest creates a CreateShareBadRequest with default headers values
+func NewCreateShareBadRequest() *CreateShareBadRequest {
+	return &CreateShareBadRequest{}
+}
+
+/*
+CreateShareBadRequest describes a response with status code 400, with default header values.
+
+请求参数错误
+*/
+type CreateShareBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create share bad request response has a 2xx status code
+func (o *CreateShareBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create share bad request response has a 3xx status code
+func (o *CreateShareBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create share bad request response has a 4xx status code
+func (o *CreateShareBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create share bad request response has a 5xx status code
+func (o *CreateShareBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create share bad request response a status code equal to that given
+func (o *CreateShareBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create share bad request response
+func (o *CreateShareBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateShareBadRequest) Error() string {
+	return fmt.Sprintf("[POST /share][%d] createShareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateShareBadRequest) String() string {
+	return fmt.Sprintf("[POST /share][%d] createShareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateShareBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateShareBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateShareServiceUnavailable creates a CreateShareServiceUnavailable with default headers values
+func NewCreateShareServiceUnavailable() *CreateShareServiceUnavailable {
+	return &CreateShareServiceUnavailable{}
+}
+
+/*
+CreateShareServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库或服务正在停止
+*/
+type CreateShareServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create share service unavailable response has a 2xx status code
+func (o *CreateShareServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create share service unavailable response has a 3xx status code
+func (o *CreateShareServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create share service unavailable response has a 4xx status code
+func (o *CreateShareServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create share service unavailable response has a 5xx status code
+func (o *CreateShareServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create share service unavailable response a status code equal to that given
+func (o *CreateShareServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the create share service unavailable response
+func (o *CreateShareServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *CreateShareServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /share][%d] createShareServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CreateShareServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /share][%d] createShareServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CreateShareServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateShareServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
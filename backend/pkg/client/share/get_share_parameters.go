@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetShareParams creates a new GetShareParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetShareParams() *GetShareParams {
+	return &GetShareParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetShareParamsWithTimeout creates a new GetShareParams object
+// with the ability to set a timeout on a request.
+func NewGetShareParamsWithTimeout(timeout time.Duration) *GetShareParams {
+	return &GetShareParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetShareParamsWithContext creates a new GetShareParams object
+// with the ability to set a context for a request.
+func NewGetShareParamsWithContext(ctx context.Context) *GetShareParams {
+	return &GetShareParams{
+		Context: ctx,
+	}
+}
+
+// NewGetShareParamsWithHTTPClient creates a new GetShareParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetShareParamsWithHTTPClient(client *http.Client) *GetShareParams {
+	return &GetShareParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetShareParams contains all the parameters to send to the API endpoint
+
+	for the get share operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetShareParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* ID.
+
+	   分享ID
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get share params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetShareParams) WithDefaults() *GetShareParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get share params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetShareParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get share params
+func (o *GetShareParams) WithTimeout(timeout time.Duration) *GetShareParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get share params
+func (o *GetShareParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get share params
+func (o *GetShareParams) WithContext(ctx context.Context) *GetShareParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get share params
+func (o *GetShareParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get share params
+func (o *GetShareParams) WithHTTPClient(client *http.Client) *GetShareParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get share params
+func (o *GetShareParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the get share params
+func (o *GetShareParams) WithAcceptLanguage(acceptLanguage *string) *GetShareParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the get share params
+func (o *GetShareParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithID adds the id to the get share params
+func (o *GetShareParams) WithID(id string) *GetShareParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get share params
+func (o *GetShareParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the get share params
+func (o *GetShareParams) WithLang(lang *string) *GetShareParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the get share params
+func (o *GetShareParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetShareParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
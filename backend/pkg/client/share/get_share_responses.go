@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetShareReader is a Reader for the GetShare structure.
+type GetShareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetShareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetShareOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetShareBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetShareNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewGetShareServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /share/{id}] getShare", response, response.Code())
+	}
+}
+
+// NewGetShareOK creates a GetShareOK with default headers values
+func NewGetShareOK() *GetShareOK {
+	return &GetShareOK{}
+}
+
+/*
+GetShareOK describes a response with status code 200, with default header values.
+
+成功获取分享
+*/
+type GetShareOK struct {
+	Payload *models.Share
+}
+
+// IsSuccess returns true when this get share o k response has a 2xx status code
+func (o *GetShareOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get share o k response has a 3xx status code
+func (o *GetShareOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get share o k response has a 4xx status code
+func (o *GetShareOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get share o k response has a 5xx status code
+func (o *GetShareOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get share o k response a status code equal to that given
+func (o *GetShareOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get share o k response
+func (o *GetShareOK) Code() int {
+	return 200
+}
+
+func (o *GetShareOK) Error() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareOK  %+v", 200, o.Payload)
+}
+
+func (o *GetShareOK) String() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareOK  %+v", 200, o.Payload)
+}
+
+func (o *GetShareOK) GetPayload() *models.Share {
+	return o.Payload
+}
+
+func (o *GetShareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Share)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetShareBadRequest creates a GetShareBadRequest with default headers values
+func NewGetShareBadRequest() *GetShareBadRequest {
+	return &GetShareBadRequest{}
+}
+
+/*
+GetShareBadRequest describes a response with status code 400, with default header values.
+
+分享时的游戏版本已不可用
+*/
+type GetShareBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get share bad request response has a 2xx status code
+func (o *GetShareBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get share bad request response has a 3xx status code
+func (o *GetShareBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get share bad request response has a 4xx status code
+func (o *GetShareBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get share bad request response has a 5xx status code
+func (o *GetShareBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get share bad request response a status code equal to that given
+func (o *GetShareBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get share bad request response
+func (o *GetShareBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetShareBadRequest) Error() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetShareBadRequest) String() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetShareBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetShareBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetShareNotFound creates a GetShareNotFound with default headers values
+func NewGetShareNotFound() *GetShareNotFound {
+	return &GetShareNotFound{}
+}
+
+/*
+GetShareNotFound describes a response with status code 404, with default header values.
+
+分享不存在
+*/
+type GetShareNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get share not found response has a 2xx status code
+func (o *GetShareNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get share not found response has a 3xx status code
+func (o *GetShareNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get share not found response has a 4xx status code
+func (o *GetShareNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get share not found response has a 5xx status code
+func (o *GetShareNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get share not found response a status code equal to that given
+func (o *GetShareNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get share not found response
+func (o *GetShareNotFound) Code() int {
+	return 404
+}
+
+func (o *GetShareNotFound) Error() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetShareNotFound) String() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetShareNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetShareNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetShareServiceUnavailable creates a GetShareServiceUnavailable with default headers values
+func NewGetShareServiceUnavailable() *GetShareServiceUnavailable {
+	return &GetShareServiceUnavailable{}
+}
+
+/*
+GetShareServiceUnavailable describes a response with status code 503, with default header values.
+
+未启用数据库或服务正在停止
+*/
+type GetShareServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get share service unavailable response has a 2xx status code
+func (o *GetShareServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get share service unavailable response has a 3xx status code
+func (o *GetShareServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get share service unavailable response has a 4xx status code
+func (o *GetShareServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get share service unavailable response has a 5xx status code
+func (o *GetShareServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get share service unavailable response a status code equal to that given
+func (o *GetShareServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the get share service unavailable response
+func (o *GetShareServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *GetShareServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetShareServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /share/{id}][%d] getShareServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetShareServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetShareServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
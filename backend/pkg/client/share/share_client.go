@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new share API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for share API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	CreateShare(params *CreateShareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateShareOK, *CreateShareCreated, error)
+
+	GetShare(params *GetShareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetShareOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+	CreateShare 创建计算分享s
+
+	保存规范化的计算请求并返回稳定的短ID，网页中通过 /s/{id} 打开。
+
+未指定游戏版本时固定为当前最新版本，相同的计算得到相同的ID。请求参数无效时不保存。
+*/
+func (a *Client) CreateShare(params *CreateShareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateShareOK, *CreateShareCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateShareParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createShare",
+		Method:             "POST",
+		PathPattern:        "/share",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateShareReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *CreateShareOK:
+		return value, nil, nil
+	case *CreateShareCreated:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for share: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetShare 获取计算分享s
+
+获取分享的计算请求，并按分享时的游戏版本重新计算结果
+*/
+func (a *Client) GetShare(params *GetShareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetShareOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetShareParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getShare",
+		Method:             "GET",
+		PathPattern:        "/share/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetShareReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetShareOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getShare: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// HealthCheckReader is a Reader for the HealthCheck structure.
+type HealthCheckReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *HealthCheckReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewHealthCheckOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, runtime.NewAPIError("[GET /health] healthCheck", response, response.Code())
+	}
+}
+
+// NewHealthCheckOK creates a HealthCheckOK with default headers values
+func NewHealthCheckOK() *HealthCheckOK {
+	return &HealthCheckOK{}
+}
+
+/*
+HealthCheckOK describes a response with status code 200, with default header values.
+
+服务正常
+*/
+type HealthCheckOK struct {
+	Payload *models.HealthResponse
+}
+
+// IsSuccess returns true when this health check o k response has a 2xx status code
+func (o *HealthCheckOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this health check o k response has a 3xx status code
+func (o *HealthCheckOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this health check o k response has a 4xx status code
+func (o *HealthCheckOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this health check o k response has a 5xx status code
+func (o *HealthCheckOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this health check o k response a status code equal to that given
+func (o *HealthCheckOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the health check o k response
+func (o *HealthCheckOK) Code() int {
+	return 200
+}
+
+func (o *HealthCheckOK) Error() string {
+	return fmt.Sprintf("[GET /health][%d] healthCheckOK  %+v", 200, o.Payload)
+}
+
+func (o *HealthCheckOK) String() string {
+	return fmt.Sprintf("[GET /health][%d] healthCheckOK  %+v", 200, o.Payload)
+}
+
+func (o *HealthCheckOK) GetPayload() *models.HealthResponse {
+	return o.Payload
+}
+
+func (o *HealthCheckOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HealthResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
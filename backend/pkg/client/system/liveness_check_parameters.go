@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewLivenessCheckParams creates a new LivenessCheckParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewLivenessCheckParams() *LivenessCheckParams {
+	return &LivenessCheckParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewLivenessCheckParamsWithTimeout creates a new LivenessCheckParams object
+// with the ability to set a timeout on a request.
+func NewLivenessCheckParamsWithTimeout(timeout time.Duration) *LivenessCheckParams {
+	return &LivenessCheckParams{
+		timeout: timeout,
+	}
+}
+
+// NewLivenessCheckParamsWithContext creates a new LivenessCheckParams object
+// with the ability to set a context for a request.
+func NewLivenessCheckParamsWithContext(ctx context.Context) *LivenessCheckParams {
+	return &LivenessCheckParams{
+		Context: ctx,
+	}
+}
+
+// NewLivenessCheckParamsWithHTTPClient creates a new LivenessCheckParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewLivenessCheckParamsWithHTTPClient(client *http.Client) *LivenessCheckParams {
+	return &LivenessCheckParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+LivenessCheckParams contains all the parameters to send to the API endpoint
+
+	for the liveness check operation.
+
+	Typically these are written to a http.Request.
+*/
+type LivenessCheckParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the liveness check params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *LivenessCheckParams) WithDefaults() *LivenessCheckParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the liveness check params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *LivenessCheckParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the liveness check params
+func (o *LivenessCheckParams) WithTimeout(timeout time.Duration) *LivenessCheckParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the liveness check params
+func (o *LivenessCheckParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the liveness check params
+func (o *LivenessCheckParams) WithContext(ctx context.Context) *LivenessCheckParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the liveness check params
+func (o *LivenessCheckParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the liveness check params
+func (o *LivenessCheckParams) WithHTTPClient(client *http.Client) *LivenessCheckParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the liveness check params
+func (o *LivenessCheckParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *LivenessCheckParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
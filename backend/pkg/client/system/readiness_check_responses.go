@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ReadinessCheckReader is a Reader for the ReadinessCheck structure.
+type ReadinessCheckReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReadinessCheckReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReadinessCheckOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 503:
+		result := NewReadinessCheckServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /health/ready] readinessCheck", response, response.Code())
+	}
+}
+
+// NewReadinessCheckOK creates a ReadinessCheckOK with default headers values
+func NewReadinessCheckOK() *ReadinessCheckOK {
+	return &ReadinessCheckOK{}
+}
+
+/*
+ReadinessCheckOK describes a response with status code 200, with default header values.
+
+服务就绪
+*/
+type ReadinessCheckOK struct {
+	Payload *models.HealthResponse
+}
+
+// IsSuccess returns true when this readiness check o k response has a 2xx status code
+func (o *ReadinessCheckOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this readiness check o k response has a 3xx status code
+func (o *ReadinessCheckOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this readiness check o k response has a 4xx status code
+func (o *ReadinessCheckOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this readiness check o k response has a 5xx status code
+func (o *ReadinessCheckOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this readiness check o k response a status code equal to that given
+func (o *ReadinessCheckOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the readiness check o k response
+func (o *ReadinessCheckOK) Code() int {
+	return 200
+}
+
+func (o *ReadinessCheckOK) Error() string {
+	return fmt.Sprintf("[GET /health/ready][%d] readinessCheckOK  %+v", 200, o.Payload)
+}
+
+func (o *ReadinessCheckOK) String() string {
+	return fmt.Sprintf("[GET /health/ready][%d] readinessCheckOK  %+v", 200, o.Payload)
+}
+
+func (o *ReadinessCheckOK) GetPayload() *models.HealthResponse {
+	return o.Payload
+}
+
+func (o *ReadinessCheckOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HealthResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReadinessCheckServiceUnavailable creates a ReadinessCheckServiceUnavailable with default headers values
+func NewReadinessCheckServiceUnavailable() *ReadinessCheckServiceUnavailable {
+	return &ReadinessCheckServiceUnavailable{}
+}
+
+/*
+ReadinessCheckServiceUnavailable describes a response with status code 503, with default header values.
+
+服务未就绪，components中为各组件的状态
+*/
+type ReadinessCheckServiceUnavailable struct {
+	Payload *models.HealthResponse
+}
+
+// IsSuccess returns true when this readiness check service unavailable response has a 2xx status code
+func (o *ReadinessCheckServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this readiness check service unavailable response has a 3xx status code
+func (o *ReadinessCheckServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this readiness check service unavailable response has a 4xx status code
+func (o *ReadinessCheckServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this readiness check service unavailable response has a 5xx status code
+func (o *ReadinessCheckServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this readiness check service unavailable response a status code equal to that given
+func (o *ReadinessCheckServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the readiness check service unavailable response
+func (o *ReadinessCheckServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReadinessCheckServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /health/ready][%d] readinessCheckServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ReadinessCheckServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /health/ready][%d] readinessCheckServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *ReadinessCheckServiceUnavailable) GetPayload() *models.HealthResponse {
+	return o.Payload
+}
+
+func (o *ReadinessCheckServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HealthResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListToolsParams creates a new ListToolsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListToolsParams() *ListToolsParams {
+	return &ListToolsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListToolsParamsWithTimeout creates a new ListToolsParams object
+// with the ability to set a timeout on a request.
+func NewListToolsParamsWithTimeout(timeout time.Duration) *ListToolsParams {
+	return &ListToolsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListToolsParamsWithContext creates a new ListToolsParams object
+// with the ability to set a context for a request.
+func NewListToolsParamsWithContext(ctx context.Context) *ListToolsParams {
+	return &ListToolsParams{
+		Context: ctx,
+	}
+}
+
+// NewListToolsParamsWithHTTPClient creates a new ListToolsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListToolsParamsWithHTTPClient(client *http.Client) *ListToolsParams {
+	return &ListToolsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListToolsParams contains all the parameters to send to the API endpoint
+
+	for the list tools operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListToolsParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list tools params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListToolsParams) WithDefaults() *ListToolsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list tools params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListToolsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list tools params
+func (o *ListToolsParams) WithTimeout(timeout time.Duration) *ListToolsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list tools params
+func (o *ListToolsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list tools params
+func (o *ListToolsParams) WithContext(ctx context.Context) *ListToolsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list tools params
+func (o *ListToolsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list tools params
+func (o *ListToolsParams) WithHTTPClient(client *http.Client) *ListToolsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list tools params
+func (o *ListToolsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the list tools params
+func (o *ListToolsParams) WithAcceptLanguage(acceptLanguage *string) *ListToolsParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the list tools params
+func (o *ListToolsParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithLang adds the lang to the list tools params
+func (o *ListToolsParams) WithLang(lang *string) *ListToolsParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the list tools params
+func (o *ListToolsParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListToolsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
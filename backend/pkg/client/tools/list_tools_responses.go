@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListToolsReader is a Reader for the ListTools structure.
+type ListToolsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListToolsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListToolsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, runtime.NewAPIError("[GET /tools] listTools", response, response.Code())
+	}
+}
+
+// NewListToolsOK creates a ListToolsOK with default headers values
+func NewListToolsOK() *ListToolsOK {
+	return &ListToolsOK{}
+}
+
+/*
+ListToolsOK describes a response with status code 200, with default header values.
+
+成功获取工具列表
+*/
+type ListToolsOK struct {
+	Payload *models.ToolsListResponse
+}
+
+// IsSuccess returns true when this list tools o k response has a 2xx status code
+func (o *ListToolsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list tools o k response has a 3xx status code
+func (o *ListToolsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list tools o k response has a 4xx status code
+func (o *ListToolsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list tools o k response has a 5xx status code
+func (o *ListToolsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list tools o k response a status code equal to that given
+func (o *ListToolsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list tools o k response
+func (o *ListToolsOK) Code() int {
+	return 200
+}
+
+func (o *ListToolsOK) Error() string {
+	return fmt.Sprintf("[GET /tools][%d] listToolsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListToolsOK) String() string {
+	return fmt.Sprintf("[GET /tools][%d] listToolsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListToolsOK) GetPayload() *models.ToolsListResponse {
+	return o.Payload
+}
+
+func (o *ListToolsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ToolsListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRunToolParams creates a new RunToolParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRunToolParams() *RunToolParams {
+	return &RunToolParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRunToolParamsWithTimeout creates a new RunToolParams object
+// with the ability to set a timeout on a request.
+func NewRunToolParamsWithTimeout(timeout time.Duration) *RunToolParams {
+	return &RunToolParams{
+		timeout: timeout,
+	}
+}
+
+// NewRunToolParamsWithContext creates a new RunToolParams object
+// with the ability to set a context for a request.
+func NewRunToolParamsWithContext(ctx context.Context) *RunToolParams {
+	return &RunToolParams{
+		Context: ctx,
+	}
+}
+
+// NewRunToolParamsWithHTTPClient creates a new RunToolParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRunToolParamsWithHTTPClient(client *http.Client) *RunToolParams {
+	return &RunToolParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RunToolParams contains all the parameters to send to the API endpoint
+
+	for the run tool operation.
+
+	Typically these are written to a http.Request.
+*/
+type RunToolParams struct {
+
+	/* AcceptLanguage.
+
+	   浏览器语言偏好，未指定lang时使用，默认zh-CN
+	*/
+	AcceptLanguage *string
+
+	// Body.
+	Body interface{}
+
+	/* ID.
+
+	   工具ID
+	*/
+	ID string
+
+	/* Lang.
+
+	   响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	*/
+	Lang *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the run tool params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RunToolParams) WithDefaults() *RunToolParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the run tool params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RunToolParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the run tool params
+func (o *RunToolParams) WithTimeout(timeout time.Duration) *RunToolParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the run tool params
+func (o *RunToolParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the run tool params
+func (o *RunToolParams) WithContext(ctx context.Context) *RunToolParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the run tool params
+func (o *RunToolParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the run tool params
+func (o *RunToolParams) WithHTTPClient(client *http.Client) *RunToolParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the run tool params
+func (o *RunToolParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcceptLanguage adds the acceptLanguage to the run tool params
+func (o *RunToolParams) WithAcceptLanguage(acceptLanguage *string) *RunToolParams {
+	o.SetAcceptLanguage(acceptLanguage)
+	return o
+}
+
+// SetAcceptLanguage adds the acceptLanguage to the run tool params
+func (o *RunToolParams) SetAcceptLanguage(acceptLanguage *string) {
+	o.AcceptLanguage = acceptLanguage
+}
+
+// WithBody adds the body to the run tool params
+func (o *RunToolParams) WithBody(body interface{}) *RunToolParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the run tool params
+func (o *RunToolParams) SetBody(body interface{}) {
+	o.Body = body
+}
+
+// WithID adds the id to the run tool params
+func (o *RunToolParams) WithID(id string) *RunToolParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the run tool params
+func (o *RunToolParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLang adds the lang to the run tool params
+func (o *RunToolParams) WithLang(lang *string) *RunToolParams {
+	o.SetLang(lang)
+	return o
+}
+
+// SetLang adds the lang to the run tool params
+func (o *RunToolParams) SetLang(lang *string) {
+	o.Lang = lang
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RunToolParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AcceptLanguage != nil {
+
+		// header param Accept-Language
+		if err := r.SetHeaderParam("Accept-Language", *o.AcceptLanguage); err != nil {
+			return err
+		}
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Lang != nil {
+
+		// query param lang
+		var qrLang string
+
+		if o.Lang != nil {
+			qrLang = *o.Lang
+		}
+		qLang := qrLang
+		if qLang != "" {
+
+			if err := r.SetQueryParam("lang", qLang); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,547 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// RunToolReader is a Reader for the RunTool structure.
+type RunToolReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RunToolReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRunToolOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRunToolBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRunToolNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRunToolUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 502:
+		result := NewRunToolBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewRunToolServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 504:
+		result := NewRunToolGatewayTimeout()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /tools/{id}/run] runTool", response, response.Code())
+	}
+}
+
+// NewRunToolOK creates a RunToolOK with default headers values
+func NewRunToolOK() *RunToolOK {
+	return &RunToolOK{}
+}
+
+/*
+RunToolOK describes a response with status code 200, with default header values.
+
+运行成功
+*/
+type RunToolOK struct {
+	Payload *models.ToolRunResponse
+}
+
+// IsSuccess returns true when this run tool o k response has a 2xx status code
+func (o *RunToolOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this run tool o k response has a 3xx status code
+func (o *RunToolOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool o k response has a 4xx status code
+func (o *RunToolOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run tool o k response has a 5xx status code
+func (o *RunToolOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run tool o k response a status code equal to that given
+func (o *RunToolOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the run tool o k response
+func (o *RunToolOK) Code() int {
+	return 200
+}
+
+func (o *RunToolOK) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolOK  %+v", 200, o.Payload)
+}
+
+func (o *RunToolOK) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolOK  %+v", 200, o.Payload)
+}
+
+func (o *RunToolOK) GetPayload() *models.ToolRunResponse {
+	return o.Payload
+}
+
+func (o *RunToolOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ToolRunResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolBadRequest creates a RunToolBadRequest with default headers values
+func NewRunToolBadRequest() *RunToolBadRequest {
+	return &RunToolBadRequest{}
+}
+
+/*
+RunToolBadRequest describes a response with status code 400, with default header values.
+
+输入不符合工具的inputSchema或计算参数错误
+*/
+type RunToolBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool bad request response has a 2xx status code
+func (o *RunToolBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool bad request response has a 3xx status code
+func (o *RunToolBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool bad request response has a 4xx status code
+func (o *RunToolBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this run tool bad request response has a 5xx status code
+func (o *RunToolBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run tool bad request response a status code equal to that given
+func (o *RunToolBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the run tool bad request response
+func (o *RunToolBadRequest) Code() int {
+	return 400
+}
+
+func (o *RunToolBadRequest) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RunToolBadRequest) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RunToolBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolNotFound creates a RunToolNotFound with default headers values
+func NewRunToolNotFound() *RunToolNotFound {
+	return &RunToolNotFound{}
+}
+
+/*
+RunToolNotFound describes a response with status code 404, with default header values.
+
+工具不存在或未启用
+*/
+type RunToolNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool not found response has a 2xx status code
+func (o *RunToolNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool not found response has a 3xx status code
+func (o *RunToolNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool not found response has a 4xx status code
+func (o *RunToolNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this run tool not found response has a 5xx status code
+func (o *RunToolNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run tool not found response a status code equal to that given
+func (o *RunToolNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the run tool not found response
+func (o *RunToolNotFound) Code() int {
+	return 404
+}
+
+func (o *RunToolNotFound) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RunToolNotFound) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RunToolNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolUnprocessableEntity creates a RunToolUnprocessableEntity with default headers values
+func NewRunToolUnprocessableEntity() *RunToolUnprocessableEntity {
+	return &RunToolUnprocessableEntity{}
+}
+
+/*
+RunToolUnprocessableEntity describes a response with status code 422, with default header values.
+
+脚本工具超过执行步数、时间或内存上限
+*/
+type RunToolUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool unprocessable entity response has a 2xx status code
+func (o *RunToolUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool unprocessable entity response has a 3xx status code
+func (o *RunToolUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool unprocessable entity response has a 4xx status code
+func (o *RunToolUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this run tool unprocessable entity response has a 5xx status code
+func (o *RunToolUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this run tool unprocessable entity response a status code equal to that given
+func (o *RunToolUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the run tool unprocessable entity response
+func (o *RunToolUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *RunToolUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RunToolUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RunToolUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolBadGateway creates a RunToolBadGateway with default headers values
+func NewRunToolBadGateway() *RunToolBadGateway {
+	return &RunToolBadGateway{}
+}
+
+/*
+RunToolBadGateway describes a response with status code 502, with default header values.
+
+插件运行失败
+*/
+type RunToolBadGateway struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool bad gateway response has a 2xx status code
+func (o *RunToolBadGateway) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool bad gateway response has a 3xx status code
+func (o *RunToolBadGateway) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool bad gateway response has a 4xx status code
+func (o *RunToolBadGateway) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run tool bad gateway response has a 5xx status code
+func (o *RunToolBadGateway) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this run tool bad gateway response a status code equal to that given
+func (o *RunToolBadGateway) IsCode(code int) bool {
+	return code == 502
+}
+
+// Code gets the status code for the run tool bad gateway response
+func (o *RunToolBadGateway) Code() int {
+	return 502
+}
+
+func (o *RunToolBadGateway) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *RunToolBadGateway) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *RunToolBadGateway) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolServiceUnavailable creates a RunToolServiceUnavailable with default headers values
+func NewRunToolServiceUnavailable() *RunToolServiceUnavailable {
+	return &RunToolServiceUnavailable{}
+}
+
+/*
+RunToolServiceUnavailable describes a response with status code 503, with default header values.
+
+插件正在重新启动或服务正在停止
+*/
+type RunToolServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool service unavailable response has a 2xx status code
+func (o *RunToolServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool service unavailable response has a 3xx status code
+func (o *RunToolServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool service unavailable response has a 4xx status code
+func (o *RunToolServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run tool service unavailable response has a 5xx status code
+func (o *RunToolServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this run tool service unavailable response a status code equal to that given
+func (o *RunToolServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the run tool service unavailable response
+func (o *RunToolServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *RunToolServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *RunToolServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *RunToolServiceUnavailable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRunToolGatewayTimeout creates a RunToolGatewayTimeout with default headers values
+func NewRunToolGatewayTimeout() *RunToolGatewayTimeout {
+	return &RunToolGatewayTimeout{}
+}
+
+/*
+RunToolGatewayTimeout describes a response with status code 504, with default header values.
+
+插件运行超时
+*/
+type RunToolGatewayTimeout struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this run tool gateway timeout response has a 2xx status code
+func (o *RunToolGatewayTimeout) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this run tool gateway timeout response has a 3xx status code
+func (o *RunToolGatewayTimeout) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this run tool gateway timeout response has a 4xx status code
+func (o *RunToolGatewayTimeout) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this run tool gateway timeout response has a 5xx status code
+func (o *RunToolGatewayTimeout) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this run tool gateway timeout response a status code equal to that given
+func (o *RunToolGatewayTimeout) IsCode(code int) bool {
+	return code == 504
+}
+
+// Code gets the status code for the run tool gateway timeout response
+func (o *RunToolGatewayTimeout) Code() int {
+	return 504
+}
+
+func (o *RunToolGatewayTimeout) Error() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolGatewayTimeout  %+v", 504, o.Payload)
+}
+
+func (o *RunToolGatewayTimeout) String() string {
+	return fmt.Sprintf("[POST /tools/{id}/run][%d] runToolGatewayTimeout  %+v", 504, o.Payload)
+}
+
+func (o *RunToolGatewayTimeout) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RunToolGatewayTimeout) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
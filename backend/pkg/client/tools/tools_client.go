@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new tools API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for tools API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ListTools(params *ListToolsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListToolsOK, error)
+
+	RunTool(params *RunToolParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunToolOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ListTools 获取工具列表s
+
+获取工具注册表中启用的工具，TOOLS_ENABLED和TOOLS_DISABLED配置可以启用或隐藏工具
+*/
+func (a *Client) ListTools(params *ListToolsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListToolsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListToolsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listTools",
+		Method:             "GET",
+		PathPattern:        "/tools",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListToolsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListToolsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listTools: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	RunTool 运行工具s
+
+	按工具的inputSchema校验输入后运行工具，内置工具的输入为对应计算接口的请求体。
+
+插件工具在独立进程中运行，超过PLUGINS_TIMEOUT时返回504，插件进程退出后自动重新启动。
+脚本工具超过执行步数、时间或内存上限时返回422。
+*/
+func (a *Client) RunTool(params *RunToolParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunToolOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunToolParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "runTool",
+		Method:             "POST",
+		PathPattern:        "/tools/{id}/run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunToolReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunToolOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for runTool: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
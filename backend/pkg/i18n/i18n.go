@@ -0,0 +1,155 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale 默认语言，所有回退链的终点
+const DefaultLocale = "zh-CN"
+
+var (
+	catalogs = make(map[string]map[string]string)
+	mu       sync.RWMutex
+)
+
+// Register 注册指定语言的翻译，可多次调用合并翻译
+func Register(locale string, messages map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	locale = canonicalLocale(locale)
+	catalog, ok := catalogs[locale]
+	if !ok {
+		catalog = make(map[string]string, len(messages))
+		catalogs[locale] = catalog
+	}
+	for key, value := range messages {
+		catalog[key] = value
+	}
+}
+
+// FallbackChain 获取语言的回退链，例如 en-GB -> en -> zh-CN
+func FallbackChain(locale string) []string {
+	locale = canonicalLocale(locale)
+
+	var chain []string
+	if locale != "" {
+		chain = append(chain, locale)
+		if base, _, ok := strings.Cut(locale, "-"); ok {
+			chain = append(chain, base)
+		}
+	}
+	if locale != DefaultLocale {
+		chain = append(chain, DefaultLocale)
+	}
+	return chain
+}
+
+// Lookup 按回退链查找翻译
+func Lookup(locale, key string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, candidate := range FallbackChain(locale) {
+		if value, ok := catalogs[candidate][key]; ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// T 翻译消息，找不到翻译时返回key
+func T(locale, key string, args ...interface{}) string {
+	format, ok := Lookup(locale, key)
+	if !ok {
+		format = key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// TOr 翻译消息，找不到翻译时返回fallback
+func TOr(locale, key, fallback string) string {
+	if value, ok := Lookup(locale, key); ok {
+		return value
+	}
+	return fallback
+}
+
+// IsSupported 检查语言或其基础语言是否注册了翻译
+func IsSupported(locale string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	locale = canonicalLocale(locale)
+	base, _, _ := strings.Cut(locale, "-")
+	_, exact := catalogs[locale]
+	_, baseOK := catalogs[base]
+	return exact || baseOK
+}
+
+// Resolve 解析请求语言，lang参数优先于Accept-Language
+func Resolve(lang, acceptLanguage string) string {
+	if lang != "" && IsSupported(lang) {
+		return canonicalLocale(lang)
+	}
+	return ParseAcceptLanguage(acceptLanguage)
+}
+
+// ParseAcceptLanguage 解析Accept-Language请求头，返回权重最高且受支持的语言
+func ParseAcceptLanguage(header string) string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var candidates []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		if q > 0 {
+			candidates = append(candidates, weighted{locale: tag, q: q})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, candidate := range candidates {
+		if IsSupported(candidate.locale) {
+			return canonicalLocale(candidate.locale)
+		}
+	}
+	return DefaultLocale
+}
+
+// canonicalLocale 规范化语言标签，例如 en_us -> en-US
+func canonicalLocale(locale string) string {
+	locale = strings.TrimSpace(strings.ReplaceAll(locale, "_", "-"))
+	if locale == "" {
+		return ""
+	}
+
+	base, region, ok := strings.Cut(locale, "-")
+	base = strings.ToLower(base)
+	if !ok {
+		return base
+	}
+	return base + "-" + strings.ToUpper(region)
+}
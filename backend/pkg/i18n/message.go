@@ -0,0 +1,25 @@
+package i18n
+
+// Message 可本地化的消息，同时实现error接口，默认按DefaultLocale输出
+type Message struct {
+	Key  string
+	Args []interface{}
+}
+
+// NewMessage 创建可本地化的消息
+func NewMessage(key string, args ...interface{}) *Message {
+	return &Message{Key: key, Args: args}
+}
+
+// Localize 按指定语言输出消息
+func (m *Message) Localize(locale string) string {
+	if m == nil {
+		return ""
+	}
+	return T(locale, m.Key, m.Args...)
+}
+
+// Error 实现error接口
+func (m *Message) Error() string {
+	return m.Localize(DefaultLocale)
+}
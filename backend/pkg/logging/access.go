@@ -0,0 +1,117 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// accessEntry 访问日志条目，由内层的路由和处理器补充操作ID和计算参数
+type accessEntry struct {
+	mu          sync.Mutex
+	operationID string
+	attrs       []slog.Attr
+}
+
+// accessEntryKey 上下文中访问日志条目的键
+type accessEntryKey struct{}
+
+// SetOperationID 设置访问日志的操作ID
+func SetOperationID(ctx context.Context, operationID string) {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok {
+		entry.mu.Lock()
+		entry.operationID = operationID
+		entry.mu.Unlock()
+	}
+}
+
+// OperationID 获取路由设置的操作ID，未匹配到路由时为空
+func OperationID(ctx context.Context) string {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok {
+		entry.mu.Lock()
+		defer entry.mu.Unlock()
+		return entry.operationID
+	}
+	return ""
+}
+
+// AddAttrs 为访问日志添加字段，如计算参数
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok {
+		entry.mu.Lock()
+		entry.attrs = append(entry.attrs, attrs...)
+		entry.mu.Unlock()
+	}
+}
+
+// AccessLog 访问日志中间件，请求结束后记录方法、路径、操作ID、状态码、耗时和处理器添加的字段
+// 需要放在RequestIDMiddleware内层以便日志带上请求ID
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		entry := &accessEntry{}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		ctx := context.WithValue(r.Context(), accessEntryKey{}, entry)
+
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		entry.mu.Lock()
+		defer entry.mu.Unlock()
+
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("operation_id", entry.operationID),
+			slog.Int("status", recorder.status),
+			slog.Int64("bytes", recorder.bytes),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("remote_addr", r.RemoteAddr),
+		}
+		attrs = append(attrs, entry.attrs...)
+
+		level := slog.LevelInfo
+		if recorder.status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		FromContext(ctx).LogAttrs(ctx, level, "request", attrs...)
+	})
+}
+
+// statusRecorder 记录响应状态码和字节数
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int64
+	wroteHeader bool
+}
+
+// WriteHeader 记录状态码
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write 记录写入的字节数
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += int64(n)
+	return n, err
+}
+
+// Flush 支持流式响应
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap 返回原始ResponseWriter，供http.ResponseController使用
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
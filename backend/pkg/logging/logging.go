@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// ParseLevel 解析日志级别，支持debug、info、warn和error，无效时使用info
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// New 创建日志记录器，format为json时输出JSON，否则输出文本
+func New(w io.Writer, level, format string) *slog.Logger {
+	options := &slog.HandlerOptions{Level: ParseLevel(level)}
+	if strings.EqualFold(format, "json") {
+		return slog.New(slog.NewJSONHandler(w, options))
+	}
+	return slog.New(slog.NewTextHandler(w, options))
+}
+
+// Setup 创建输出到标准错误的日志记录器并设为默认，标准库log的输出也转为info级别日志
+func Setup(level, format string) *slog.Logger {
+	logger := New(os.Stderr, level, format)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// Printf 以info级别记录格式化日志，用于只接受Printf形式日志函数的组件
+func Printf(format string, args ...interface{}) {
+	slog.Default().Info(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// loggerKey 上下文中日志记录器的键
+type loggerKey struct{}
+
+// WithLogger 将日志记录器放入上下文
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext 获取上下文中的日志记录器，没有时返回默认记录器
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
@@ -0,0 +1,31 @@
+package restapi
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+// newAuthenticator 根据配置创建API key认证器，key文件无法读取时退出
+// 启用数据库时用量写入数据库，否则写入key文件同目录的用量文件
+func newAuthenticator(cfg *config.Config, db *store.DB) *auth.Authenticator {
+	flushInterval := cfg.Auth.FlushInterval
+	if db != nil {
+		flushInterval = 0
+	}
+	keys, err := auth.NewFileStore(cfg.Auth.KeysFile, flushInterval)
+	if err != nil {
+		slog.Error("加载API key失败", "path", cfg.Auth.KeysFile, "error", err)
+		os.Exit(1)
+	}
+	if cfg.Auth.Required {
+		slog.Info("已启用API key认证", "keys_file", cfg.Auth.KeysFile)
+	}
+	if db != nil {
+		return auth.NewAuthenticator(auth.NewDBStore(keys, db.Usage(), cfg.Auth.FlushInterval), cfg.Auth.Required)
+	}
+	return auth.NewAuthenticator(keys, cfg.Auth.Required)
+}
@@ -0,0 +1,38 @@
+package restapi
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+)
+
+// newResultCache 根据配置创建计算结果缓存，目录重新加载时清空，未启用时返回nil
+func newResultCache(cfg *config.Config) *cache.Cache {
+	if cfg.Cache.Store == "none" {
+		slog.Info("计算结果缓存已关闭")
+		return nil
+	}
+
+	var shared cache.Store
+	if cfg.Cache.Store == "redis" {
+		if client := newRedisClient(cfg, "cache"); client != nil {
+			shared = cache.NewRedisStore(client, "oncehuman:cache:")
+		} else {
+			slog.Warn("计算结果缓存只使用进程内存储")
+		}
+	}
+
+	local := cache.NewMemoryStore(cfg.Cache.MaxEntries, cfg.Cache.MaxBytes)
+	resultCache := cache.New("results", local, shared, cfg.Cache.TTL)
+	models.OnCatalogReload(func() {
+		if err := resultCache.Purge(context.Background()); err != nil {
+			slog.Warn("清空计算结果缓存失败", "error", err)
+			return
+		}
+		slog.Info("目录已重新加载，计算结果缓存已清空")
+	})
+	return resultCache
+}
@@ -14,12 +14,24 @@ import (
 	"crypto/tls"
 	"net/http"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/export"
 	"github.com/SpenserCai/OnceHumanTools/backend/internal/handlers"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/script"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/admin"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/inventory"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/jobs"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/mod"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/preset"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/scripts"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/share"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/system"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/tools"
 	// 导入我们的处理器
@@ -33,13 +45,14 @@ func configureFlags(api *operations.OncehumanToolsAPI) {
 
 func configureAPI(api *operations.OncehumanToolsAPI) http.Handler {
 	// configure the api here
-	api.ServeError = errors.ServeError
+	api.ServeError = handlers.ServeError
 
 	// Set your custom logger if needed. Default one is log.Printf
 	// Expected interface func(string, ...interface{})
 	//
 	// Example:
 	// api.Logger = log.Printf
+	api.Logger = logging.Printf
 
 	api.UseSwaggerUI()
 	// To continue using redoc as your UI, uncomment the following line
@@ -48,28 +61,93 @@ func configureAPI(api *operations.OncehumanToolsAPI) http.Handler {
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.JSONProducer = runtime.JSONProducer()
-
-	// 创建处理器实例
-	systemHandler := handlers.NewSystemHandler()
-	toolsHandler := handlers.NewToolsHandler()
-	modHandler := handlers.NewModHandler()
+	// 计算结果的导出格式，按Accept或format查询参数选择
+	api.CsvProducer = export.CSVProducer()
+	api.MarkdownProducer = export.MarkdownProducer()
+	api.RegisterProducer(export.JSONLinesMime, export.JSONLinesProducer())
+
+	// 事件流由处理器直接写入，生产者只用于订阅失败时的错误响应
+	api.TextEventStreamProducer = runtime.JSONProducer()
+
+	// 创建处理器实例，启动时生成目录
+	cfg := config.LoadConfig()
+	models.LatestCatalog()
+	resultCache := newResultCache(cfg)
+	db := newDatabase(cfg)
+	jobManager := newJobManager(cfg)
+	authenticator := newAuthenticator(cfg, db)
+	authHandler := handlers.NewAuthHandler(authenticator)
+	checker := newHealthChecker(cfg, resultCache, db)
+	systemHandler := handlers.NewSystemHandler(checker)
+	modHandler := handlers.NewModHandler(db, resultCache)
+	toolsHandler := handlers.NewToolsHandler(newToolRegistry(cfg, modHandler))
+	jobHandler := handlers.NewJobHandler(jobManager, db, resultCache)
+	shareHandler := handlers.NewShareHandler(db, resultCache)
+	inventoryHandler := handlers.NewInventoryHandler(db, resultCache)
+	presetHandler := handlers.NewPresetHandler(db)
+	scriptHandler := handlers.NewScriptHandler(script.ConfigOptions(cfg.Scripts))
+
+	// API key认证，接口要求的权限由规范中的x-scope指定
+	api.APIKeyAuth = authHandler.APIKeyAuth
+	api.APIAuthorizer = runtime.AuthorizerFunc(authHandler.Authorize)
 
 	// 连接模组相关处理器
 	api.ModCalculateAffixProbabilityHandler = mod.CalculateAffixProbabilityHandlerFunc(modHandler.CalculateAffixProbability)
+	api.ModCalculateAffixValueProbabilityHandler = mod.CalculateAffixValueProbabilityHandlerFunc(modHandler.CalculateAffixValueProbability)
 	api.ModCalculateStrengthenProbabilityHandler = mod.CalculateStrengthenProbabilityHandlerFunc(modHandler.CalculateStrengthenProbability)
 	api.ModListAffixesHandler = mod.ListAffixesHandlerFunc(modHandler.ListAffixes)
+	api.ModGetAffixHandler = mod.GetAffixHandlerFunc(modHandler.GetAffix)
+	api.ModSearchAffixesHandler = mod.SearchAffixesHandlerFunc(modHandler.SearchAffixes)
+	api.ModListRaritiesHandler = mod.ListRaritiesHandlerFunc(modHandler.ListRarities)
+	api.ModListGameVersionsHandler = mod.ListGameVersionsHandlerFunc(modHandler.ListGameVersions)
+	api.ModDiffGameVersionsHandler = mod.DiffGameVersionsHandlerFunc(modHandler.DiffGameVersions)
+
+	// 连接异步任务处理器
+	api.JobsSubmitJobHandler = jobs.SubmitJobHandlerFunc(jobHandler.SubmitJob)
+	api.JobsGetJobHandler = jobs.GetJobHandlerFunc(jobHandler.GetJob)
+	api.JobsCancelJobHandler = jobs.CancelJobHandlerFunc(jobHandler.CancelJob)
+	api.JobsGetJobResultHandler = jobs.GetJobResultHandlerFunc(jobHandler.GetJobResult)
+	api.JobsStreamJobEventsHandler = jobs.StreamJobEventsHandlerFunc(jobHandler.StreamJobEvents)
+
+	// 连接分享处理器
+	api.ShareCreateShareHandler = share.CreateShareHandlerFunc(shareHandler.CreateShare)
+	api.ShareGetShareHandler = share.GetShareHandlerFunc(shareHandler.GetShare)
+
+	// 连接背包处理器
+	api.InventoryListInventoryModsHandler = inventory.ListInventoryModsHandlerFunc(inventoryHandler.ListInventoryMods)
+	api.InventoryCreateInventoryModHandler = inventory.CreateInventoryModHandlerFunc(inventoryHandler.CreateInventoryMod)
+	api.InventoryGetInventoryModHandler = inventory.GetInventoryModHandlerFunc(inventoryHandler.GetInventoryMod)
+	api.InventoryUpdateInventoryModHandler = inventory.UpdateInventoryModHandlerFunc(inventoryHandler.UpdateInventoryMod)
+	api.InventoryDeleteInventoryModHandler = inventory.DeleteInventoryModHandlerFunc(inventoryHandler.DeleteInventoryMod)
+	api.InventoryAnalyzeInventoryHandler = inventory.AnalyzeInventoryHandlerFunc(inventoryHandler.AnalyzeInventory)
+
+	// 连接预设处理器
+	api.PresetListPresetsHandler = preset.ListPresetsHandlerFunc(presetHandler.ListPresets)
+	api.PresetSavePresetHandler = preset.SavePresetHandlerFunc(presetHandler.SavePreset)
+	api.PresetDeletePresetHandler = preset.DeletePresetHandlerFunc(presetHandler.DeletePreset)
+
+	// 连接管理处理器
+	api.AdminListAPIKeysHandler = admin.ListAPIKeysHandlerFunc(authHandler.ListAPIKeys)
 
 	// 连接系统处理器
 	api.SystemHealthCheckHandler = system.HealthCheckHandlerFunc(systemHandler.HealthCheck)
+	api.SystemLivenessCheckHandler = system.LivenessCheckHandlerFunc(systemHandler.LivenessCheck)
+	api.SystemReadinessCheckHandler = system.ReadinessCheckHandlerFunc(systemHandler.ReadinessCheck)
 
 	// 连接工具处理器
 	api.ToolsListToolsHandler = tools.ListToolsHandlerFunc(toolsHandler.ListTools)
+	api.ToolsRunToolHandler = tools.RunToolHandlerFunc(toolsHandler.RunTool)
 
-	api.PreServerShutdown = func() {}
+	// 连接脚本处理器
+	api.ScriptsRunScriptHandler = scripts.RunScriptHandlerFunc(scriptHandler.RunScript)
 
-	api.ServerShutdown = func() {}
+	// 收到停止信号后就绪检查返回503并拒绝新任务，进行中的请求和任务在宽限期内结束后保存API key用量
+	onShutdown(authenticator.Close)
+	stop := newShutdown(cfg, checker, jobManager)
+	api.PreServerShutdown = stop.prepare
+	api.ServerShutdown = stop.finish
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	return setupGlobalMiddleware(api.Serve(setupMiddlewares), authenticator)
 }
 
 // The TLS configuration before HTTPS server starts.
@@ -82,16 +160,32 @@ func configureTLS(tlsConfig *tls.Config) {
 // This function can be called multiple times, depending on the number of serving schemes.
 // scheme value will be set accordingly: "http", "https" or "unix".
 func configureServer(s *http.Server, scheme, addr string) {
+	// 请求上下文在停止服务的宽限期结束时取消
+	s.BaseContext = baseContext
 }
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	// 路由完成后为访问日志记录操作ID
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			logging.SetOperationID(r.Context(), route.Operation.ID)
+		}
+		handler.ServeHTTP(w, r)
+	})
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics.
-func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	return handler
+func setupGlobalMiddleware(handler http.Handler, authenticator *auth.Authenticator) http.Handler {
+	cfg := config.LoadConfig()
+	// 从外到内：请求ID、访问日志、请求指标、安全响应头、导出格式、跨域、限流，限流响应也带有跨域头以便浏览器读取
+	handler = newRateLimiter(cfg, authenticator).Middleware(handler)
+	handler = newCORS(cfg)(handler)
+	handler = export.FormatMiddleware(handler)
+	handler = newSecurityHeaders(cfg)(handler)
+	handler = newMetrics(cfg)(handler)
+	handler = logging.AccessLog(handler)
+	return logging.RequestIDMiddleware(handler)
 }
@@ -0,0 +1,53 @@
+package restapi
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+// newDatabase 根据配置打开数据库并应用迁移，未启用时返回nil，打开或迁移失败时退出，停止服务时关闭
+func newDatabase(cfg *config.Config) *store.DB {
+	if cfg.Database.Driver == store.DriverNone {
+		slog.Info("未启用数据库，依赖数据库的功能不可用")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	db, err := store.Open(ctx, store.ConfigOptions(cfg.Database))
+	if err != nil {
+		slog.Error("打开数据库失败", "driver", cfg.Database.Driver, "error", err)
+		os.Exit(1)
+	}
+	onShutdown(db.Close)
+
+	if cfg.Database.AutoMigrate {
+		applied, err := db.Migrate(ctx, 0)
+		if err != nil {
+			slog.Error("应用数据库迁移失败", "error", err)
+			os.Exit(1)
+		}
+		for _, m := range applied {
+			slog.Info("已应用数据库迁移", "version", m.Version, "name", m.Name)
+		}
+	} else if pending, err := db.Pending(ctx); err != nil {
+		slog.Error("读取数据库迁移状态失败", "error", err)
+		os.Exit(1)
+	} else if len(pending) > 0 {
+		slog.Warn("数据库有未应用的迁移，请运行 server migrate up", "pending", len(pending))
+	}
+
+	version, err := db.Version(ctx)
+	if err != nil {
+		slog.Error("读取数据库版本失败", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("已连接数据库", "driver", db.Driver(), "schema_version", version)
+	return db
+}
@@ -16,7 +16,11 @@
 //	  - application/json
 //
 //	Produces:
+//	  - text/csv
 //	  - application/json
+//	  - application/x-ndjson
+//	  - text/markdown
+//	  - text/event-stream
 //
 // swagger:meta
 package restapi
@@ -43,9 +43,37 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
+    "/admin/keys": {
+      "get": {
+        "description": "获取所有API key及其用量，不返回key明文和摘要",
+        "tags": [
+          "Admin"
+        ],
+        "summary": "获取API key列表",
+        "operationId": "listApiKeys",
+        "parameters": [
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取API key列表",
+            "schema": {
+              "$ref": "#/definitions/APIKeyListResponse"
+            }
+          }
+        },
+        "x-scope": "admin"
+      }
+    },
     "/health": {
       "get": {
-        "description": "检查服务是否正常运行",
+        "security": [],
+        "description": "检查服务是否正常运行，与/health/live相同，保留用于兼容",
         "tags": [
           "System"
         ],
@@ -61,47 +89,135 @@ func init() {
         }
       }
     },
-    "/mod/affix/list": {
+    "/health/live": {
       "get": {
-        "description": "获取所有可用的模组词条",
+        "security": [],
+        "description": "进程能处理请求时返回200，不检查依赖的组件，用于判断是否需要重启",
         "tags": [
-          "Mod"
+          "System"
         ],
-        "summary": "获取词条列表",
-        "operationId": "listAffixes",
+        "summary": "存活检查",
+        "operationId": "livenessCheck",
         "responses": {
           "200": {
-            "description": "成功获取词条列表",
+            "description": "服务存活",
             "schema": {
-              "$ref": "#/definitions/AffixListResponse"
+              "$ref": "#/definitions/HealthResponse"
             }
           }
         }
       }
     },
-    "/mod/affix/probability": {
-      "post": {
-        "description": "计算指定词条组合出现的概率",
+    "/health/ready": {
+      "get": {
+        "security": [],
+        "description": "检查目录是否已加载、缓存和数据库是否可用以及服务是否正在停止，全部正常时返回200，用于判断是否转发流量",
         "tags": [
-          "Mod"
+          "System"
         ],
-        "summary": "计算模组词条概率",
-        "operationId": "calculateAffixProbability",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "就绪检查",
+        "operationId": "readinessCheck",
+        "responses": {
+          "200": {
+            "description": "服务就绪",
             "schema": {
-              "$ref": "#/definitions/AffixProbabilityRequest"
+              "$ref": "#/definitions/HealthResponse"
+            }
+          },
+          "503": {
+            "description": "服务未就绪，components中为各组件的状态",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
             }
           }
+        }
+      }
+    },
+    "/inventory/analysis": {
+      "get": {
+        "description": "按强化规则计算背包中每个模组用剩余强化次数达到目标等级的概率和目标词条的期望提升，\n按达成概率从高到低排列，并给出建议分解的模组：无法达到目标的模组，以及同类型中概率和期望提升都不如另一个模组的模组。\n",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "分析背包中的模组",
+        "operationId": "analyzeInventory",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "maxItems": 10,
+            "minItems": 1,
+            "type": "array",
+            "items": {
+              "minimum": 1,
+              "type": "integer",
+              "format": "int32"
+            },
+            "collectionFormat": "csv",
+            "description": "目标等级，如5/5/x/x填5,5",
+            "name": "targetLevels",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int32"
+            },
+            "collectionFormat": "csv",
+            "description": "只有这些词条计入目标和期望提升，与targets合并；都不填表示全部词条",
+            "name": "affixIds",
+            "in": "query"
+          },
+          {
+            "maxLength": 200,
+            "type": "string",
+            "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e，与affixIds合并",
+            "name": "targets",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "true表示目标等级可以由任意词条达到，false表示按词条顺序对应",
+            "name": "orderIndependent",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "weapon",
+              "helmet",
+              "mask",
+              "top",
+              "gloves",
+              "bottoms",
+              "shoes"
+            ],
+            "type": "string",
+            "description": "只分析该类型的模组",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/GameVersion"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
         ],
         "responses": {
           "200": {
-            "description": "计算成功",
+            "description": "分析结果",
             "schema": {
-              "$ref": "#/definitions/AffixProbabilityResponse"
+              "$ref": "#/definitions/InventoryAnalysis"
             }
           },
           "400": {
@@ -109,33 +225,106 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
       }
     },
-    "/mod/strengthen/probability": {
+    "/inventory/mods": {
+      "get": {
+        "description": "获取玩家背包中的模组，最近更新的在前",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "获取背包中的模组",
+        "operationId": "listInventoryMods",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "enum": [
+              "weapon",
+              "helmet",
+              "mask",
+              "top",
+              "gloves",
+              "bottoms",
+              "shoes"
+            ],
+            "type": "string",
+            "description": "只返回该类型的模组",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取模组列表",
+            "schema": {
+              "$ref": "#/definitions/InventoryModListResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
       "post": {
-        "description": "计算模组词条强化到目标等级的概率",
+        "description": "把模组添加到玩家背包，词条和等级按最新游戏版本的稀有度规则校验",
         "tags": [
-          "Mod"
+          "Inventory"
         ],
-        "summary": "计算强化成功概率",
-        "operationId": "calculateStrengthenProbability",
+        "summary": "添加模组",
+        "operationId": "createInventoryMod",
         "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/StrengthenProbabilityRequest"
+              "$ref": "#/definitions/InventoryModInput"
             }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
           }
         ],
         "responses": {
-          "200": {
-            "description": "计算成功",
+          "201": {
+            "description": "已添加模组",
             "schema": {
-              "$ref": "#/definitions/StrengthenProbabilityResponse"
+              "$ref": "#/definitions/InventoryMod"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "模组的地址"
+              }
             }
           },
           "400": {
@@ -143,207 +332,6238 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "409": {
+            "description": "背包已满",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
       }
     },
-    "/tools": {
+    "/inventory/mods/{id}": {
       "get": {
-        "description": "获取所有可用的工具",
         "tags": [
-          "Tools"
+          "Inventory"
+        ],
+        "summary": "获取模组",
+        "operationId": "getInventoryMod",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/ModID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
         ],
-        "summary": "获取工具列表",
-        "operationId": "listTools",
         "responses": {
           "200": {
-            "description": "成功获取工具列表",
+            "description": "成功获取模组",
             "schema": {
-              "$ref": "#/definitions/ToolsListResponse"
+              "$ref": "#/definitions/InventoryMod"
+            }
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "Affix": {
-      "type": "object",
-      "required": [
-        "id",
-        "name"
-      ],
-      "properties": {
-        "category": {
-          "type": "string",
-          "example": "damage"
         },
-        "description": {
-          "type": "string",
-          "example": "提升异常状态伤害"
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "put": {
+        "description": "替换模组的全部字段，通常在强化后更新词条等级和已强化次数",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "更新模组",
+        "operationId": "updateInventoryMod",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/ModID"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InventoryModInput"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已更新模组",
+            "schema": {
+              "$ref": "#/definitions/InventoryMod"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "id": {
-          "type": "integer",
-          "format": "int32",
-          "example": 1
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "删除模组",
+        "operationId": "deleteInventoryMod",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/ModID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "已删除模组"
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "type": "string",
-          "example": "异常伤害"
-        }
+        "x-key-required": true,
+        "x-scope": "calculate"
       }
     },
-    "AffixListResponse": {
-      "type": "object",
-      "required": [
-        "affixes"
-      ],
-      "properties": {
+    "/jobs": {
+      "post": {
+        "description": "提交耗时较长的计算任务，立即返回任务ID，之后轮询任务状态并获取结果",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "提交异步计算任务",
+        "operationId": "submitJob",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/JobRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "任务已提交",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "任务状态的地址"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "任务队列已满或服务正在停止，稍后重试",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}": {
+      "get": {
+        "description": "获取任务状态和进度，任务结束后保留一段时间，过期后返回404",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "获取任务状态",
+        "operationId": "getJob",
+        "parameters": [
+          {
+            "$ref": "#/parameters/JobID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取任务状态",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "description": "取消排队中或执行中的任务，已结束的任务不受影响",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "取消任务",
+        "operationId": "cancelJob",
+        "parameters": [
+          {
+            "$ref": "#/parameters/JobID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已请求取消，返回当前任务状态，执行中的任务稍后变为canceled",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}/events": {
+      "get": {
+        "description": "以Server-Sent Events推送任务状态。每个事件的data为Job：\nprogress事件在任务开始执行、进度和概率估计变化时推送；\ncompleted事件在任务结束时推送一次，之后连接关闭，结果通过getJobResult获取。\n连接空闲时定期发送注释行保持连接。\n",
+        "produces": [
+          "text/event-stream",
+          "application/json"
+        ],
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "订阅任务进度",
+        "operationId": "streamJobEvents",
+        "parameters": [
+          {
+            "$ref": "#/parameters/JobID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "事件流，每个事件的data为Job",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}/result": {
+      "get": {
+        "description": "获取已完成任务的计算结果，结果按本次请求的语言输出。\nCSV、Markdown和JSON Lines与对应的同步计算接口格式相同\n",
+        "produces": [
+          "application/json",
+          "text/csv",
+          "text/markdown",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "获取任务结果",
+        "operationId": "getJobResult",
+        "parameters": [
+          {
+            "$ref": "#/parameters/JobID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          },
+          {
+            "$ref": "#/parameters/Format"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取任务结果",
+            "schema": {
+              "$ref": "#/definitions/JobResult"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "任务尚未完成、执行失败或已取消",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/list": {
+      "get": {
+        "description": "获取所有可用的模组词条",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取词条列表",
+        "operationId": "listAffixes",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "词条分类（damage、defense、utility），不填返回全部",
+            "name": "category",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/GameVersion"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取词条列表",
+            "schema": {
+              "$ref": "#/definitions/AffixListResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/affix/probability": {
+      "post": {
+        "description": "计算指定词条组合出现的概率",
+        "produces": [
+          "application/json",
+          "text/csv",
+          "text/markdown",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算模组词条概率",
+        "operationId": "calculateAffixProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AffixProbabilityRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          },
+          {
+            "$ref": "#/parameters/Format"
+          },
+          {
+            "$ref": "#/parameters/IfNoneMatch"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/AffixProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/search": {
+      "get": {
+        "description": "按中文名、拼音、拼音首字母或英文别名模糊搜索词条，可按分类过滤",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "搜索词条",
+        "operationId": "searchAffixes",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "搜索关键字，不填返回分类下的全部词条",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "词条分类（damage、defense、utility）",
+            "name": "category",
+            "in": "query"
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "搜索成功",
+            "schema": {
+              "$ref": "#/definitions/AffixSearchResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/affix/value/probability": {
+      "post": {
+        "description": "计算指定词条出现且数值达到阈值或档位的概率",
+        "produces": [
+          "application/json",
+          "text/csv",
+          "text/markdown",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算词条数值概率",
+        "operationId": "calculateAffixValueProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AffixValueProbabilityRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          },
+          {
+            "$ref": "#/parameters/Format"
+          },
+          {
+            "$ref": "#/parameters/IfNoneMatch"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/AffixValueProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/{id}": {
+      "get": {
+        "description": "根据ID获取词条",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取词条详情",
+        "operationId": "getAffix",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/GameVersion"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取词条",
+            "schema": {
+              "$ref": "#/definitions/Affix"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "词条不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/rarity/list": {
+      "get": {
+        "description": "获取所有模组稀有度及其词条数量、词条池、等级和掉落权重",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取稀有度列表",
+        "operationId": "listRarities",
+        "parameters": [
+          {
+            "$ref": "#/parameters/GameVersion"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取稀有度列表",
+            "schema": {
+              "$ref": "#/definitions/RarityListResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/strengthen/probability": {
+      "post": {
+        "description": "计算模组词条强化到目标等级的概率",
+        "produces": [
+          "application/json",
+          "text/csv",
+          "text/markdown",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算强化成功概率",
+        "operationId": "calculateStrengthenProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/StrengthenProbabilityRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          },
+          {
+            "$ref": "#/parameters/Format"
+          },
+          {
+            "$ref": "#/parameters/IfNoneMatch"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/StrengthenProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/versions": {
+      "get": {
+        "description": "获取所有游戏版本及其相对上一版本的词条、数值、掉落权重和强化规则变动",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取游戏版本列表",
+        "operationId": "listGameVersions",
+        "parameters": [
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取游戏版本列表",
+            "schema": {
+              "$ref": "#/definitions/GameVersionListResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/versions/diff": {
+      "get": {
+        "description": "比较任意两个游戏版本之间的词条、数值、掉落权重和强化规则变动",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "比较游戏版本",
+        "operationId": "diffGameVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "起始游戏版本ID",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "目标游戏版本ID，不填或latest表示最新版本",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "比较成功",
+            "schema": {
+              "$ref": "#/definitions/GameVersionDiffResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/presets": {
+      "get": {
+        "description": "获取内置预设、玩家保存的预设和X-Guild-ID指定的服务器保存的预设。\n需要目标词条的地方可以用preset:\u003c名称\u003e引用预设，查找顺序为玩家、服务器、内置。未启用数据库时只有内置预设。\n",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "获取目标词条预设",
+        "operationId": "listPresets",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取预设列表",
+            "schema": {
+              "$ref": "#/definitions/PresetListResponse"
+            }
+          },
+          "503": {
+            "description": "数据库不可用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/presets/{scope}/{name}": {
+      "put": {
+        "description": "创建或更新玩家或服务器的预设，与内置预设同名时优先使用保存的预设。\n需要API key；服务器预设还需要key可以管理X-Guild-ID指定的服务器（创建key时的-guilds，admin权限可以管理全部服务器），否则返回403 guild_not_allowed。\n",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "保存预设",
+        "operationId": "savePreset",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PresetScope"
+          },
+          {
+            "$ref": "#/parameters/PresetName"
+          },
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PresetInput"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已保存预设",
+            "schema": {
+              "$ref": "#/definitions/Preset"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "API key不能管理该服务器的预设",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "description": "需要API key，服务器预设的权限要求与保存时相同",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "删除预设",
+        "operationId": "deletePreset",
+        "parameters": [
+          {
+            "$ref": "#/parameters/PresetScope"
+          },
+          {
+            "$ref": "#/parameters/PresetName"
+          },
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "已删除预设"
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "API key不能管理该服务器的预设",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "预设不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      }
+    },
+    "/scripts/run": {
+      "post": {
+        "description": "在沙箱中执行Starlark脚本并调用main(input)，返回值编码为JSON作为结果。\n脚本可以使用math、json、choose、combinations、Random，以及访问游戏版本目录的affix和strengthen模块；\n不能加载其他文件或访问文件、网络。执行步数超过SCRIPTS_MAX_STEPS时返回422和script_step_limit，\n执行时间超过SCRIPTS_TIMEOUT时返回422和script_timeout，字符串、列表等分配的内存超过SCRIPTS_MAX_ALLOC_MB时返回422和script_memory_limit；\n语法错误和运行时错误（包括内置函数的元素个数超过上限）返回400和script_error，消息带出错位置。\n",
+        "tags": [
+          "Scripts"
+        ],
+        "summary": "运行Starlark脚本",
+        "operationId": "runScript",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ScriptRunRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "执行成功",
+            "schema": {
+              "$ref": "#/definitions/ScriptRunResponse"
+            }
+          },
+          "400": {
+            "description": "脚本出错或游戏版本不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "脚本超过执行步数、时间或内存上限",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/share": {
+      "post": {
+        "description": "保存规范化的计算请求并返回稳定的短ID，网页中通过 /s/{id} 打开。\n未指定游戏版本时固定为当前最新版本，相同的计算得到相同的ID。请求参数无效时不保存。\n",
+        "tags": [
+          "Share"
+        ],
+        "summary": "创建计算分享",
+        "operationId": "createShare",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShareRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/PlayerID"
+          },
+          {
+            "$ref": "#/parameters/GuildID"
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "相同的计算已经分享过，返回已有的分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            }
+          },
+          "201": {
+            "description": "已创建分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "分享的地址"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/share/{id}": {
+      "get": {
+        "description": "获取分享的计算请求，并按分享时的游戏版本重新计算结果",
+        "tags": [
+          "Share"
+        ],
+        "summary": "获取计算分享",
+        "operationId": "getShare",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "分享ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            }
+          },
+          "400": {
+            "description": "分享时的游戏版本已不可用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "分享不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/tools": {
+      "get": {
+        "description": "获取工具注册表中启用的工具，TOOLS_ENABLED和TOOLS_DISABLED配置可以启用或隐藏工具",
+        "tags": [
+          "Tools"
+        ],
+        "summary": "获取工具列表",
+        "operationId": "listTools",
+        "parameters": [
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取工具列表",
+            "schema": {
+              "$ref": "#/definitions/ToolsListResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/tools/{id}/run": {
+      "post": {
+        "description": "按工具的inputSchema校验输入后运行工具，内置工具的输入为对应计算接口的请求体。\n插件工具在独立进程中运行，超过PLUGINS_TIMEOUT时返回504，插件进程退出后自动重新启动。\n脚本工具超过执行步数、时间或内存上限时返回422。\n",
+        "tags": [
+          "Tools"
+        ],
+        "summary": "运行工具",
+        "operationId": "runTool",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ToolID"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "工具输入，符合工具的inputSchema",
+              "type": "object"
+            }
+          },
+          {
+            "$ref": "#/parameters/Lang"
+          },
+          {
+            "$ref": "#/parameters/AcceptLanguage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "运行成功",
+            "schema": {
+              "$ref": "#/definitions/ToolRunResponse"
+            }
+          },
+          "400": {
+            "description": "输入不符合工具的inputSchema或计算参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "工具不存在或未启用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "脚本工具超过执行步数、时间或内存上限",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "502": {
+            "description": "插件运行失败",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "插件正在重新启动或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "504": {
+            "description": "插件运行超时",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "scopes",
+        "dailyQuota",
+        "createdAt",
+        "usage"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "dailyQuota": {
+          "description": "每日请求数上限（UTC自然日），0表示不限",
+          "type": "integer",
+          "format": "int32"
+        },
+        "guilds": {
+          "description": "可以修改服务器预设的服务器ID，*表示全部服务器",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "key ID",
+          "type": "string",
+          "example": "3f9a1c0b"
+        },
+        "name": {
+          "description": "key名称",
+          "type": "string",
+          "example": "discord-bot"
+        },
+        "revokedAt": {
+          "description": "吊销时间，未吊销时为空",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scopes": {
+          "description": "权限范围，calculate包含catalog，admin包含全部权限",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "catalog",
+              "calculate",
+              "admin"
+            ]
+          }
+        },
+        "usage": {
+          "$ref": "#/definitions/APIKeyUsage"
+        }
+      }
+    },
+    "APIKeyListResponse": {
+      "type": "object",
+      "required": [
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/APIKey"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "APIKeyUsage": {
+      "type": "object",
+      "required": [
+        "today",
+        "total"
+      ],
+      "properties": {
+        "lastUsedAt": {
+          "description": "最后使用时间",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "today": {
+          "description": "当天（UTC）的请求数",
+          "type": "integer",
+          "format": "int32"
+        },
+        "total": {
+          "description": "累计请求数",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Affix": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "aliases": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Status DMG",
+            "Status Damage"
+          ]
+        },
+        "category": {
+          "type": "string",
+          "example": "damage"
+        },
+        "description": {
+          "type": "string",
+          "example": "提升异常状态伤害"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "name": {
+          "type": "string",
+          "example": "异常伤害"
+        },
+        "pinyin": {
+          "type": "string",
+          "example": "yi chang shang hai"
+        }
+      }
+    },
+    "AffixListResponse": {
+      "type": "object",
+      "required": [
+        "affixes"
+      ],
+      "properties": {
+        "affixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Affix"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32",
+          "example": 10
+        }
+      }
+    },
+    "AffixProbabilityRequest": {
+      "description": "targetAffixIds和targets至少提供一个，两者的词条合并计算",
+      "type": "object",
+      "properties": {
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
+        },
+        "rarity": {
+          "description": "稀有度ID，any表示按掉落权重积分所有稀有度",
+          "type": "string",
+          "example": "gold"
+        },
+        "showCombinations": {
+          "type": "boolean",
+          "default": false
+        },
+        "slotCount": {
+          "description": "词条数量，指定稀有度时忽略",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1,
+          "example": 3
+        },
+        "targetAffixIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            4,
+            5,
+            6
+          ]
+        },
+        "targets": {
+          "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e",
+          "type": "string",
+          "maxLength": 200,
+          "example": "preset:elite-dps, 1"
+        }
+      }
+    },
+    "AffixProbabilityResponse": {
+      "type": "object",
+      "required": [
+        "probability",
+        "probabilityPercent",
+        "totalCombinations",
+        "validCombinations"
+      ],
+      "properties": {
+        "combinations": {
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int32"
+            }
+          },
+          "example": [
+            [
+              1,
+              4,
+              5
+            ],
+            [
+              1,
+              4,
+              6
+            ],
+            [
+              1,
+              5,
+              6
+            ],
+            [
+              4,
+              5,
+              6
+            ]
+          ]
+        },
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0333
+        },
+        "probabilityPercent": {
+          "type": "number",
+          "format": "double",
+          "example": 3.33
+        },
+        "rarity": {
+          "type": "string",
+          "example": "any"
+        },
+        "rarityBreakdown": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RarityProbability"
+          }
+        },
+        "slotCount": {
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        },
+        "targetRange": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            4,
+            5,
+            6
+          ]
+        },
+        "totalCombinations": {
+          "type": "integer",
+          "format": "int64",
+          "example": 120
+        },
+        "validCombinations": {
+          "type": "integer",
+          "format": "int64",
+          "example": 4
+        }
+      }
+    },
+    "AffixSearchResponse": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AffixSearchResult"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        }
+      }
+    },
+    "AffixSearchResult": {
+      "type": "object",
+      "required": [
+        "affix"
+      ],
+      "properties": {
+        "affix": {
+          "$ref": "#/definitions/Affix"
+        },
+        "matchedBy": {
+          "description": "匹配来源（id、name、pinyin、initials、alias）",
+          "type": "string",
+          "example": "initials"
+        },
+        "score": {
+          "description": "匹配得分，越高越精确",
+          "type": "integer",
+          "format": "int32",
+          "example": 85
+        }
+      }
+    },
+    "AffixValueDetail": {
+      "type": "object",
+      "properties": {
+        "affixId": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "max": {
+          "type": "number",
+          "format": "double",
+          "example": 10
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "example": 4
+        },
+        "minTier": {
+          "type": "integer",
+          "format": "int32",
+          "example": 0
+        },
+        "minValue": {
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.15
+        },
+        "tiers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AffixValueTier"
+          }
+        },
+        "unit": {
+          "type": "string",
+          "example": "%"
+        }
+      }
+    },
+    "AffixValueProbabilityRequest": {
+      "type": "object",
+      "required": [
+        "requirements"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
+        },
+        "level": {
+          "description": "词条等级，上限取决于游戏版本",
+          "type": "integer",
+          "format": "int32",
+          "default": 1,
+          "minimum": 1,
+          "example": 1
+        },
+        "requirements": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/AffixValueRequirement"
+          }
+        },
+        "slotCount": {
+          "description": "词条数量，0表示只计算数值概率",
+          "type": "integer",
+          "format": "int32",
+          "example": 4
+        }
+      }
+    },
+    "AffixValueProbabilityResponse": {
+      "type": "object",
+      "required": [
+        "probability",
+        "probabilityPercent"
+      ],
+      "properties": {
+        "appearProbability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.1333
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AffixValueDetail"
+          }
+        },
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "level": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0033
+        },
+        "probabilityPercent": {
+          "type": "number",
+          "format": "double",
+          "example": 0.33
+        },
+        "slotCount": {
+          "type": "integer",
+          "format": "int32",
+          "example": 4
+        },
+        "valueProbability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0225
+        }
+      }
+    },
+    "AffixValueRequirement": {
+      "type": "object",
+      "required": [
+        "affixId"
+      ],
+      "properties": {
+        "affixId": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "minTier": {
+          "description": "档位下限，不填表示不限制",
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        },
+        "minValue": {
+          "description": "数值下限，不填表示不限制",
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "topTier": {
+          "description": "是否要求最高档位，优先于minTier",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "AffixValueTier": {
+      "type": "object",
+      "properties": {
+        "max": {
+          "type": "number",
+          "format": "double",
+          "example": 10
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "tier": {
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        },
+        "weight": {
+          "type": "number",
+          "format": "double",
+          "example": 0.15
+        }
+      }
+    },
+    "BuildInfo": {
+      "type": "object",
+      "required": [
+        "version",
+        "goVersion"
+      ],
+      "properties": {
+        "buildTime": {
+          "description": "构建时间，未注入时为提交时间",
+          "type": "string",
+          "example": "2026-10-19T12:00:00Z"
+        },
+        "commit": {
+          "description": "提交哈希",
+          "type": "string",
+          "example": "abdae36c1f0e"
+        },
+        "goVersion": {
+          "type": "string",
+          "example": "go1.24.0"
+        },
+        "modified": {
+          "description": "构建时工作区是否有未提交的修改",
+          "type": "boolean"
+        },
+        "version": {
+          "description": "版本号，构建时未注入且无法从模块信息读取时为dev",
+          "type": "string",
+          "example": "v1.2.0"
+        }
+      }
+    },
+    "CatalogChange": {
+      "type": "object",
+      "required": [
+        "target",
+        "id",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "changed"
+          ]
+        },
+        "field": {
+          "type": "string",
+          "example": "dropWeight"
+        },
+        "id": {
+          "description": "词条ID、稀有度ID或词条等级",
+          "type": "string",
+          "example": "5"
+        },
+        "new": {
+          "type": "string",
+          "example": "0.12"
+        },
+        "old": {
+          "type": "string",
+          "example": "0.1"
+        },
+        "target": {
+          "description": "变动对象",
+          "type": "string",
+          "enum": [
+            "affix",
+            "rarity",
+            "affixValue",
+            "levelScale"
+          ]
+        }
+      }
+    },
+    "ErrorDetails": {
+      "type": "object",
+      "properties": {
+        "allowed": {
+          "description": "允许的取值",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "field": {
+          "description": "出错的请求字段",
+          "type": "string",
+          "example": "slotCount"
+        },
+        "max": {
+          "description": "允许的最大值",
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true,
+          "example": 10
+        },
+        "min": {
+          "description": "允许的最小值",
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true,
+          "example": 1
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "错误响应，请求头Accept为application/problem+json时按RFC 7807格式返回ProblemDetails",
+      "type": "object",
+      "required": [
+        "error",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "机器可读的错误码，客户端应按错误码处理错误",
+          "type": "string",
+          "enum": [
+            "out_of_range",
+            "invalid_count",
+            "required",
+            "duplicate",
+            "no_valid_targets",
+            "unknown_rarity",
+            "unknown_game_version",
+            "unknown_category",
+            "rarity_required",
+            "target_below_initial",
+            "affix_not_found",
+            "affix_ambiguous",
+            "rate_limited",
+            "job_not_found",
+            "job_queue_full",
+            "job_not_finished",
+            "job_failed",
+            "job_canceled",
+            "job_timeout",
+            "api_key_required",
+            "invalid_api_key",
+            "insufficient_scope",
+            "quota_exceeded",
+            "shutting_down",
+            "share_not_found",
+            "storage_unavailable",
+            "mod_not_found",
+            "inventory_full",
+            "affix_not_allowed",
+            "preset_not_found",
+            "invalid_preset_name",
+            "tool_not_found",
+            "invalid_input",
+            "tool_failed",
+            "tool_timeout",
+            "script_error",
+            "script_step_limit",
+            "script_timeout",
+            "script_memory_limit",
+            "invalid_value",
+            "not_found",
+            "method_not_allowed",
+            "unsupported_media_type",
+            "not_acceptable",
+            "guild_not_allowed"
+          ],
+          "example": "out_of_range"
+        },
+        "details": {
+          "$ref": "#/definitions/ErrorDetails"
+        },
+        "error": {
+          "description": "错误类别，与HTTP状态码对应",
+          "type": "string",
+          "example": "bad_request"
+        },
+        "message": {
+          "description": "按请求语言本地化的错误消息",
+          "type": "string",
+          "example": "词条数量必须在1-10之间"
+        }
+      }
+    },
+    "GameVersion": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "changes": {
+          "description": "相对上一版本的变动，首个版本为空",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CatalogChange"
+          }
+        },
+        "id": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "latest": {
+          "description": "是否为最新版本",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string",
+          "example": "正式上线"
+        },
+        "notes": {
+          "type": "string"
+        },
+        "releaseDate": {
+          "type": "string",
+          "example": "2024-07-09"
+        }
+      }
+    },
+    "GameVersionDiffResponse": {
+      "type": "object",
+      "required": [
+        "from",
+        "to",
+        "changes"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CatalogChange"
+          }
+        },
+        "from": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "to": {
+          "type": "string",
+          "example": "1.0"
+        }
+      }
+    },
+    "GameVersionListResponse": {
+      "type": "object",
+      "required": [
+        "versions",
+        "latest"
+      ],
+      "properties": {
+        "latest": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GameVersion"
+          }
+        }
+      }
+    },
+    "HealthComponent": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "error": {
+          "description": "不可用的原因",
+          "type": "string"
+        },
+        "latencyMs": {
+          "description": "检查耗时（毫秒）",
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "description": "组件名称",
+          "type": "string",
+          "example": "cache"
+        },
+        "status": {
+          "description": "ok表示正常，fail表示不可用，disabled表示未启用（不影响就绪状态）",
+          "type": "string",
+          "enum": [
+            "ok",
+            "fail",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "HealthResponse": {
+      "type": "object",
+      "required": [
+        "status",
+        "timestamp"
+      ],
+      "properties": {
+        "build": {
+          "$ref": "#/definitions/BuildInfo"
+        },
+        "components": {
+          "description": "各组件的检查结果，只在就绪检查中返回",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HealthComponent"
+          },
+          "x-omitempty": true
+        },
+        "status": {
+          "description": "ok表示正常，unavailable表示有组件不可用，draining表示服务正在停止",
+          "type": "string",
+          "enum": [
+            "ok",
+            "unavailable",
+            "draining"
+          ],
+          "example": "ok"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "version": {
+          "description": "服务版本，与build.version相同",
+          "type": "string",
+          "example": "v1.2.0"
+        }
+      }
+    },
+    "InventoryAnalysis": {
+      "type": "object",
+      "required": [
+        "gameVersion",
+        "targetLevels",
+        "orderIndependent",
+        "mods",
+        "scrap"
+      ],
+      "properties": {
+        "affixIds": {
+          "description": "计入目标的词条，包括targets解析出的词条",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        },
+        "gameVersion": {
+          "type": "string"
+        },
+        "mods": {
+          "description": "按达成概率从高到低排列，概率相同时期望提升高的在前",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryModOutlook"
+          }
+        },
+        "orderIndependent": {
+          "type": "boolean"
+        },
+        "scrap": {
+          "description": "建议分解的模组",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ScrapSuggestion"
+          }
+        },
+        "targetLevels": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        }
+      }
+    },
+    "InventoryMod": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "rarity",
+        "affixes",
+        "enhancementsUsed",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "affixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryModAffix"
+          }
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "enhancementsUsed": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "id": {
+          "type": "string",
+          "example": "3f9c2a1b7d4e6f80"
+        },
+        "name": {
+          "type": "string",
+          "example": "暴击头"
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "weapon",
+            "helmet",
+            "mask",
+            "top",
+            "gloves",
+            "bottoms",
+            "shoes"
+          ],
+          "example": "helmet"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "InventoryModAffix": {
+      "type": "object",
+      "required": [
+        "affixId",
+        "level"
+      ],
+      "properties": {
+        "affixId": {
+          "description": "词条ID",
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "level": {
+          "description": "当前等级",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1,
+          "example": 3
+        }
+      }
+    },
+    "InventoryModInput": {
+      "type": "object",
+      "required": [
+        "type",
+        "rarity",
+        "affixes"
+      ],
+      "properties": {
+        "affixes": {
+          "description": "词条和当前等级，数量与稀有度的词条数量一致",
+          "type": "array",
+          "maxItems": 10,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/InventoryModAffix"
+          }
+        },
+        "enhancementsUsed": {
+          "description": "已使用的强化次数",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "name": {
+          "description": "备注名",
+          "type": "string",
+          "maxLength": 64,
+          "example": "暴击头"
+        },
+        "rarity": {
+          "description": "稀有度ID",
+          "type": "string",
+          "example": "gold"
+        },
+        "type": {
+          "description": "模组类型（装备部位）",
+          "type": "string",
+          "enum": [
+            "weapon",
+            "helmet",
+            "mask",
+            "top",
+            "gloves",
+            "bottoms",
+            "shoes"
+          ],
+          "example": "helmet"
+        }
+      }
+    },
+    "InventoryModListResponse": {
+      "type": "object",
+      "required": [
+        "mods",
+        "total"
+      ],
+      "properties": {
+        "mods": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryMod"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "InventoryModOutlook": {
+      "description": "模组用剩余强化次数强化的结果",
+      "type": "object",
+      "required": [
+        "mod",
+        "enhancementsLeft",
+        "probability",
+        "probabilityPercent",
+        "expectedLevels",
+        "expectedGain"
+      ],
+      "properties": {
+        "enhancementsLeft": {
+          "description": "剩余强化次数",
+          "type": "integer",
+          "format": "int32"
+        },
+        "expectedGain": {
+          "description": "计入目标的词条期望提升的等级之和",
+          "type": "number",
+          "format": "double"
+        },
+        "expectedLevels": {
+          "description": "各词条强化后的期望等级，与mod.affixes顺序一致",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "mod": {
+          "$ref": "#/definitions/InventoryMod"
+        },
+        "probability": {
+          "description": "达到目标等级的概率",
+          "type": "number",
+          "format": "double"
+        },
+        "probabilityPercent": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "Job": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "status",
+        "progress",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "estimate": {
+          "description": "根据已完成部分得到的概率估计，随计算进行逐步收敛，只有部分计算类型提供",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true,
+          "example": 0.0312
+        },
+        "expiresAt": {
+          "description": "任务结束后结果的过期时间",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "finishedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "example": "3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d"
+        },
+        "progress": {
+          "description": "任务进度，0到1",
+          "type": "number",
+          "format": "double",
+          "example": 0.42
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "任务状态",
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "succeeded",
+            "failed",
+            "canceled"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "JobItem": {
+      "description": "批量任务中的一个计算，按type填写对应的计算参数",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability"
+          ],
+          "example": "affixProbability"
+        }
+      }
+    },
+    "JobItemResult": {
+      "description": "批量任务中一个计算的结果，计算失败时只有error，不影响其余计算",
+      "type": "object",
+      "required": [
+        "index",
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "index": {
+          "description": "在请求items中的位置，从0开始",
+          "type": "integer",
+          "format": "int32",
+          "example": 0
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "affixProbability"
+        }
+      }
+    },
+    "JobRequest": {
+      "description": "异步计算任务，按type填写对应的计算参数；batch在一个任务中依次计算items中的多个计算",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
+        },
+        "items": {
+          "description": "type为batch时的计算列表，结果按相同顺序返回",
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "$ref": "#/definitions/JobItem"
+          }
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability",
+            "batch"
+          ],
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "JobResult": {
+      "description": "任务结果，只包含与任务类型对应的字段",
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "id": {
+          "type": "string",
+          "example": "3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d"
+        },
+        "items": {
+          "description": "批量任务每个计算的结果，与请求的items顺序相同",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JobItemResult"
+          },
+          "x-omitempty": true
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "Preset": {
+      "type": "object",
+      "required": [
+        "name",
+        "scope",
+        "targetAffixIds",
+        "affixNames"
+      ],
+      "properties": {
+        "affixNames": {
+          "description": "目标词条的本地化名称，与targetAffixIds一一对应",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "createdAt": {
+          "description": "保存的时间，内置预设没有",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "预设名称，以preset:\u003c名称\u003e引用",
+          "type": "string",
+          "example": "elite-dps"
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "builtin",
+            "user",
+            "guild"
+          ]
+        },
+        "targetAffixIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        },
+        "title": {
+          "description": "内置预设的本地化名称",
+          "type": "string",
+          "example": "精英输出"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "PresetInput": {
+      "description": "targetAffixIds和targets至少提供一个，两者的词条合并保存",
+      "type": "object",
+      "properties": {
+        "targetAffixIds": {
+          "type": "array",
+          "maxItems": 10,
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            4,
+            5,
+            6
+          ]
+        },
+        "targets": {
+          "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e",
+          "type": "string",
+          "maxLength": 200,
+          "example": "暴击伤害, 4"
+        }
+      }
+    },
+    "PresetListResponse": {
+      "type": "object",
+      "required": [
+        "presets",
+        "total"
+      ],
+      "properties": {
+        "presets": {
+          "description": "依次为玩家、服务器和内置预设，同一范围内按名称排序",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Preset"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "ProblemDetails": {
+      "description": "RFC 7807 问题详情",
+      "type": "object",
+      "required": [
+        "type",
+        "title",
+        "status"
+      ],
+      "properties": {
+        "allowed": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "code": {
+          "type": "string",
+          "example": "out_of_range"
+        },
+        "detail": {
+          "type": "string",
+          "example": "词条数量必须在1-10之间"
+        },
+        "field": {
+          "type": "string",
+          "example": "slotCount"
+        },
+        "instance": {
+          "type": "string",
+          "example": "/api/v1/mod/affix/probability"
+        },
+        "max": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "min": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "integer",
+          "format": "int32",
+          "example": 400
+        },
+        "title": {
+          "type": "string",
+          "example": "bad_request"
+        },
+        "type": {
+          "type": "string",
+          "example": "https://oncehuman.tools/problems/out_of_range"
+        }
+      }
+    },
+    "Rarity": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "affixPool": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10
+          ]
+        },
+        "dropWeight": {
+          "type": "number",
+          "format": "double",
+          "example": 0.1
+        },
+        "id": {
+          "type": "string",
+          "example": "gold"
+        },
+        "maxEnhancements": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "maxLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "maxStartLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 2
+        },
+        "minStartLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "name": {
+          "type": "string",
+          "example": "金色"
+        },
+        "slotCount": {
+          "type": "integer",
+          "format": "int32",
+          "example": 4
+        }
+      }
+    },
+    "RarityListResponse": {
+      "type": "object",
+      "required": [
+        "rarities"
+      ],
+      "properties": {
+        "rarities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Rarity"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        }
+      }
+    },
+    "RarityProbability": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "金色"
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0048
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "slotCount": {
+          "type": "integer",
+          "format": "int32",
+          "example": 4
+        },
+        "totalCombinations": {
+          "description": "词条概率为该稀有度的组合总数；强化概率为各掉落等级的强化结果数之和",
+          "type": "integer",
+          "format": "int64",
+          "example": 210
+        },
+        "validCombinations": {
+          "description": "其中满足目标的组合数或强化结果数",
+          "type": "integer",
+          "format": "int64",
+          "example": 1
+        },
+        "weight": {
+          "description": "归一化后的掉落权重",
+          "type": "number",
+          "format": "double",
+          "example": 0.1
+        }
+      }
+    },
+    "ScrapSuggestion": {
+      "type": "object",
+      "required": [
+        "modId",
+        "reason"
+      ],
+      "properties": {
+        "betterModId": {
+          "description": "reason为dominated时更好的模组",
+          "type": "string"
+        },
+        "modId": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "unreachable表示无法达到目标，dominated表示同类型中有概率和期望提升都不低于它的模组",
+          "type": "string",
+          "enum": [
+            "unreachable",
+            "dominated"
+          ]
+        }
+      }
+    },
+    "ScriptRunRequest": {
+      "type": "object",
+      "required": [
+        "source"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "脚本中affix和strengthen使用的游戏版本，省略或latest时为最新版本",
+          "type": "string",
+          "example": "latest"
+        },
+        "input": {
+          "description": "传给main的参数，省略时为空字典",
+          "type": "object",
+          "example": {
+            "k": 4
+          }
+        },
+        "source": {
+          "description": "Starlark脚本源码，需要定义main(input)函数",
+          "type": "string",
+          "maxLength": 65536,
+          "minLength": 1,
+          "example": "def main(input):\n    return choose(10, input[\"k\"])\n"
+        }
+      }
+    },
+    "ScriptRunResponse": {
+      "type": "object",
+      "required": [
+        "result",
+        "steps",
+        "gameVersion"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "脚本实际使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "output": {
+          "description": "脚本print的输出，最多100行",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "result": {
+          "description": "main的返回值",
+          "x-nullable": true,
+          "example": 210
+        },
+        "steps": {
+          "description": "执行步数",
+          "type": "integer",
+          "format": "int64",
+          "example": 1024
+        }
+      }
+    },
+    "Share": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "gameVersion",
+        "path",
+        "request",
+        "result",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "gameVersion": {
+          "description": "分享时的游戏版本，结果按该版本的目录计算",
+          "type": "string",
+          "example": "1.0"
+        },
+        "id": {
+          "description": "分享ID，由规范化的请求生成",
+          "type": "string",
+          "example": "k3Vx9QaZ_b2M"
+        },
+        "path": {
+          "description": "网页中打开分享的路径",
+          "type": "string",
+          "example": "/s/k3Vx9QaZ_b2M"
+        },
+        "request": {
+          "$ref": "#/definitions/ShareRequest"
+        },
+        "result": {
+          "$ref": "#/definitions/ShareResult"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "ShareRequest": {
+      "description": "分享的计算请求，按type填写对应的计算参数",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability"
+          ],
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "ShareResult": {
+      "description": "计算结果，只包含与计算类型对应的字段",
+      "type": "object",
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
+        }
+      }
+    },
+    "StrengthenPath": {
+      "type": "object",
+      "properties": {
+        "finalLevels": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StrengthenStep"
+          }
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "StrengthenProbabilityRequest": {
+      "type": "object",
+      "required": [
+        "targetLevels"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
+        },
+        "initialLevels": {
+          "description": "初始等级，数量与稀有度的词条数量一致；指定稀有度时可不填，按掉落等级分布积分",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32",
+            "minimum": 1
+          },
+          "example": [
+            1,
+            2,
+            3,
+            1
+          ]
+        },
+        "orderIndependent": {
+          "description": "true表示顺序无关模式，false表示位置对应模式",
+          "type": "boolean",
+          "default": true
+        },
+        "rarity": {
+          "description": "稀有度ID，不填按金色规则计算，any表示按掉落权重积分所有稀有度",
+          "type": "string",
+          "example": "gold"
+        },
+        "showPaths": {
+          "type": "boolean",
+          "default": false
+        },
+        "table": {
+          "description": "同时返回强化概率表：单个词条从各初始等级强化到各目标等级的概率，导出CSV和Markdown时输出该表；只用于同步接口",
+          "type": "boolean",
+          "default": false
+        },
+        "targetLevels": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int32",
+            "minimum": 1
+          },
+          "example": [
+            3,
+            4,
+            5,
+            2
+          ]
+        }
+      }
+    },
+    "StrengthenProbabilityResponse": {
+      "type": "object",
+      "required": [
+        "probability",
+        "probabilityPercent",
+        "successfulOutcomes",
+        "totalOutcomes"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "paths": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StrengthenPath"
+          }
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.75
+        },
+        "probabilityPercent": {
+          "type": "number",
+          "format": "double",
+          "example": 75
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "rarityBreakdown": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RarityProbability"
+          }
+        },
+        "successfulOutcomes": {
+          "type": "integer",
+          "format": "int64",
+          "example": 768
+        },
+        "table": {
+          "$ref": "#/definitions/StrengthenTable"
+        },
+        "totalOutcomes": {
+          "type": "integer",
+          "format": "int64",
+          "example": 1024
+        }
+      }
+    },
+    "StrengthenStep": {
+      "type": "object",
+      "properties": {
+        "newLevel": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "slot": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "StrengthenTable": {
+      "description": "强化概率表，其他词条为掉落的最低等级，目标等级不高于初始等级时概率为1",
+      "type": "object",
+      "required": [
+        "rarity",
+        "levels",
+        "rows"
+      ],
+      "properties": {
+        "levels": {
+          "description": "目标等级，与每行的probabilities一一对应",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            2,
+            3,
+            4,
+            5
+          ]
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StrengthenTableRow"
+          }
+        }
+      }
+    },
+    "StrengthenTableRow": {
+      "type": "object",
+      "required": [
+        "initialLevel",
+        "probabilities"
+      ],
+      "properties": {
+        "initialLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "probabilities": {
+          "description": "用全部强化次数强化到不低于各目标等级的概率",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        }
+      }
+    },
+    "Tool": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "category"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "example": "mod"
+        },
+        "description": {
+          "type": "string",
+          "example": "计算特定词条组合出现的概率"
+        },
+        "icon": {
+          "type": "string",
+          "example": "dice"
+        },
+        "id": {
+          "type": "string",
+          "example": "affix-probability"
+        },
+        "inputSchema": {
+          "description": "工具输入的JSON Schema，内置工具为对应计算接口的请求体",
+          "type": "object"
+        },
+        "name": {
+          "type": "string",
+          "example": "模组词条概率计算器"
+        },
+        "source": {
+          "description": "工具来源，builtin为内置工具，plugin为插件目录中的外部插件，script为脚本目录中的Starlark脚本",
+          "type": "string",
+          "enum": [
+            "builtin",
+            "plugin",
+            "script"
+          ],
+          "example": "builtin"
+        }
+      }
+    },
+    "ToolRunResponse": {
+      "type": "object",
+      "required": [
+        "toolId",
+        "result"
+      ],
+      "properties": {
+        "result": {
+          "description": "工具的运行结果，内置工具为对应计算接口的响应体",
+          "type": "object"
+        },
+        "toolId": {
+          "type": "string",
+          "example": "affix-probability"
+        }
+      }
+    },
+    "ToolsListResponse": {
+      "type": "object",
+      "required": [
+        "tools"
+      ],
+      "properties": {
+        "categories": {
+          "description": "启用的工具所属的分类，按首次出现的顺序排列",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "mod"
+          ]
+        },
+        "tools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Tool"
+          }
+        }
+      }
+    }
+  },
+  "parameters": {
+    "AcceptLanguage": {
+      "type": "string",
+      "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+      "name": "Accept-Language",
+      "in": "header"
+    },
+    "Format": {
+      "enum": [
+        "json",
+        "csv",
+        "markdown",
+        "jsonl"
+      ],
+      "type": "string",
+      "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+      "name": "format",
+      "in": "query"
+    },
+    "GameVersion": {
+      "type": "string",
+      "description": "游戏版本ID，不填或latest表示最新版本",
+      "name": "gameVersion",
+      "in": "query"
+    },
+    "GuildID": {
+      "maxLength": 64,
+      "type": "string",
+      "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+      "name": "X-Guild-ID",
+      "in": "header"
+    },
+    "IfNoneMatch": {
+      "type": "string",
+      "description": "之前响应的ETag，结果未变化时返回304",
+      "name": "If-None-Match",
+      "in": "header"
+    },
+    "JobID": {
+      "type": "string",
+      "description": "任务ID，只有提交任务的API key可以访问",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "Lang": {
+      "type": "string",
+      "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+      "name": "lang",
+      "in": "query"
+    },
+    "ModID": {
+      "type": "string",
+      "description": "模组ID",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "PlayerID": {
+      "maxLength": 64,
+      "type": "string",
+      "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+      "name": "X-Player-ID",
+      "in": "header"
+    },
+    "PresetName": {
+      "type": "string",
+      "description": "预设名称，只能包含小写字母、数字和-",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "PresetScope": {
+      "enum": [
+        "user",
+        "guild"
+      ],
+      "type": "string",
+      "description": "预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设",
+      "name": "scope",
+      "in": "path",
+      "required": true
+    },
+    "ToolID": {
+      "type": "string",
+      "description": "工具ID",
+      "name": "id",
+      "in": "path",
+      "required": true
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "description": "API key，由管理命令apikey创建。\n未要求认证（AUTH_REQUIRED=false）时可以匿名访问；携带key时按key的权限范围（x-scope）和每日配额检查：\nkey无效返回401 invalid_api_key，权限不足返回403 insufficient_scope，当日配额用完返回429 quota_exceeded。\n管理接口（x-scope: admin）和保存个人数据的接口（x-key-required）始终需要key，未携带时返回401 api_key_required。\n",
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "apiKey": []
+    },
+    {}
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "提供OnceHuman游戏相关的计算工具API",
+    "title": "OnceHuman工具集API",
+    "contact": {
+      "name": "API Support",
+      "email": "support@oncehuman.tools"
+    },
+    "license": {
+      "name": "MIT"
+    },
+    "version": "1.0.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/keys": {
+      "get": {
+        "description": "获取所有API key及其用量，不返回key明文和摘要",
+        "tags": [
+          "Admin"
+        ],
+        "summary": "获取API key列表",
+        "operationId": "listApiKeys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取API key列表",
+            "schema": {
+              "$ref": "#/definitions/APIKeyListResponse"
+            }
+          }
+        },
+        "x-scope": "admin"
+      }
+    },
+    "/health": {
+      "get": {
+        "security": [],
+        "description": "检查服务是否正常运行，与/health/live相同，保留用于兼容",
+        "tags": [
+          "System"
+        ],
+        "summary": "健康检查",
+        "operationId": "healthCheck",
+        "responses": {
+          "200": {
+            "description": "服务正常",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          }
+        }
+      }
+    },
+    "/health/live": {
+      "get": {
+        "security": [],
+        "description": "进程能处理请求时返回200，不检查依赖的组件，用于判断是否需要重启",
+        "tags": [
+          "System"
+        ],
+        "summary": "存活检查",
+        "operationId": "livenessCheck",
+        "responses": {
+          "200": {
+            "description": "服务存活",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          }
+        }
+      }
+    },
+    "/health/ready": {
+      "get": {
+        "security": [],
+        "description": "检查目录是否已加载、缓存和数据库是否可用以及服务是否正在停止，全部正常时返回200，用于判断是否转发流量",
+        "tags": [
+          "System"
+        ],
+        "summary": "就绪检查",
+        "operationId": "readinessCheck",
+        "responses": {
+          "200": {
+            "description": "服务就绪",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          },
+          "503": {
+            "description": "服务未就绪，components中为各组件的状态",
+            "schema": {
+              "$ref": "#/definitions/HealthResponse"
+            }
+          }
+        }
+      }
+    },
+    "/inventory/analysis": {
+      "get": {
+        "description": "按强化规则计算背包中每个模组用剩余强化次数达到目标等级的概率和目标词条的期望提升，\n按达成概率从高到低排列，并给出建议分解的模组：无法达到目标的模组，以及同类型中概率和期望提升都不如另一个模组的模组。\n",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "分析背包中的模组",
+        "operationId": "analyzeInventory",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "maxItems": 10,
+            "minItems": 1,
+            "type": "array",
+            "items": {
+              "minimum": 1,
+              "type": "integer",
+              "format": "int32"
+            },
+            "collectionFormat": "csv",
+            "description": "目标等级，如5/5/x/x填5,5",
+            "name": "targetLevels",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int32"
+            },
+            "collectionFormat": "csv",
+            "description": "只有这些词条计入目标和期望提升，与targets合并；都不填表示全部词条",
+            "name": "affixIds",
+            "in": "query"
+          },
+          {
+            "maxLength": 200,
+            "type": "string",
+            "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e，与affixIds合并",
+            "name": "targets",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "true表示目标等级可以由任意词条达到，false表示按词条顺序对应",
+            "name": "orderIndependent",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "weapon",
+              "helmet",
+              "mask",
+              "top",
+              "gloves",
+              "bottoms",
+              "shoes"
+            ],
+            "type": "string",
+            "description": "只分析该类型的模组",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "游戏版本ID，不填或latest表示最新版本",
+            "name": "gameVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "分析结果",
+            "schema": {
+              "$ref": "#/definitions/InventoryAnalysis"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      }
+    },
+    "/inventory/mods": {
+      "get": {
+        "description": "获取玩家背包中的模组，最近更新的在前",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "获取背包中的模组",
+        "operationId": "listInventoryMods",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "enum": [
+              "weapon",
+              "helmet",
+              "mask",
+              "top",
+              "gloves",
+              "bottoms",
+              "shoes"
+            ],
+            "type": "string",
+            "description": "只返回该类型的模组",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取模组列表",
+            "schema": {
+              "$ref": "#/definitions/InventoryModListResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "post": {
+        "description": "把模组添加到玩家背包，词条和等级按最新游戏版本的稀有度规则校验",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "添加模组",
+        "operationId": "createInventoryMod",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InventoryModInput"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "已添加模组",
+            "schema": {
+              "$ref": "#/definitions/InventoryMod"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "模组的地址"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "背包已满",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      }
+    },
+    "/inventory/mods/{id}": {
+      "get": {
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "获取模组",
+        "operationId": "getInventoryMod",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "模组ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取模组",
+            "schema": {
+              "$ref": "#/definitions/InventoryMod"
+            }
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "put": {
+        "description": "替换模组的全部字段，通常在强化后更新词条等级和已强化次数",
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "更新模组",
+        "operationId": "updateInventoryMod",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "模组ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InventoryModInput"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已更新模组",
+            "schema": {
+              "$ref": "#/definitions/InventoryMod"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "tags": [
+          "Inventory"
+        ],
+        "summary": "删除模组",
+        "operationId": "deleteInventoryMod",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "模组ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "已删除模组"
+          },
+          "404": {
+            "description": "模组不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs": {
+      "post": {
+        "description": "提交耗时较长的计算任务，立即返回任务ID，之后轮询任务状态并获取结果",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "提交异步计算任务",
+        "operationId": "submitJob",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/JobRequest"
+            }
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "任务已提交",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "任务状态的地址"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "任务队列已满或服务正在停止，稍后重试",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}": {
+      "get": {
+        "description": "获取任务状态和进度，任务结束后保留一段时间，过期后返回404",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "获取任务状态",
+        "operationId": "getJob",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "任务ID，只有提交任务的API key可以访问",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取任务状态",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "description": "取消排队中或执行中的任务，已结束的任务不受影响",
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "取消任务",
+        "operationId": "cancelJob",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "任务ID，只有提交任务的API key可以访问",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已请求取消，返回当前任务状态，执行中的任务稍后变为canceled",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}/events": {
+      "get": {
+        "description": "以Server-Sent Events推送任务状态。每个事件的data为Job：\nprogress事件在任务开始执行、进度和概率估计变化时推送；\ncompleted事件在任务结束时推送一次，之后连接关闭，结果通过getJobResult获取。\n连接空闲时定期发送注释行保持连接。\n",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "订阅任务进度",
+        "operationId": "streamJobEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "任务ID，只有提交任务的API key可以访问",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "事件流，每个事件的data为Job",
+            "schema": {
+              "$ref": "#/definitions/Job"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/jobs/{id}/result": {
+      "get": {
+        "description": "获取已完成任务的计算结果，结果按本次请求的语言输出。\nCSV、Markdown和JSON Lines与对应的同步计算接口格式相同\n",
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv",
+          "text/markdown"
+        ],
+        "tags": [
+          "Jobs"
+        ],
+        "summary": "获取任务结果",
+        "operationId": "getJobResult",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "任务ID，只有提交任务的API key可以访问",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          },
+          {
+            "enum": [
+              "json",
+              "csv",
+              "markdown",
+              "jsonl"
+            ],
+            "type": "string",
+            "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取任务结果",
+            "schema": {
+              "$ref": "#/definitions/JobResult"
+            }
+          },
+          "404": {
+            "description": "任务不存在、已过期或由其他API key提交",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "任务尚未完成、执行失败或已取消",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/list": {
+      "get": {
+        "description": "获取所有可用的模组词条",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取词条列表",
+        "operationId": "listAffixes",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "词条分类（damage、defense、utility），不填返回全部",
+            "name": "category",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "游戏版本ID，不填或latest表示最新版本",
+            "name": "gameVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取词条列表",
+            "schema": {
+              "$ref": "#/definitions/AffixListResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/affix/probability": {
+      "post": {
+        "description": "计算指定词条组合出现的概率",
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv",
+          "text/markdown"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算模组词条概率",
+        "operationId": "calculateAffixProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AffixProbabilityRequest"
+            }
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          },
+          {
+            "enum": [
+              "json",
+              "csv",
+              "markdown",
+              "jsonl"
+            ],
+            "type": "string",
+            "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "之前响应的ETag，结果未变化时返回304",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/AffixProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/search": {
+      "get": {
+        "description": "按中文名、拼音、拼音首字母或英文别名模糊搜索词条，可按分类过滤",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "搜索词条",
+        "operationId": "searchAffixes",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "搜索关键字，不填返回分类下的全部词条",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "词条分类（damage、defense、utility）",
+            "name": "category",
+            "in": "query"
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "搜索成功",
+            "schema": {
+              "$ref": "#/definitions/AffixSearchResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/affix/value/probability": {
+      "post": {
+        "description": "计算指定词条出现且数值达到阈值或档位的概率",
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv",
+          "text/markdown"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算词条数值概率",
+        "operationId": "calculateAffixValueProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AffixValueProbabilityRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          },
+          {
+            "enum": [
+              "json",
+              "csv",
+              "markdown",
+              "jsonl"
+            ],
+            "type": "string",
+            "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "之前响应的ETag，结果未变化时返回304",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/AffixValueProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/affix/{id}": {
+      "get": {
+        "description": "根据ID获取词条",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取词条详情",
+        "operationId": "getAffix",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "游戏版本ID，不填或latest表示最新版本",
+            "name": "gameVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取词条",
+            "schema": {
+              "$ref": "#/definitions/Affix"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "词条不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/rarity/list": {
+      "get": {
+        "description": "获取所有模组稀有度及其词条数量、词条池、等级和掉落权重",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取稀有度列表",
+        "operationId": "listRarities",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "游戏版本ID，不填或latest表示最新版本",
+            "name": "gameVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取稀有度列表",
+            "schema": {
+              "$ref": "#/definitions/RarityListResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/strengthen/probability": {
+      "post": {
+        "description": "计算模组词条强化到目标等级的概率",
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv",
+          "text/markdown"
+        ],
+        "tags": [
+          "Mod"
+        ],
+        "summary": "计算强化成功概率",
+        "operationId": "calculateStrengthenProbability",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/StrengthenProbabilityRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          },
+          {
+            "enum": [
+              "json",
+              "csv",
+              "markdown",
+              "jsonl"
+            ],
+            "type": "string",
+            "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "之前响应的ETag，结果未变化时返回304",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "计算成功",
+            "schema": {
+              "$ref": "#/definitions/StrengthenProbabilityResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成"
+              }
+            }
+          },
+          "304": {
+            "description": "结果与If-None-Match中的ETag相同，不返回响应体",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "计算结果的实体标签"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/mod/versions": {
+      "get": {
+        "description": "获取所有游戏版本及其相对上一版本的词条、数值、掉落权重和强化规则变动",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "获取游戏版本列表",
+        "operationId": "listGameVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取游戏版本列表",
+            "schema": {
+              "$ref": "#/definitions/GameVersionListResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/mod/versions/diff": {
+      "get": {
+        "description": "比较任意两个游戏版本之间的词条、数值、掉落权重和强化规则变动",
+        "tags": [
+          "Mod"
+        ],
+        "summary": "比较游戏版本",
+        "operationId": "diffGameVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "起始游戏版本ID",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "目标游戏版本ID，不填或latest表示最新版本",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "比较成功",
+            "schema": {
+              "$ref": "#/definitions/GameVersionDiffResponse"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/presets": {
+      "get": {
+        "description": "获取内置预设、玩家保存的预设和X-Guild-ID指定的服务器保存的预设。\n需要目标词条的地方可以用preset:\u003c名称\u003e引用预设，查找顺序为玩家、服务器、内置。未启用数据库时只有内置预设。\n",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "获取目标词条预设",
+        "operationId": "listPresets",
+        "parameters": [
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取预设列表",
+            "schema": {
+              "$ref": "#/definitions/PresetListResponse"
+            }
+          },
+          "503": {
+            "description": "数据库不可用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/presets/{scope}/{name}": {
+      "put": {
+        "description": "创建或更新玩家或服务器的预设，与内置预设同名时优先使用保存的预设。\n需要API key；服务器预设还需要key可以管理X-Guild-ID指定的服务器（创建key时的-guilds，admin权限可以管理全部服务器），否则返回403 guild_not_allowed。\n",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "保存预设",
+        "operationId": "savePreset",
+        "parameters": [
+          {
+            "enum": [
+              "user",
+              "guild"
+            ],
+            "type": "string",
+            "description": "预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "预设名称，只能包含小写字母、数字和-",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PresetInput"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "已保存预设",
+            "schema": {
+              "$ref": "#/definitions/Preset"
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "API key不能管理该服务器的预设",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      },
+      "delete": {
+        "description": "需要API key，服务器预设的权限要求与保存时相同",
+        "tags": [
+          "Preset"
+        ],
+        "summary": "删除预设",
+        "operationId": "deletePreset",
+        "parameters": [
+          {
+            "enum": [
+              "user",
+              "guild"
+            ],
+            "type": "string",
+            "description": "预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "预设名称，只能包含小写字母、数字和-",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "已删除预设"
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "API key不能管理该服务器的预设",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "预设不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-key-required": true,
+        "x-scope": "calculate"
+      }
+    },
+    "/scripts/run": {
+      "post": {
+        "description": "在沙箱中执行Starlark脚本并调用main(input)，返回值编码为JSON作为结果。\n脚本可以使用math、json、choose、combinations、Random，以及访问游戏版本目录的affix和strengthen模块；\n不能加载其他文件或访问文件、网络。执行步数超过SCRIPTS_MAX_STEPS时返回422和script_step_limit，\n执行时间超过SCRIPTS_TIMEOUT时返回422和script_timeout，字符串、列表等分配的内存超过SCRIPTS_MAX_ALLOC_MB时返回422和script_memory_limit；\n语法错误和运行时错误（包括内置函数的元素个数超过上限）返回400和script_error，消息带出错位置。\n",
+        "tags": [
+          "Scripts"
+        ],
+        "summary": "运行Starlark脚本",
+        "operationId": "runScript",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ScriptRunRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "执行成功",
+            "schema": {
+              "$ref": "#/definitions/ScriptRunResponse"
+            }
+          },
+          "400": {
+            "description": "脚本出错或游戏版本不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "脚本超过执行步数、时间或内存上限",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/share": {
+      "post": {
+        "description": "保存规范化的计算请求并返回稳定的短ID，网页中通过 /s/{id} 打开。\n未指定游戏版本时固定为当前最新版本，相同的计算得到相同的ID。请求参数无效时不保存。\n",
+        "tags": [
+          "Share"
+        ],
+        "summary": "创建计算分享",
+        "operationId": "createShare",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShareRequest"
+            }
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+            "name": "X-Player-ID",
+            "in": "header"
+          },
+          {
+            "maxLength": 64,
+            "type": "string",
+            "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+            "name": "X-Guild-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "相同的计算已经分享过，返回已有的分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            }
+          },
+          "201": {
+            "description": "已创建分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "分享的地址"
+              }
+            }
+          },
+          "400": {
+            "description": "请求参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/share/{id}": {
+      "get": {
+        "description": "获取分享的计算请求，并按分享时的游戏版本重新计算结果",
+        "tags": [
+          "Share"
+        ],
+        "summary": "获取计算分享",
+        "operationId": "getShare",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "分享ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取分享",
+            "schema": {
+              "$ref": "#/definitions/Share"
+            }
+          },
+          "400": {
+            "description": "分享时的游戏版本已不可用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "分享不存在",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "未启用数据库或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    },
+    "/tools": {
+      "get": {
+        "description": "获取工具注册表中启用的工具，TOOLS_ENABLED和TOOLS_DISABLED配置可以启用或隐藏工具",
+        "tags": [
+          "Tools"
+        ],
+        "summary": "获取工具列表",
+        "operationId": "listTools",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "成功获取工具列表",
+            "schema": {
+              "$ref": "#/definitions/ToolsListResponse"
+            }
+          }
+        },
+        "x-scope": "catalog"
+      }
+    },
+    "/tools/{id}/run": {
+      "post": {
+        "description": "按工具的inputSchema校验输入后运行工具，内置工具的输入为对应计算接口的请求体。\n插件工具在独立进程中运行，超过PLUGINS_TIMEOUT时返回504，插件进程退出后自动重新启动。\n脚本工具超过执行步数、时间或内存上限时返回422。\n",
+        "tags": [
+          "Tools"
+        ],
+        "summary": "运行工具",
+        "operationId": "runTool",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "工具ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "工具输入，符合工具的inputSchema",
+              "type": "object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+            "name": "lang",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+            "name": "Accept-Language",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "运行成功",
+            "schema": {
+              "$ref": "#/definitions/ToolRunResponse"
+            }
+          },
+          "400": {
+            "description": "输入不符合工具的inputSchema或计算参数错误",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "工具不存在或未启用",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "脚本工具超过执行步数、时间或内存上限",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "502": {
+            "description": "插件运行失败",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "503": {
+            "description": "插件正在重新启动或服务正在停止",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "504": {
+            "description": "插件运行超时",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-scope": "calculate"
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "scopes",
+        "dailyQuota",
+        "createdAt",
+        "usage"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "dailyQuota": {
+          "description": "每日请求数上限（UTC自然日），0表示不限",
+          "type": "integer",
+          "format": "int32"
+        },
+        "guilds": {
+          "description": "可以修改服务器预设的服务器ID，*表示全部服务器",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "key ID",
+          "type": "string",
+          "example": "3f9a1c0b"
+        },
+        "name": {
+          "description": "key名称",
+          "type": "string",
+          "example": "discord-bot"
+        },
+        "revokedAt": {
+          "description": "吊销时间，未吊销时为空",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scopes": {
+          "description": "权限范围，calculate包含catalog，admin包含全部权限",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "catalog",
+              "calculate",
+              "admin"
+            ]
+          }
+        },
+        "usage": {
+          "$ref": "#/definitions/APIKeyUsage"
+        }
+      }
+    },
+    "APIKeyListResponse": {
+      "type": "object",
+      "required": [
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/APIKey"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "APIKeyUsage": {
+      "type": "object",
+      "required": [
+        "today",
+        "total"
+      ],
+      "properties": {
+        "lastUsedAt": {
+          "description": "最后使用时间",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "today": {
+          "description": "当天（UTC）的请求数",
+          "type": "integer",
+          "format": "int32"
+        },
+        "total": {
+          "description": "累计请求数",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Affix": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "aliases": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Status DMG",
+            "Status Damage"
+          ]
+        },
+        "category": {
+          "type": "string",
+          "example": "damage"
+        },
+        "description": {
+          "type": "string",
+          "example": "提升异常状态伤害"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "name": {
+          "type": "string",
+          "example": "异常伤害"
+        },
+        "pinyin": {
+          "type": "string",
+          "example": "yi chang shang hai"
+        }
+      }
+    },
+    "AffixListResponse": {
+      "type": "object",
+      "required": [
+        "affixes"
+      ],
+      "properties": {
         "affixes": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Affix"
+            "$ref": "#/definitions/Affix"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32",
+          "example": 10
+        }
+      }
+    },
+    "AffixProbabilityRequest": {
+      "description": "targetAffixIds和targets至少提供一个，两者的词条合并计算",
+      "type": "object",
+      "properties": {
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
+        },
+        "rarity": {
+          "description": "稀有度ID，any表示按掉落权重积分所有稀有度",
+          "type": "string",
+          "example": "gold"
+        },
+        "showCombinations": {
+          "type": "boolean",
+          "default": false
+        },
+        "slotCount": {
+          "description": "词条数量，指定稀有度时忽略",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1,
+          "example": 3
+        },
+        "targetAffixIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            4,
+            5,
+            6
+          ]
+        },
+        "targets": {
+          "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e",
+          "type": "string",
+          "maxLength": 200,
+          "example": "preset:elite-dps, 1"
+        }
+      }
+    },
+    "AffixProbabilityResponse": {
+      "type": "object",
+      "required": [
+        "probability",
+        "probabilityPercent",
+        "totalCombinations",
+        "validCombinations"
+      ],
+      "properties": {
+        "combinations": {
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int32"
+            }
+          },
+          "example": [
+            [
+              1,
+              4,
+              5
+            ],
+            [
+              1,
+              4,
+              6
+            ],
+            [
+              1,
+              5,
+              6
+            ],
+            [
+              4,
+              5,
+              6
+            ]
+          ]
+        },
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0333
+        },
+        "probabilityPercent": {
+          "type": "number",
+          "format": "double",
+          "example": 3.33
+        },
+        "rarity": {
+          "type": "string",
+          "example": "any"
+        },
+        "rarityBreakdown": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RarityProbability"
+          }
+        },
+        "slotCount": {
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        },
+        "targetRange": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            4,
+            5,
+            6
+          ]
+        },
+        "totalCombinations": {
+          "type": "integer",
+          "format": "int64",
+          "example": 120
+        },
+        "validCombinations": {
+          "type": "integer",
+          "format": "int64",
+          "example": 4
+        }
+      }
+    },
+    "AffixSearchResponse": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AffixSearchResult"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        }
+      }
+    },
+    "AffixSearchResult": {
+      "type": "object",
+      "required": [
+        "affix"
+      ],
+      "properties": {
+        "affix": {
+          "$ref": "#/definitions/Affix"
+        },
+        "matchedBy": {
+          "description": "匹配来源（id、name、pinyin、initials、alias）",
+          "type": "string",
+          "example": "initials"
+        },
+        "score": {
+          "description": "匹配得分，越高越精确",
+          "type": "integer",
+          "format": "int32",
+          "example": 85
+        }
+      }
+    },
+    "AffixValueDetail": {
+      "type": "object",
+      "properties": {
+        "affixId": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "max": {
+          "type": "number",
+          "format": "double",
+          "example": 10
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "example": 4
+        },
+        "minTier": {
+          "type": "integer",
+          "format": "int32",
+          "example": 0
+        },
+        "minValue": {
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "probability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.15
+        },
+        "tiers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AffixValueTier"
           }
         },
-        "total": {
-          "type": "integer",
-          "format": "int32",
-          "example": 10
+        "unit": {
+          "type": "string",
+          "example": "%"
         }
       }
     },
-    "AffixProbabilityRequest": {
+    "AffixValueProbabilityRequest": {
       "type": "object",
       "required": [
-        "slotCount",
-        "targetAffixIds"
+        "requirements"
       ],
       "properties": {
-        "showCombinations": {
-          "type": "boolean",
-          "default": false
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
         },
-        "slotCount": {
+        "level": {
+          "description": "词条等级，上限取决于游戏版本",
           "type": "integer",
           "format": "int32",
-          "maximum": 10,
+          "default": 1,
           "minimum": 1,
-          "example": 3
+          "example": 1
         },
-        "targetAffixIds": {
+        "requirements": {
           "type": "array",
           "minItems": 1,
           "items": {
-            "type": "integer",
-            "format": "int32"
-          },
-          "example": [
-            1,
-            4,
-            5,
-            6
-          ]
+            "$ref": "#/definitions/AffixValueRequirement"
+          }
+        },
+        "slotCount": {
+          "description": "词条数量，0表示只计算数值概率",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 0,
+          "example": 4
         }
       }
     },
-    "AffixProbabilityResponse": {
+    "AffixValueProbabilityResponse": {
       "type": "object",
       "required": [
         "probability",
-        "probabilityPercent",
-        "totalCombinations",
-        "validCombinations"
+        "probabilityPercent"
       ],
       "properties": {
-        "combinations": {
+        "appearProbability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.1333
+        },
+        "details": {
           "type": "array",
           "items": {
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int32"
-            }
-          },
-          "example": [
-            [
-              1,
-              4,
-              5
-            ],
-            [
-              1,
-              4,
-              6
-            ],
-            [
-              1,
-              5,
-              6
-            ],
-            [
-              4,
-              5,
-              6
-            ]
-          ]
+            "$ref": "#/definitions/AffixValueDetail"
+          }
+        },
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
+        "level": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
         },
         "probability": {
           "type": "number",
           "format": "double",
-          "example": 0.0333
+          "example": 0.0033
         },
         "probabilityPercent": {
           "type": "number",
           "format": "double",
-          "example": 3.33
+          "example": 0.33
         },
         "slotCount": {
           "type": "integer",
           "format": "int32",
+          "example": 4
+        },
+        "valueProbability": {
+          "type": "number",
+          "format": "double",
+          "example": 0.0225
+        }
+      }
+    },
+    "AffixValueRequirement": {
+      "type": "object",
+      "required": [
+        "affixId"
+      ],
+      "properties": {
+        "affixId": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "minTier": {
+          "description": "档位下限，不填表示不限制",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 0,
           "example": 3
         },
-        "targetRange": {
+        "minValue": {
+          "description": "数值下限，不填表示不限制",
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "topTier": {
+          "description": "是否要求最高档位，优先于minTier",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "AffixValueTier": {
+      "type": "object",
+      "properties": {
+        "max": {
+          "type": "number",
+          "format": "double",
+          "example": 10
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "example": 8
+        },
+        "tier": {
+          "type": "integer",
+          "format": "int32",
+          "example": 3
+        },
+        "weight": {
+          "type": "number",
+          "format": "double",
+          "example": 0.15
+        }
+      }
+    },
+    "BuildInfo": {
+      "type": "object",
+      "required": [
+        "version",
+        "goVersion"
+      ],
+      "properties": {
+        "buildTime": {
+          "description": "构建时间，未注入时为提交时间",
+          "type": "string",
+          "example": "2026-10-19T12:00:00Z"
+        },
+        "commit": {
+          "description": "提交哈希",
+          "type": "string",
+          "example": "abdae36c1f0e"
+        },
+        "goVersion": {
+          "type": "string",
+          "example": "go1.24.0"
+        },
+        "modified": {
+          "description": "构建时工作区是否有未提交的修改",
+          "type": "boolean"
+        },
+        "version": {
+          "description": "版本号，构建时未注入且无法从模块信息读取时为dev",
+          "type": "string",
+          "example": "v1.2.0"
+        }
+      }
+    },
+    "CatalogChange": {
+      "type": "object",
+      "required": [
+        "target",
+        "id",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "changed"
+          ]
+        },
+        "field": {
+          "type": "string",
+          "example": "dropWeight"
+        },
+        "id": {
+          "description": "词条ID、稀有度ID或词条等级",
+          "type": "string",
+          "example": "5"
+        },
+        "new": {
+          "type": "string",
+          "example": "0.12"
+        },
+        "old": {
+          "type": "string",
+          "example": "0.1"
+        },
+        "target": {
+          "description": "变动对象",
+          "type": "string",
+          "enum": [
+            "affix",
+            "rarity",
+            "affixValue",
+            "levelScale"
+          ]
+        }
+      }
+    },
+    "ErrorDetails": {
+      "type": "object",
+      "properties": {
+        "allowed": {
+          "description": "允许的取值",
           "type": "array",
           "items": {
-            "type": "integer",
-            "format": "int32"
+            "type": "string"
           },
-          "example": [
-            1,
-            4,
-            5,
-            6
-          ]
+          "x-omitempty": true
         },
-        "totalCombinations": {
+        "field": {
+          "description": "出错的请求字段",
+          "type": "string",
+          "example": "slotCount"
+        },
+        "max": {
+          "description": "允许的最大值",
           "type": "integer",
-          "format": "int64",
-          "example": 120
+          "format": "int32",
+          "x-nullable": true,
+          "example": 10
         },
-        "validCombinations": {
+        "min": {
+          "description": "允许的最小值",
           "type": "integer",
-          "format": "int64",
-          "example": 4
+          "format": "int32",
+          "x-nullable": true,
+          "example": 1
         }
       }
     },
     "ErrorResponse": {
+      "description": "错误响应，请求头Accept为application/problem+json时按RFC 7807格式返回ProblemDetails",
       "type": "object",
       "required": [
         "error",
         "message"
       ],
       "properties": {
+        "code": {
+          "description": "机器可读的错误码，客户端应按错误码处理错误",
+          "type": "string",
+          "enum": [
+            "out_of_range",
+            "invalid_count",
+            "required",
+            "duplicate",
+            "no_valid_targets",
+            "unknown_rarity",
+            "unknown_game_version",
+            "unknown_category",
+            "rarity_required",
+            "target_below_initial",
+            "affix_not_found",
+            "affix_ambiguous",
+            "rate_limited",
+            "job_not_found",
+            "job_queue_full",
+            "job_not_finished",
+            "job_failed",
+            "job_canceled",
+            "job_timeout",
+            "api_key_required",
+            "invalid_api_key",
+            "insufficient_scope",
+            "quota_exceeded",
+            "shutting_down",
+            "share_not_found",
+            "storage_unavailable",
+            "mod_not_found",
+            "inventory_full",
+            "affix_not_allowed",
+            "preset_not_found",
+            "invalid_preset_name",
+            "tool_not_found",
+            "invalid_input",
+            "tool_failed",
+            "tool_timeout",
+            "script_error",
+            "script_step_limit",
+            "script_timeout",
+            "script_memory_limit",
+            "invalid_value",
+            "not_found",
+            "method_not_allowed",
+            "unsupported_media_type",
+            "not_acceptable",
+            "guild_not_allowed"
+          ],
+          "example": "out_of_range"
+        },
         "details": {
-          "type": "object"
+          "$ref": "#/definitions/ErrorDetails"
         },
         "error": {
+          "description": "错误类别，与HTTP状态码对应",
           "type": "string",
           "example": "bad_request"
         },
         "message": {
+          "description": "按请求语言本地化的错误消息",
+          "type": "string",
+          "example": "词条数量必须在1-10之间"
+        }
+      }
+    },
+    "GameVersion": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "changes": {
+          "description": "相对上一版本的变动，首个版本为空",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CatalogChange"
+          }
+        },
+        "id": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "latest": {
+          "description": "是否为最新版本",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string",
+          "example": "正式上线"
+        },
+        "notes": {
+          "type": "string"
+        },
+        "releaseDate": {
+          "type": "string",
+          "example": "2024-07-09"
+        }
+      }
+    },
+    "GameVersionDiffResponse": {
+      "type": "object",
+      "required": [
+        "from",
+        "to",
+        "changes"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CatalogChange"
+          }
+        },
+        "from": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "to": {
+          "type": "string",
+          "example": "1.0"
+        }
+      }
+    },
+    "GameVersionListResponse": {
+      "type": "object",
+      "required": [
+        "versions",
+        "latest"
+      ],
+      "properties": {
+        "latest": {
+          "type": "string",
+          "example": "1.0"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GameVersion"
+          }
+        }
+      }
+    },
+    "HealthComponent": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "error": {
+          "description": "不可用的原因",
+          "type": "string"
+        },
+        "latencyMs": {
+          "description": "检查耗时（毫秒）",
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "description": "组件名称",
+          "type": "string",
+          "example": "cache"
+        },
+        "status": {
+          "description": "ok表示正常，fail表示不可用，disabled表示未启用（不影响就绪状态）",
           "type": "string",
-          "example": "Invalid request parameters"
+          "enum": [
+            "ok",
+            "fail",
+            "disabled"
+          ]
         }
       }
     },
@@ -354,8 +6574,25 @@ func init() {
         "timestamp"
       ],
       "properties": {
+        "build": {
+          "$ref": "#/definitions/BuildInfo"
+        },
+        "components": {
+          "description": "各组件的检查结果，只在就绪检查中返回",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HealthComponent"
+          },
+          "x-omitempty": true
+        },
         "status": {
+          "description": "ok表示正常，unavailable表示有组件不可用，draining表示服务正在停止",
           "type": "string",
+          "enum": [
+            "ok",
+            "unavailable",
+            "draining"
+          ],
           "example": "ok"
         },
         "timestamp": {
@@ -363,543 +6600,901 @@ func init() {
           "format": "date-time"
         },
         "version": {
+          "description": "服务版本，与build.version相同",
           "type": "string",
-          "example": "1.0.0"
+          "example": "v1.2.0"
         }
       }
     },
-    "StrengthenPath": {
+    "InventoryAnalysis": {
       "type": "object",
+      "required": [
+        "gameVersion",
+        "targetLevels",
+        "orderIndependent",
+        "mods",
+        "scrap"
+      ],
       "properties": {
-        "finalLevels": {
+        "affixIds": {
+          "description": "计入目标的词条，包括targets解析出的词条",
           "type": "array",
           "items": {
             "type": "integer",
             "format": "int32"
           }
         },
-        "steps": {
+        "gameVersion": {
+          "type": "string"
+        },
+        "mods": {
+          "description": "按达成概率从高到低排列，概率相同时期望提升高的在前",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/StrengthenStep"
+            "$ref": "#/definitions/InventoryModOutlook"
           }
         },
-        "success": {
+        "orderIndependent": {
           "type": "boolean"
+        },
+        "scrap": {
+          "description": "建议分解的模组",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ScrapSuggestion"
+          }
+        },
+        "targetLevels": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
         }
       }
     },
-    "StrengthenProbabilityRequest": {
+    "InventoryMod": {
       "type": "object",
       "required": [
-        "initialLevels",
-        "targetLevels"
+        "id",
+        "type",
+        "rarity",
+        "affixes",
+        "enhancementsUsed",
+        "createdAt",
+        "updatedAt"
       ],
       "properties": {
-        "initialLevels": {
+        "affixes": {
           "type": "array",
-          "maxItems": 4,
-          "minItems": 4,
           "items": {
-            "type": "integer",
-            "format": "int32",
-            "maximum": 5,
-            "minimum": 1
-          },
-          "example": [
-            1,
-            2,
-            3,
-            1
-          ]
+            "$ref": "#/definitions/InventoryModAffix"
+          }
         },
-        "orderIndependent": {
-          "description": "true表示顺序无关模式，false表示位置对应模式",
-          "type": "boolean",
-          "default": true
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "showPaths": {
-          "type": "boolean",
-          "default": false
+        "enhancementsUsed": {
+          "type": "integer",
+          "format": "int32"
         },
-        "targetLevels": {
+        "id": {
+          "type": "string",
+          "example": "3f9c2a1b7d4e6f80"
+        },
+        "name": {
+          "type": "string",
+          "example": "暴击头"
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "weapon",
+            "helmet",
+            "mask",
+            "top",
+            "gloves",
+            "bottoms",
+            "shoes"
+          ],
+          "example": "helmet"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "InventoryModAffix": {
+      "type": "object",
+      "required": [
+        "affixId",
+        "level"
+      ],
+      "properties": {
+        "affixId": {
+          "description": "词条ID",
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "level": {
+          "description": "当前等级",
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1,
+          "example": 3
+        }
+      }
+    },
+    "InventoryModInput": {
+      "type": "object",
+      "required": [
+        "type",
+        "rarity",
+        "affixes"
+      ],
+      "properties": {
+        "affixes": {
+          "description": "词条和当前等级，数量与稀有度的词条数量一致",
           "type": "array",
-          "maxItems": 4,
-          "minItems": 4,
+          "maxItems": 10,
+          "minItems": 1,
           "items": {
-            "type": "integer",
-            "format": "int32",
-            "maximum": 5,
-            "minimum": 1
-          },
-          "example": [
-            3,
-            4,
-            5,
-            2
-          ]
+            "$ref": "#/definitions/InventoryModAffix"
+          }
+        },
+        "enhancementsUsed": {
+          "description": "已使用的强化次数",
+          "type": "integer",
+          "format": "int32",
+          "default": 0,
+          "minimum": 0
+        },
+        "name": {
+          "description": "备注名",
+          "type": "string",
+          "maxLength": 64,
+          "example": "暴击头"
+        },
+        "rarity": {
+          "description": "稀有度ID",
+          "type": "string",
+          "example": "gold"
+        },
+        "type": {
+          "description": "模组类型（装备部位）",
+          "type": "string",
+          "enum": [
+            "weapon",
+            "helmet",
+            "mask",
+            "top",
+            "gloves",
+            "bottoms",
+            "shoes"
+          ],
+          "example": "helmet"
         }
       }
     },
-    "StrengthenProbabilityResponse": {
+    "InventoryModListResponse": {
+      "type": "object",
+      "required": [
+        "mods",
+        "total"
+      ],
+      "properties": {
+        "mods": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryMod"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "InventoryModOutlook": {
+      "description": "模组用剩余强化次数强化的结果",
       "type": "object",
       "required": [
+        "mod",
+        "enhancementsLeft",
         "probability",
         "probabilityPercent",
-        "successfulOutcomes",
-        "totalOutcomes"
+        "expectedLevels",
+        "expectedGain"
       ],
       "properties": {
-        "paths": {
+        "enhancementsLeft": {
+          "description": "剩余强化次数",
+          "type": "integer",
+          "format": "int32"
+        },
+        "expectedGain": {
+          "description": "计入目标的词条期望提升的等级之和",
+          "type": "number",
+          "format": "double"
+        },
+        "expectedLevels": {
+          "description": "各词条强化后的期望等级，与mod.affixes顺序一致",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/StrengthenPath"
+            "type": "number",
+            "format": "double"
           }
         },
+        "mod": {
+          "$ref": "#/definitions/InventoryMod"
+        },
         "probability": {
+          "description": "达到目标等级的概率",
           "type": "number",
-          "format": "double",
-          "example": 0.75
+          "format": "double"
         },
         "probabilityPercent": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "Job": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "status",
+        "progress",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "estimate": {
+          "description": "根据已完成部分得到的概率估计，随计算进行逐步收敛，只有部分计算类型提供",
           "type": "number",
           "format": "double",
-          "example": 75
+          "x-nullable": true,
+          "example": 0.0312
         },
-        "successfulOutcomes": {
-          "type": "integer",
-          "format": "int64",
-          "example": 768
+        "expiresAt": {
+          "description": "任务结束后结果的过期时间",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
-        "totalOutcomes": {
-          "type": "integer",
-          "format": "int64",
-          "example": 1024
+        "finishedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "example": "3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d"
+        },
+        "progress": {
+          "description": "任务进度，0到1",
+          "type": "number",
+          "format": "double",
+          "example": 0.42
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "任务状态",
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "succeeded",
+            "failed",
+            "canceled"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
         }
       }
     },
-    "StrengthenStep": {
+    "JobItem": {
+      "description": "批量任务中的一个计算，按type填写对应的计算参数",
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "newLevel": {
-          "type": "integer",
-          "format": "int32"
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
         },
-        "slot": {
-          "type": "integer",
-          "format": "int32"
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
         },
-        "step": {
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability"
+          ],
+          "example": "affixProbability"
+        }
+      }
+    },
+    "JobItemResult": {
+      "description": "批量任务中一个计算的结果，计算失败时只有error，不影响其余计算",
+      "type": "object",
+      "required": [
+        "index",
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "index": {
+          "description": "在请求items中的位置，从0开始",
           "type": "integer",
-          "format": "int32"
+          "format": "int32",
+          "example": 0
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "affixProbability"
         }
       }
     },
-    "Tool": {
+    "JobRequest": {
+      "description": "异步计算任务，按type填写对应的计算参数；batch在一个任务中依次计算items中的多个计算",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
+        },
+        "items": {
+          "description": "type为batch时的计算列表，结果按相同顺序返回",
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "$ref": "#/definitions/JobItem"
+          }
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability",
+            "batch"
+          ],
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "JobResult": {
+      "description": "任务结果，只包含与任务类型对应的字段",
       "type": "object",
       "required": [
         "id",
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "id": {
+          "type": "string",
+          "example": "3f2a9c0d4b6e4f1a8c7d2e5b9a0f1c3d"
+        },
+        "items": {
+          "description": "批量任务每个计算的结果，与请求的items顺序相同",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JobItemResult"
+          },
+          "x-omitempty": true
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "Preset": {
+      "type": "object",
+      "required": [
         "name",
-        "category"
+        "scope",
+        "targetAffixIds",
+        "affixNames"
       ],
       "properties": {
-        "category": {
+        "affixNames": {
+          "description": "目标词条的本地化名称，与targetAffixIds一一对应",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "createdAt": {
+          "description": "保存的时间，内置预设没有",
           "type": "string",
-          "example": "mod"
+          "format": "date-time",
+          "x-nullable": true
         },
-        "description": {
+        "name": {
+          "description": "预设名称，以preset:\u003c名称\u003e引用",
           "type": "string",
-          "example": "计算特定词条组合出现的概率"
+          "example": "elite-dps"
         },
-        "icon": {
+        "scope": {
           "type": "string",
-          "example": "dice"
+          "enum": [
+            "builtin",
+            "user",
+            "guild"
+          ]
         },
-        "id": {
+        "targetAffixIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        },
+        "title": {
+          "description": "内置预设的本地化名称",
           "type": "string",
-          "example": "affix-probability"
+          "example": "精英输出"
         },
-        "name": {
+        "updatedAt": {
           "type": "string",
-          "example": "模组词条概率计算器"
+          "format": "date-time",
+          "x-nullable": true
         }
       }
     },
-    "ToolsListResponse": {
+    "PresetInput": {
+      "description": "targetAffixIds和targets至少提供一个，两者的词条合并保存",
       "type": "object",
-      "required": [
-        "tools"
-      ],
       "properties": {
-        "categories": {
+        "targetAffixIds": {
           "type": "array",
+          "maxItems": 10,
           "items": {
-            "type": "string"
+            "type": "integer",
+            "format": "int32"
           },
           "example": [
-            "mod",
-            "weapon",
-            "character"
+            4,
+            5,
+            6
           ]
         },
-        "tools": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Tool"
-          }
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "提供OnceHuman游戏相关的计算工具API",
-    "title": "OnceHuman工具集API",
-    "contact": {
-      "name": "API Support",
-      "email": "support@oncehuman.tools"
-    },
-    "license": {
-      "name": "MIT"
-    },
-    "version": "1.0.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/health": {
-      "get": {
-        "description": "检查服务是否正常运行",
-        "tags": [
-          "System"
-        ],
-        "summary": "健康检查",
-        "operationId": "healthCheck",
-        "responses": {
-          "200": {
-            "description": "服务正常",
-            "schema": {
-              "$ref": "#/definitions/HealthResponse"
-            }
-          }
-        }
-      }
-    },
-    "/mod/affix/list": {
-      "get": {
-        "description": "获取所有可用的模组词条",
-        "tags": [
-          "Mod"
-        ],
-        "summary": "获取词条列表",
-        "operationId": "listAffixes",
-        "responses": {
-          "200": {
-            "description": "成功获取词条列表",
-            "schema": {
-              "$ref": "#/definitions/AffixListResponse"
-            }
-          }
-        }
-      }
-    },
-    "/mod/affix/probability": {
-      "post": {
-        "description": "计算指定词条组合出现的概率",
-        "tags": [
-          "Mod"
-        ],
-        "summary": "计算模组词条概率",
-        "operationId": "calculateAffixProbability",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AffixProbabilityRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "计算成功",
-            "schema": {
-              "$ref": "#/definitions/AffixProbabilityResponse"
-            }
-          },
-          "400": {
-            "description": "请求参数错误",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+        "targets": {
+          "description": "以逗号分隔的目标词条，可以是ID、名称、拼音或preset:\u003c预设名称\u003e",
+          "type": "string",
+          "maxLength": 200,
+          "example": "暴击伤害, 4"
         }
       }
     },
-    "/mod/strengthen/probability": {
-      "post": {
-        "description": "计算模组词条强化到目标等级的概率",
-        "tags": [
-          "Mod"
-        ],
-        "summary": "计算强化成功概率",
-        "operationId": "calculateStrengthenProbability",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/StrengthenProbabilityRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "计算成功",
-            "schema": {
-              "$ref": "#/definitions/StrengthenProbabilityResponse"
-            }
-          },
-          "400": {
-            "description": "请求参数错误",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "PresetListResponse": {
+      "type": "object",
+      "required": [
+        "presets",
+        "total"
+      ],
+      "properties": {
+        "presets": {
+          "description": "依次为玩家、服务器和内置预设，同一范围内按名称排序",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Preset"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "/tools": {
-      "get": {
-        "description": "获取所有可用的工具",
-        "tags": [
-          "Tools"
-        ],
-        "summary": "获取工具列表",
-        "operationId": "listTools",
-        "responses": {
-          "200": {
-            "description": "成功获取工具列表",
-            "schema": {
-              "$ref": "#/definitions/ToolsListResponse"
-            }
-          }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "Affix": {
+    "ProblemDetails": {
+      "description": "RFC 7807 问题详情",
       "type": "object",
       "required": [
-        "id",
-        "name"
+        "type",
+        "title",
+        "status"
       ],
       "properties": {
-        "category": {
+        "allowed": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "code": {
           "type": "string",
-          "example": "damage"
+          "example": "out_of_range"
         },
-        "description": {
+        "detail": {
           "type": "string",
-          "example": "提升异常状态伤害"
+          "example": "词条数量必须在1-10之间"
         },
-        "id": {
+        "field": {
+          "type": "string",
+          "example": "slotCount"
+        },
+        "instance": {
+          "type": "string",
+          "example": "/api/v1/mod/affix/probability"
+        },
+        "max": {
           "type": "integer",
           "format": "int32",
-          "example": 1
+          "x-nullable": true
         },
-        "name": {
+        "min": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "integer",
+          "format": "int32",
+          "example": 400
+        },
+        "title": {
           "type": "string",
-          "example": "异常伤害"
+          "example": "bad_request"
+        },
+        "type": {
+          "type": "string",
+          "example": "https://oncehuman.tools/problems/out_of_range"
         }
       }
     },
-    "AffixListResponse": {
+    "Rarity": {
       "type": "object",
       "required": [
-        "affixes"
+        "id",
+        "name"
       ],
       "properties": {
-        "affixes": {
+        "affixPool": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Affix"
-          }
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10
+          ]
         },
-        "total": {
+        "dropWeight": {
+          "type": "number",
+          "format": "double",
+          "example": 0.1
+        },
+        "id": {
+          "type": "string",
+          "example": "gold"
+        },
+        "maxEnhancements": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "maxLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 5
+        },
+        "maxStartLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 2
+        },
+        "minStartLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "name": {
+          "type": "string",
+          "example": "金色"
+        },
+        "slotCount": {
           "type": "integer",
           "format": "int32",
-          "example": 10
+          "example": 4
         }
       }
     },
-    "AffixProbabilityRequest": {
+    "RarityListResponse": {
       "type": "object",
       "required": [
-        "slotCount",
-        "targetAffixIds"
+        "rarities"
       ],
       "properties": {
-        "showCombinations": {
-          "type": "boolean",
-          "default": false
+        "rarities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Rarity"
+          }
         },
-        "slotCount": {
+        "total": {
           "type": "integer",
           "format": "int32",
-          "maximum": 10,
-          "minimum": 1,
           "example": 3
-        },
-        "targetAffixIds": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "integer",
-            "format": "int32"
-          },
-          "example": [
-            1,
-            4,
-            5,
-            6
-          ]
         }
       }
     },
-    "AffixProbabilityResponse": {
+    "RarityProbability": {
       "type": "object",
-      "required": [
-        "probability",
-        "probabilityPercent",
-        "totalCombinations",
-        "validCombinations"
-      ],
       "properties": {
-        "combinations": {
-          "type": "array",
-          "items": {
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int32"
-            }
-          },
-          "example": [
-            [
-              1,
-              4,
-              5
-            ],
-            [
-              1,
-              4,
-              6
-            ],
-            [
-              1,
-              5,
-              6
-            ],
-            [
-              4,
-              5,
-              6
-            ]
-          ]
+        "name": {
+          "type": "string",
+          "example": "金色"
         },
         "probability": {
           "type": "number",
           "format": "double",
-          "example": 0.0333
+          "example": 0.0048
         },
-        "probabilityPercent": {
-          "type": "number",
-          "format": "double",
-          "example": 3.33
+        "rarity": {
+          "type": "string",
+          "example": "gold"
         },
         "slotCount": {
           "type": "integer",
           "format": "int32",
-          "example": 3
-        },
-        "targetRange": {
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int32"
-          },
-          "example": [
-            1,
-            4,
-            5,
-            6
-          ]
+          "example": 4
         },
         "totalCombinations": {
+          "description": "词条概率为该稀有度的组合总数；强化概率为各掉落等级的强化结果数之和",
           "type": "integer",
           "format": "int64",
-          "example": 120
+          "example": 210
         },
         "validCombinations": {
+          "description": "其中满足目标的组合数或强化结果数",
           "type": "integer",
           "format": "int64",
-          "example": 4
+          "example": 1
+        },
+        "weight": {
+          "description": "归一化后的掉落权重",
+          "type": "number",
+          "format": "double",
+          "example": 0.1
         }
       }
     },
-    "ErrorResponse": {
+    "ScrapSuggestion": {
       "type": "object",
       "required": [
-        "error",
-        "message"
+        "modId",
+        "reason"
       ],
       "properties": {
-        "details": {
-          "type": "object"
+        "betterModId": {
+          "description": "reason为dominated时更好的模组",
+          "type": "string"
         },
-        "error": {
+        "modId": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "unreachable表示无法达到目标，dominated表示同类型中有概率和期望提升都不低于它的模组",
           "type": "string",
-          "example": "bad_request"
+          "enum": [
+            "unreachable",
+            "dominated"
+          ]
+        }
+      }
+    },
+    "ScriptRunRequest": {
+      "type": "object",
+      "required": [
+        "source"
+      ],
+      "properties": {
+        "gameVersion": {
+          "description": "脚本中affix和strengthen使用的游戏版本，省略或latest时为最新版本",
+          "type": "string",
+          "example": "latest"
         },
-        "message": {
+        "input": {
+          "description": "传给main的参数，省略时为空字典",
+          "type": "object",
+          "example": {
+            "k": 4
+          }
+        },
+        "source": {
+          "description": "Starlark脚本源码，需要定义main(input)函数",
           "type": "string",
-          "example": "Invalid request parameters"
+          "maxLength": 65536,
+          "minLength": 1,
+          "example": "def main(input):\n    return choose(10, input[\"k\"])\n"
         }
       }
     },
-    "HealthResponse": {
+    "ScriptRunResponse": {
       "type": "object",
       "required": [
-        "status",
-        "timestamp"
+        "result",
+        "steps",
+        "gameVersion"
       ],
       "properties": {
-        "status": {
+        "gameVersion": {
+          "description": "脚本实际使用的游戏版本",
           "type": "string",
-          "example": "ok"
+          "example": "1.0"
         },
-        "timestamp": {
+        "output": {
+          "description": "脚本print的输出，最多100行",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "result": {
+          "description": "main的返回值",
+          "x-nullable": true,
+          "example": 210
+        },
+        "steps": {
+          "description": "执行步数",
+          "type": "integer",
+          "format": "int64",
+          "example": 1024
+        }
+      }
+    },
+    "Share": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "gameVersion",
+        "path",
+        "request",
+        "result",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
           "type": "string",
           "format": "date-time"
         },
-        "version": {
+        "gameVersion": {
+          "description": "分享时的游戏版本，结果按该版本的目录计算",
+          "type": "string",
+          "example": "1.0"
+        },
+        "id": {
+          "description": "分享ID，由规范化的请求生成",
+          "type": "string",
+          "example": "k3Vx9QaZ_b2M"
+        },
+        "path": {
+          "description": "网页中打开分享的路径",
           "type": "string",
-          "example": "1.0.0"
+          "example": "/s/k3Vx9QaZ_b2M"
+        },
+        "request": {
+          "$ref": "#/definitions/ShareRequest"
+        },
+        "result": {
+          "$ref": "#/definitions/ShareResult"
+        },
+        "type": {
+          "type": "string",
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "ShareRequest": {
+      "description": "分享的计算请求，按type填写对应的计算参数",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityRequest"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityRequest"
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityRequest"
+        },
+        "type": {
+          "description": "计算类型",
+          "type": "string",
+          "enum": [
+            "affixProbability",
+            "affixValueProbability",
+            "strengthenProbability"
+          ],
+          "example": "strengthenProbability"
+        }
+      }
+    },
+    "ShareResult": {
+      "description": "计算结果，只包含与计算类型对应的字段",
+      "type": "object",
+      "properties": {
+        "affixProbability": {
+          "$ref": "#/definitions/AffixProbabilityResponse"
+        },
+        "affixValueProbability": {
+          "$ref": "#/definitions/AffixValueProbabilityResponse"
+        },
+        "strengthenProbability": {
+          "$ref": "#/definitions/StrengthenProbabilityResponse"
         }
       }
     },
@@ -927,18 +7522,20 @@ func init() {
     "StrengthenProbabilityRequest": {
       "type": "object",
       "required": [
-        "initialLevels",
         "targetLevels"
       ],
       "properties": {
+        "gameVersion": {
+          "description": "游戏版本ID，不填或latest表示最新版本",
+          "type": "string",
+          "example": "latest"
+        },
         "initialLevels": {
+          "description": "初始等级，数量与稀有度的词条数量一致；指定稀有度时可不填，按掉落等级分布积分",
           "type": "array",
-          "maxItems": 4,
-          "minItems": 4,
           "items": {
             "type": "integer",
             "format": "int32",
-            "maximum": 5,
             "minimum": 1
           },
           "example": [
@@ -953,18 +7550,26 @@ func init() {
           "type": "boolean",
           "default": true
         },
+        "rarity": {
+          "description": "稀有度ID，不填按金色规则计算，any表示按掉落权重积分所有稀有度",
+          "type": "string",
+          "example": "gold"
+        },
         "showPaths": {
           "type": "boolean",
           "default": false
         },
+        "table": {
+          "description": "同时返回强化概率表：单个词条从各初始等级强化到各目标等级的概率，导出CSV和Markdown时输出该表；只用于同步接口",
+          "type": "boolean",
+          "default": false
+        },
         "targetLevels": {
           "type": "array",
-          "maxItems": 4,
-          "minItems": 4,
+          "minItems": 1,
           "items": {
             "type": "integer",
             "format": "int32",
-            "maximum": 5,
             "minimum": 1
           },
           "example": [
@@ -985,6 +7590,11 @@ func init() {
         "totalOutcomes"
       ],
       "properties": {
+        "gameVersion": {
+          "description": "计算使用的游戏版本",
+          "type": "string",
+          "example": "1.0"
+        },
         "paths": {
           "type": "array",
           "items": {
@@ -1001,11 +7611,24 @@ func init() {
           "format": "double",
           "example": 75
         },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "rarityBreakdown": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RarityProbability"
+          }
+        },
         "successfulOutcomes": {
           "type": "integer",
           "format": "int64",
           "example": 768
         },
+        "table": {
+          "$ref": "#/definitions/StrengthenTable"
+        },
         "totalOutcomes": {
           "type": "integer",
           "format": "int64",
@@ -1030,6 +7653,64 @@ func init() {
         }
       }
     },
+    "StrengthenTable": {
+      "description": "强化概率表，其他词条为掉落的最低等级，目标等级不高于初始等级时概率为1",
+      "type": "object",
+      "required": [
+        "rarity",
+        "levels",
+        "rows"
+      ],
+      "properties": {
+        "levels": {
+          "description": "目标等级，与每行的probabilities一一对应",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "example": [
+            1,
+            2,
+            3,
+            4,
+            5
+          ]
+        },
+        "rarity": {
+          "type": "string",
+          "example": "gold"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StrengthenTableRow"
+          }
+        }
+      }
+    },
+    "StrengthenTableRow": {
+      "type": "object",
+      "required": [
+        "initialLevel",
+        "probabilities"
+      ],
+      "properties": {
+        "initialLevel": {
+          "type": "integer",
+          "format": "int32",
+          "example": 1
+        },
+        "probabilities": {
+          "description": "用全部强化次数强化到不低于各目标等级的概率",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        }
+      }
+    },
     "Tool": {
       "type": "object",
       "required": [
@@ -1054,9 +7735,40 @@ func init() {
           "type": "string",
           "example": "affix-probability"
         },
+        "inputSchema": {
+          "description": "工具输入的JSON Schema，内置工具为对应计算接口的请求体",
+          "type": "object"
+        },
         "name": {
           "type": "string",
           "example": "模组词条概率计算器"
+        },
+        "source": {
+          "description": "工具来源，builtin为内置工具，plugin为插件目录中的外部插件，script为脚本目录中的Starlark脚本",
+          "type": "string",
+          "enum": [
+            "builtin",
+            "plugin",
+            "script"
+          ],
+          "example": "builtin"
+        }
+      }
+    },
+    "ToolRunResponse": {
+      "type": "object",
+      "required": [
+        "toolId",
+        "result"
+      ],
+      "properties": {
+        "result": {
+          "description": "工具的运行结果，内置工具为对应计算接口的响应体",
+          "type": "object"
+        },
+        "toolId": {
+          "type": "string",
+          "example": "affix-probability"
         }
       }
     },
@@ -1067,14 +7779,13 @@ func init() {
       ],
       "properties": {
         "categories": {
+          "description": "启用的工具所属的分类，按首次出现的顺序排列",
           "type": "array",
           "items": {
             "type": "string"
           },
           "example": [
-            "mod",
-            "weapon",
-            "character"
+            "mod"
           ]
         },
         "tools": {
@@ -1085,6 +7796,111 @@ func init() {
         }
       }
     }
-  }
+  },
+  "parameters": {
+    "AcceptLanguage": {
+      "type": "string",
+      "description": "浏览器语言偏好，未指定lang时使用，默认zh-CN",
+      "name": "Accept-Language",
+      "in": "header"
+    },
+    "Format": {
+      "enum": [
+        "json",
+        "csv",
+        "markdown",
+        "jsonl"
+      ],
+      "type": "string",
+      "description": "结果格式，优先于Accept请求头：csv（text/csv）、markdown（text/markdown，用于论坛帖子）、\njsonl（application/x-ndjson，每行一个组合或路径，用于大量组合）。错误响应始终为JSON\n",
+      "name": "format",
+      "in": "query"
+    },
+    "GameVersion": {
+      "type": "string",
+      "description": "游戏版本ID，不填或latest表示最新版本",
+      "name": "gameVersion",
+      "in": "query"
+    },
+    "GuildID": {
+      "maxLength": 64,
+      "type": "string",
+      "description": "服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器",
+      "name": "X-Guild-ID",
+      "in": "header"
+    },
+    "IfNoneMatch": {
+      "type": "string",
+      "description": "之前响应的ETag，结果未变化时返回304",
+      "name": "If-None-Match",
+      "in": "header"
+    },
+    "JobID": {
+      "type": "string",
+      "description": "任务ID，只有提交任务的API key可以访问",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "Lang": {
+      "type": "string",
+      "description": "响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略",
+      "name": "lang",
+      "in": "query"
+    },
+    "ModID": {
+      "type": "string",
+      "description": "模组ID",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "PlayerID": {
+      "maxLength": 64,
+      "type": "string",
+      "description": "玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立",
+      "name": "X-Player-ID",
+      "in": "header"
+    },
+    "PresetName": {
+      "type": "string",
+      "description": "预设名称，只能包含小写字母、数字和-",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "PresetScope": {
+      "enum": [
+        "user",
+        "guild"
+      ],
+      "type": "string",
+      "description": "预设范围，user为玩家预设，guild为X-Guild-ID指定的服务器预设",
+      "name": "scope",
+      "in": "path",
+      "required": true
+    },
+    "ToolID": {
+      "type": "string",
+      "description": "工具ID",
+      "name": "id",
+      "in": "path",
+      "required": true
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "description": "API key，由管理命令apikey创建。\n未要求认证（AUTH_REQUIRED=false）时可以匿名访问；携带key时按key的权限范围（x-scope）和每日配额检查：\nkey无效返回401 invalid_api_key，权限不足返回403 insufficient_scope，当日配额用完返回429 quota_exceeded。\n管理接口（x-scope: admin）和保存个人数据的接口（x-key-required）始终需要key，未携带时返回401 api_key_required。\n",
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "apiKey": []
+    },
+    {}
+  ]
 }`))
 }
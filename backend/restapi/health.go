@@ -0,0 +1,40 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/cache"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/health"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/store"
+)
+
+// newHealthChecker 创建就绪检查器，检查目录、计算结果缓存和数据库
+func newHealthChecker(cfg *config.Config, resultCache *cache.Cache, db *store.DB) *health.Checker {
+	checker := health.NewChecker()
+
+	checker.Register("catalog", func(ctx context.Context) error {
+		if !models.CatalogsLoaded() {
+			return errors.New("目录未加载")
+		}
+		return nil
+	})
+
+	checker.Register("cache", func(ctx context.Context) error {
+		if resultCache == nil {
+			return health.ErrDisabled
+		}
+		return resultCache.Ping(ctx)
+	})
+
+	checker.Register("database", func(ctx context.Context) error {
+		if db == nil {
+			return health.ErrDisabled
+		}
+		return db.Ping(ctx)
+	})
+
+	return checker
+}
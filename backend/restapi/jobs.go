@@ -0,0 +1,16 @@
+package restapi
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/jobs"
+)
+
+// newJobManager 根据配置创建异步计算任务管理器
+func newJobManager(cfg *config.Config) *jobs.Manager {
+	return jobs.NewManager(jobs.Config{
+		Workers:   cfg.Jobs.Workers,
+		QueueSize: cfg.Jobs.QueueSize,
+		Timeout:   cfg.Jobs.Timeout,
+		ResultTTL: cfg.Jobs.ResultTTL,
+	})
+}
@@ -0,0 +1,31 @@
+package restapi
+
+import (
+	"net/http"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/metrics"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+)
+
+// metricsOperationID 指标接口在访问日志和请求指标中使用的操作ID
+const metricsOperationID = "metrics"
+
+// newMetrics 根据配置创建指标中间件，统计请求并在指标路径输出Prometheus指标，未启用时不处理
+func newMetrics(cfg *config.Config) func(http.Handler) http.Handler {
+	if !cfg.Metrics.Enabled {
+		return func(handler http.Handler) http.Handler { return handler }
+	}
+
+	exporter := metrics.Handler()
+	return func(handler http.Handler) http.Handler {
+		return metrics.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == cfg.Metrics.Path && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+				logging.SetOperationID(r.Context(), metricsOperationID)
+				exporter.ServeHTTP(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		}))
+	}
+}
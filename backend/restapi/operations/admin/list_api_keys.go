@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListAPIKeysHandlerFunc turns a function with the right signature into a list Api keys handler
+type ListAPIKeysHandlerFunc func(ListAPIKeysParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAPIKeysHandlerFunc) Handle(params ListAPIKeysParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAPIKeysHandler interface for that can handle valid list Api keys params
+type ListAPIKeysHandler interface {
+	Handle(ListAPIKeysParams, interface{}) middleware.Responder
+}
+
+// NewListAPIKeys creates a new http.Handler for the list Api keys operation
+func NewListAPIKeys(ctx *middleware.Context, handler ListAPIKeysHandler) *ListAPIKeys {
+	return &ListAPIKeys{Context: ctx, Handler: handler}
+}
+
+/*
+	ListAPIKeys swagger:route GET /admin/keys Admin listApiKeys
+
+获取API key列表
+
+获取所有API key及其用量，不返回key明文和摘要
+*/
+type ListAPIKeys struct {
+	Context *middleware.Context
+	Handler ListAPIKeysHandler
+}
+
+func (o *ListAPIKeys) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAPIKeysParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
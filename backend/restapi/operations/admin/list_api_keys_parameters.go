@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAPIKeysParams creates a new ListAPIKeysParams object
+//
+// There are no default values defined in the spec.
+func NewListAPIKeysParams() ListAPIKeysParams {
+
+	return ListAPIKeysParams{}
+}
+
+// ListAPIKeysParams contains all the bound params for the list Api keys operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listApiKeys
+type ListAPIKeysParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*浏览器语言偏好，未指定lang时使用，默认zh-CN
+	  In: header
+	*/
+	AcceptLanguage *string
+	/*响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	  In: query
+	*/
+	Lang *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListAPIKeysParams() beforehand.
+func (o *ListAPIKeysParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindAcceptLanguage(r.Header[http.CanonicalHeaderKey("Accept-Language")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLang, qhkLang, _ := qs.GetOK("lang")
+	if err := o.bindLang(qLang, qhkLang, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAcceptLanguage binds and validates parameter AcceptLanguage from header.
+func (o *ListAPIKeysParams) bindAcceptLanguage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.AcceptLanguage = &raw
+
+	return nil
+}
+
+// bindLang binds and validates parameter Lang from query.
+func (o *ListAPIKeysParams) bindLang(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Lang = &raw
+
+	return nil
+}
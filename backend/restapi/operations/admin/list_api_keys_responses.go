@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListAPIKeysOKCode is the HTTP code returned for type ListAPIKeysOK
+const ListAPIKeysOKCode int = 200
+
+/*
+ListAPIKeysOK 成功获取API key列表
+
+swagger:response listApiKeysOK
+*/
+type ListAPIKeysOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIKeyListResponse `json:"body,omitempty"`
+}
+
+// NewListAPIKeysOK creates ListAPIKeysOK with default headers values
+func NewListAPIKeysOK() *ListAPIKeysOK {
+
+	return &ListAPIKeysOK{}
+}
+
+// WithPayload adds the payload to the list Api keys o k response
+func (o *ListAPIKeysOK) WithPayload(payload *models.APIKeyListResponse) *ListAPIKeysOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Api keys o k response
+func (o *ListAPIKeysOK) SetPayload(payload *models.APIKeyListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAPIKeysOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AnalyzeInventoryHandlerFunc turns a function with the right signature into a analyze inventory handler
+type AnalyzeInventoryHandlerFunc func(AnalyzeInventoryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AnalyzeInventoryHandlerFunc) Handle(params AnalyzeInventoryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AnalyzeInventoryHandler interface for that can handle valid analyze inventory params
+type AnalyzeInventoryHandler interface {
+	Handle(AnalyzeInventoryParams, interface{}) middleware.Responder
+}
+
+// NewAnalyzeInventory creates a new http.Handler for the analyze inventory operation
+func NewAnalyzeInventory(ctx *middleware.Context, handler AnalyzeInventoryHandler) *AnalyzeInventory {
+	return &AnalyzeInventory{Context: ctx, Handler: handler}
+}
+
+/*
+	AnalyzeInventory swagger:route GET /inventory/analysis Inventory analyzeInventory
+
+分析背包中的模组
+
+按强化规则计算背包中每个模组用剩余强化次数达到目标等级的概率和目标词条的期望提升，
+按达成概率从高到低排列，并给出建议分解的模组：无法达到目标的模组，以及同类型中概率和期望提升都不如另一个模组的模组。
+*/
+type AnalyzeInventory struct {
+	Context *middleware.Context
+	Handler AnalyzeInventoryHandler
+}
+
+func (o *AnalyzeInventory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAnalyzeInventoryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
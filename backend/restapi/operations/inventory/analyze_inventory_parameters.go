@@ -0,0 +1,448 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewAnalyzeInventoryParams creates a new AnalyzeInventoryParams object
+// with the default values initialized.
+func NewAnalyzeInventoryParams() AnalyzeInventoryParams {
+
+	var (
+		// initialize parameters with default values
+
+		orderIndependentDefault = bool(true)
+	)
+
+	return AnalyzeInventoryParams{
+		OrderIndependent: &orderIndependentDefault,
+	}
+}
+
+// AnalyzeInventoryParams contains all the bound params for the analyze inventory operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters analyzeInventory
+type AnalyzeInventoryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*浏览器语言偏好，未指定lang时使用，默认zh-CN
+	  In: header
+	*/
+	AcceptLanguage *string
+	/*服务器ID，同一API key下区分不同服务器（如Discord服务器）的预设，不填时不使用服务器预设；修改服务器预设需要key可以管理该服务器
+	  Max Length: 64
+	  In: header
+	*/
+	XGuildID *string
+	/*玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	  Max Length: 64
+	  In: header
+	*/
+	XPlayerID *string
+	/*只有这些词条计入目标和期望提升，与targets合并；都不填表示全部词条
+	  In: query
+	  Collection Format: csv
+	*/
+	AffixIds []int32
+	/*游戏版本ID，不填或latest表示最新版本
+	  In: query
+	*/
+	GameVersion *string
+	/*响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	  In: query
+	*/
+	Lang *string
+	/*true表示目标等级可以由任意词条达到，false表示按词条顺序对应
+	  In: query
+	  Default: true
+	*/
+	OrderIndependent *bool
+	/*目标等级，如5/5/x/x填5,5
+	  Required: true
+	  Max Items: 10
+	  Min Items: 1
+	  In: query
+	  Collection Format: csv
+	*/
+	TargetLevels []int32
+	/*以逗号分隔的目标词条，可以是ID、名称、拼音或preset:<预设名称>，与affixIds合并
+	  Max Length: 200
+	  In: query
+	*/
+	Targets *string
+	/*只分析该类型的模组
+	  In: query
+	*/
+	Type *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAnalyzeInventoryParams() beforehand.
+func (o *AnalyzeInventoryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindAcceptLanguage(r.Header[http.CanonicalHeaderKey("Accept-Language")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXGuildID(r.Header[http.CanonicalHeaderKey("X-Guild-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXPlayerID(r.Header[http.CanonicalHeaderKey("X-Player-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qAffixIds, qhkAffixIds, _ := qs.GetOK("affixIds")
+	if err := o.bindAffixIds(qAffixIds, qhkAffixIds, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGameVersion, qhkGameVersion, _ := qs.GetOK("gameVersion")
+	if err := o.bindGameVersion(qGameVersion, qhkGameVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLang, qhkLang, _ := qs.GetOK("lang")
+	if err := o.bindLang(qLang, qhkLang, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrderIndependent, qhkOrderIndependent, _ := qs.GetOK("orderIndependent")
+	if err := o.bindOrderIndependent(qOrderIndependent, qhkOrderIndependent, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTargetLevels, qhkTargetLevels, _ := qs.GetOK("targetLevels")
+	if err := o.bindTargetLevels(qTargetLevels, qhkTargetLevels, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTargets, qhkTargets, _ := qs.GetOK("targets")
+	if err := o.bindTargets(qTargets, qhkTargets, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qType, qhkType, _ := qs.GetOK("type")
+	if err := o.bindType(qType, qhkType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAcceptLanguage binds and validates parameter AcceptLanguage from header.
+func (o *AnalyzeInventoryParams) bindAcceptLanguage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.AcceptLanguage = &raw
+
+	return nil
+}
+
+// bindXGuildID binds and validates parameter XGuildID from header.
+func (o *AnalyzeInventoryParams) bindXGuildID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XGuildID = &raw
+
+	if err := o.validateXGuildID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateXGuildID carries on validations for parameter XGuildID
+func (o *AnalyzeInventoryParams) validateXGuildID(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("X-Guild-ID", "header", *o.XGuildID, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindXPlayerID binds and validates parameter XPlayerID from header.
+func (o *AnalyzeInventoryParams) bindXPlayerID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XPlayerID = &raw
+
+	if err := o.validateXPlayerID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateXPlayerID carries on validations for parameter XPlayerID
+func (o *AnalyzeInventoryParams) validateXPlayerID(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("X-Player-ID", "header", *o.XPlayerID, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindAffixIds binds and validates array parameter AffixIds from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *AnalyzeInventoryParams) bindAffixIds(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvAffixIds string
+	if len(rawData) > 0 {
+		qvAffixIds = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	affixIdsIC := swag.SplitByFormat(qvAffixIds, "csv")
+	if len(affixIdsIC) == 0 {
+		return nil
+	}
+
+	var affixIdsIR []int32
+	for i, affixIdsIV := range affixIdsIC {
+		// items.Format: "int32"
+		affixIdsI, err := swag.ConvertInt32(affixIdsIV)
+		if err != nil {
+			return errors.InvalidType(fmt.Sprintf("%s.%v", "affixIds", i), "query", "int32", affixIdsI)
+		}
+
+		affixIdsIR = append(affixIdsIR, affixIdsI)
+	}
+
+	o.AffixIds = affixIdsIR
+
+	return nil
+}
+
+// bindGameVersion binds and validates parameter GameVersion from query.
+func (o *AnalyzeInventoryParams) bindGameVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.GameVersion = &raw
+
+	return nil
+}
+
+// bindLang binds and validates parameter Lang from query.
+func (o *AnalyzeInventoryParams) bindLang(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Lang = &raw
+
+	return nil
+}
+
+// bindOrderIndependent binds and validates parameter OrderIndependent from query.
+func (o *AnalyzeInventoryParams) bindOrderIndependent(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewAnalyzeInventoryParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("orderIndependent", "query", "bool", raw)
+	}
+	o.OrderIndependent = &value
+
+	return nil
+}
+
+// bindTargetLevels binds and validates array parameter TargetLevels from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *AnalyzeInventoryParams) bindTargetLevels(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("targetLevels", "query", rawData)
+	}
+	var qvTargetLevels string
+	if len(rawData) > 0 {
+		qvTargetLevels = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	targetLevelsIC := swag.SplitByFormat(qvTargetLevels, "csv")
+	if len(targetLevelsIC) == 0 {
+		return errors.Required("targetLevels", "query", targetLevelsIC)
+	}
+
+	var targetLevelsIR []int32
+	for i, targetLevelsIV := range targetLevelsIC {
+		// items.Format: "int32"
+		targetLevelsI, err := swag.ConvertInt32(targetLevelsIV)
+		if err != nil {
+			return errors.InvalidType(fmt.Sprintf("%s.%v", "targetLevels", i), "query", "int32", targetLevelsI)
+		}
+
+		if err := validate.MinimumInt(fmt.Sprintf("%s.%v", "targetLevels", i), "query", int64(targetLevelsI), 1, false); err != nil {
+			return err
+		}
+
+		targetLevelsIR = append(targetLevelsIR, targetLevelsI)
+	}
+
+	o.TargetLevels = targetLevelsIR
+	if err := o.validateTargetLevels(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTargetLevels carries on validations for parameter TargetLevels
+func (o *AnalyzeInventoryParams) validateTargetLevels(formats strfmt.Registry) error {
+
+	targetLevelsSize := int64(len(o.TargetLevels))
+
+	// minItems: 1
+	if err := validate.MinItems("targetLevels", "query", targetLevelsSize, 1); err != nil {
+		return err
+	}
+
+	// maxItems: 10
+	if err := validate.MaxItems("targetLevels", "query", targetLevelsSize, 10); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTargets binds and validates parameter Targets from query.
+func (o *AnalyzeInventoryParams) bindTargets(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Targets = &raw
+
+	if err := o.validateTargets(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTargets carries on validations for parameter Targets
+func (o *AnalyzeInventoryParams) validateTargets(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("targets", "query", *o.Targets, 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindType binds and validates parameter Type from query.
+func (o *AnalyzeInventoryParams) bindType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Type = &raw
+
+	if err := o.validateType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateType carries on validations for parameter Type
+func (o *AnalyzeInventoryParams) validateType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("type", "query", *o.Type, []interface{}{"weapon", "helmet", "mask", "top", "gloves", "bottoms", "shoes"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
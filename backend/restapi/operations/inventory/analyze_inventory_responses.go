@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// AnalyzeInventoryOKCode is the HTTP code returned for type AnalyzeInventoryOK
+const AnalyzeInventoryOKCode int = 200
+
+/*
+AnalyzeInventoryOK 分析结果
+
+swagger:response analyzeInventoryOK
+*/
+type AnalyzeInventoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InventoryAnalysis `json:"body,omitempty"`
+}
+
+// NewAnalyzeInventoryOK creates AnalyzeInventoryOK with default headers values
+func NewAnalyzeInventoryOK() *AnalyzeInventoryOK {
+
+	return &AnalyzeInventoryOK{}
+}
+
+// WithPayload adds the payload to the analyze inventory o k response
+func (o *AnalyzeInventoryOK) WithPayload(payload *models.InventoryAnalysis) *AnalyzeInventoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the analyze inventory o k response
+func (o *AnalyzeInventoryOK) SetPayload(payload *models.InventoryAnalysis) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnalyzeInventoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AnalyzeInventoryBadRequestCode is the HTTP code returned for type AnalyzeInventoryBadRequest
+const AnalyzeInventoryBadRequestCode int = 400
+
+/*
+AnalyzeInventoryBadRequest 请求参数错误
+
+swagger:response analyzeInventoryBadRequest
+*/
+type AnalyzeInventoryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAnalyzeInventoryBadRequest creates AnalyzeInventoryBadRequest with default headers values
+func NewAnalyzeInventoryBadRequest() *AnalyzeInventoryBadRequest {
+
+	return &AnalyzeInventoryBadRequest{}
+}
+
+// WithPayload adds the payload to the analyze inventory bad request response
+func (o *AnalyzeInventoryBadRequest) WithPayload(payload *models.ErrorResponse) *AnalyzeInventoryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the analyze inventory bad request response
+func (o *AnalyzeInventoryBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnalyzeInventoryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AnalyzeInventoryServiceUnavailableCode is the HTTP code returned for type AnalyzeInventoryServiceUnavailable
+const AnalyzeInventoryServiceUnavailableCode int = 503
+
+/*
+AnalyzeInventoryServiceUnavailable 未启用数据库或服务正在停止
+
+swagger:response analyzeInventoryServiceUnavailable
+*/
+type AnalyzeInventoryServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAnalyzeInventoryServiceUnavailable creates AnalyzeInventoryServiceUnavailable with default headers values
+func NewAnalyzeInventoryServiceUnavailable() *AnalyzeInventoryServiceUnavailable {
+
+	return &AnalyzeInventoryServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the analyze inventory service unavailable response
+func (o *AnalyzeInventoryServiceUnavailable) WithPayload(payload *models.ErrorResponse) *AnalyzeInventoryServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the analyze inventory service unavailable response
+func (o *AnalyzeInventoryServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnalyzeInventoryServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// AnalyzeInventoryURL generates an URL for the analyze inventory operation
+type AnalyzeInventoryURL struct {
+	AffixIds         []int32
+	GameVersion      *string
+	Lang             *string
+	OrderIndependent *bool
+	TargetLevels     []int32
+	Targets          *string
+	Type             *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *AnalyzeInventoryURL) WithBasePath(bp string) *AnalyzeInventoryURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *AnalyzeInventoryURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *AnalyzeInventoryURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/inventory/analysis"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var affixIdsIR []string
+	for _, affixIdsI := range o.AffixIds {
+		affixIdsIS := swag.FormatInt32(affixIdsI)
+		if affixIdsIS != "" {
+			affixIdsIR = append(affixIdsIR, affixIdsIS)
+		}
+	}
+
+	affixIds := swag.JoinByFormat(affixIdsIR, "csv")
+
+	if len(affixIds) > 0 {
+		qsv := affixIds[0]
+		if qsv != "" {
+			qs.Set("affixIds", qsv)
+		}
+	}
+
+	var gameVersionQ string
+	if o.GameVersion != nil {
+		gameVersionQ = *o.GameVersion
+	}
+	if gameVersionQ != "" {
+		qs.Set("gameVersion", gameVersionQ)
+	}
+
+	var langQ string
+	if o.Lang != nil {
+		langQ = *o.Lang
+	}
+	if langQ != "" {
+		qs.Set("lang", langQ)
+	}
+
+	var orderIndependentQ string
+	if o.OrderIndependent != nil {
+		orderIndependentQ = swag.FormatBool(*o.OrderIndependent)
+	}
+	if orderIndependentQ != "" {
+		qs.Set("orderIndependent", orderIndependentQ)
+	}
+
+	var targetLevelsIR []string
+	for _, targetLevelsI := range o.TargetLevels {
+		targetLevelsIS := swag.FormatInt32(targetLevelsI)
+		if targetLevelsIS != "" {
+			targetLevelsIR = append(targetLevelsIR, targetLevelsIS)
+		}
+	}
+
+	targetLevels := swag.JoinByFormat(targetLevelsIR, "csv")
+
+	if len(targetLevels) > 0 {
+		qsv := targetLevels[0]
+		if qsv != "" {
+			qs.Set("targetLevels", qsv)
+		}
+	}
+
+	var targetsQ string
+	if o.Targets != nil {
+		targetsQ = *o.Targets
+	}
+	if targetsQ != "" {
+		qs.Set("targets", targetsQ)
+	}
+
+	var typeVarQ string
+	if o.Type != nil {
+		typeVarQ = *o.Type
+	}
+	if typeVarQ != "" {
+		qs.Set("type", typeVarQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *AnalyzeInventoryURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *AnalyzeInventoryURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *AnalyzeInventoryURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on AnalyzeInventoryURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on AnalyzeInventoryURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *AnalyzeInventoryURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
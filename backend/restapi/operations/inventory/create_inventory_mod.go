@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateInventoryModHandlerFunc turns a function with the right signature into a create inventory mod handler
+type CreateInventoryModHandlerFunc func(CreateInventoryModParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateInventoryModHandlerFunc) Handle(params CreateInventoryModParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateInventoryModHandler interface for that can handle valid create inventory mod params
+type CreateInventoryModHandler interface {
+	Handle(CreateInventoryModParams, interface{}) middleware.Responder
+}
+
+// NewCreateInventoryMod creates a new http.Handler for the create inventory mod operation
+func NewCreateInventoryMod(ctx *middleware.Context, handler CreateInventoryModHandler) *CreateInventoryMod {
+	return &CreateInventoryMod{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateInventoryMod swagger:route POST /inventory/mods Inventory createInventoryMod
+
+添加模组
+
+把模组添加到玩家背包，词条和等级按最新游戏版本的稀有度规则校验
+*/
+type CreateInventoryMod struct {
+	Context *middleware.Context
+	Handler CreateInventoryModHandler
+}
+
+func (o *CreateInventoryMod) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateInventoryModParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,216 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CreateInventoryModCreatedCode is the HTTP code returned for type CreateInventoryModCreated
+const CreateInventoryModCreatedCode int = 201
+
+/*
+CreateInventoryModCreated 已添加模组
+
+swagger:response createInventoryModCreated
+*/
+type CreateInventoryModCreated struct {
+	/*模组的地址
+
+	 */
+	Location string `json:"Location"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InventoryMod `json:"body,omitempty"`
+}
+
+// NewCreateInventoryModCreated creates CreateInventoryModCreated with default headers values
+func NewCreateInventoryModCreated() *CreateInventoryModCreated {
+
+	return &CreateInventoryModCreated{}
+}
+
+// WithLocation adds the location to the create inventory mod created response
+func (o *CreateInventoryModCreated) WithLocation(location string) *CreateInventoryModCreated {
+	o.Location = location
+	return o
+}
+
+// SetLocation sets the location to the create inventory mod created response
+func (o *CreateInventoryModCreated) SetLocation(location string) {
+	o.Location = location
+}
+
+// WithPayload adds the payload to the create inventory mod created response
+func (o *CreateInventoryModCreated) WithPayload(payload *models.InventoryMod) *CreateInventoryModCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create inventory mod created response
+func (o *CreateInventoryModCreated) SetPayload(payload *models.InventoryMod) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateInventoryModCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Location
+
+	location := o.Location
+	if location != "" {
+		rw.Header().Set("Location", location)
+	}
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateInventoryModBadRequestCode is the HTTP code returned for type CreateInventoryModBadRequest
+const CreateInventoryModBadRequestCode int = 400
+
+/*
+CreateInventoryModBadRequest 请求参数错误
+
+swagger:response createInventoryModBadRequest
+*/
+type CreateInventoryModBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateInventoryModBadRequest creates CreateInventoryModBadRequest with default headers values
+func NewCreateInventoryModBadRequest() *CreateInventoryModBadRequest {
+
+	return &CreateInventoryModBadRequest{}
+}
+
+// WithPayload adds the payload to the create inventory mod bad request response
+func (o *CreateInventoryModBadRequest) WithPayload(payload *models.ErrorResponse) *CreateInventoryModBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create inventory mod bad request response
+func (o *CreateInventoryModBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateInventoryModBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateInventoryModConflictCode is the HTTP code returned for type CreateInventoryModConflict
+const CreateInventoryModConflictCode int = 409
+
+/*
+CreateInventoryModConflict 背包已满
+
+swagger:response createInventoryModConflict
+*/
+type CreateInventoryModConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateInventoryModConflict creates CreateInventoryModConflict with default headers values
+func NewCreateInventoryModConflict() *CreateInventoryModConflict {
+
+	return &CreateInventoryModConflict{}
+}
+
+// WithPayload adds the payload to the create inventory mod conflict response
+func (o *CreateInventoryModConflict) WithPayload(payload *models.ErrorResponse) *CreateInventoryModConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create inventory mod conflict response
+func (o *CreateInventoryModConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateInventoryModConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateInventoryModServiceUnavailableCode is the HTTP code returned for type CreateInventoryModServiceUnavailable
+const CreateInventoryModServiceUnavailableCode int = 503
+
+/*
+CreateInventoryModServiceUnavailable 未启用数据库
+
+swagger:response createInventoryModServiceUnavailable
+*/
+type CreateInventoryModServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateInventoryModServiceUnavailable creates CreateInventoryModServiceUnavailable with default headers values
+func NewCreateInventoryModServiceUnavailable() *CreateInventoryModServiceUnavailable {
+
+	return &CreateInventoryModServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the create inventory mod service unavailable response
+func (o *CreateInventoryModServiceUnavailable) WithPayload(payload *models.ErrorResponse) *CreateInventoryModServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create inventory mod service unavailable response
+func (o *CreateInventoryModServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateInventoryModServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteInventoryModHandlerFunc turns a function with the right signature into a delete inventory mod handler
+type DeleteInventoryModHandlerFunc func(DeleteInventoryModParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteInventoryModHandlerFunc) Handle(params DeleteInventoryModParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteInventoryModHandler interface for that can handle valid delete inventory mod params
+type DeleteInventoryModHandler interface {
+	Handle(DeleteInventoryModParams, interface{}) middleware.Responder
+}
+
+// NewDeleteInventoryMod creates a new http.Handler for the delete inventory mod operation
+func NewDeleteInventoryMod(ctx *middleware.Context, handler DeleteInventoryModHandler) *DeleteInventoryMod {
+	return &DeleteInventoryMod{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteInventoryMod swagger:route DELETE /inventory/mods/{id} Inventory deleteInventoryMod
+
+删除模组
+*/
+type DeleteInventoryMod struct {
+	Context *middleware.Context
+	Handler DeleteInventoryModHandler
+}
+
+func (o *DeleteInventoryMod) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteInventoryModParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
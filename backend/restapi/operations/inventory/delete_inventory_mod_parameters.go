@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteInventoryModParams creates a new DeleteInventoryModParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteInventoryModParams() DeleteInventoryModParams {
+
+	return DeleteInventoryModParams{}
+}
+
+// DeleteInventoryModParams contains all the bound params for the delete inventory mod operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deleteInventoryMod
+type DeleteInventoryModParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*浏览器语言偏好，未指定lang时使用，默认zh-CN
+	  In: header
+	*/
+	AcceptLanguage *string
+	/*玩家ID，同一API key下区分不同玩家的背包（如机器人按聊天平台用户区分），默认default；不同key的玩家互相独立
+	  Max Length: 64
+	  In: header
+	*/
+	XPlayerID *string
+	/*模组ID
+	  Required: true
+	  In: path
+	*/
+	ID string
+	/*响应语言（如zh-CN、en），优先于Accept-Language，不支持的语言会被忽略
+	  In: query
+	*/
+	Lang *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteInventoryModParams() beforehand.
+func (o *DeleteInventoryModParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindAcceptLanguage(r.Header[http.CanonicalHeaderKey("Accept-Language")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXPlayerID(r.Header[http.CanonicalHeaderKey("X-Player-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLang, qhkLang, _ := qs.GetOK("lang")
+	if err := o.bindLang(qLang, qhkLang, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAcceptLanguage binds and validates parameter AcceptLanguage from header.
+func (o *DeleteInventoryModParams) bindAcceptLanguage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.AcceptLanguage = &raw
+
+	return nil
+}
+
+// bindXPlayerID binds and validates parameter XPlayerID from header.
+func (o *DeleteInventoryModParams) bindXPlayerID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XPlayerID = &raw
+
+	if err := o.validateXPlayerID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateXPlayerID carries on validations for parameter XPlayerID
+func (o *DeleteInventoryModParams) validateXPlayerID(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("X-Player-ID", "header", *o.XPlayerID, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *DeleteInventoryModParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ID = raw
+
+	return nil
+}
+
+// bindLang binds and validates parameter Lang from query.
+func (o *DeleteInventoryModParams) bindLang(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Lang = &raw
+
+	return nil
+}
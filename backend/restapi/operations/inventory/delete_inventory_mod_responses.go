@@ -0,0 +1,129 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DeleteInventoryModNoContentCode is the HTTP code returned for type DeleteInventoryModNoContent
+const DeleteInventoryModNoContentCode int = 204
+
+/*
+DeleteInventoryModNoContent 已删除模组
+
+swagger:response deleteInventoryModNoContent
+*/
+type DeleteInventoryModNoContent struct {
+}
+
+// NewDeleteInventoryModNoContent creates DeleteInventoryModNoContent with default headers values
+func NewDeleteInventoryModNoContent() *DeleteInventoryModNoContent {
+
+	return &DeleteInventoryModNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteInventoryModNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteInventoryModNotFoundCode is the HTTP code returned for type DeleteInventoryModNotFound
+const DeleteInventoryModNotFoundCode int = 404
+
+/*
+DeleteInventoryModNotFound 模组不存在
+
+swagger:response deleteInventoryModNotFound
+*/
+type DeleteInventoryModNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteInventoryModNotFound creates DeleteInventoryModNotFound with default headers values
+func NewDeleteInventoryModNotFound() *DeleteInventoryModNotFound {
+
+	return &DeleteInventoryModNotFound{}
+}
+
+// WithPayload adds the payload to the delete inventory mod not found response
+func (o *DeleteInventoryModNotFound) WithPayload(payload *models.ErrorResponse) *DeleteInventoryModNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete inventory mod not found response
+func (o *DeleteInventoryModNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteInventoryModNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteInventoryModServiceUnavailableCode is the HTTP code returned for type DeleteInventoryModServiceUnavailable
+const DeleteInventoryModServiceUnavailableCode int = 503
+
+/*
+DeleteInventoryModServiceUnavailable 未启用数据库
+
+swagger:response deleteInventoryModServiceUnavailable
+*/
+type DeleteInventoryModServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteInventoryModServiceUnavailable creates DeleteInventoryModServiceUnavailable with default headers values
+func NewDeleteInventoryModServiceUnavailable() *DeleteInventoryModServiceUnavailable {
+
+	return &DeleteInventoryModServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the delete inventory mod service unavailable response
+func (o *DeleteInventoryModServiceUnavailable) WithPayload(payload *models.ErrorResponse) *DeleteInventoryModServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete inventory mod service unavailable response
+func (o *DeleteInventoryModServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteInventoryModServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetInventoryModHandlerFunc turns a function with the right signature into a get inventory mod handler
+type GetInventoryModHandlerFunc func(GetInventoryModParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetInventoryModHandlerFunc) Handle(params GetInventoryModParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetInventoryModHandler interface for that can handle valid get inventory mod params
+type GetInventoryModHandler interface {
+	Handle(GetInventoryModParams, interface{}) middleware.Responder
+}
+
+// NewGetInventoryMod creates a new http.Handler for the get inventory mod operation
+func NewGetInventoryMod(ctx *middleware.Context, handler GetInventoryModHandler) *GetInventoryMod {
+	return &GetInventoryMod{Context: ctx, Handler: handler}
+}
+
+/*
+	GetInventoryMod swagger:route GET /inventory/mods/{id} Inventory getInventoryMod
+
+获取模组
+*/
+type GetInventoryMod struct {
+	Context *middleware.Context
+	Handler GetInventoryModHandler
+}
+
+func (o *GetInventoryMod) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetInventoryModParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
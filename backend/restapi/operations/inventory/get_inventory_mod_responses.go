@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetInventoryModOKCode is the HTTP code returned for type GetInventoryModOK
+const GetInventoryModOKCode int = 200
+
+/*
+GetInventoryModOK 成功获取模组
+
+swagger:response getInventoryModOK
+*/
+type GetInventoryModOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InventoryMod `json:"body,omitempty"`
+}
+
+// NewGetInventoryModOK creates GetInventoryModOK with default headers values
+func NewGetInventoryModOK() *GetInventoryModOK {
+
+	return &GetInventoryModOK{}
+}
+
+// WithPayload adds the payload to the get inventory mod o k response
+func (o *GetInventoryModOK) WithPayload(payload *models.InventoryMod) *GetInventoryModOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get inventory mod o k response
+func (o *GetInventoryModOK) SetPayload(payload *models.InventoryMod) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInventoryModOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInventoryModNotFoundCode is the HTTP code returned for type GetInventoryModNotFound
+const GetInventoryModNotFoundCode int = 404
+
+/*
+GetInventoryModNotFound 模组不存在
+
+swagger:response getInventoryModNotFound
+*/
+type GetInventoryModNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetInventoryModNotFound creates GetInventoryModNotFound with default headers values
+func NewGetInventoryModNotFound() *GetInventoryModNotFound {
+
+	return &GetInventoryModNotFound{}
+}
+
+// WithPayload adds the payload to the get inventory mod not found response
+func (o *GetInventoryModNotFound) WithPayload(payload *models.ErrorResponse) *GetInventoryModNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get inventory mod not found response
+func (o *GetInventoryModNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInventoryModNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInventoryModServiceUnavailableCode is the HTTP code returned for type GetInventoryModServiceUnavailable
+const GetInventoryModServiceUnavailableCode int = 503
+
+/*
+GetInventoryModServiceUnavailable 未启用数据库
+
+swagger:response getInventoryModServiceUnavailable
+*/
+type GetInventoryModServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetInventoryModServiceUnavailable creates GetInventoryModServiceUnavailable with default headers values
+func NewGetInventoryModServiceUnavailable() *GetInventoryModServiceUnavailable {
+
+	return &GetInventoryModServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the get inventory mod service unavailable response
+func (o *GetInventoryModServiceUnavailable) WithPayload(payload *models.ErrorResponse) *GetInventoryModServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get inventory mod service unavailable response
+func (o *GetInventoryModServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInventoryModServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListInventoryModsHandlerFunc turns a function with the right signature into a list inventory mods handler
+type ListInventoryModsHandlerFunc func(ListInventoryModsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListInventoryModsHandlerFunc) Handle(params ListInventoryModsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListInventoryModsHandler interface for that can handle valid list inventory mods params
+type ListInventoryModsHandler interface {
+	Handle(ListInventoryModsParams, interface{}) middleware.Responder
+}
+
+// NewListInventoryMods creates a new http.Handler for the list inventory mods operation
+func NewListInventoryMods(ctx *middleware.Context, handler ListInventoryModsHandler) *ListInventoryMods {
+	return &ListInventoryMods{Context: ctx, Handler: handler}
+}
+
+/*
+	ListInventoryMods swagger:route GET /inventory/mods Inventory listInventoryMods
+
+获取背包中的模组
+
+获取玩家背包中的模组，最近更新的在前
+*/
+type ListInventoryMods struct {
+	Context *middleware.Context
+	Handler ListInventoryModsHandler
+}
+
+func (o *ListInventoryMods) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListInventoryModsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListInventoryModsOKCode is the HTTP code returned for type ListInventoryModsOK
+const ListInventoryModsOKCode int = 200
+
+/*
+ListInventoryModsOK 成功获取模组列表
+
+swagger:response listInventoryModsOK
+*/
+type ListInventoryModsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InventoryModListResponse `json:"body,omitempty"`
+}
+
+// NewListInventoryModsOK creates ListInventoryModsOK with default headers values
+func NewListInventoryModsOK() *ListInventoryModsOK {
+
+	return &ListInventoryModsOK{}
+}
+
+// WithPayload adds the payload to the list inventory mods o k response
+func (o *ListInventoryModsOK) WithPayload(payload *models.InventoryModListResponse) *ListInventoryModsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list inventory mods o k response
+func (o *ListInventoryModsOK) SetPayload(payload *models.InventoryModListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListInventoryModsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListInventoryModsServiceUnavailableCode is the HTTP code returned for type ListInventoryModsServiceUnavailable
+const ListInventoryModsServiceUnavailableCode int = 503
+
+/*
+ListInventoryModsServiceUnavailable 未启用数据库
+
+swagger:response listInventoryModsServiceUnavailable
+*/
+type ListInventoryModsServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListInventoryModsServiceUnavailable creates ListInventoryModsServiceUnavailable with default headers values
+func NewListInventoryModsServiceUnavailable() *ListInventoryModsServiceUnavailable {
+
+	return &ListInventoryModsServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the list inventory mods service unavailable response
+func (o *ListInventoryModsServiceUnavailable) WithPayload(payload *models.ErrorResponse) *ListInventoryModsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list inventory mods service unavailable response
+func (o *ListInventoryModsServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListInventoryModsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateInventoryModHandlerFunc turns a function with the right signature into a update inventory mod handler
+type UpdateInventoryModHandlerFunc func(UpdateInventoryModParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateInventoryModHandlerFunc) Handle(params UpdateInventoryModParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateInventoryModHandler interface for that can handle valid update inventory mod params
+type UpdateInventoryModHandler interface {
+	Handle(UpdateInventoryModParams, interface{}) middleware.Responder
+}
+
+// NewUpdateInventoryMod creates a new http.Handler for the update inventory mod operation
+func NewUpdateInventoryMod(ctx *middleware.Context, handler UpdateInventoryModHandler) *UpdateInventoryMod {
+	return &UpdateInventoryMod{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateInventoryMod swagger:route PUT /inventory/mods/{id} Inventory updateInventoryMod
+
+更新模组
+
+替换模组的全部字段，通常在强化后更新词条等级和已强化次数
+*/
+type UpdateInventoryMod struct {
+	Context *middleware.Context
+	Handler UpdateInventoryModHandler
+}
+
+func (o *UpdateInventoryMod) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateInventoryModParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
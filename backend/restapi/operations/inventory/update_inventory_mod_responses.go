@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// UpdateInventoryModOKCode is the HTTP code returned for type UpdateInventoryModOK
+const UpdateInventoryModOKCode int = 200
+
+/*
+UpdateInventoryModOK 已更新模组
+
+swagger:response updateInventoryModOK
+*/
+type UpdateInventoryModOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InventoryMod `json:"body,omitempty"`
+}
+
+// NewUpdateInventoryModOK creates UpdateInventoryModOK with default headers values
+func NewUpdateInventoryModOK() *UpdateInventoryModOK {
+
+	return &UpdateInventoryModOK{}
+}
+
+// WithPayload adds the payload to the update inventory mod o k response
+func (o *UpdateInventoryModOK) WithPayload(payload *models.InventoryMod) *UpdateInventoryModOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update inventory mod o k response
+func (o *UpdateInventoryModOK) SetPayload(payload *models.InventoryMod) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateInventoryModOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateInventoryModBadRequestCode is the HTTP code returned for type UpdateInventoryModBadRequest
+const UpdateInventoryModBadRequestCode int = 400
+
+/*
+UpdateInventoryModBadRequest 请求参数错误
+
+swagger:response updateInventoryModBadRequest
+*/
+type UpdateInventoryModBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateInventoryModBadRequest creates UpdateInventoryModBadRequest with default headers values
+func NewUpdateInventoryModBadRequest() *UpdateInventoryModBadRequest {
+
+	return &UpdateInventoryModBadRequest{}
+}
+
+// WithPayload adds the payload to the update inventory mod bad request response
+func (o *UpdateInventoryModBadRequest) WithPayload(payload *models.ErrorResponse) *UpdateInventoryModBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update inventory mod bad request response
+func (o *UpdateInventoryModBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateInventoryModBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateInventoryModNotFoundCode is the HTTP code returned for type UpdateInventoryModNotFound
+const UpdateInventoryModNotFoundCode int = 404
+
+/*
+UpdateInventoryModNotFound 模组不存在
+
+swagger:response updateInventoryModNotFound
+*/
+type UpdateInventoryModNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateInventoryModNotFound creates UpdateInventoryModNotFound with default headers values
+func NewUpdateInventoryModNotFound() *UpdateInventoryModNotFound {
+
+	return &UpdateInventoryModNotFound{}
+}
+
+// WithPayload adds the payload to the update inventory mod not found response
+func (o *UpdateInventoryModNotFound) WithPayload(payload *models.ErrorResponse) *UpdateInventoryModNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update inventory mod not found response
+func (o *UpdateInventoryModNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateInventoryModNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateInventoryModServiceUnavailableCode is the HTTP code returned for type UpdateInventoryModServiceUnavailable
+const UpdateInventoryModServiceUnavailableCode int = 503
+
+/*
+UpdateInventoryModServiceUnavailable 未启用数据库
+
+swagger:response updateInventoryModServiceUnavailable
+*/
+type UpdateInventoryModServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateInventoryModServiceUnavailable creates UpdateInventoryModServiceUnavailable with default headers values
+func NewUpdateInventoryModServiceUnavailable() *UpdateInventoryModServiceUnavailable {
+
+	return &UpdateInventoryModServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the update inventory mod service unavailable response
+func (o *UpdateInventoryModServiceUnavailable) WithPayload(payload *models.ErrorResponse) *UpdateInventoryModServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update inventory mod service unavailable response
+func (o *UpdateInventoryModServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateInventoryModServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
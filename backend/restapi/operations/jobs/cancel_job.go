@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CancelJobHandlerFunc turns a function with the right signature into a cancel job handler
+type CancelJobHandlerFunc func(CancelJobParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelJobHandlerFunc) Handle(params CancelJobParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CancelJobHandler interface for that can handle valid cancel job params
+type CancelJobHandler interface {
+	Handle(CancelJobParams, interface{}) middleware.Responder
+}
+
+// NewCancelJob creates a new http.Handler for the cancel job operation
+func NewCancelJob(ctx *middleware.Context, handler CancelJobHandler) *CancelJob {
+	return &CancelJob{Context: ctx, Handler: handler}
+}
+
+/*
+	CancelJob swagger:route DELETE /jobs/{id} Jobs cancelJob
+
+取消任务
+
+取消排队中或执行中的任务，已结束的任务不受影响
+*/
+type CancelJob struct {
+	Context *middleware.Context
+	Handler CancelJobHandler
+}
+
+func (o *CancelJob) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCancelJobParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CancelJobOKCode is the HTTP code returned for type CancelJobOK
+const CancelJobOKCode int = 200
+
+/*
+CancelJobOK 已请求取消，返回当前任务状态，执行中的任务稍后变为canceled
+
+swagger:response cancelJobOK
+*/
+type CancelJobOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Job `json:"body,omitempty"`
+}
+
+// NewCancelJobOK creates CancelJobOK with default headers values
+func NewCancelJobOK() *CancelJobOK {
+
+	return &CancelJobOK{}
+}
+
+// WithPayload adds the payload to the cancel job o k response
+func (o *CancelJobOK) WithPayload(payload *models.Job) *CancelJobOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel job o k response
+func (o *CancelJobOK) SetPayload(payload *models.Job) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelJobOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelJobNotFoundCode is the HTTP code returned for type CancelJobNotFound
+const CancelJobNotFoundCode int = 404
+
+/*
+CancelJobNotFound 任务不存在、已过期或由其他API key提交
+
+swagger:response cancelJobNotFound
+*/
+type CancelJobNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelJobNotFound creates CancelJobNotFound with default headers values
+func NewCancelJobNotFound() *CancelJobNotFound {
+
+	return &CancelJobNotFound{}
+}
+
+// WithPayload adds the payload to the cancel job not found response
+func (o *CancelJobNotFound) WithPayload(payload *models.ErrorResponse) *CancelJobNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel job not found response
+func (o *CancelJobNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelJobNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
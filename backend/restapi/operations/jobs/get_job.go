@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetJobHandlerFunc turns a function with the right signature into a get job handler
+type GetJobHandlerFunc func(GetJobParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetJobHandlerFunc) Handle(params GetJobParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetJobHandler interface for that can handle valid get job params
+type GetJobHandler interface {
+	Handle(GetJobParams, interface{}) middleware.Responder
+}
+
+// NewGetJob creates a new http.Handler for the get job operation
+func NewGetJob(ctx *middleware.Context, handler GetJobHandler) *GetJob {
+	return &GetJob{Context: ctx, Handler: handler}
+}
+
+/*
+	GetJob swagger:route GET /jobs/{id} Jobs getJob
+
+获取任务状态
+
+获取任务状态和进度，任务结束后保留一段时间，过期后返回404
+*/
+type GetJob struct {
+	Context *middleware.Context
+	Handler GetJobHandler
+}
+
+func (o *GetJob) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetJobParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetJobOKCode is the HTTP code returned for type GetJobOK
+const GetJobOKCode int = 200
+
+/*
+GetJobOK 成功获取任务状态
+
+swagger:response getJobOK
+*/
+type GetJobOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Job `json:"body,omitempty"`
+}
+
+// NewGetJobOK creates GetJobOK with default headers values
+func NewGetJobOK() *GetJobOK {
+
+	return &GetJobOK{}
+}
+
+// WithPayload adds the payload to the get job o k response
+func (o *GetJobOK) WithPayload(payload *models.Job) *GetJobOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get job o k response
+func (o *GetJobOK) SetPayload(payload *models.Job) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetJobOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetJobNotFoundCode is the HTTP code returned for type GetJobNotFound
+const GetJobNotFoundCode int = 404
+
+/*
+GetJobNotFound 任务不存在、已过期或由其他API key提交
+
+swagger:response getJobNotFound
+*/
+type GetJobNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetJobNotFound creates GetJobNotFound with default headers values
+func NewGetJobNotFound() *GetJobNotFound {
+
+	return &GetJobNotFound{}
+}
+
+// WithPayload adds the payload to the get job not found response
+func (o *GetJobNotFound) WithPayload(payload *models.ErrorResponse) *GetJobNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get job not found response
+func (o *GetJobNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetJobNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetJobResultHandlerFunc turns a function with the right signature into a get job result handler
+type GetJobResultHandlerFunc func(GetJobResultParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetJobResultHandlerFunc) Handle(params GetJobResultParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetJobResultHandler interface for that can handle valid get job result params
+type GetJobResultHandler interface {
+	Handle(GetJobResultParams, interface{}) middleware.Responder
+}
+
+// NewGetJobResult creates a new http.Handler for the get job result operation
+func NewGetJobResult(ctx *middleware.Context, handler GetJobResultHandler) *GetJobResult {
+	return &GetJobResult{Context: ctx, Handler: handler}
+}
+
+/*
+	GetJobResult swagger:route GET /jobs/{id}/result Jobs getJobResult
+
+获取任务结果
+
+获取已完成任务的计算结果，结果按本次请求的语言输出。
+CSV、Markdown和JSON Lines与对应的同步计算接口格式相同
+*/
+type GetJobResult struct {
+	Context *middleware.Context
+	Handler GetJobResultHandler
+}
+
+func (o *GetJobResult) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetJobResultParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetJobResultOKCode is the HTTP code returned for type GetJobResultOK
+const GetJobResultOKCode int = 200
+
+/*
+GetJobResultOK 成功获取任务结果
+
+swagger:response getJobResultOK
+*/
+type GetJobResultOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.JobResult `json:"body,omitempty"`
+}
+
+// NewGetJobResultOK creates GetJobResultOK with default headers values
+func NewGetJobResultOK() *GetJobResultOK {
+
+	return &GetJobResultOK{}
+}
+
+// WithPayload adds the payload to the get job result o k response
+func (o *GetJobResultOK) WithPayload(payload *models.JobResult) *GetJobResultOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get job result o k response
+func (o *GetJobResultOK) SetPayload(payload *models.JobResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetJobResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetJobResultNotFoundCode is the HTTP code returned for type GetJobResultNotFound
+const GetJobResultNotFoundCode int = 404
+
+/*
+GetJobResultNotFound 任务不存在、已过期或由其他API key提交
+
+swagger:response getJobResultNotFound
+*/
+type GetJobResultNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetJobResultNotFound creates GetJobResultNotFound with default headers values
+func NewGetJobResultNotFound() *GetJobResultNotFound {
+
+	return &GetJobResultNotFound{}
+}
+
+// WithPayload adds the payload to the get job result not found response
+func (o *GetJobResultNotFound) WithPayload(payload *models.ErrorResponse) *GetJobResultNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get job result not found response
+func (o *GetJobResultNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetJobResultNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetJobResultConflictCode is the HTTP code returned for type GetJobResultConflict
+const GetJobResultConflictCode int = 409
+
+/*
+GetJobResultConflict 任务尚未完成、执行失败或已取消
+
+swagger:response getJobResultConflict
+*/
+type GetJobResultConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetJobResultConflict creates GetJobResultConflict with default headers values
+func NewGetJobResultConflict() *GetJobResultConflict {
+
+	return &GetJobResultConflict{}
+}
+
+// WithPayload adds the payload to the get job result conflict response
+func (o *GetJobResultConflict) WithPayload(payload *models.ErrorResponse) *GetJobResultConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get job result conflict response
+func (o *GetJobResultConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetJobResultConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
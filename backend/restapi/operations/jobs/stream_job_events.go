@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// StreamJobEventsHandlerFunc turns a function with the right signature into a stream job events handler
+type StreamJobEventsHandlerFunc func(StreamJobEventsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StreamJobEventsHandlerFunc) Handle(params StreamJobEventsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// StreamJobEventsHandler interface for that can handle valid stream job events params
+type StreamJobEventsHandler interface {
+	Handle(StreamJobEventsParams, interface{}) middleware.Responder
+}
+
+// NewStreamJobEvents creates a new http.Handler for the stream job events operation
+func NewStreamJobEvents(ctx *middleware.Context, handler StreamJobEventsHandler) *StreamJobEvents {
+	return &StreamJobEvents{Context: ctx, Handler: handler}
+}
+
+/*
+	StreamJobEvents swagger:route GET /jobs/{id}/events Jobs streamJobEvents
+
+订阅任务进度
+
+以Server-Sent Events推送任务状态。每个事件的data为Job：
+progress事件在任务开始执行、进度和概率估计变化时推送；
+completed事件在任务结束时推送一次，之后连接关闭，结果通过getJobResult获取。
+连接空闲时定期发送注释行保持连接。
+*/
+type StreamJobEvents struct {
+	Context *middleware.Context
+	Handler StreamJobEventsHandler
+}
+
+func (o *StreamJobEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewStreamJobEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// StreamJobEventsOKCode is the HTTP code returned for type StreamJobEventsOK
+const StreamJobEventsOKCode int = 200
+
+/*
+StreamJobEventsOK 事件流，每个事件的data为Job
+
+swagger:response streamJobEventsOK
+*/
+type StreamJobEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Job `json:"body,omitempty"`
+}
+
+// NewStreamJobEventsOK creates StreamJobEventsOK with default headers values
+func NewStreamJobEventsOK() *StreamJobEventsOK {
+
+	return &StreamJobEventsOK{}
+}
+
+// WithPayload adds the payload to the stream job events o k response
+func (o *StreamJobEventsOK) WithPayload(payload *models.Job) *StreamJobEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream job events o k response
+func (o *StreamJobEventsOK) SetPayload(payload *models.Job) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamJobEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamJobEventsNotFoundCode is the HTTP code returned for type StreamJobEventsNotFound
+const StreamJobEventsNotFoundCode int = 404
+
+/*
+StreamJobEventsNotFound 任务不存在、已过期或由其他API key提交
+
+swagger:response streamJobEventsNotFound
+*/
+type StreamJobEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewStreamJobEventsNotFound creates StreamJobEventsNotFound with default headers values
+func NewStreamJobEventsNotFound() *StreamJobEventsNotFound {
+
+	return &StreamJobEventsNotFound{}
+}
+
+// WithPayload adds the payload to the stream job events not found response
+func (o *StreamJobEventsNotFound) WithPayload(payload *models.ErrorResponse) *StreamJobEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream job events not found response
+func (o *StreamJobEventsNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamJobEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
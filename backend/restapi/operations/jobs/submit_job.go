@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SubmitJobHandlerFunc turns a function with the right signature into a submit job handler
+type SubmitJobHandlerFunc func(SubmitJobParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SubmitJobHandlerFunc) Handle(params SubmitJobParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SubmitJobHandler interface for that can handle valid submit job params
+type SubmitJobHandler interface {
+	Handle(SubmitJobParams, interface{}) middleware.Responder
+}
+
+// NewSubmitJob creates a new http.Handler for the submit job operation
+func NewSubmitJob(ctx *middleware.Context, handler SubmitJobHandler) *SubmitJob {
+	return &SubmitJob{Context: ctx, Handler: handler}
+}
+
+/*
+	SubmitJob swagger:route POST /jobs Jobs submitJob
+
+提交异步计算任务
+
+提交耗时较长的计算任务，立即返回任务ID，之后轮询任务状态并获取结果
+*/
+type SubmitJob struct {
+	Context *middleware.Context
+	Handler SubmitJobHandler
+}
+
+func (o *SubmitJob) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSubmitJobParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
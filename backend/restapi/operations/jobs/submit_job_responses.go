@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package jobs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SubmitJobAcceptedCode is the HTTP code returned for type SubmitJobAccepted
+const SubmitJobAcceptedCode int = 202
+
+/*
+SubmitJobAccepted 任务已提交
+
+swagger:response submitJobAccepted
+*/
+type SubmitJobAccepted struct {
+	/*任务状态的地址
+
+	 */
+	Location string `json:"Location"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Job `json:"body,omitempty"`
+}
+
+// NewSubmitJobAccepted creates SubmitJobAccepted with default headers values
+func NewSubmitJobAccepted() *SubmitJobAccepted {
+
+	return &SubmitJobAccepted{}
+}
+
+// WithLocation adds the location to the submit job accepted response
+func (o *SubmitJobAccepted) WithLocation(location string) *SubmitJobAccepted {
+	o.Location = location
+	return o
+}
+
+// SetLocation sets the location to the submit job accepted response
+func (o *SubmitJobAccepted) SetLocation(location string) {
+	o.Location = location
+}
+
+// WithPayload adds the payload to the submit job accepted response
+func (o *SubmitJobAccepted) WithPayload(payload *models.Job) *SubmitJobAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the submit job accepted response
+func (o *SubmitJobAccepted) SetPayload(payload *models.Job) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubmitJobAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Location
+
+	location := o.Location
+	if location != "" {
+		rw.Header().Set("Location", location)
+	}
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SubmitJobBadRequestCode is the HTTP code returned for type SubmitJobBadRequest
+const SubmitJobBadRequestCode int = 400
+
+/*
+SubmitJobBadRequest 请求参数错误
+
+swagger:response submitJobBadRequest
+*/
+type SubmitJobBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSubmitJobBadRequest creates SubmitJobBadRequest with default headers values
+func NewSubmitJobBadRequest() *SubmitJobBadRequest {
+
+	return &SubmitJobBadRequest{}
+}
+
+// WithPayload adds the payload to the submit job bad request response
+func (o *SubmitJobBadRequest) WithPayload(payload *models.ErrorResponse) *SubmitJobBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the submit job bad request response
+func (o *SubmitJobBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubmitJobBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SubmitJobServiceUnavailableCode is the HTTP code returned for type SubmitJobServiceUnavailable
+const SubmitJobServiceUnavailableCode int = 503
+
+/*
+SubmitJobServiceUnavailable 任务队列已满或服务正在停止，稍后重试
+
+swagger:response submitJobServiceUnavailable
+*/
+type SubmitJobServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSubmitJobServiceUnavailable creates SubmitJobServiceUnavailable with default headers values
+func NewSubmitJobServiceUnavailable() *SubmitJobServiceUnavailable {
+
+	return &SubmitJobServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the submit job service unavailable response
+func (o *SubmitJobServiceUnavailable) WithPayload(payload *models.ErrorResponse) *SubmitJobServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the submit job service unavailable response
+func (o *SubmitJobServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubmitJobServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
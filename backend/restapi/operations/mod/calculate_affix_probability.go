@@ -12,16 +12,16 @@ import (
 )
 
 // CalculateAffixProbabilityHandlerFunc turns a function with the right signature into a calculate affix probability handler
-type CalculateAffixProbabilityHandlerFunc func(CalculateAffixProbabilityParams) middleware.Responder
+type CalculateAffixProbabilityHandlerFunc func(CalculateAffixProbabilityParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn CalculateAffixProbabilityHandlerFunc) Handle(params CalculateAffixProbabilityParams) middleware.Responder {
-	return fn(params)
+func (fn CalculateAffixProbabilityHandlerFunc) Handle(params CalculateAffixProbabilityParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // CalculateAffixProbabilityHandler interface for that can handle valid calculate affix probability params
 type CalculateAffixProbabilityHandler interface {
-	Handle(CalculateAffixProbabilityParams) middleware.Responder
+	Handle(CalculateAffixProbabilityParams, interface{}) middleware.Responder
 }
 
 // NewCalculateAffixProbability creates a new http.Handler for the calculate affix probability operation
@@ -47,12 +47,25 @@ func (o *CalculateAffixProbability) ServeHTTP(rw http.ResponseWriter, r *http.Re
 		*r = *rCtx
 	}
 	var Params = NewCalculateAffixProbabilityParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -22,6 +22,10 @@ CalculateAffixProbabilityOK 计算成功
 swagger:response calculateAffixProbabilityOK
 */
 type CalculateAffixProbabilityOK struct {
+	/*计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +39,17 @@ func NewCalculateAffixProbabilityOK() *CalculateAffixProbabilityOK {
 	return &CalculateAffixProbabilityOK{}
 }
 
+// WithETag adds the eTag to the calculate affix probability o k response
+func (o *CalculateAffixProbabilityOK) WithETag(eTag string) *CalculateAffixProbabilityOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate affix probability o k response
+func (o *CalculateAffixProbabilityOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the calculate affix probability o k response
 func (o *CalculateAffixProbabilityOK) WithPayload(payload *models.AffixProbabilityResponse) *CalculateAffixProbabilityOK {
 	o.Payload = payload
@@ -49,6 +64,13 @@ func (o *CalculateAffixProbabilityOK) SetPayload(payload *models.AffixProbabilit
 // WriteResponse to the client
 func (o *CalculateAffixProbabilityOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +80,53 @@ func (o *CalculateAffixProbabilityOK) WriteResponse(rw http.ResponseWriter, prod
 	}
 }
 
+// CalculateAffixProbabilityNotModifiedCode is the HTTP code returned for type CalculateAffixProbabilityNotModified
+const CalculateAffixProbabilityNotModifiedCode int = 304
+
+/*
+CalculateAffixProbabilityNotModified 结果与If-None-Match中的ETag相同，不返回响应体
+
+swagger:response calculateAffixProbabilityNotModified
+*/
+type CalculateAffixProbabilityNotModified struct {
+	/*计算结果的实体标签
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewCalculateAffixProbabilityNotModified creates CalculateAffixProbabilityNotModified with default headers values
+func NewCalculateAffixProbabilityNotModified() *CalculateAffixProbabilityNotModified {
+
+	return &CalculateAffixProbabilityNotModified{}
+}
+
+// WithETag adds the eTag to the calculate affix probability not modified response
+func (o *CalculateAffixProbabilityNotModified) WithETag(eTag string) *CalculateAffixProbabilityNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate affix probability not modified response
+func (o *CalculateAffixProbabilityNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *CalculateAffixProbabilityNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 // CalculateAffixProbabilityBadRequestCode is the HTTP code returned for type CalculateAffixProbabilityBadRequest
 const CalculateAffixProbabilityBadRequestCode int = 400
 
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CalculateAffixValueProbabilityHandlerFunc turns a function with the right signature into a calculate affix value probability handler
+type CalculateAffixValueProbabilityHandlerFunc func(CalculateAffixValueProbabilityParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CalculateAffixValueProbabilityHandlerFunc) Handle(params CalculateAffixValueProbabilityParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CalculateAffixValueProbabilityHandler interface for that can handle valid calculate affix value probability params
+type CalculateAffixValueProbabilityHandler interface {
+	Handle(CalculateAffixValueProbabilityParams, interface{}) middleware.Responder
+}
+
+// NewCalculateAffixValueProbability creates a new http.Handler for the calculate affix value probability operation
+func NewCalculateAffixValueProbability(ctx *middleware.Context, handler CalculateAffixValueProbabilityHandler) *CalculateAffixValueProbability {
+	return &CalculateAffixValueProbability{Context: ctx, Handler: handler}
+}
+
+/*
+	CalculateAffixValueProbability swagger:route POST /mod/affix/value/probability Mod calculateAffixValueProbability
+
+计算词条数值概率
+
+计算指定词条出现且数值达到阈值或档位的概率
+*/
+type CalculateAffixValueProbability struct {
+	Context *middleware.Context
+	Handler CalculateAffixValueProbabilityHandler
+}
+
+func (o *CalculateAffixValueProbability) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCalculateAffixValueProbabilityParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
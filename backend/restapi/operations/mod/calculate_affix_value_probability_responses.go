@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CalculateAffixValueProbabilityOKCode is the HTTP code returned for type CalculateAffixValueProbabilityOK
+const CalculateAffixValueProbabilityOKCode int = 200
+
+/*
+CalculateAffixValueProbabilityOK 计算成功
+
+swagger:response calculateAffixValueProbabilityOK
+*/
+type CalculateAffixValueProbabilityOK struct {
+	/*计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AffixValueProbabilityResponse `json:"body,omitempty"`
+}
+
+// NewCalculateAffixValueProbabilityOK creates CalculateAffixValueProbabilityOK with default headers values
+func NewCalculateAffixValueProbabilityOK() *CalculateAffixValueProbabilityOK {
+
+	return &CalculateAffixValueProbabilityOK{}
+}
+
+// WithETag adds the eTag to the calculate affix value probability o k response
+func (o *CalculateAffixValueProbabilityOK) WithETag(eTag string) *CalculateAffixValueProbabilityOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate affix value probability o k response
+func (o *CalculateAffixValueProbabilityOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the calculate affix value probability o k response
+func (o *CalculateAffixValueProbabilityOK) WithPayload(payload *models.AffixValueProbabilityResponse) *CalculateAffixValueProbabilityOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the calculate affix value probability o k response
+func (o *CalculateAffixValueProbabilityOK) SetPayload(payload *models.AffixValueProbabilityResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CalculateAffixValueProbabilityOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CalculateAffixValueProbabilityNotModifiedCode is the HTTP code returned for type CalculateAffixValueProbabilityNotModified
+const CalculateAffixValueProbabilityNotModifiedCode int = 304
+
+/*
+CalculateAffixValueProbabilityNotModified 结果与If-None-Match中的ETag相同，不返回响应体
+
+swagger:response calculateAffixValueProbabilityNotModified
+*/
+type CalculateAffixValueProbabilityNotModified struct {
+	/*计算结果的实体标签
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewCalculateAffixValueProbabilityNotModified creates CalculateAffixValueProbabilityNotModified with default headers values
+func NewCalculateAffixValueProbabilityNotModified() *CalculateAffixValueProbabilityNotModified {
+
+	return &CalculateAffixValueProbabilityNotModified{}
+}
+
+// WithETag adds the eTag to the calculate affix value probability not modified response
+func (o *CalculateAffixValueProbabilityNotModified) WithETag(eTag string) *CalculateAffixValueProbabilityNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate affix value probability not modified response
+func (o *CalculateAffixValueProbabilityNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *CalculateAffixValueProbabilityNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+// CalculateAffixValueProbabilityBadRequestCode is the HTTP code returned for type CalculateAffixValueProbabilityBadRequest
+const CalculateAffixValueProbabilityBadRequestCode int = 400
+
+/*
+CalculateAffixValueProbabilityBadRequest 请求参数错误
+
+swagger:response calculateAffixValueProbabilityBadRequest
+*/
+type CalculateAffixValueProbabilityBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCalculateAffixValueProbabilityBadRequest creates CalculateAffixValueProbabilityBadRequest with default headers values
+func NewCalculateAffixValueProbabilityBadRequest() *CalculateAffixValueProbabilityBadRequest {
+
+	return &CalculateAffixValueProbabilityBadRequest{}
+}
+
+// WithPayload adds the payload to the calculate affix value probability bad request response
+func (o *CalculateAffixValueProbabilityBadRequest) WithPayload(payload *models.ErrorResponse) *CalculateAffixValueProbabilityBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the calculate affix value probability bad request response
+func (o *CalculateAffixValueProbabilityBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CalculateAffixValueProbabilityBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
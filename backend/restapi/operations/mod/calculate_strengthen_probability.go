@@ -12,16 +12,16 @@ import (
 )
 
 // CalculateStrengthenProbabilityHandlerFunc turns a function with the right signature into a calculate strengthen probability handler
-type CalculateStrengthenProbabilityHandlerFunc func(CalculateStrengthenProbabilityParams) middleware.Responder
+type CalculateStrengthenProbabilityHandlerFunc func(CalculateStrengthenProbabilityParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn CalculateStrengthenProbabilityHandlerFunc) Handle(params CalculateStrengthenProbabilityParams) middleware.Responder {
-	return fn(params)
+func (fn CalculateStrengthenProbabilityHandlerFunc) Handle(params CalculateStrengthenProbabilityParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // CalculateStrengthenProbabilityHandler interface for that can handle valid calculate strengthen probability params
 type CalculateStrengthenProbabilityHandler interface {
-	Handle(CalculateStrengthenProbabilityParams) middleware.Responder
+	Handle(CalculateStrengthenProbabilityParams, interface{}) middleware.Responder
 }
 
 // NewCalculateStrengthenProbability creates a new http.Handler for the calculate strengthen probability operation
@@ -47,12 +47,25 @@ func (o *CalculateStrengthenProbability) ServeHTTP(rw http.ResponseWriter, r *ht
 		*r = *rCtx
 	}
 	var Params = NewCalculateStrengthenProbabilityParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
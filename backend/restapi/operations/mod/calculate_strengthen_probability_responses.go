@@ -22,6 +22,10 @@ CalculateStrengthenProbabilityOK 计算成功
 swagger:response calculateStrengthenProbabilityOK
 */
 type CalculateStrengthenProbabilityOK struct {
+	/*计算结果的实体标签，由规范化的请求参数、游戏版本目录和语言生成
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +39,17 @@ func NewCalculateStrengthenProbabilityOK() *CalculateStrengthenProbabilityOK {
 	return &CalculateStrengthenProbabilityOK{}
 }
 
+// WithETag adds the eTag to the calculate strengthen probability o k response
+func (o *CalculateStrengthenProbabilityOK) WithETag(eTag string) *CalculateStrengthenProbabilityOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate strengthen probability o k response
+func (o *CalculateStrengthenProbabilityOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the calculate strengthen probability o k response
 func (o *CalculateStrengthenProbabilityOK) WithPayload(payload *models.StrengthenProbabilityResponse) *CalculateStrengthenProbabilityOK {
 	o.Payload = payload
@@ -49,6 +64,13 @@ func (o *CalculateStrengthenProbabilityOK) SetPayload(payload *models.Strengthen
 // WriteResponse to the client
 func (o *CalculateStrengthenProbabilityOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +80,53 @@ func (o *CalculateStrengthenProbabilityOK) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// CalculateStrengthenProbabilityNotModifiedCode is the HTTP code returned for type CalculateStrengthenProbabilityNotModified
+const CalculateStrengthenProbabilityNotModifiedCode int = 304
+
+/*
+CalculateStrengthenProbabilityNotModified 结果与If-None-Match中的ETag相同，不返回响应体
+
+swagger:response calculateStrengthenProbabilityNotModified
+*/
+type CalculateStrengthenProbabilityNotModified struct {
+	/*计算结果的实体标签
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewCalculateStrengthenProbabilityNotModified creates CalculateStrengthenProbabilityNotModified with default headers values
+func NewCalculateStrengthenProbabilityNotModified() *CalculateStrengthenProbabilityNotModified {
+
+	return &CalculateStrengthenProbabilityNotModified{}
+}
+
+// WithETag adds the eTag to the calculate strengthen probability not modified response
+func (o *CalculateStrengthenProbabilityNotModified) WithETag(eTag string) *CalculateStrengthenProbabilityNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the calculate strengthen probability not modified response
+func (o *CalculateStrengthenProbabilityNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *CalculateStrengthenProbabilityNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 // CalculateStrengthenProbabilityBadRequestCode is the HTTP code returned for type CalculateStrengthenProbabilityBadRequest
 const CalculateStrengthenProbabilityBadRequestCode int = 400
 
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DiffGameVersionsHandlerFunc turns a function with the right signature into a diff game versions handler
+type DiffGameVersionsHandlerFunc func(DiffGameVersionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DiffGameVersionsHandlerFunc) Handle(params DiffGameVersionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DiffGameVersionsHandler interface for that can handle valid diff game versions params
+type DiffGameVersionsHandler interface {
+	Handle(DiffGameVersionsParams, interface{}) middleware.Responder
+}
+
+// NewDiffGameVersions creates a new http.Handler for the diff game versions operation
+func NewDiffGameVersions(ctx *middleware.Context, handler DiffGameVersionsHandler) *DiffGameVersions {
+	return &DiffGameVersions{Context: ctx, Handler: handler}
+}
+
+/*
+	DiffGameVersions swagger:route GET /mod/versions/diff Mod diffGameVersions
+
+比较游戏版本
+
+比较任意两个游戏版本之间的词条、数值、掉落权重和强化规则变动
+*/
+type DiffGameVersions struct {
+	Context *middleware.Context
+	Handler DiffGameVersionsHandler
+}
+
+func (o *DiffGameVersions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDiffGameVersionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DiffGameVersionsOKCode is the HTTP code returned for type DiffGameVersionsOK
+const DiffGameVersionsOKCode int = 200
+
+/*
+DiffGameVersionsOK 比较成功
+
+swagger:response diffGameVersionsOK
+*/
+type DiffGameVersionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GameVersionDiffResponse `json:"body,omitempty"`
+}
+
+// NewDiffGameVersionsOK creates DiffGameVersionsOK with default headers values
+func NewDiffGameVersionsOK() *DiffGameVersionsOK {
+
+	return &DiffGameVersionsOK{}
+}
+
+// WithPayload adds the payload to the diff game versions o k response
+func (o *DiffGameVersionsOK) WithPayload(payload *models.GameVersionDiffResponse) *DiffGameVersionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the diff game versions o k response
+func (o *DiffGameVersionsOK) SetPayload(payload *models.GameVersionDiffResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DiffGameVersionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DiffGameVersionsBadRequestCode is the HTTP code returned for type DiffGameVersionsBadRequest
+const DiffGameVersionsBadRequestCode int = 400
+
+/*
+DiffGameVersionsBadRequest 请求参数错误
+
+swagger:response diffGameVersionsBadRequest
+*/
+type DiffGameVersionsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDiffGameVersionsBadRequest creates DiffGameVersionsBadRequest with default headers values
+func NewDiffGameVersionsBadRequest() *DiffGameVersionsBadRequest {
+
+	return &DiffGameVersionsBadRequest{}
+}
+
+// WithPayload adds the payload to the diff game versions bad request response
+func (o *DiffGameVersionsBadRequest) WithPayload(payload *models.ErrorResponse) *DiffGameVersionsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the diff game versions bad request response
+func (o *DiffGameVersionsBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DiffGameVersionsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAffixHandlerFunc turns a function with the right signature into a get affix handler
+type GetAffixHandlerFunc func(GetAffixParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAffixHandlerFunc) Handle(params GetAffixParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetAffixHandler interface for that can handle valid get affix params
+type GetAffixHandler interface {
+	Handle(GetAffixParams, interface{}) middleware.Responder
+}
+
+// NewGetAffix creates a new http.Handler for the get affix operation
+func NewGetAffix(ctx *middleware.Context, handler GetAffixHandler) *GetAffix {
+	return &GetAffix{Context: ctx, Handler: handler}
+}
+
+/*
+	GetAffix swagger:route GET /mod/affix/{id} Mod getAffix
+
+获取词条详情
+
+根据ID获取词条
+*/
+type GetAffix struct {
+	Context *middleware.Context
+	Handler GetAffixHandler
+}
+
+func (o *GetAffix) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAffixParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
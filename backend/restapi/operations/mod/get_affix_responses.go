@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetAffixOKCode is the HTTP code returned for type GetAffixOK
+const GetAffixOKCode int = 200
+
+/*
+GetAffixOK 成功获取词条
+
+swagger:response getAffixOK
+*/
+type GetAffixOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Affix `json:"body,omitempty"`
+}
+
+// NewGetAffixOK creates GetAffixOK with default headers values
+func NewGetAffixOK() *GetAffixOK {
+
+	return &GetAffixOK{}
+}
+
+// WithPayload adds the payload to the get affix o k response
+func (o *GetAffixOK) WithPayload(payload *models.Affix) *GetAffixOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get affix o k response
+func (o *GetAffixOK) SetPayload(payload *models.Affix) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAffixOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAffixBadRequestCode is the HTTP code returned for type GetAffixBadRequest
+const GetAffixBadRequestCode int = 400
+
+/*
+GetAffixBadRequest 请求参数错误
+
+swagger:response getAffixBadRequest
+*/
+type GetAffixBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetAffixBadRequest creates GetAffixBadRequest with default headers values
+func NewGetAffixBadRequest() *GetAffixBadRequest {
+
+	return &GetAffixBadRequest{}
+}
+
+// WithPayload adds the payload to the get affix bad request response
+func (o *GetAffixBadRequest) WithPayload(payload *models.ErrorResponse) *GetAffixBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get affix bad request response
+func (o *GetAffixBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAffixBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAffixNotFoundCode is the HTTP code returned for type GetAffixNotFound
+const GetAffixNotFoundCode int = 404
+
+/*
+GetAffixNotFound 词条不存在
+
+swagger:response getAffixNotFound
+*/
+type GetAffixNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetAffixNotFound creates GetAffixNotFound with default headers values
+func NewGetAffixNotFound() *GetAffixNotFound {
+
+	return &GetAffixNotFound{}
+}
+
+// WithPayload adds the payload to the get affix not found response
+func (o *GetAffixNotFound) WithPayload(payload *models.ErrorResponse) *GetAffixNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get affix not found response
+func (o *GetAffixNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAffixNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
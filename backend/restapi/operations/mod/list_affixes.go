@@ -12,16 +12,16 @@ import (
 )
 
 // ListAffixesHandlerFunc turns a function with the right signature into a list affixes handler
-type ListAffixesHandlerFunc func(ListAffixesParams) middleware.Responder
+type ListAffixesHandlerFunc func(ListAffixesParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn ListAffixesHandlerFunc) Handle(params ListAffixesParams) middleware.Responder {
-	return fn(params)
+func (fn ListAffixesHandlerFunc) Handle(params ListAffixesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // ListAffixesHandler interface for that can handle valid list affixes params
 type ListAffixesHandler interface {
-	Handle(ListAffixesParams) middleware.Responder
+	Handle(ListAffixesParams, interface{}) middleware.Responder
 }
 
 // NewListAffixes creates a new http.Handler for the list affixes operation
@@ -47,12 +47,25 @@ func (o *ListAffixes) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewListAffixesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -57,3 +57,48 @@ func (o *ListAffixesOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 		}
 	}
 }
+
+// ListAffixesBadRequestCode is the HTTP code returned for type ListAffixesBadRequest
+const ListAffixesBadRequestCode int = 400
+
+/*
+ListAffixesBadRequest 请求参数错误
+
+swagger:response listAffixesBadRequest
+*/
+type ListAffixesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListAffixesBadRequest creates ListAffixesBadRequest with default headers values
+func NewListAffixesBadRequest() *ListAffixesBadRequest {
+
+	return &ListAffixesBadRequest{}
+}
+
+// WithPayload adds the payload to the list affixes bad request response
+func (o *ListAffixesBadRequest) WithPayload(payload *models.ErrorResponse) *ListAffixesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list affixes bad request response
+func (o *ListAffixesBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAffixesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
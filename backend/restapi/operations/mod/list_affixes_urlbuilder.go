@@ -13,7 +13,13 @@ import (
 
 // ListAffixesURL generates an URL for the list affixes operation
 type ListAffixesURL struct {
+	Category    *string
+	GameVersion *string
+	Lang        *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -43,6 +49,34 @@ func (o *ListAffixesURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var categoryQ string
+	if o.Category != nil {
+		categoryQ = *o.Category
+	}
+	if categoryQ != "" {
+		qs.Set("category", categoryQ)
+	}
+
+	var gameVersionQ string
+	if o.GameVersion != nil {
+		gameVersionQ = *o.GameVersion
+	}
+	if gameVersionQ != "" {
+		qs.Set("gameVersion", gameVersionQ)
+	}
+
+	var langQ string
+	if o.Lang != nil {
+		langQ = *o.Lang
+	}
+	if langQ != "" {
+		qs.Set("lang", langQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
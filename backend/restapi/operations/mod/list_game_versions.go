@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListGameVersionsHandlerFunc turns a function with the right signature into a list game versions handler
+type ListGameVersionsHandlerFunc func(ListGameVersionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListGameVersionsHandlerFunc) Handle(params ListGameVersionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListGameVersionsHandler interface for that can handle valid list game versions params
+type ListGameVersionsHandler interface {
+	Handle(ListGameVersionsParams, interface{}) middleware.Responder
+}
+
+// NewListGameVersions creates a new http.Handler for the list game versions operation
+func NewListGameVersions(ctx *middleware.Context, handler ListGameVersionsHandler) *ListGameVersions {
+	return &ListGameVersions{Context: ctx, Handler: handler}
+}
+
+/*
+	ListGameVersions swagger:route GET /mod/versions Mod listGameVersions
+
+获取游戏版本列表
+
+获取所有游戏版本及其相对上一版本的词条、数值、掉落权重和强化规则变动
+*/
+type ListGameVersions struct {
+	Context *middleware.Context
+	Handler ListGameVersionsHandler
+}
+
+func (o *ListGameVersions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListGameVersionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListGameVersionsOKCode is the HTTP code returned for type ListGameVersionsOK
+const ListGameVersionsOKCode int = 200
+
+/*
+ListGameVersionsOK 成功获取游戏版本列表
+
+swagger:response listGameVersionsOK
+*/
+type ListGameVersionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GameVersionListResponse `json:"body,omitempty"`
+}
+
+// NewListGameVersionsOK creates ListGameVersionsOK with default headers values
+func NewListGameVersionsOK() *ListGameVersionsOK {
+
+	return &ListGameVersionsOK{}
+}
+
+// WithPayload adds the payload to the list game versions o k response
+func (o *ListGameVersionsOK) WithPayload(payload *models.GameVersionListResponse) *ListGameVersionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list game versions o k response
+func (o *ListGameVersionsOK) SetPayload(payload *models.GameVersionListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListGameVersionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListRaritiesHandlerFunc turns a function with the right signature into a list rarities handler
+type ListRaritiesHandlerFunc func(ListRaritiesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListRaritiesHandlerFunc) Handle(params ListRaritiesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListRaritiesHandler interface for that can handle valid list rarities params
+type ListRaritiesHandler interface {
+	Handle(ListRaritiesParams, interface{}) middleware.Responder
+}
+
+// NewListRarities creates a new http.Handler for the list rarities operation
+func NewListRarities(ctx *middleware.Context, handler ListRaritiesHandler) *ListRarities {
+	return &ListRarities{Context: ctx, Handler: handler}
+}
+
+/*
+	ListRarities swagger:route GET /mod/rarity/list Mod listRarities
+
+获取稀有度列表
+
+获取所有模组稀有度及其词条数量、词条池、等级和掉落权重
+*/
+type ListRarities struct {
+	Context *middleware.Context
+	Handler ListRaritiesHandler
+}
+
+func (o *ListRarities) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListRaritiesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListRaritiesOKCode is the HTTP code returned for type ListRaritiesOK
+const ListRaritiesOKCode int = 200
+
+/*
+ListRaritiesOK 成功获取稀有度列表
+
+swagger:response listRaritiesOK
+*/
+type ListRaritiesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RarityListResponse `json:"body,omitempty"`
+}
+
+// NewListRaritiesOK creates ListRaritiesOK with default headers values
+func NewListRaritiesOK() *ListRaritiesOK {
+
+	return &ListRaritiesOK{}
+}
+
+// WithPayload adds the payload to the list rarities o k response
+func (o *ListRaritiesOK) WithPayload(payload *models.RarityListResponse) *ListRaritiesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list rarities o k response
+func (o *ListRaritiesOK) SetPayload(payload *models.RarityListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRaritiesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListRaritiesBadRequestCode is the HTTP code returned for type ListRaritiesBadRequest
+const ListRaritiesBadRequestCode int = 400
+
+/*
+ListRaritiesBadRequest 请求参数错误
+
+swagger:response listRaritiesBadRequest
+*/
+type ListRaritiesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListRaritiesBadRequest creates ListRaritiesBadRequest with default headers values
+func NewListRaritiesBadRequest() *ListRaritiesBadRequest {
+
+	return &ListRaritiesBadRequest{}
+}
+
+// WithPayload adds the payload to the list rarities bad request response
+func (o *ListRaritiesBadRequest) WithPayload(payload *models.ErrorResponse) *ListRaritiesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list rarities bad request response
+func (o *ListRaritiesBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRaritiesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SearchAffixesHandlerFunc turns a function with the right signature into a search affixes handler
+type SearchAffixesHandlerFunc func(SearchAffixesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchAffixesHandlerFunc) Handle(params SearchAffixesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SearchAffixesHandler interface for that can handle valid search affixes params
+type SearchAffixesHandler interface {
+	Handle(SearchAffixesParams, interface{}) middleware.Responder
+}
+
+// NewSearchAffixes creates a new http.Handler for the search affixes operation
+func NewSearchAffixes(ctx *middleware.Context, handler SearchAffixesHandler) *SearchAffixes {
+	return &SearchAffixes{Context: ctx, Handler: handler}
+}
+
+/*
+	SearchAffixes swagger:route GET /mod/affix/search Mod searchAffixes
+
+搜索词条
+
+按中文名、拼音、拼音首字母或英文别名模糊搜索词条，可按分类过滤
+*/
+type SearchAffixes struct {
+	Context *middleware.Context
+	Handler SearchAffixesHandler
+}
+
+func (o *SearchAffixes) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSearchAffixesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
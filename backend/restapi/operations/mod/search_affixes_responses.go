@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mod
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SearchAffixesOKCode is the HTTP code returned for type SearchAffixesOK
+const SearchAffixesOKCode int = 200
+
+/*
+SearchAffixesOK 搜索成功
+
+swagger:response searchAffixesOK
+*/
+type SearchAffixesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AffixSearchResponse `json:"body,omitempty"`
+}
+
+// NewSearchAffixesOK creates SearchAffixesOK with default headers values
+func NewSearchAffixesOK() *SearchAffixesOK {
+
+	return &SearchAffixesOK{}
+}
+
+// WithPayload adds the payload to the search affixes o k response
+func (o *SearchAffixesOK) WithPayload(payload *models.AffixSearchResponse) *SearchAffixesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search affixes o k response
+func (o *SearchAffixesOK) SetPayload(payload *models.AffixSearchResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchAffixesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SearchAffixesBadRequestCode is the HTTP code returned for type SearchAffixesBadRequest
+const SearchAffixesBadRequestCode int = 400
+
+/*
+SearchAffixesBadRequest 请求参数错误
+
+swagger:response searchAffixesBadRequest
+*/
+type SearchAffixesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSearchAffixesBadRequest creates SearchAffixesBadRequest with default headers values
+func NewSearchAffixesBadRequest() *SearchAffixesBadRequest {
+
+	return &SearchAffixesBadRequest{}
+}
+
+// WithPayload adds the payload to the search affixes bad request response
+func (o *SearchAffixesBadRequest) WithPayload(payload *models.ErrorResponse) *SearchAffixesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search affixes bad request response
+func (o *SearchAffixesBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchAffixesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
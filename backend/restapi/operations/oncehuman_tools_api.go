@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,7 +20,13 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/admin"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/inventory"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/jobs"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/mod"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/preset"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/scripts"
+	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/share"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/system"
 	"github.com/SpenserCai/OnceHumanTools/backend/restapi/operations/tools"
 )
@@ -44,23 +51,120 @@ func NewOncehumanToolsAPI(spec *loads.Document) *OncehumanToolsAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		CsvProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("csv producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
+		MarkdownProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("markdown producer has not yet been implemented")
+		}),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
-		ModCalculateAffixProbabilityHandler: mod.CalculateAffixProbabilityHandlerFunc(func(params mod.CalculateAffixProbabilityParams) middleware.Responder {
+		InventoryAnalyzeInventoryHandler: inventory.AnalyzeInventoryHandlerFunc(func(params inventory.AnalyzeInventoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.AnalyzeInventory has not yet been implemented")
+		}),
+		ModCalculateAffixProbabilityHandler: mod.CalculateAffixProbabilityHandlerFunc(func(params mod.CalculateAffixProbabilityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation mod.CalculateAffixProbability has not yet been implemented")
 		}),
-		ModCalculateStrengthenProbabilityHandler: mod.CalculateStrengthenProbabilityHandlerFunc(func(params mod.CalculateStrengthenProbabilityParams) middleware.Responder {
+		ModCalculateAffixValueProbabilityHandler: mod.CalculateAffixValueProbabilityHandlerFunc(func(params mod.CalculateAffixValueProbabilityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.CalculateAffixValueProbability has not yet been implemented")
+		}),
+		ModCalculateStrengthenProbabilityHandler: mod.CalculateStrengthenProbabilityHandlerFunc(func(params mod.CalculateStrengthenProbabilityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation mod.CalculateStrengthenProbability has not yet been implemented")
 		}),
+		JobsCancelJobHandler: jobs.CancelJobHandlerFunc(func(params jobs.CancelJobParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.CancelJob has not yet been implemented")
+		}),
+		InventoryCreateInventoryModHandler: inventory.CreateInventoryModHandlerFunc(func(params inventory.CreateInventoryModParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.CreateInventoryMod has not yet been implemented")
+		}),
+		ShareCreateShareHandler: share.CreateShareHandlerFunc(func(params share.CreateShareParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation share.CreateShare has not yet been implemented")
+		}),
+		InventoryDeleteInventoryModHandler: inventory.DeleteInventoryModHandlerFunc(func(params inventory.DeleteInventoryModParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.DeleteInventoryMod has not yet been implemented")
+		}),
+		PresetDeletePresetHandler: preset.DeletePresetHandlerFunc(func(params preset.DeletePresetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation preset.DeletePreset has not yet been implemented")
+		}),
+		ModDiffGameVersionsHandler: mod.DiffGameVersionsHandlerFunc(func(params mod.DiffGameVersionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.DiffGameVersions has not yet been implemented")
+		}),
+		ModGetAffixHandler: mod.GetAffixHandlerFunc(func(params mod.GetAffixParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.GetAffix has not yet been implemented")
+		}),
+		InventoryGetInventoryModHandler: inventory.GetInventoryModHandlerFunc(func(params inventory.GetInventoryModParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.GetInventoryMod has not yet been implemented")
+		}),
+		JobsGetJobHandler: jobs.GetJobHandlerFunc(func(params jobs.GetJobParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.GetJob has not yet been implemented")
+		}),
+		JobsGetJobResultHandler: jobs.GetJobResultHandlerFunc(func(params jobs.GetJobResultParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.GetJobResult has not yet been implemented")
+		}),
+		ShareGetShareHandler: share.GetShareHandlerFunc(func(params share.GetShareParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation share.GetShare has not yet been implemented")
+		}),
 		SystemHealthCheckHandler: system.HealthCheckHandlerFunc(func(params system.HealthCheckParams) middleware.Responder {
 			return middleware.NotImplemented("operation system.HealthCheck has not yet been implemented")
 		}),
-		ModListAffixesHandler: mod.ListAffixesHandlerFunc(func(params mod.ListAffixesParams) middleware.Responder {
+		ModListAffixesHandler: mod.ListAffixesHandlerFunc(func(params mod.ListAffixesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation mod.ListAffixes has not yet been implemented")
 		}),
-		ToolsListToolsHandler: tools.ListToolsHandlerFunc(func(params tools.ListToolsParams) middleware.Responder {
+		AdminListAPIKeysHandler: admin.ListAPIKeysHandlerFunc(func(params admin.ListAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.ListAPIKeys has not yet been implemented")
+		}),
+		ModListGameVersionsHandler: mod.ListGameVersionsHandlerFunc(func(params mod.ListGameVersionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.ListGameVersions has not yet been implemented")
+		}),
+		InventoryListInventoryModsHandler: inventory.ListInventoryModsHandlerFunc(func(params inventory.ListInventoryModsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.ListInventoryMods has not yet been implemented")
+		}),
+		PresetListPresetsHandler: preset.ListPresetsHandlerFunc(func(params preset.ListPresetsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation preset.ListPresets has not yet been implemented")
+		}),
+		ModListRaritiesHandler: mod.ListRaritiesHandlerFunc(func(params mod.ListRaritiesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.ListRarities has not yet been implemented")
+		}),
+		ToolsListToolsHandler: tools.ListToolsHandlerFunc(func(params tools.ListToolsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation tools.ListTools has not yet been implemented")
 		}),
+		SystemLivenessCheckHandler: system.LivenessCheckHandlerFunc(func(params system.LivenessCheckParams) middleware.Responder {
+			return middleware.NotImplemented("operation system.LivenessCheck has not yet been implemented")
+		}),
+		SystemReadinessCheckHandler: system.ReadinessCheckHandlerFunc(func(params system.ReadinessCheckParams) middleware.Responder {
+			return middleware.NotImplemented("operation system.ReadinessCheck has not yet been implemented")
+		}),
+		ScriptsRunScriptHandler: scripts.RunScriptHandlerFunc(func(params scripts.RunScriptParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation scripts.RunScript has not yet been implemented")
+		}),
+		ToolsRunToolHandler: tools.RunToolHandlerFunc(func(params tools.RunToolParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tools.RunTool has not yet been implemented")
+		}),
+		PresetSavePresetHandler: preset.SavePresetHandlerFunc(func(params preset.SavePresetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation preset.SavePreset has not yet been implemented")
+		}),
+		ModSearchAffixesHandler: mod.SearchAffixesHandlerFunc(func(params mod.SearchAffixesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mod.SearchAffixes has not yet been implemented")
+		}),
+		JobsStreamJobEventsHandler: jobs.StreamJobEventsHandlerFunc(func(params jobs.StreamJobEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.StreamJobEvents has not yet been implemented")
+		}),
+		JobsSubmitJobHandler: jobs.SubmitJobHandlerFunc(func(params jobs.SubmitJobParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.SubmitJob has not yet been implemented")
+		}),
+		InventoryUpdateInventoryModHandler: inventory.UpdateInventoryModHandlerFunc(func(params inventory.UpdateInventoryModParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation inventory.UpdateInventoryMod has not yet been implemented")
+		}),
+
+		// Applies when the "X-API-Key" header is set
+		APIKeyAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (apiKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -93,20 +197,91 @@ type OncehumanToolsAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// CsvProducer registers a producer for the following mime types:
+	//   - text/csv
+	CsvProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONProducer runtime.Producer
-
+	// MarkdownProducer registers a producer for the following mime types:
+	//   - text/markdown
+	MarkdownProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
+
+	// APIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-API-Key provided in the header
+	APIKeyAuth func(string) (interface{}, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// InventoryAnalyzeInventoryHandler sets the operation handler for the analyze inventory operation
+	InventoryAnalyzeInventoryHandler inventory.AnalyzeInventoryHandler
 	// ModCalculateAffixProbabilityHandler sets the operation handler for the calculate affix probability operation
 	ModCalculateAffixProbabilityHandler mod.CalculateAffixProbabilityHandler
+	// ModCalculateAffixValueProbabilityHandler sets the operation handler for the calculate affix value probability operation
+	ModCalculateAffixValueProbabilityHandler mod.CalculateAffixValueProbabilityHandler
 	// ModCalculateStrengthenProbabilityHandler sets the operation handler for the calculate strengthen probability operation
 	ModCalculateStrengthenProbabilityHandler mod.CalculateStrengthenProbabilityHandler
+	// JobsCancelJobHandler sets the operation handler for the cancel job operation
+	JobsCancelJobHandler jobs.CancelJobHandler
+	// InventoryCreateInventoryModHandler sets the operation handler for the create inventory mod operation
+	InventoryCreateInventoryModHandler inventory.CreateInventoryModHandler
+	// ShareCreateShareHandler sets the operation handler for the create share operation
+	ShareCreateShareHandler share.CreateShareHandler
+	// InventoryDeleteInventoryModHandler sets the operation handler for the delete inventory mod operation
+	InventoryDeleteInventoryModHandler inventory.DeleteInventoryModHandler
+	// PresetDeletePresetHandler sets the operation handler for the delete preset operation
+	PresetDeletePresetHandler preset.DeletePresetHandler
+	// ModDiffGameVersionsHandler sets the operation handler for the diff game versions operation
+	ModDiffGameVersionsHandler mod.DiffGameVersionsHandler
+	// ModGetAffixHandler sets the operation handler for the get affix operation
+	ModGetAffixHandler mod.GetAffixHandler
+	// InventoryGetInventoryModHandler sets the operation handler for the get inventory mod operation
+	InventoryGetInventoryModHandler inventory.GetInventoryModHandler
+	// JobsGetJobHandler sets the operation handler for the get job operation
+	JobsGetJobHandler jobs.GetJobHandler
+	// JobsGetJobResultHandler sets the operation handler for the get job result operation
+	JobsGetJobResultHandler jobs.GetJobResultHandler
+	// ShareGetShareHandler sets the operation handler for the get share operation
+	ShareGetShareHandler share.GetShareHandler
 	// SystemHealthCheckHandler sets the operation handler for the health check operation
 	SystemHealthCheckHandler system.HealthCheckHandler
 	// ModListAffixesHandler sets the operation handler for the list affixes operation
 	ModListAffixesHandler mod.ListAffixesHandler
+	// AdminListAPIKeysHandler sets the operation handler for the list Api keys operation
+	AdminListAPIKeysHandler admin.ListAPIKeysHandler
+	// ModListGameVersionsHandler sets the operation handler for the list game versions operation
+	ModListGameVersionsHandler mod.ListGameVersionsHandler
+	// InventoryListInventoryModsHandler sets the operation handler for the list inventory mods operation
+	InventoryListInventoryModsHandler inventory.ListInventoryModsHandler
+	// PresetListPresetsHandler sets the operation handler for the list presets operation
+	PresetListPresetsHandler preset.ListPresetsHandler
+	// ModListRaritiesHandler sets the operation handler for the list rarities operation
+	ModListRaritiesHandler mod.ListRaritiesHandler
 	// ToolsListToolsHandler sets the operation handler for the list tools operation
 	ToolsListToolsHandler tools.ListToolsHandler
+	// SystemLivenessCheckHandler sets the operation handler for the liveness check operation
+	SystemLivenessCheckHandler system.LivenessCheckHandler
+	// SystemReadinessCheckHandler sets the operation handler for the readiness check operation
+	SystemReadinessCheckHandler system.ReadinessCheckHandler
+	// ScriptsRunScriptHandler sets the operation handler for the run script operation
+	ScriptsRunScriptHandler scripts.RunScriptHandler
+	// ToolsRunToolHandler sets the operation handler for the run tool operation
+	ToolsRunToolHandler tools.RunToolHandler
+	// PresetSavePresetHandler sets the operation handler for the save preset operation
+	PresetSavePresetHandler preset.SavePresetHandler
+	// ModSearchAffixesHandler sets the operation handler for the search affixes operation
+	ModSearchAffixesHandler mod.SearchAffixesHandler
+	// JobsStreamJobEventsHandler sets the operation handler for the stream job events operation
+	JobsStreamJobEventsHandler jobs.StreamJobEventsHandler
+	// JobsSubmitJobHandler sets the operation handler for the submit job operation
+	JobsSubmitJobHandler jobs.SubmitJobHandler
+	// InventoryUpdateInventoryModHandler sets the operation handler for the update inventory mod operation
+	InventoryUpdateInventoryModHandler inventory.UpdateInventoryModHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -180,25 +355,119 @@ func (o *OncehumanToolsAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.CsvProducer == nil {
+		unregistered = append(unregistered, "CsvProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.MarkdownProducer == nil {
+		unregistered = append(unregistered, "MarkdownProducer")
+	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
+	if o.APIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
+
+	if o.InventoryAnalyzeInventoryHandler == nil {
+		unregistered = append(unregistered, "inventory.AnalyzeInventoryHandler")
+	}
 	if o.ModCalculateAffixProbabilityHandler == nil {
 		unregistered = append(unregistered, "mod.CalculateAffixProbabilityHandler")
 	}
+	if o.ModCalculateAffixValueProbabilityHandler == nil {
+		unregistered = append(unregistered, "mod.CalculateAffixValueProbabilityHandler")
+	}
 	if o.ModCalculateStrengthenProbabilityHandler == nil {
 		unregistered = append(unregistered, "mod.CalculateStrengthenProbabilityHandler")
 	}
+	if o.JobsCancelJobHandler == nil {
+		unregistered = append(unregistered, "jobs.CancelJobHandler")
+	}
+	if o.InventoryCreateInventoryModHandler == nil {
+		unregistered = append(unregistered, "inventory.CreateInventoryModHandler")
+	}
+	if o.ShareCreateShareHandler == nil {
+		unregistered = append(unregistered, "share.CreateShareHandler")
+	}
+	if o.InventoryDeleteInventoryModHandler == nil {
+		unregistered = append(unregistered, "inventory.DeleteInventoryModHandler")
+	}
+	if o.PresetDeletePresetHandler == nil {
+		unregistered = append(unregistered, "preset.DeletePresetHandler")
+	}
+	if o.ModDiffGameVersionsHandler == nil {
+		unregistered = append(unregistered, "mod.DiffGameVersionsHandler")
+	}
+	if o.ModGetAffixHandler == nil {
+		unregistered = append(unregistered, "mod.GetAffixHandler")
+	}
+	if o.InventoryGetInventoryModHandler == nil {
+		unregistered = append(unregistered, "inventory.GetInventoryModHandler")
+	}
+	if o.JobsGetJobHandler == nil {
+		unregistered = append(unregistered, "jobs.GetJobHandler")
+	}
+	if o.JobsGetJobResultHandler == nil {
+		unregistered = append(unregistered, "jobs.GetJobResultHandler")
+	}
+	if o.ShareGetShareHandler == nil {
+		unregistered = append(unregistered, "share.GetShareHandler")
+	}
 	if o.SystemHealthCheckHandler == nil {
 		unregistered = append(unregistered, "system.HealthCheckHandler")
 	}
 	if o.ModListAffixesHandler == nil {
 		unregistered = append(unregistered, "mod.ListAffixesHandler")
 	}
+	if o.AdminListAPIKeysHandler == nil {
+		unregistered = append(unregistered, "admin.ListAPIKeysHandler")
+	}
+	if o.ModListGameVersionsHandler == nil {
+		unregistered = append(unregistered, "mod.ListGameVersionsHandler")
+	}
+	if o.InventoryListInventoryModsHandler == nil {
+		unregistered = append(unregistered, "inventory.ListInventoryModsHandler")
+	}
+	if o.PresetListPresetsHandler == nil {
+		unregistered = append(unregistered, "preset.ListPresetsHandler")
+	}
+	if o.ModListRaritiesHandler == nil {
+		unregistered = append(unregistered, "mod.ListRaritiesHandler")
+	}
 	if o.ToolsListToolsHandler == nil {
 		unregistered = append(unregistered, "tools.ListToolsHandler")
 	}
+	if o.SystemLivenessCheckHandler == nil {
+		unregistered = append(unregistered, "system.LivenessCheckHandler")
+	}
+	if o.SystemReadinessCheckHandler == nil {
+		unregistered = append(unregistered, "system.ReadinessCheckHandler")
+	}
+	if o.ScriptsRunScriptHandler == nil {
+		unregistered = append(unregistered, "scripts.RunScriptHandler")
+	}
+	if o.ToolsRunToolHandler == nil {
+		unregistered = append(unregistered, "tools.RunToolHandler")
+	}
+	if o.PresetSavePresetHandler == nil {
+		unregistered = append(unregistered, "preset.SavePresetHandler")
+	}
+	if o.ModSearchAffixesHandler == nil {
+		unregistered = append(unregistered, "mod.SearchAffixesHandler")
+	}
+	if o.JobsStreamJobEventsHandler == nil {
+		unregistered = append(unregistered, "jobs.StreamJobEventsHandler")
+	}
+	if o.JobsSubmitJobHandler == nil {
+		unregistered = append(unregistered, "jobs.SubmitJobHandler")
+	}
+	if o.InventoryUpdateInventoryModHandler == nil {
+		unregistered = append(unregistered, "inventory.UpdateInventoryModHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -214,12 +483,21 @@ func (o *OncehumanToolsAPI) ServeErrorFor(operationID string) func(http.Response
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *OncehumanToolsAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "apiKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.APIKeyAuth)
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *OncehumanToolsAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -245,8 +523,16 @@ func (o *OncehumanToolsAPI) ProducersFor(mediaTypes []string) map[string]runtime
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "text/csv":
+			result["text/csv"] = o.CsvProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
+		case "text/markdown":
+			result["text/markdown"] = o.MarkdownProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -287,6 +573,10 @@ func (o *OncehumanToolsAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/inventory/analysis"] = inventory.NewAnalyzeInventory(o.context, o.InventoryAnalyzeInventoryHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -294,7 +584,55 @@ func (o *OncehumanToolsAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/mod/affix/value/probability"] = mod.NewCalculateAffixValueProbability(o.context, o.ModCalculateAffixValueProbabilityHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/mod/strengthen/probability"] = mod.NewCalculateStrengthenProbability(o.context, o.ModCalculateStrengthenProbabilityHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/jobs/{id}"] = jobs.NewCancelJob(o.context, o.JobsCancelJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/inventory/mods"] = inventory.NewCreateInventoryMod(o.context, o.InventoryCreateInventoryModHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/share"] = share.NewCreateShare(o.context, o.ShareCreateShareHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/inventory/mods/{id}"] = inventory.NewDeleteInventoryMod(o.context, o.InventoryDeleteInventoryModHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/presets/{scope}/{name}"] = preset.NewDeletePreset(o.context, o.PresetDeletePresetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mod/versions/diff"] = mod.NewDiffGameVersions(o.context, o.ModDiffGameVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mod/affix/{id}"] = mod.NewGetAffix(o.context, o.ModGetAffixHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/inventory/mods/{id}"] = inventory.NewGetInventoryMod(o.context, o.InventoryGetInventoryModHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/jobs/{id}"] = jobs.NewGetJob(o.context, o.JobsGetJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/jobs/{id}/result"] = jobs.NewGetJobResult(o.context, o.JobsGetJobResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/share/{id}"] = share.NewGetShare(o.context, o.ShareGetShareHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -306,7 +644,63 @@ func (o *OncehumanToolsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/keys"] = admin.NewListAPIKeys(o.context, o.AdminListAPIKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mod/versions"] = mod.NewListGameVersions(o.context, o.ModListGameVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/inventory/mods"] = inventory.NewListInventoryMods(o.context, o.InventoryListInventoryModsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/presets"] = preset.NewListPresets(o.context, o.PresetListPresetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mod/rarity/list"] = mod.NewListRarities(o.context, o.ModListRaritiesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/tools"] = tools.NewListTools(o.context, o.ToolsListToolsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/health/live"] = system.NewLivenessCheck(o.context, o.SystemLivenessCheckHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/health/ready"] = system.NewReadinessCheck(o.context, o.SystemReadinessCheckHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/scripts/run"] = scripts.NewRunScript(o.context, o.ScriptsRunScriptHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/tools/{id}/run"] = tools.NewRunTool(o.context, o.ToolsRunToolHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/presets/{scope}/{name}"] = preset.NewSavePreset(o.context, o.PresetSavePresetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mod/affix/search"] = mod.NewSearchAffixes(o.context, o.ModSearchAffixesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/jobs/{id}/events"] = jobs.NewStreamJobEvents(o.context, o.JobsStreamJobEventsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/jobs"] = jobs.NewSubmitJob(o.context, o.JobsSubmitJobHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/inventory/mods/{id}"] = inventory.NewUpdateInventoryMod(o.context, o.InventoryUpdateInventoryModHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
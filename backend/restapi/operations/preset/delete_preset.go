@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeletePresetHandlerFunc turns a function with the right signature into a delete preset handler
+type DeletePresetHandlerFunc func(DeletePresetParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeletePresetHandlerFunc) Handle(params DeletePresetParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeletePresetHandler interface for that can handle valid delete preset params
+type DeletePresetHandler interface {
+	Handle(DeletePresetParams, interface{}) middleware.Responder
+}
+
+// NewDeletePreset creates a new http.Handler for the delete preset operation
+func NewDeletePreset(ctx *middleware.Context, handler DeletePresetHandler) *DeletePreset {
+	return &DeletePreset{Context: ctx, Handler: handler}
+}
+
+/*
+	DeletePreset swagger:route DELETE /presets/{scope}/{name} Preset deletePreset
+
+删除预设
+
+需要API key，服务器预设的权限要求与保存时相同
+*/
+type DeletePreset struct {
+	Context *middleware.Context
+	Handler DeletePresetHandler
+}
+
+func (o *DeletePreset) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeletePresetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
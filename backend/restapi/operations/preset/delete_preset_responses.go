@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// DeletePresetNoContentCode is the HTTP code returned for type DeletePresetNoContent
+const DeletePresetNoContentCode int = 204
+
+/*
+DeletePresetNoContent 已删除预设
+
+swagger:response deletePresetNoContent
+*/
+type DeletePresetNoContent struct {
+}
+
+// NewDeletePresetNoContent creates DeletePresetNoContent with default headers values
+func NewDeletePresetNoContent() *DeletePresetNoContent {
+
+	return &DeletePresetNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeletePresetNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeletePresetBadRequestCode is the HTTP code returned for type DeletePresetBadRequest
+const DeletePresetBadRequestCode int = 400
+
+/*
+DeletePresetBadRequest 请求参数错误
+
+swagger:response deletePresetBadRequest
+*/
+type DeletePresetBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeletePresetBadRequest creates DeletePresetBadRequest with default headers values
+func NewDeletePresetBadRequest() *DeletePresetBadRequest {
+
+	return &DeletePresetBadRequest{}
+}
+
+// WithPayload adds the payload to the delete preset bad request response
+func (o *DeletePresetBadRequest) WithPayload(payload *models.ErrorResponse) *DeletePresetBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete preset bad request response
+func (o *DeletePresetBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePresetBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeletePresetForbiddenCode is the HTTP code returned for type DeletePresetForbidden
+const DeletePresetForbiddenCode int = 403
+
+/*
+DeletePresetForbidden API key不能管理该服务器的预设
+
+swagger:response deletePresetForbidden
+*/
+type DeletePresetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeletePresetForbidden creates DeletePresetForbidden with default headers values
+func NewDeletePresetForbidden() *DeletePresetForbidden {
+
+	return &DeletePresetForbidden{}
+}
+
+// WithPayload adds the payload to the delete preset forbidden response
+func (o *DeletePresetForbidden) WithPayload(payload *models.ErrorResponse) *DeletePresetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete preset forbidden response
+func (o *DeletePresetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePresetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeletePresetNotFoundCode is the HTTP code returned for type DeletePresetNotFound
+const DeletePresetNotFoundCode int = 404
+
+/*
+DeletePresetNotFound 预设不存在
+
+swagger:response deletePresetNotFound
+*/
+type DeletePresetNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeletePresetNotFound creates DeletePresetNotFound with default headers values
+func NewDeletePresetNotFound() *DeletePresetNotFound {
+
+	return &DeletePresetNotFound{}
+}
+
+// WithPayload adds the payload to the delete preset not found response
+func (o *DeletePresetNotFound) WithPayload(payload *models.ErrorResponse) *DeletePresetNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete preset not found response
+func (o *DeletePresetNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePresetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeletePresetServiceUnavailableCode is the HTTP code returned for type DeletePresetServiceUnavailable
+const DeletePresetServiceUnavailableCode int = 503
+
+/*
+DeletePresetServiceUnavailable 未启用数据库
+
+swagger:response deletePresetServiceUnavailable
+*/
+type DeletePresetServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeletePresetServiceUnavailable creates DeletePresetServiceUnavailable with default headers values
+func NewDeletePresetServiceUnavailable() *DeletePresetServiceUnavailable {
+
+	return &DeletePresetServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the delete preset service unavailable response
+func (o *DeletePresetServiceUnavailable) WithPayload(payload *models.ErrorResponse) *DeletePresetServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete preset service unavailable response
+func (o *DeletePresetServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePresetServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListPresetsHandlerFunc turns a function with the right signature into a list presets handler
+type ListPresetsHandlerFunc func(ListPresetsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPresetsHandlerFunc) Handle(params ListPresetsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListPresetsHandler interface for that can handle valid list presets params
+type ListPresetsHandler interface {
+	Handle(ListPresetsParams, interface{}) middleware.Responder
+}
+
+// NewListPresets creates a new http.Handler for the list presets operation
+func NewListPresets(ctx *middleware.Context, handler ListPresetsHandler) *ListPresets {
+	return &ListPresets{Context: ctx, Handler: handler}
+}
+
+/*
+	ListPresets swagger:route GET /presets Preset listPresets
+
+获取目标词条预设
+
+获取内置预设、玩家保存的预设和X-Guild-ID指定的服务器保存的预设。
+需要目标词条的地方可以用preset:<名称>引用预设，查找顺序为玩家、服务器、内置。未启用数据库时只有内置预设。
+*/
+type ListPresets struct {
+	Context *middleware.Context
+	Handler ListPresetsHandler
+}
+
+func (o *ListPresets) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListPresetsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
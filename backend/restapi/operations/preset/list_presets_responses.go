@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ListPresetsOKCode is the HTTP code returned for type ListPresetsOK
+const ListPresetsOKCode int = 200
+
+/*
+ListPresetsOK 成功获取预设列表
+
+swagger:response listPresetsOK
+*/
+type ListPresetsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PresetListResponse `json:"body,omitempty"`
+}
+
+// NewListPresetsOK creates ListPresetsOK with default headers values
+func NewListPresetsOK() *ListPresetsOK {
+
+	return &ListPresetsOK{}
+}
+
+// WithPayload adds the payload to the list presets o k response
+func (o *ListPresetsOK) WithPayload(payload *models.PresetListResponse) *ListPresetsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list presets o k response
+func (o *ListPresetsOK) SetPayload(payload *models.PresetListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPresetsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListPresetsServiceUnavailableCode is the HTTP code returned for type ListPresetsServiceUnavailable
+const ListPresetsServiceUnavailableCode int = 503
+
+/*
+ListPresetsServiceUnavailable 数据库不可用
+
+swagger:response listPresetsServiceUnavailable
+*/
+type ListPresetsServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListPresetsServiceUnavailable creates ListPresetsServiceUnavailable with default headers values
+func NewListPresetsServiceUnavailable() *ListPresetsServiceUnavailable {
+
+	return &ListPresetsServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the list presets service unavailable response
+func (o *ListPresetsServiceUnavailable) WithPayload(payload *models.ErrorResponse) *ListPresetsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list presets service unavailable response
+func (o *ListPresetsServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPresetsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SavePresetHandlerFunc turns a function with the right signature into a save preset handler
+type SavePresetHandlerFunc func(SavePresetParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SavePresetHandlerFunc) Handle(params SavePresetParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SavePresetHandler interface for that can handle valid save preset params
+type SavePresetHandler interface {
+	Handle(SavePresetParams, interface{}) middleware.Responder
+}
+
+// NewSavePreset creates a new http.Handler for the save preset operation
+func NewSavePreset(ctx *middleware.Context, handler SavePresetHandler) *SavePreset {
+	return &SavePreset{Context: ctx, Handler: handler}
+}
+
+/*
+	SavePreset swagger:route PUT /presets/{scope}/{name} Preset savePreset
+
+保存预设
+
+创建或更新玩家或服务器的预设，与内置预设同名时优先使用保存的预设。
+需要API key；服务器预设还需要key可以管理X-Guild-ID指定的服务器（创建key时的-guilds，admin权限可以管理全部服务器），否则返回403 guild_not_allowed。
+*/
+type SavePreset struct {
+	Context *middleware.Context
+	Handler SavePresetHandler
+}
+
+func (o *SavePreset) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSavePresetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
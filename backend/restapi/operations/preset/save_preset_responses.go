@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package preset
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// SavePresetOKCode is the HTTP code returned for type SavePresetOK
+const SavePresetOKCode int = 200
+
+/*
+SavePresetOK 已保存预设
+
+swagger:response savePresetOK
+*/
+type SavePresetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Preset `json:"body,omitempty"`
+}
+
+// NewSavePresetOK creates SavePresetOK with default headers values
+func NewSavePresetOK() *SavePresetOK {
+
+	return &SavePresetOK{}
+}
+
+// WithPayload adds the payload to the save preset o k response
+func (o *SavePresetOK) WithPayload(payload *models.Preset) *SavePresetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the save preset o k response
+func (o *SavePresetOK) SetPayload(payload *models.Preset) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SavePresetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SavePresetBadRequestCode is the HTTP code returned for type SavePresetBadRequest
+const SavePresetBadRequestCode int = 400
+
+/*
+SavePresetBadRequest 请求参数错误
+
+swagger:response savePresetBadRequest
+*/
+type SavePresetBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSavePresetBadRequest creates SavePresetBadRequest with default headers values
+func NewSavePresetBadRequest() *SavePresetBadRequest {
+
+	return &SavePresetBadRequest{}
+}
+
+// WithPayload adds the payload to the save preset bad request response
+func (o *SavePresetBadRequest) WithPayload(payload *models.ErrorResponse) *SavePresetBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the save preset bad request response
+func (o *SavePresetBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SavePresetBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SavePresetForbiddenCode is the HTTP code returned for type SavePresetForbidden
+const SavePresetForbiddenCode int = 403
+
+/*
+SavePresetForbidden API key不能管理该服务器的预设
+
+swagger:response savePresetForbidden
+*/
+type SavePresetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSavePresetForbidden creates SavePresetForbidden with default headers values
+func NewSavePresetForbidden() *SavePresetForbidden {
+
+	return &SavePresetForbidden{}
+}
+
+// WithPayload adds the payload to the save preset forbidden response
+func (o *SavePresetForbidden) WithPayload(payload *models.ErrorResponse) *SavePresetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the save preset forbidden response
+func (o *SavePresetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SavePresetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SavePresetServiceUnavailableCode is the HTTP code returned for type SavePresetServiceUnavailable
+const SavePresetServiceUnavailableCode int = 503
+
+/*
+SavePresetServiceUnavailable 未启用数据库
+
+swagger:response savePresetServiceUnavailable
+*/
+type SavePresetServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSavePresetServiceUnavailable creates SavePresetServiceUnavailable with default headers values
+func NewSavePresetServiceUnavailable() *SavePresetServiceUnavailable {
+
+	return &SavePresetServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the save preset service unavailable response
+func (o *SavePresetServiceUnavailable) WithPayload(payload *models.ErrorResponse) *SavePresetServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the save preset service unavailable response
+func (o *SavePresetServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SavePresetServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
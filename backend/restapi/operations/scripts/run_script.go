@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scripts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RunScriptHandlerFunc turns a function with the right signature into a run script handler
+type RunScriptHandlerFunc func(RunScriptParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RunScriptHandlerFunc) Handle(params RunScriptParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RunScriptHandler interface for that can handle valid run script params
+type RunScriptHandler interface {
+	Handle(RunScriptParams, interface{}) middleware.Responder
+}
+
+// NewRunScript creates a new http.Handler for the run script operation
+func NewRunScript(ctx *middleware.Context, handler RunScriptHandler) *RunScript {
+	return &RunScript{Context: ctx, Handler: handler}
+}
+
+/*
+	RunScript swagger:route POST /scripts/run Scripts runScript
+
+运行Starlark脚本
+
+在沙箱中执行Starlark脚本并调用main(input)，返回值编码为JSON作为结果。
+脚本可以使用math、json、choose、combinations、Random，以及访问游戏版本目录的affix和strengthen模块；
+不能加载其他文件或访问文件、网络。执行步数超过SCRIPTS_MAX_STEPS时返回422和script_step_limit，
+执行时间超过SCRIPTS_TIMEOUT时返回422和script_timeout，字符串、列表等分配的内存超过SCRIPTS_MAX_ALLOC_MB时返回422和script_memory_limit；
+语法错误和运行时错误（包括内置函数的元素个数超过上限）返回400和script_error，消息带出错位置。
+*/
+type RunScript struct {
+	Context *middleware.Context
+	Handler RunScriptHandler
+}
+
+func (o *RunScript) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRunScriptParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scripts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// RunScriptOKCode is the HTTP code returned for type RunScriptOK
+const RunScriptOKCode int = 200
+
+/*
+RunScriptOK 执行成功
+
+swagger:response runScriptOK
+*/
+type RunScriptOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ScriptRunResponse `json:"body,omitempty"`
+}
+
+// NewRunScriptOK creates RunScriptOK with default headers values
+func NewRunScriptOK() *RunScriptOK {
+
+	return &RunScriptOK{}
+}
+
+// WithPayload adds the payload to the run script o k response
+func (o *RunScriptOK) WithPayload(payload *models.ScriptRunResponse) *RunScriptOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run script o k response
+func (o *RunScriptOK) SetPayload(payload *models.ScriptRunResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunScriptOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunScriptBadRequestCode is the HTTP code returned for type RunScriptBadRequest
+const RunScriptBadRequestCode int = 400
+
+/*
+RunScriptBadRequest 脚本出错或游戏版本不存在
+
+swagger:response runScriptBadRequest
+*/
+type RunScriptBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunScriptBadRequest creates RunScriptBadRequest with default headers values
+func NewRunScriptBadRequest() *RunScriptBadRequest {
+
+	return &RunScriptBadRequest{}
+}
+
+// WithPayload adds the payload to the run script bad request response
+func (o *RunScriptBadRequest) WithPayload(payload *models.ErrorResponse) *RunScriptBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run script bad request response
+func (o *RunScriptBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunScriptBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunScriptUnprocessableEntityCode is the HTTP code returned for type RunScriptUnprocessableEntity
+const RunScriptUnprocessableEntityCode int = 422
+
+/*
+RunScriptUnprocessableEntity 脚本超过执行步数、时间或内存上限
+
+swagger:response runScriptUnprocessableEntity
+*/
+type RunScriptUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunScriptUnprocessableEntity creates RunScriptUnprocessableEntity with default headers values
+func NewRunScriptUnprocessableEntity() *RunScriptUnprocessableEntity {
+
+	return &RunScriptUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the run script unprocessable entity response
+func (o *RunScriptUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RunScriptUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run script unprocessable entity response
+func (o *RunScriptUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunScriptUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunScriptServiceUnavailableCode is the HTTP code returned for type RunScriptServiceUnavailable
+const RunScriptServiceUnavailableCode int = 503
+
+/*
+RunScriptServiceUnavailable 服务正在停止
+
+swagger:response runScriptServiceUnavailable
+*/
+type RunScriptServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunScriptServiceUnavailable creates RunScriptServiceUnavailable with default headers values
+func NewRunScriptServiceUnavailable() *RunScriptServiceUnavailable {
+
+	return &RunScriptServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the run script service unavailable response
+func (o *RunScriptServiceUnavailable) WithPayload(payload *models.ErrorResponse) *RunScriptServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run script service unavailable response
+func (o *RunScriptServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunScriptServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
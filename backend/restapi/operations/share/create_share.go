@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateShareHandlerFunc turns a function with the right signature into a create share handler
+type CreateShareHandlerFunc func(CreateShareParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateShareHandlerFunc) Handle(params CreateShareParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateShareHandler interface for that can handle valid create share params
+type CreateShareHandler interface {
+	Handle(CreateShareParams, interface{}) middleware.Responder
+}
+
+// NewCreateShare creates a new http.Handler for the create share operation
+func NewCreateShare(ctx *middleware.Context, handler CreateShareHandler) *CreateShare {
+	return &CreateShare{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateShare swagger:route POST /share Share createShare
+
+创建计算分享
+
+保存规范化的计算请求并返回稳定的短ID，网页中通过 /s/{id} 打开。
+未指定游戏版本时固定为当前最新版本，相同的计算得到相同的ID。请求参数无效时不保存。
+*/
+type CreateShare struct {
+	Context *middleware.Context
+	Handler CreateShareHandler
+}
+
+func (o *CreateShare) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateShareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,216 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// CreateShareOKCode is the HTTP code returned for type CreateShareOK
+const CreateShareOKCode int = 200
+
+/*
+CreateShareOK 相同的计算已经分享过，返回已有的分享
+
+swagger:response createShareOK
+*/
+type CreateShareOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Share `json:"body,omitempty"`
+}
+
+// NewCreateShareOK creates CreateShareOK with default headers values
+func NewCreateShareOK() *CreateShareOK {
+
+	return &CreateShareOK{}
+}
+
+// WithPayload adds the payload to the create share o k response
+func (o *CreateShareOK) WithPayload(payload *models.Share) *CreateShareOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create share o k response
+func (o *CreateShareOK) SetPayload(payload *models.Share) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateShareOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateShareCreatedCode is the HTTP code returned for type CreateShareCreated
+const CreateShareCreatedCode int = 201
+
+/*
+CreateShareCreated 已创建分享
+
+swagger:response createShareCreated
+*/
+type CreateShareCreated struct {
+	/*分享的地址
+
+	 */
+	Location string `json:"Location"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Share `json:"body,omitempty"`
+}
+
+// NewCreateShareCreated creates CreateShareCreated with default headers values
+func NewCreateShareCreated() *CreateShareCreated {
+
+	return &CreateShareCreated{}
+}
+
+// WithLocation adds the location to the create share created response
+func (o *CreateShareCreated) WithLocation(location string) *CreateShareCreated {
+	o.Location = location
+	return o
+}
+
+// SetLocation sets the location to the create share created response
+func (o *CreateShareCreated) SetLocation(location string) {
+	o.Location = location
+}
+
+// WithPayload adds the payload to the create share created response
+func (o *CreateShareCreated) WithPayload(payload *models.Share) *CreateShareCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create share created response
+func (o *CreateShareCreated) SetPayload(payload *models.Share) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateShareCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Location
+
+	location := o.Location
+	if location != "" {
+		rw.Header().Set("Location", location)
+	}
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateShareBadRequestCode is the HTTP code returned for type CreateShareBadRequest
+const CreateShareBadRequestCode int = 400
+
+/*
+CreateShareBadRequest 请求参数错误
+
+swagger:response createShareBadRequest
+*/
+type CreateShareBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateShareBadRequest creates CreateShareBadRequest with default headers values
+func NewCreateShareBadRequest() *CreateShareBadRequest {
+
+	return &CreateShareBadRequest{}
+}
+
+// WithPayload adds the payload to the create share bad request response
+func (o *CreateShareBadRequest) WithPayload(payload *models.ErrorResponse) *CreateShareBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create share bad request response
+func (o *CreateShareBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateShareBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateShareServiceUnavailableCode is the HTTP code returned for type CreateShareServiceUnavailable
+const CreateShareServiceUnavailableCode int = 503
+
+/*
+CreateShareServiceUnavailable 未启用数据库或服务正在停止
+
+swagger:response createShareServiceUnavailable
+*/
+type CreateShareServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateShareServiceUnavailable creates CreateShareServiceUnavailable with default headers values
+func NewCreateShareServiceUnavailable() *CreateShareServiceUnavailable {
+
+	return &CreateShareServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the create share service unavailable response
+func (o *CreateShareServiceUnavailable) WithPayload(payload *models.ErrorResponse) *CreateShareServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create share service unavailable response
+func (o *CreateShareServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateShareServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetShareHandlerFunc turns a function with the right signature into a get share handler
+type GetShareHandlerFunc func(GetShareParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetShareHandlerFunc) Handle(params GetShareParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetShareHandler interface for that can handle valid get share params
+type GetShareHandler interface {
+	Handle(GetShareParams, interface{}) middleware.Responder
+}
+
+// NewGetShare creates a new http.Handler for the get share operation
+func NewGetShare(ctx *middleware.Context, handler GetShareHandler) *GetShare {
+	return &GetShare{Context: ctx, Handler: handler}
+}
+
+/*
+	GetShare swagger:route GET /share/{id} Share getShare
+
+获取计算分享
+
+获取分享的计算请求，并按分享时的游戏版本重新计算结果
+*/
+type GetShare struct {
+	Context *middleware.Context
+	Handler GetShareHandler
+}
+
+func (o *GetShare) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetShareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package share
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// GetShareOKCode is the HTTP code returned for type GetShareOK
+const GetShareOKCode int = 200
+
+/*
+GetShareOK 成功获取分享
+
+swagger:response getShareOK
+*/
+type GetShareOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Share `json:"body,omitempty"`
+}
+
+// NewGetShareOK creates GetShareOK with default headers values
+func NewGetShareOK() *GetShareOK {
+
+	return &GetShareOK{}
+}
+
+// WithPayload adds the payload to the get share o k response
+func (o *GetShareOK) WithPayload(payload *models.Share) *GetShareOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get share o k response
+func (o *GetShareOK) SetPayload(payload *models.Share) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetShareOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetShareBadRequestCode is the HTTP code returned for type GetShareBadRequest
+const GetShareBadRequestCode int = 400
+
+/*
+GetShareBadRequest 分享时的游戏版本已不可用
+
+swagger:response getShareBadRequest
+*/
+type GetShareBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetShareBadRequest creates GetShareBadRequest with default headers values
+func NewGetShareBadRequest() *GetShareBadRequest {
+
+	return &GetShareBadRequest{}
+}
+
+// WithPayload adds the payload to the get share bad request response
+func (o *GetShareBadRequest) WithPayload(payload *models.ErrorResponse) *GetShareBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get share bad request response
+func (o *GetShareBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetShareBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetShareNotFoundCode is the HTTP code returned for type GetShareNotFound
+const GetShareNotFoundCode int = 404
+
+/*
+GetShareNotFound 分享不存在
+
+swagger:response getShareNotFound
+*/
+type GetShareNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetShareNotFound creates GetShareNotFound with default headers values
+func NewGetShareNotFound() *GetShareNotFound {
+
+	return &GetShareNotFound{}
+}
+
+// WithPayload adds the payload to the get share not found response
+func (o *GetShareNotFound) WithPayload(payload *models.ErrorResponse) *GetShareNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get share not found response
+func (o *GetShareNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetShareNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetShareServiceUnavailableCode is the HTTP code returned for type GetShareServiceUnavailable
+const GetShareServiceUnavailableCode int = 503
+
+/*
+GetShareServiceUnavailable 未启用数据库或服务正在停止
+
+swagger:response getShareServiceUnavailable
+*/
+type GetShareServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetShareServiceUnavailable creates GetShareServiceUnavailable with default headers values
+func NewGetShareServiceUnavailable() *GetShareServiceUnavailable {
+
+	return &GetShareServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the get share service unavailable response
+func (o *GetShareServiceUnavailable) WithPayload(payload *models.ErrorResponse) *GetShareServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get share service unavailable response
+func (o *GetShareServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetShareServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -34,7 +34,7 @@ func NewHealthCheck(ctx *middleware.Context, handler HealthCheckHandler) *Health
 
 健康检查
 
-检查服务是否正常运行
+检查服务是否正常运行，与/health/live相同，保留用于兼容
 */
 type HealthCheck struct {
 	Context *middleware.Context
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// LivenessCheckHandlerFunc turns a function with the right signature into a liveness check handler
+type LivenessCheckHandlerFunc func(LivenessCheckParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn LivenessCheckHandlerFunc) Handle(params LivenessCheckParams) middleware.Responder {
+	return fn(params)
+}
+
+// LivenessCheckHandler interface for that can handle valid liveness check params
+type LivenessCheckHandler interface {
+	Handle(LivenessCheckParams) middleware.Responder
+}
+
+// NewLivenessCheck creates a new http.Handler for the liveness check operation
+func NewLivenessCheck(ctx *middleware.Context, handler LivenessCheckHandler) *LivenessCheck {
+	return &LivenessCheck{Context: ctx, Handler: handler}
+}
+
+/*
+	LivenessCheck swagger:route GET /health/live System livenessCheck
+
+存活检查
+
+进程能处理请求时返回200，不检查依赖的组件，用于判断是否需要重启
+*/
+type LivenessCheck struct {
+	Context *middleware.Context
+	Handler LivenessCheckHandler
+}
+
+func (o *LivenessCheck) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewLivenessCheckParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// LivenessCheckOKCode is the HTTP code returned for type LivenessCheckOK
+const LivenessCheckOKCode int = 200
+
+/*
+LivenessCheckOK 服务存活
+
+swagger:response livenessCheckOK
+*/
+type LivenessCheckOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HealthResponse `json:"body,omitempty"`
+}
+
+// NewLivenessCheckOK creates LivenessCheckOK with default headers values
+func NewLivenessCheckOK() *LivenessCheckOK {
+
+	return &LivenessCheckOK{}
+}
+
+// WithPayload adds the payload to the liveness check o k response
+func (o *LivenessCheckOK) WithPayload(payload *models.HealthResponse) *LivenessCheckOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the liveness check o k response
+func (o *LivenessCheckOK) SetPayload(payload *models.HealthResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LivenessCheckOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
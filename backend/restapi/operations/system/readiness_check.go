@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ReadinessCheckHandlerFunc turns a function with the right signature into a readiness check handler
+type ReadinessCheckHandlerFunc func(ReadinessCheckParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReadinessCheckHandlerFunc) Handle(params ReadinessCheckParams) middleware.Responder {
+	return fn(params)
+}
+
+// ReadinessCheckHandler interface for that can handle valid readiness check params
+type ReadinessCheckHandler interface {
+	Handle(ReadinessCheckParams) middleware.Responder
+}
+
+// NewReadinessCheck creates a new http.Handler for the readiness check operation
+func NewReadinessCheck(ctx *middleware.Context, handler ReadinessCheckHandler) *ReadinessCheck {
+	return &ReadinessCheck{Context: ctx, Handler: handler}
+}
+
+/*
+	ReadinessCheck swagger:route GET /health/ready System readinessCheck
+
+就绪检查
+
+检查目录是否已加载、缓存和数据库是否可用以及服务是否正在停止，全部正常时返回200，用于判断是否转发流量
+*/
+type ReadinessCheck struct {
+	Context *middleware.Context
+	Handler ReadinessCheckHandler
+}
+
+func (o *ReadinessCheck) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReadinessCheckParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// ReadinessCheckOKCode is the HTTP code returned for type ReadinessCheckOK
+const ReadinessCheckOKCode int = 200
+
+/*
+ReadinessCheckOK 服务就绪
+
+swagger:response readinessCheckOK
+*/
+type ReadinessCheckOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HealthResponse `json:"body,omitempty"`
+}
+
+// NewReadinessCheckOK creates ReadinessCheckOK with default headers values
+func NewReadinessCheckOK() *ReadinessCheckOK {
+
+	return &ReadinessCheckOK{}
+}
+
+// WithPayload adds the payload to the readiness check o k response
+func (o *ReadinessCheckOK) WithPayload(payload *models.HealthResponse) *ReadinessCheckOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the readiness check o k response
+func (o *ReadinessCheckOK) SetPayload(payload *models.HealthResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReadinessCheckOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReadinessCheckServiceUnavailableCode is the HTTP code returned for type ReadinessCheckServiceUnavailable
+const ReadinessCheckServiceUnavailableCode int = 503
+
+/*
+ReadinessCheckServiceUnavailable 服务未就绪，components中为各组件的状态
+
+swagger:response readinessCheckServiceUnavailable
+*/
+type ReadinessCheckServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HealthResponse `json:"body,omitempty"`
+}
+
+// NewReadinessCheckServiceUnavailable creates ReadinessCheckServiceUnavailable with default headers values
+func NewReadinessCheckServiceUnavailable() *ReadinessCheckServiceUnavailable {
+
+	return &ReadinessCheckServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the readiness check service unavailable response
+func (o *ReadinessCheckServiceUnavailable) WithPayload(payload *models.HealthResponse) *ReadinessCheckServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the readiness check service unavailable response
+func (o *ReadinessCheckServiceUnavailable) SetPayload(payload *models.HealthResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReadinessCheckServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
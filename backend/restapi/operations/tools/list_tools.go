@@ -12,16 +12,16 @@ import (
 )
 
 // ListToolsHandlerFunc turns a function with the right signature into a list tools handler
-type ListToolsHandlerFunc func(ListToolsParams) middleware.Responder
+type ListToolsHandlerFunc func(ListToolsParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn ListToolsHandlerFunc) Handle(params ListToolsParams) middleware.Responder {
-	return fn(params)
+func (fn ListToolsHandlerFunc) Handle(params ListToolsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // ListToolsHandler interface for that can handle valid list tools params
 type ListToolsHandler interface {
-	Handle(ListToolsParams) middleware.Responder
+	Handle(ListToolsParams, interface{}) middleware.Responder
 }
 
 // NewListTools creates a new http.Handler for the list tools operation
@@ -34,7 +34,7 @@ func NewListTools(ctx *middleware.Context, handler ListToolsHandler) *ListTools
 
 获取工具列表
 
-获取所有可用的工具
+获取工具注册表中启用的工具，TOOLS_ENABLED和TOOLS_DISABLED配置可以启用或隐藏工具
 */
 type ListTools struct {
 	Context *middleware.Context
@@ -47,12 +47,25 @@ func (o *ListTools) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewListToolsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RunToolHandlerFunc turns a function with the right signature into a run tool handler
+type RunToolHandlerFunc func(RunToolParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RunToolHandlerFunc) Handle(params RunToolParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RunToolHandler interface for that can handle valid run tool params
+type RunToolHandler interface {
+	Handle(RunToolParams, interface{}) middleware.Responder
+}
+
+// NewRunTool creates a new http.Handler for the run tool operation
+func NewRunTool(ctx *middleware.Context, handler RunToolHandler) *RunTool {
+	return &RunTool{Context: ctx, Handler: handler}
+}
+
+/*
+	RunTool swagger:route POST /tools/{id}/run Tools runTool
+
+运行工具
+
+按工具的inputSchema校验输入后运行工具，内置工具的输入为对应计算接口的请求体。
+插件工具在独立进程中运行，超过PLUGINS_TIMEOUT时返回504，插件进程退出后自动重新启动。
+脚本工具超过执行步数、时间或内存上限时返回422。
+*/
+type RunTool struct {
+	Context *middleware.Context
+	Handler RunToolHandler
+}
+
+func (o *RunTool) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRunToolParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
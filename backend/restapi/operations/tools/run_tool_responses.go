@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tools
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+)
+
+// RunToolOKCode is the HTTP code returned for type RunToolOK
+const RunToolOKCode int = 200
+
+/*
+RunToolOK 运行成功
+
+swagger:response runToolOK
+*/
+type RunToolOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ToolRunResponse `json:"body,omitempty"`
+}
+
+// NewRunToolOK creates RunToolOK with default headers values
+func NewRunToolOK() *RunToolOK {
+
+	return &RunToolOK{}
+}
+
+// WithPayload adds the payload to the run tool o k response
+func (o *RunToolOK) WithPayload(payload *models.ToolRunResponse) *RunToolOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool o k response
+func (o *RunToolOK) SetPayload(payload *models.ToolRunResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolBadRequestCode is the HTTP code returned for type RunToolBadRequest
+const RunToolBadRequestCode int = 400
+
+/*
+RunToolBadRequest 输入不符合工具的inputSchema或计算参数错误
+
+swagger:response runToolBadRequest
+*/
+type RunToolBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolBadRequest creates RunToolBadRequest with default headers values
+func NewRunToolBadRequest() *RunToolBadRequest {
+
+	return &RunToolBadRequest{}
+}
+
+// WithPayload adds the payload to the run tool bad request response
+func (o *RunToolBadRequest) WithPayload(payload *models.ErrorResponse) *RunToolBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool bad request response
+func (o *RunToolBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolNotFoundCode is the HTTP code returned for type RunToolNotFound
+const RunToolNotFoundCode int = 404
+
+/*
+RunToolNotFound 工具不存在或未启用
+
+swagger:response runToolNotFound
+*/
+type RunToolNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolNotFound creates RunToolNotFound with default headers values
+func NewRunToolNotFound() *RunToolNotFound {
+
+	return &RunToolNotFound{}
+}
+
+// WithPayload adds the payload to the run tool not found response
+func (o *RunToolNotFound) WithPayload(payload *models.ErrorResponse) *RunToolNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool not found response
+func (o *RunToolNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolUnprocessableEntityCode is the HTTP code returned for type RunToolUnprocessableEntity
+const RunToolUnprocessableEntityCode int = 422
+
+/*
+RunToolUnprocessableEntity 脚本工具超过执行步数、时间或内存上限
+
+swagger:response runToolUnprocessableEntity
+*/
+type RunToolUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolUnprocessableEntity creates RunToolUnprocessableEntity with default headers values
+func NewRunToolUnprocessableEntity() *RunToolUnprocessableEntity {
+
+	return &RunToolUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the run tool unprocessable entity response
+func (o *RunToolUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RunToolUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool unprocessable entity response
+func (o *RunToolUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolBadGatewayCode is the HTTP code returned for type RunToolBadGateway
+const RunToolBadGatewayCode int = 502
+
+/*
+RunToolBadGateway 插件运行失败
+
+swagger:response runToolBadGateway
+*/
+type RunToolBadGateway struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolBadGateway creates RunToolBadGateway with default headers values
+func NewRunToolBadGateway() *RunToolBadGateway {
+
+	return &RunToolBadGateway{}
+}
+
+// WithPayload adds the payload to the run tool bad gateway response
+func (o *RunToolBadGateway) WithPayload(payload *models.ErrorResponse) *RunToolBadGateway {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool bad gateway response
+func (o *RunToolBadGateway) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolBadGateway) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(502)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolServiceUnavailableCode is the HTTP code returned for type RunToolServiceUnavailable
+const RunToolServiceUnavailableCode int = 503
+
+/*
+RunToolServiceUnavailable 插件正在重新启动或服务正在停止
+
+swagger:response runToolServiceUnavailable
+*/
+type RunToolServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolServiceUnavailable creates RunToolServiceUnavailable with default headers values
+func NewRunToolServiceUnavailable() *RunToolServiceUnavailable {
+
+	return &RunToolServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the run tool service unavailable response
+func (o *RunToolServiceUnavailable) WithPayload(payload *models.ErrorResponse) *RunToolServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool service unavailable response
+func (o *RunToolServiceUnavailable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RunToolGatewayTimeoutCode is the HTTP code returned for type RunToolGatewayTimeout
+const RunToolGatewayTimeoutCode int = 504
+
+/*
+RunToolGatewayTimeout 插件运行超时
+
+swagger:response runToolGatewayTimeout
+*/
+type RunToolGatewayTimeout struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRunToolGatewayTimeout creates RunToolGatewayTimeout with default headers values
+func NewRunToolGatewayTimeout() *RunToolGatewayTimeout {
+
+	return &RunToolGatewayTimeout{}
+}
+
+// WithPayload adds the payload to the run tool gateway timeout response
+func (o *RunToolGatewayTimeout) WithPayload(payload *models.ErrorResponse) *RunToolGatewayTimeout {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the run tool gateway timeout response
+func (o *RunToolGatewayTimeout) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RunToolGatewayTimeout) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(504)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
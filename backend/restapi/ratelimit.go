@@ -0,0 +1,53 @@
+package restapi
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/auth"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/handlers"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/ratelimit"
+)
+
+// newRateLimiter 根据配置创建限流器，认证存储中有效的key与RATE_LIMIT_API_KEYS一样按key限流
+func newRateLimiter(cfg *config.Config, authenticator *auth.Authenticator) *ratelimit.Limiter {
+	trustedProxies, err := ratelimit.ParseTrustedProxies(cfg.RateLimit.TrustedProxies)
+	if err != nil {
+		slog.Error("限流配置无效", "error", err)
+		os.Exit(1)
+	}
+
+	period := cfg.RateLimit.Period
+	limiterConfig := ratelimit.Config{
+		IP: ratelimit.Policy{
+			Cheap:     ratelimit.Rate{Limit: cfg.RateLimit.Requests, Period: period},
+			Expensive: ratelimit.Rate{Limit: cfg.RateLimit.ExpensiveRequests, Period: period},
+		},
+		APIKey: ratelimit.Policy{
+			Cheap:     ratelimit.Rate{Limit: cfg.RateLimit.KeyRequests, Period: period},
+			Expensive: ratelimit.Rate{Limit: cfg.RateLimit.KeyExpensive, Period: period},
+		},
+		APIKeys:        cfg.RateLimit.APIKeys,
+		KnownKey:       authenticator.Known,
+		TrustedProxies: trustedProxies,
+		PathPrefix:     "/api/v1/",
+		ExemptPaths:    []string{"/api/v1/health", "/api/v1/health/live", "/api/v1/health/ready"},
+	}
+
+	return ratelimit.NewLimiter(newRateLimitStore(cfg), limiterConfig, handlers.RateLimited)
+}
+
+// newRateLimitStore 创建令牌桶存储，Redis不可用时回退到内存存储
+func newRateLimitStore(cfg *config.Config) ratelimit.Store {
+	if cfg.RateLimit.Store != "redis" {
+		return ratelimit.NewMemoryStore()
+	}
+
+	client := newRedisClient(cfg, "ratelimit")
+	if client == nil {
+		slog.Warn("限流使用内存存储")
+		return ratelimit.NewMemoryStore()
+	}
+	return ratelimit.NewRedisStore(client, "oncehuman:ratelimit:")
+}
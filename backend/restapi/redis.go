@@ -0,0 +1,33 @@
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+)
+
+// newRedisClient 根据配置连接Redis，连接失败时返回nil，purpose用于日志，停止服务时关闭连接
+func newRedisClient(cfg *config.Config, purpose string) *redis.Client {
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		slog.Warn("连接Redis失败", "purpose", purpose, "error", err)
+		client.Close()
+		return nil
+	}
+
+	slog.Info("已连接Redis", "purpose", purpose, "addr", client.Options().Addr)
+	onShutdown(client.Close)
+	return client
+}
@@ -0,0 +1,47 @@
+package restapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/rs/cors"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/security"
+)
+
+// newCORS 根据配置创建跨域中间件，未配置允许的来源时不处理跨域请求
+func newCORS(cfg *config.Config) func(http.Handler) http.Handler {
+	if len(cfg.CORS.AllowedOrigins) == 0 {
+		return func(handler http.Handler) http.Handler { return handler }
+	}
+
+	for _, origin := range cfg.CORS.AllowedOrigins {
+		if origin == "*" && cfg.CORS.AllowCredentials {
+			slog.Warn("CORS允许任意来源携带凭据，任何网站都可以以用户身份调用API")
+		}
+	}
+
+	return cors.New(cors.Options{
+		AllowedOrigins:   cfg.CORS.AllowedOrigins,
+		AllowedMethods:   cfg.CORS.AllowedMethods,
+		AllowedHeaders:   cfg.CORS.AllowedHeaders,
+		ExposedHeaders:   cfg.CORS.ExposedHeaders,
+		AllowCredentials: cfg.CORS.AllowCredentials,
+		MaxAge:           int(cfg.CORS.MaxAge.Seconds()),
+	}).Handler
+}
+
+// newSecurityHeaders 根据配置创建安全响应头中间件
+func newSecurityHeaders(cfg *config.Config) func(http.Handler) http.Handler {
+	headersConfig := security.HeadersConfig{
+		HSTSMaxAge:            cfg.Security.HSTSMaxAge,
+		HSTSIncludeSubdomains: cfg.Security.HSTSIncludeSubdomains,
+		ContentSecurityPolicy: cfg.Security.ContentSecurityPolicy,
+		DocsSecurityPolicy:    cfg.Security.DocsSecurityPolicy,
+		DocsPath:              "/api/v1/docs",
+	}
+	return func(handler http.Handler) http.Handler {
+		return security.Headers(headersConfig, handler)
+	}
+}
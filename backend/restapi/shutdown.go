@@ -0,0 +1,103 @@
+package restapi
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/health"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/jobs"
+)
+
+// ShutdownMargin 宽限期结束后等待被取消的请求返回的时间，服务器的--graceful-timeout至少为停止延迟、宽限期与此之和
+const ShutdownMargin = 5 * time.Second
+
+// baseCtx 所有请求上下文的父上下文，宽限期结束时取消，进行中的计算随之停止
+var baseCtx, cancelBase = context.WithCancel(context.Background())
+
+// baseContext 作为http.Server的BaseContext
+func baseContext(net.Listener) context.Context {
+	return baseCtx
+}
+
+var (
+	closersMu sync.Mutex
+	closers   []func() error
+)
+
+// onShutdown 注册停止服务时最后执行的清理函数，如写入API key用量和关闭Redis连接
+func onShutdown(closer func() error) {
+	closersMu.Lock()
+	defer closersMu.Unlock()
+	closers = append(closers, closer)
+}
+
+// shutdown 停止服务的流程：就绪检查返回503并拒绝新任务，等待进行中的请求和任务结束，超过宽限期后取消剩余的计算
+type shutdown struct {
+	cfg        config.ShutdownConfig
+	checker    *health.Checker
+	jobManager *jobs.Manager
+	jobsDone   chan struct{}
+}
+
+// newShutdown 创建停止服务流程
+func newShutdown(cfg *config.Config, checker *health.Checker, jobManager *jobs.Manager) *shutdown {
+	return &shutdown{
+		cfg:        cfg.Shutdown,
+		checker:    checker,
+		jobManager: jobManager,
+		jobsDone:   make(chan struct{}),
+	}
+}
+
+// prepare 在服务器停止监听前执行，停止延迟期间仍接受请求以便负载均衡摘除实例
+func (s *shutdown) prepare() {
+	deadline := time.Now().Add(s.cfg.DrainDelay + s.cfg.GracePeriod)
+	slog.Info("开始停止服务", "drain_delay", s.cfg.DrainDelay.String(), "grace_period", s.cfg.GracePeriod.String())
+
+	s.checker.SetDraining()
+	s.jobManager.Drain()
+
+	go func() {
+		defer close(s.jobsDone)
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+		if err := s.jobManager.Shutdown(ctx); err != nil {
+			slog.Warn("宽限期内未结束的任务已取消")
+		}
+	}()
+
+	go func() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		select {
+		case <-baseCtx.Done():
+		case <-timer.C:
+			slog.Warn("宽限期已到，取消进行中的请求")
+			cancelBase()
+		}
+	}()
+
+	if s.cfg.DrainDelay > 0 {
+		time.Sleep(s.cfg.DrainDelay)
+	}
+	slog.Info("停止接受新连接，等待进行中的请求结束")
+}
+
+// finish 在进行中的请求结束后执行，等待任务结束并执行清理函数
+func (s *shutdown) finish() {
+	<-s.jobsDone
+	cancelBase()
+
+	closersMu.Lock()
+	defer closersMu.Unlock()
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i](); err != nil {
+			slog.Warn("停止服务时清理失败", "error", err)
+		}
+	}
+	slog.Info("服务已停止")
+}
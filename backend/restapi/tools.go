@@ -0,0 +1,89 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/go-openapi/loads"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/config"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/handlers"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/plugins"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/registry"
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/script"
+)
+
+// newToolRegistry 创建工具注册表并注册内置工具、插件和脚本工具，配置的功能开关启用或隐藏工具；
+// 内置工具注册失败时退出，与已注册的工具ID重复的插件和脚本工具跳过
+func newToolRegistry(cfg *config.Config, modHandler *handlers.ModHandler) *registry.Registry {
+	reg := registry.New(cfg.Tools.Enabled, cfg.Tools.Disabled)
+	for _, tool := range modHandler.BuiltinTools(definitionSchemas()) {
+		if err := reg.Register(tool); err != nil {
+			slog.Error("注册工具失败", "tool", tool.ID, "error", err)
+			os.Exit(1)
+		}
+	}
+	for _, plugin := range newPluginManager(cfg).Plugins() {
+		if err := reg.Register(plugin.Tool()); err != nil {
+			slog.Error("注册插件工具失败，已跳过", "tool", plugin.Info().ID, "error", err)
+		}
+	}
+	for _, tool := range loadScriptTools(cfg) {
+		if err := reg.Register(tool); err != nil {
+			slog.Error("注册脚本工具失败，已跳过", "tool", tool.ID, "error", err)
+		}
+	}
+	return reg
+}
+
+// loadScriptTools 加载脚本目录中的脚本工具
+func loadScriptTools(cfg *config.Config) []registry.Tool {
+	tools, err := script.LoadTools(cfg.Scripts.Dir, script.ConfigOptions(cfg.Scripts))
+	if err != nil {
+		slog.Error("加载脚本工具失败", "dir", cfg.Scripts.Dir, "error", err)
+		os.Exit(1)
+	}
+	return tools
+}
+
+// newPluginManager 启动插件目录中的插件，停止服务时结束插件进程
+func newPluginManager(cfg *config.Config) *plugins.Manager {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	manager, err := plugins.Load(ctx, cfg.Plugins.Dir, plugins.ConfigOptions(cfg.Plugins))
+	if err != nil {
+		slog.Error("加载插件失败", "dir", cfg.Plugins.Dir, "error", err)
+		os.Exit(1)
+	}
+	onShutdown(manager.Close)
+	return manager
+}
+
+// definitionSchemas 展开一次API规范，返回按名称获取定义的JSON Schema的函数，引用已展开，定义不存在时返回nil
+func definitionSchemas() func(name string) json.RawMessage {
+	doc, err := loads.Analyzed(SwaggerJSON, "")
+	if err == nil {
+		doc, err = doc.Expanded()
+	}
+	if err != nil {
+		slog.Error("展开API规范失败", "error", err)
+		return func(string) json.RawMessage { return nil }
+	}
+
+	definitions := doc.Spec().Definitions
+	return func(name string) json.RawMessage {
+		schema, ok := definitions[name]
+		if !ok {
+			return nil
+		}
+		data, err := json.Marshal(schema)
+		if err != nil {
+			return nil
+		}
+		return data
+	}
+}
@@ -7,7 +7,18 @@ import (
 // AffixProbabilityResult 词条概率计算结果
 type AffixProbabilityResult = services.AffixProbabilityResult
 
+// RarityProbability 单个稀有度下的概率
+type RarityProbability = services.RarityProbability
+
 // NewAffixProbabilityService 创建词条概率服务
 func NewAffixProbabilityService() *services.AffixProbabilityService {
 	return services.NewAffixProbabilityService()
 }
+
+// AffixMatch 词条匹配结果
+type AffixMatch = services.AffixMatch
+
+// NewAffixSearchService 创建词条搜索服务
+func NewAffixSearchService() *services.AffixSearchService {
+	return services.NewAffixSearchService()
+}
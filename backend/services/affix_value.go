@@ -0,0 +1,16 @@
+package services
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+)
+
+// AffixValueProbabilityResult 词条数值概率计算结果
+type AffixValueProbabilityResult = services.AffixValueProbabilityResult
+
+// AffixValueRequirement 词条数值要求
+type AffixValueRequirement = services.AffixValueRequirement
+
+// NewAffixValueProbabilityService 创建词条数值概率服务
+func NewAffixValueProbabilityService() *services.AffixValueProbabilityService {
+	return services.NewAffixValueProbabilityService()
+}
@@ -0,0 +1,4 @@
+// Package services 早期为机器人导出的服务构造函数，返回的服务类型属于internal包，外部模块无法使用其方法签名中的其他类型。
+//
+// Deprecated: 使用pkg/calc调用计算，使用pkg/client调用HTTP接口。
+package services
@@ -14,6 +14,13 @@ type Bot interface {
 	IsRunning() bool
 }
 
+// InstrumentedBot 支持指标的机器人，注册到管理器时接收管理器的指标
+type InstrumentedBot interface {
+	Bot
+	// SetMetrics 设置命令指标
+	SetMetrics(metrics *Metrics)
+}
+
 // Command 命令接口
 type Command interface {
 	// GetName 获取命令名称
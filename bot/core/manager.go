@@ -8,14 +8,16 @@ import (
 
 // BotManager 机器人管理器
 type BotManager struct {
-	bots map[string]Bot
-	mu   sync.RWMutex
+	bots    map[string]Bot
+	metrics *Metrics
+	mu      sync.RWMutex
 }
 
 // NewBotManager 创建新的机器人管理器
 func NewBotManager() *BotManager {
 	return &BotManager{
-		bots: make(map[string]Bot),
+		bots:    make(map[string]Bot),
+		metrics: NewMetrics(),
 	}
 }
 
@@ -30,9 +32,18 @@ func (m *BotManager) Register(bot Bot) error {
 	}
 
 	m.bots[name] = bot
+	m.metrics.watchRunning(bot)
+	if instrumented, ok := bot.(InstrumentedBot); ok {
+		instrumented.SetMetrics(m.metrics)
+	}
 	return nil
 }
 
+// Metrics 获取所有机器人共用的指标
+func (m *BotManager) Metrics() *Metrics {
+	return m.metrics
+}
+
 // Start 启动所有机器人
 func (m *BotManager) Start(ctx context.Context) error {
 	m.mu.RLock()
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics 机器人指标，按平台和命令统计调用数、处理耗时和错误数
+type Metrics struct {
+	registry *prometheus.Registry
+	commands *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewMetrics 创建机器人指标，注册表包含Go运行时和进程指标
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		commands: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "oncehuman",
+			Subsystem: "bot",
+			Name:      "commands_total",
+			Help:      "命令调用数，按平台和命令统计",
+		}, []string{"platform", "command"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "oncehuman",
+			Subsystem: "bot",
+			Name:      "command_duration_seconds",
+			Help:      "命令处理耗时，按平台和命令统计",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 10),
+		}, []string{"platform", "command"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "oncehuman",
+			Subsystem: "bot",
+			Name:      "command_errors_total",
+			Help:      "命令失败数，按平台和命令统计",
+		}, []string{"platform", "command"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.commands,
+		m.duration,
+		m.errors,
+	)
+	return m
+}
+
+// ObserveCommand 记录一次命令调用
+func (m *Metrics) ObserveCommand(platform, command string, duration time.Duration, failed bool) {
+	m.commands.WithLabelValues(platform, command).Inc()
+	m.duration.WithLabelValues(platform, command).Observe(duration.Seconds())
+	if failed {
+		m.errors.WithLabelValues(platform, command).Inc()
+	}
+}
+
+// watchRunning 注册机器人运行状态指标，运行中为1
+func (m *Metrics) watchRunning(bot Bot) {
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   "oncehuman",
+		Subsystem:   "bot",
+		Name:        "running",
+		Help:        "机器人是否正在运行",
+		ConstLabels: prometheus.Labels{"platform": bot.GetName()},
+	}, func() float64 {
+		if bot.IsRunning() {
+			return 1
+		}
+		return 0
+	}))
+}
+
+// Handler 输出Prometheus文本格式指标的处理器
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
 	"github.com/SpenserCai/OnceHumanTools/bot/core"
 	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
 	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord/commands"
@@ -15,25 +20,43 @@ import (
 
 func main() {
 	// 加载环境变量
-	if err := godotenv.Load(); err != nil {
-		log.Println("未找到 .env 文件，使用系统环境变量")
+	envErr := godotenv.Load()
+
+	// 初始化日志，级别和格式与后端相同
+	logging.Setup(os.Getenv("LOG_LEVEL"), os.Getenv("LOG_FORMAT"))
+	if envErr != nil {
+		slog.Info("未找到 .env 文件，使用系统环境变量")
 	}
 
 	// 创建机器人管理器
 	manager := core.NewBotManager()
 
+	// 通过后端的预设接口保存用户和服务器预设
+	api := newAPIClient()
+	presets := commands.NewPresetStore(api)
+
+	// 后端加载的插件作为通用命令注册，通过后端的工具接口运行
+	pluginTools := loadPluginTools(api)
+
 	// 初始化Discord机器人
 	if discordToken := os.Getenv("DISCORD_BOT_TOKEN"); discordToken != "" {
-		if err := initDiscordBot(manager, discordToken); err != nil {
-			log.Fatalf("初始化Discord机器人失败: %v", err)
+		if err := initDiscordBot(manager, discordToken, api, presets, pluginTools); err != nil {
+			slog.Error("初始化Discord机器人失败", "error", err)
+			os.Exit(1)
 		}
 	} else {
-		log.Println("未配置 DISCORD_BOT_TOKEN，跳过Discord机器人")
+		slog.Warn("未配置 DISCORD_BOT_TOKEN，跳过Discord机器人")
 	}
 
 	// 检查是否有机器人被注册
 	if len(manager.ListBots()) == 0 {
-		log.Fatal("没有可用的机器人，请至少配置一个机器人")
+		slog.Error("没有可用的机器人，请至少配置一个机器人")
+		os.Exit(1)
+	}
+
+	// 启动指标服务，未配置 METRICS_ADDR 时不启动
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		go serveMetrics(addr, manager.Metrics())
 	}
 
 	// 创建上下文
@@ -43,7 +66,7 @@ func main() {
 	// 启动所有机器人
 	go func() {
 		if err := manager.Start(ctx); err != nil {
-			log.Printf("机器人启动失败: %v", err)
+			slog.Error("机器人启动失败", "error", err)
 			cancel()
 		}
 	}()
@@ -54,21 +77,60 @@ func main() {
 
 	select {
 	case <-sigChan:
-		log.Println("收到中断信号，正在停止机器人...")
+		slog.Info("收到中断信号，正在停止机器人...")
 	case <-ctx.Done():
-		log.Println("上下文已取消，正在停止机器人...")
+		slog.Info("上下文已取消，正在停止机器人...")
 	}
 
 	// 停止所有机器人
 	if err := manager.Stop(); err != nil {
-		log.Printf("停止机器人时出错: %v", err)
+		slog.Error("停止机器人时出错", "error", err)
+	}
+
+	slog.Info("程序已退出")
+}
+
+// serveMetrics 在指定地址的/metrics路径输出Prometheus指标
+func serveMetrics(addr string, metrics *core.Metrics) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	slog.Info("指标服务已启动", "addr", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		slog.Error("指标服务失败", "error", err)
+	}
+}
+
+// newAPIClient 按API_BASE_URL和API_KEY创建后端客户端，未配置API_BASE_URL时返回nil，只能使用内置预设，没有插件命令
+func newAPIClient() *client.OncehumanTools {
+	baseURL := os.Getenv("API_BASE_URL")
+	if baseURL == "" {
+		slog.Info("未配置 API_BASE_URL，只能使用内置预设，不注册插件命令")
+		return nil
 	}
 
-	log.Println("程序已退出")
+	api, err := client.NewWithOptions(client.Options{BaseURL: baseURL, APIKey: os.Getenv("API_KEY")})
+	if err != nil {
+		slog.Error("创建后端客户端失败", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("已配置后端", "base_url", baseURL)
+	return api
+}
+
+// loadPluginTools 从后端获取插件工具，获取失败时记录日志，不注册插件命令
+func loadPluginTools(api *client.OncehumanTools) []*models.Tool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	pluginTools, err := commands.LoadPluginTools(ctx, api)
+	if err != nil {
+		slog.Error("获取插件列表失败，不注册插件命令", "error", err)
+	}
+	return pluginTools
 }
 
 // initDiscordBot 初始化Discord机器人
-func initDiscordBot(manager *core.BotManager, token string) error {
+func initDiscordBot(manager *core.BotManager, token string, api *client.OncehumanTools, presets *commands.PresetStore, pluginTools []*models.Tool) error {
 	// 配置
 	config := &discord.Config{
 		Token:   token,
@@ -84,8 +146,20 @@ func initDiscordBot(manager *core.BotManager, token string) error {
 
 	// 注册命令
 	bot.RegisterCommand(commands.CreateHelpCommand())
-	bot.RegisterCommand(commands.CreateAffixCommand())
+	bot.RegisterCommand(commands.CreateAffixCommand(presets))
+	bot.RegisterCommand(commands.CreateAffixValueCommand(presets))
 	bot.RegisterCommand(commands.CreateStrengthenCommand())
+	bot.RegisterCommand(commands.CreatePresetCommand(presets))
+
+	// 插件命令，与内置命令同名的插件跳过
+	for _, tool := range pluginTools {
+		cmd := commands.CreatePluginCommand(api, tool)
+		if bot.HasCommand(cmd.Command.Name) {
+			slog.Warn("插件与内置命令同名，跳过", "plugin", cmd.Command.Name)
+			continue
+		}
+		bot.RegisterCommand(cmd)
+	}
 
 	// 注册到管理器
 	return manager.Register(bot)
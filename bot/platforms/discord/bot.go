@@ -3,9 +3,12 @@ package discord
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/bot/core"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -15,6 +18,7 @@ type DiscordBot struct {
 	config   *Config
 	commands map[string]*SlashCommand
 	handlers map[string]CommandHandler
+	metrics  *core.Metrics
 	running  bool
 	mu       sync.RWMutex
 }
@@ -32,8 +36,9 @@ type CommandHandler func(s *discordgo.Session, i *discordgo.InteractionCreate)
 
 // SlashCommand 斜杠命令定义
 type SlashCommand struct {
-	Command *discordgo.ApplicationCommand
-	Handler CommandHandler
+	Command      *discordgo.ApplicationCommand
+	Handler      CommandHandler
+	Autocomplete CommandHandler // 可选，处理设置了Autocomplete的选项的补全
 }
 
 // NewDiscordBot 创建Discord机器人
@@ -72,11 +77,11 @@ func (b *DiscordBot) Start(ctx context.Context) error {
 	}
 
 	b.running = true
-	log.Println("Discord机器人已启动")
+	slog.Info("Discord机器人已启动")
 
 	// 注册命令
 	if err := b.registerCommands(); err != nil {
-		log.Printf("注册命令失败: %v", err)
+		slog.Error("注册命令失败", "error", err)
 	}
 
 	// 等待上下文取消
@@ -102,7 +107,7 @@ func (b *DiscordBot) Stop() error {
 	}
 
 	b.running = false
-	log.Println("Discord机器人已停止")
+	slog.Info("Discord机器人已停止")
 	return nil
 }
 
@@ -118,6 +123,13 @@ func (b *DiscordBot) IsRunning() bool {
 	return b.running
 }
 
+// SetMetrics 设置命令指标
+func (b *DiscordBot) SetMetrics(metrics *core.Metrics) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.metrics = metrics
+}
+
 // RegisterCommand 注册命令
 func (b *DiscordBot) RegisterCommand(cmd *SlashCommand) {
 	b.mu.Lock()
@@ -127,6 +139,14 @@ func (b *DiscordBot) RegisterCommand(cmd *SlashCommand) {
 	b.handlers[cmd.Command.Name] = cmd.Handler
 }
 
+// HasCommand 检查是否已注册同名命令
+func (b *DiscordBot) HasCommand(name string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, ok := b.commands[name]
+	return ok
+}
+
 // registerCommands 注册所有命令到Discord
 func (b *DiscordBot) registerCommands() error {
 	b.mu.RLock()
@@ -143,9 +163,9 @@ func (b *DiscordBot) registerCommands() error {
 		}
 		
 		if err != nil {
-			log.Printf("注册命令 %s 失败: %v", cmd.Command.Name, err)
+			slog.Error("注册命令失败", "command", cmd.Command.Name, "error", err)
 		} else {
-			log.Printf("成功注册命令: %s", cmd.Command.Name)
+			slog.Info("成功注册命令", "command", cmd.Command.Name)
 		}
 	}
 
@@ -163,14 +183,14 @@ func (b *DiscordBot) cleanupCommands() {
 
 		cmds, err := b.session.ApplicationCommands(b.session.State.User.ID, guildID)
 		if err != nil {
-			log.Printf("获取命令列表失败: %v", err)
+			slog.Error("获取命令列表失败", "error", err)
 			return
 		}
 
 		for _, cmd := range cmds {
 			err := b.session.ApplicationCommandDelete(b.session.State.User.ID, guildID, cmd.ID)
 			if err != nil {
-				log.Printf("删除命令 %s 失败: %v", cmd.Name, err)
+				slog.Error("删除命令失败", "command", cmd.Name, "error", err)
 			}
 		}
 	}
@@ -178,7 +198,7 @@ func (b *DiscordBot) cleanupCommands() {
 
 // handleReady 处理就绪事件
 func (b *DiscordBot) handleReady(s *discordgo.Session, r *discordgo.Ready) {
-	log.Printf("Discord机器人已登录: %s#%s", s.State.User.Username, s.State.User.Discriminator)
+	slog.Info("Discord机器人已登录", "user", s.State.User.Username+"#"+s.State.User.Discriminator)
 	
 	// 设置状态
 	s.UpdateStatusComplex(discordgo.UpdateStatusData{
@@ -194,7 +214,11 @@ func (b *DiscordBot) handleReady(s *discordgo.Session, r *discordgo.Ready) {
 
 // handleInteraction 处理交互事件
 func (b *DiscordBot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	// 只处理应用命令
+	// 只处理应用命令和选项补全
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		b.handleAutocomplete(s, i)
+		return
+	}
 	if i.Type != discordgo.InteractionApplicationCommand {
 		return
 	}
@@ -205,15 +229,44 @@ func (b *DiscordBot) handleInteraction(s *discordgo.Session, i *discordgo.Intera
 	// 查找处理器
 	b.mu.RLock()
 	handler, ok := b.handlers[data.Name]
+	metrics := b.metrics
 	b.mu.RUnlock()
 
+	ctx := InteractionContext(i)
+	logger := logging.FromContext(ctx).With("command", data.Name)
 	if !ok {
-		log.Printf("未找到命令处理器: %s", data.Name)
+		logger.Warn("未找到命令处理器")
 		return
 	}
 
-	// 执行处理器
+	// 执行处理器并记录命令日志，日志与后端计算日志使用相同的请求ID
+	start := time.Now()
 	handler(s, i)
+	duration := time.Since(start)
+	failed := takeFailed(i)
+	logger.Info("command",
+		"user_id", InteractionUserID(i),
+		"guild_id", i.GuildID,
+		"options", commandOptions(data.Options),
+		"failed", failed,
+		"duration_ms", float64(duration.Microseconds())/1000)
+	if metrics != nil {
+		metrics.ObserveCommand(b.GetName(), data.Name, duration, failed)
+	}
+}
+
+// handleAutocomplete 处理选项补全，补全不计入命令日志和指标
+func (b *DiscordBot) handleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ApplicationCommandData()
+
+	b.mu.RLock()
+	cmd, ok := b.commands[data.Name]
+	b.mu.RUnlock()
+
+	if !ok || cmd.Autocomplete == nil {
+		return
+	}
+	cmd.Autocomplete(s, i)
 }
 
 // CreateResponse 创建响应助手
@@ -278,14 +331,29 @@ func (r *InteractionResponse) SendEmbed(embed *discordgo.MessageEmbed) error {
 
 // SendError 发送错误消息
 func (r *InteractionResponse) SendError(err error) error {
+	logging.FromContext(r.Context()).Warn("命令执行失败", "error", err)
+	markFailed(r.interaction)
 	embed := &discordgo.MessageEmbed{
-		Title:       "❌ 错误",
-		Description: err.Error(),
+		Title:       r.errorTitle(err),
+		Description: r.localizeError(err),
 		Color:       0xFF0000,
 	}
 	return r.SendEmbed(embed)
 }
 
+// SendChoices 返回选项补全的候选项，最多25个
+func (r *InteractionResponse) SendChoices(choices []*discordgo.ApplicationCommandOptionChoice) error {
+	if len(choices) > 25 {
+		choices = choices[:25]
+	}
+	return r.session.InteractionRespond(r.interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+}
+
 // FollowUp 发送跟进消息
 func (r *InteractionResponse) FollowUp(content string) error {
 	_, err := r.session.FollowupMessageCreate(r.interaction.Interaction, true, &discordgo.WebhookParams{
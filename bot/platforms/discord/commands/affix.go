@@ -2,49 +2,78 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/calc"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
 	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
 	"github.com/bwmarrin/discordgo"
 )
 
-// CreateAffixCommand 创建词条概率计算命令
-func CreateAffixCommand() *discord.SlashCommand {
+// CreateAffixCommand 创建词条概率计算命令，目标词条可以引用presets中的预设
+func CreateAffixCommand(presets *PresetStore) *discord.SlashCommand {
 	return &discord.SlashCommand{
 		Command: &discordgo.ApplicationCommand{
-			Name:        "affix",
-			Description: "计算模组词条概率",
+			Name:                     "affix",
+			Description:              defaultText("bot.affix.description"),
+			DescriptionLocalizations: localizationsPtr("bot.affix.description"),
 			Options: []*discordgo.ApplicationCommandOption{
 				{
-					Type:        discordgo.ApplicationCommandOptionInteger,
-					Name:        "slots",
-					Description: "词条数量 (1-10)",
-					Required:    true,
-					MinValue:    &[]float64{1}[0],
-					MaxValue:    10,
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "targets",
+					Description:              defaultText("bot.affix.option.targets"),
+					DescriptionLocalizations: discord.Localizations("bot.affix.option.targets"),
+					Required:                 true,
+					Autocomplete:             true,
 				},
 				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "targets",
-					Description: "目标词条ID列表，用逗号分隔 (例如: 1,4,5,6)",
-					Required:    true,
+					Type:                     discordgo.ApplicationCommandOptionInteger,
+					Name:                     "slots",
+					Description:              defaultText("bot.affix.option.slots"),
+					DescriptionLocalizations: discord.Localizations("bot.affix.option.slots"),
+					Required:                 false,
+					MinValue:                 &[]float64{1}[0],
+					MaxValue:                 10,
 				},
 				{
-					Type:        discordgo.ApplicationCommandOptionBoolean,
-					Name:        "show_combinations",
-					Description: "是否显示详细组合",
-					Required:    false,
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "rarity",
+					Description:              defaultText("bot.affix.option.rarity"),
+					DescriptionLocalizations: discord.Localizations("bot.affix.option.rarity"),
+					Required:                 false,
+					Choices:                  GetRarityChoices(),
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionBoolean,
+					Name:                     "show_combinations",
+					Description:              defaultText("bot.affix.option.show_combinations"),
+					DescriptionLocalizations: discord.Localizations("bot.affix.option.show_combinations"),
+					Required:                 false,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "game_version",
+					Description:              defaultText("bot.option.game_version"),
+					DescriptionLocalizations: discord.Localizations("bot.option.game_version"),
+					Required:                 false,
+					Choices:                  GetGameVersionChoices(),
 				},
 			},
 		},
-		Handler: handleAffixCommand,
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			handleAffixCommand(s, i, presets)
+		},
+		Autocomplete: presets.autocomplete,
 	}
 }
 
 // handleAffixCommand 处理词条概率计算命令
-func handleAffixCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func handleAffixCommand(s *discordgo.Session, i *discordgo.InteractionCreate, presets *PresetStore) {
 	resp := discord.CreateResponse(s, i)
+	locale := resp.Locale()
 
 	// 延迟响应，因为计算可能需要时间
 	if err := resp.Defer(); err != nil {
@@ -54,7 +83,7 @@ func handleAffixCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	// 获取参数
 	options := i.ApplicationCommandData().Options
 	var slotCount int
-	var targetStr string
+	var targetStr, rarity, gameVersion string
 	showCombinations := false
 
 	for _, opt := range options {
@@ -63,64 +92,64 @@ func handleAffixCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 			slotCount = int(opt.IntValue())
 		case "targets":
 			targetStr = opt.StringValue()
+		case "rarity":
+			rarity = opt.StringValue()
+		case "game_version":
+			gameVersion = opt.StringValue()
 		case "show_combinations":
-			if opt.BoolValue() != nil {
-				showCombinations = *opt.BoolValue()
-			}
+			showCombinations = opt.BoolValue()
 		}
 	}
 
-	// 解析目标词条ID
-	targetIDs := parseTargetIDs(targetStr)
-	if len(targetIDs) == 0 {
-		resp.SendError(fmt.Errorf("无效的目标词条ID格式"))
+	// 解析目标词条
+	targetIDs, err := parseTargetIDs(resp, i, presets, targetStr)
+	if err != nil {
+		resp.SendError(err)
+		return
+	}
+
+	if slotCount == 0 && rarity == "" {
+		resp.SendError(i18n.NewMessage("bot.affix.slots_or_rarity_required"))
 		return
 	}
 
 	// 计算概率
-	service := services.NewAffixProbabilityService()
-	result := service.CalculateProbability(slotCount, targetIDs, showCombinations)
+	result, err := calc.AffixProbability(resp.Context(), calc.AffixProbabilityRequest{
+		GameVersion:      gameVersion,
+		SlotCount:        slotCount,
+		Rarity:           rarity,
+		TargetAffixIDs:   targetIDs,
+		ShowCombinations: showCombinations,
+	})
 
 	// 检查错误
-	if result.Error != "" {
-		resp.SendError(fmt.Errorf(result.Error))
+	if err != nil {
+		resp.SendError(err)
 		return
 	}
 
 	// 构建响应
-	embed := buildAffixResultEmbed(slotCount, result)
+	embed := buildAffixResultEmbed(result, locale)
 	resp.SendEmbed(embed)
 }
 
-// parseTargetIDs 解析目标词条ID
-func parseTargetIDs(str string) []int {
-	parts := strings.Split(str, ",")
-	var ids []int
-
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		var id int
-		if _, err := fmt.Sscanf(part, "%d", &id); err == nil && id >= 1 && id <= 10 {
-			ids = append(ids, id)
-		}
+// parseTargetIDs 解析目标词条，支持ID、中文名、拼音、拼音首字母、英文别名和preset:<名称>
+// 读取保存的预设失败时记录错误，返回通用的错误消息
+func parseTargetIDs(resp *discord.InteractionResponse, i *discordgo.InteractionCreate, presets *PresetStore, str string) ([]int, error) {
+	ids, err := presets.ResolveTargets(resp.Context(), i, str)
+	if err != nil && !presets.isServiceError(err) {
+		logging.FromContext(resp.Context()).Error("读取预设失败", "error", err)
+		return nil, i18n.NewMessage("bot.preset.storage_error")
 	}
-
-	return ids
+	return ids, err
 }
 
 // buildAffixResultEmbed 构建结果嵌入消息
-func buildAffixResultEmbed(slotCount int, result *services.AffixProbabilityResult) *discordgo.MessageEmbed {
-	// 词条名称映射
-	affixNames := map[int]string{
-		1: "异常伤害", 2: "弹匣容量", 3: "换弹速度加成",
-		4: "对普通敌人伤害", 5: "对精英敌人伤害", 6: "对上位者伤害",
-		7: "最大生命值", 8: "头部受伤减免", 9: "枪械伤害减免", 10: "异常伤害减免",
-	}
-
+func buildAffixResultEmbed(result *calc.AffixProbabilityResult, locale string) *discordgo.MessageEmbed {
 	// 构建目标词条名称列表
 	var targetNames []string
 	for _, id := range result.TargetRange {
-		if name, ok := affixNames[id]; ok {
+		if name := calc.AffixName(id, locale); name != "" {
 			targetNames = append(targetNames, name)
 		}
 	}
@@ -133,41 +162,61 @@ func buildAffixResultEmbed(slotCount int, result *services.AffixProbabilityResul
 		color = 0xFFAA00 // 橙色
 	}
 
+	description := i18n.T(locale, "bot.affix.summary", result.SlotCount)
+	if result.Rarity == calc.RarityAny {
+		description = i18n.T(locale, "bot.affix.summary_any")
+	}
+
 	embed := &discordgo.MessageEmbed{
-		Title:       "📊 词条概率计算结果",
-		Description: fmt.Sprintf("计算 %d 个词条位中出现指定词条的概率", slotCount),
+		Title:       i18n.T(locale, "bot.affix.title"),
+		Description: description,
 		Color:       color,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "📌 目标词条",
+				Name:   i18n.T(locale, "bot.affix.targets"),
 				Value:  strings.Join(targetNames, ", "),
 				Inline: false,
 			},
 			{
-				Name:   "🎲 出现概率",
+				Name:   i18n.T(locale, "bot.affix.probability"),
 				Value:  fmt.Sprintf("**%.4f%%**", result.ProbabilityPercent),
 				Inline: true,
 			},
 			{
-				Name:   "📈 精确概率",
+				Name:   i18n.T(locale, "bot.affix.exact_probability"),
 				Value:  fmt.Sprintf("%.6f", result.Probability),
 				Inline: true,
 			},
 			{
-				Name:   "🔢 满足条件的组合数",
+				Name:   i18n.T(locale, "bot.affix.valid_combinations"),
 				Value:  fmt.Sprintf("%d", result.ValidCombinations),
 				Inline: true,
 			},
 			{
-				Name:   "🔢 总组合数",
+				Name:   i18n.T(locale, "bot.affix.total_combinations"),
 				Value:  fmt.Sprintf("%d", result.TotalCombinations),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "OnceHuman工具集",
+			Text: i18n.T(locale, "bot.footer_version", result.GameVersion),
 		},
-		Timestamp: discordgo.NowTimestamp(),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	// 添加稀有度明细
+	if len(result.RarityBreakdown) > 1 {
+		var breakdown []string
+		for _, item := range result.RarityBreakdown {
+			breakdown = append(breakdown, i18n.T(locale, "bot.affix.rarity_line",
+				calc.RarityName(item.Rarity, locale), item.Weight*100, item.SlotCount, item.Probability*100))
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   i18n.T(locale, "bot.affix.rarity_breakdown"),
+			Value:  strings.Join(breakdown, "\n"),
+			Inline: false,
+		})
 	}
 
 	// 添加组合示例
@@ -175,12 +224,12 @@ func buildAffixResultEmbed(slotCount int, result *services.AffixProbabilityResul
 		var comboStrs []string
 		for i, combo := range result.Combinations {
 			if i >= 5 { // 最多显示5个
-				comboStrs = append(comboStrs, fmt.Sprintf("... 还有 %d 种组合", len(result.Combinations)-5))
+				comboStrs = append(comboStrs, i18n.T(locale, "bot.affix.more_combinations", len(result.Combinations)-5))
 				break
 			}
 			var names []string
 			for _, id := range combo {
-				if name, ok := affixNames[id]; ok {
+				if name := calc.AffixName(id, locale); name != "" {
 					names = append(names, name)
 				}
 			}
@@ -188,7 +237,7 @@ func buildAffixResultEmbed(slotCount int, result *services.AffixProbabilityResul
 		}
 
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "📝 可能的组合",
+			Name:   i18n.T(locale, "bot.affix.combinations"),
 			Value:  strings.Join(comboStrs, "\n"),
 			Inline: false,
 		})
@@ -197,18 +246,59 @@ func buildAffixResultEmbed(slotCount int, result *services.AffixProbabilityResul
 	return embed
 }
 
-// GetAffixListChoices 获取词条选择列表（用于自动完成）
+// GetAffixListChoices 获取词条选择列表（用于自动完成），名称随客户端语言显示
 func GetAffixListChoices() []*discordgo.ApplicationCommandOptionChoice {
-	return []*discordgo.ApplicationCommandOptionChoice{
-		{Name: "异常伤害", Value: "1"},
-		{Name: "弹匣容量", Value: "2"},
-		{Name: "换弹速度加成", Value: "3"},
-		{Name: "对普通敌人伤害", Value: "4"},
-		{Name: "对精英敌人伤害", Value: "5"},
-		{Name: "对上位者伤害", Value: "6"},
-		{Name: "最大生命值", Value: "7"},
-		{Name: "头部受伤减免", Value: "8"},
-		{Name: "枪械伤害减免", Value: "9"},
-		{Name: "异常伤害减免", Value: "10"},
+	affixes, _ := calc.Affixes(calc.GameVersionLatest)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(affixes))
+	for _, affix := range affixes {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:              affix.Name,
+			NameLocalizations: discord.Localizations(fmt.Sprintf("affix.%d.name", affix.ID)),
+			Value:             strconv.Itoa(affix.ID),
+		})
+	}
+	return choices
+}
+
+// GetRarityChoices 获取稀有度选择列表，名称随客户端语言显示
+func GetRarityChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := []*discordgo.ApplicationCommandOptionChoice{
+		{
+			Name:              defaultText("bot.rarity.any"),
+			NameLocalizations: discord.Localizations("bot.rarity.any"),
+			Value:             calc.RarityAny,
+		},
+	}
+	rarities, _ := calc.Rarities(calc.GameVersionLatest)
+	for _, rarity := range rarities {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:              rarity.Name,
+			NameLocalizations: discord.Localizations("rarity." + rarity.ID + ".name"),
+			Value:             rarity.ID,
+		})
 	}
+	return choices
+}
+
+// GetGameVersionChoices 获取游戏版本选择列表，最新版本在前
+func GetGameVersionChoices() []*discordgo.ApplicationCommandOptionChoice {
+	versions := calc.GameVersions()
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name: fmt.Sprintf("%s %s", version.ID, version.Name),
+			NameLocalizations: discord.LocalizationsFunc(func(locale string) string {
+				return fmt.Sprintf("%s %s", version.ID, version.Localize(locale).Name)
+			}),
+			Value: version.ID,
+		})
+	}
+	return choices
+}
+
+// localizationsPtr 生成命令级别的Discord本地化表
+func localizationsPtr(key string) *map[discordgo.Locale]string {
+	localizations := discord.Localizations(key)
+	return &localizations
 }
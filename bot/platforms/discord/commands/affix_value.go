@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/calc"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CreateAffixValueCommand 创建词条数值概率计算命令，目标词条可以引用presets中的预设
+func CreateAffixValueCommand(presets *PresetStore) *discord.SlashCommand {
+	return &discord.SlashCommand{
+		Command: &discordgo.ApplicationCommand{
+			Name:                     "affix_value",
+			Description:              defaultText("bot.affix_value.description"),
+			DescriptionLocalizations: localizationsPtr("bot.affix_value.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "targets",
+					Description:              defaultText("bot.affix_value.option.targets"),
+					DescriptionLocalizations: discord.Localizations("bot.affix_value.option.targets"),
+					Required:                 true,
+					Autocomplete:             true,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionNumber,
+					Name:                     "min_value",
+					Description:              defaultText("bot.affix_value.option.min_value"),
+					DescriptionLocalizations: discord.Localizations("bot.affix_value.option.min_value"),
+					Required:                 false,
+					MinValue:                 &[]float64{0}[0],
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionBoolean,
+					Name:                     "top_tier",
+					Description:              defaultText("bot.affix_value.option.top_tier"),
+					DescriptionLocalizations: discord.Localizations("bot.affix_value.option.top_tier"),
+					Required:                 false,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionInteger,
+					Name:                     "level",
+					Description:              defaultText("bot.affix_value.option.level"),
+					DescriptionLocalizations: discord.Localizations("bot.affix_value.option.level"),
+					Required:                 false,
+					MinValue:                 &[]float64{1}[0],
+					MaxValue:                 5,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionInteger,
+					Name:                     "slots",
+					Description:              defaultText("bot.affix_value.option.slots"),
+					DescriptionLocalizations: discord.Localizations("bot.affix_value.option.slots"),
+					Required:                 false,
+					MinValue:                 &[]float64{1}[0],
+					MaxValue:                 10,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "game_version",
+					Description:              defaultText("bot.option.game_version"),
+					DescriptionLocalizations: discord.Localizations("bot.option.game_version"),
+					Required:                 false,
+					Choices:                  GetGameVersionChoices(),
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			handleAffixValueCommand(s, i, presets)
+		},
+		Autocomplete: presets.autocomplete,
+	}
+}
+
+// handleAffixValueCommand 处理词条数值概率计算命令
+func handleAffixValueCommand(s *discordgo.Session, i *discordgo.InteractionCreate, presets *PresetStore) {
+	resp := discord.CreateResponse(s, i)
+	locale := resp.Locale()
+
+	if err := resp.Defer(); err != nil {
+		return
+	}
+
+	// 获取参数
+	options := i.ApplicationCommandData().Options
+	var targetStr, gameVersion string
+	var minValue float64
+	topTier := false
+	level := 1
+	slotCount := 0
+
+	for _, opt := range options {
+		switch opt.Name {
+		case "targets":
+			targetStr = opt.StringValue()
+		case "min_value":
+			minValue = opt.FloatValue()
+		case "top_tier":
+			topTier = opt.BoolValue()
+		case "level":
+			level = int(opt.IntValue())
+		case "slots":
+			slotCount = int(opt.IntValue())
+		case "game_version":
+			gameVersion = opt.StringValue()
+		}
+	}
+
+	targetIDs, err := parseTargetIDs(resp, i, presets, targetStr)
+	if err != nil {
+		resp.SendError(err)
+		return
+	}
+
+	requirements := make([]calc.AffixValueRequirement, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		requirements = append(requirements, calc.AffixValueRequirement{
+			AffixID:  id,
+			MinValue: minValue,
+			TopTier:  topTier,
+		})
+	}
+
+	result, err := calc.AffixValueProbability(resp.Context(), calc.AffixValueProbabilityRequest{
+		GameVersion:  gameVersion,
+		SlotCount:    slotCount,
+		Level:        level,
+		Requirements: requirements,
+	})
+	if err != nil {
+		resp.SendError(err)
+		return
+	}
+
+	embed := buildAffixValueResultEmbed(result, locale)
+	resp.SendEmbed(embed)
+}
+
+// buildAffixValueResultEmbed 构建数值概率结果嵌入消息
+func buildAffixValueResultEmbed(result *calc.AffixValueProbabilityResult, locale string) *discordgo.MessageEmbed {
+	color := 0x00FF88 // 绿色
+	if result.ProbabilityPercent < 10 {
+		color = 0xFF0044 // 红色
+	} else if result.ProbabilityPercent < 30 {
+		color = 0xFFAA00 // 橙色
+	}
+
+	description := i18n.T(locale, "bot.affix_value.summary", result.Level)
+	if result.SlotCount > 0 {
+		description = i18n.T(locale, "bot.affix_value.summary_slots", result.SlotCount, result.Level)
+	}
+
+	var details []string
+	for _, detail := range result.Details {
+		requirement := fmt.Sprintf("%.2f%s-%.2f%s", detail.Range.Min, detail.Range.Unit, detail.Range.Max, detail.Range.Unit)
+		if detail.MinValue > 0 {
+			requirement += i18n.T(locale, "bot.affix_value.min_value", detail.MinValue, detail.Range.Unit)
+		}
+		if detail.MinTier > 0 {
+			requirement += i18n.T(locale, "bot.affix_value.min_tier", detail.MinTier)
+		}
+		details = append(details, fmt.Sprintf("• **%s** (%s): %.2f%%",
+			calc.AffixName(detail.AffixID, locale), requirement, detail.Probability*100))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(locale, "bot.affix_value.title"),
+		Description: description,
+		Color:       color,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   i18n.T(locale, "bot.affix_value.probability"),
+				Value:  fmt.Sprintf("**%.4f%%**", result.ProbabilityPercent),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(locale, "bot.affix_value.appear_probability"),
+				Value:  fmt.Sprintf("%.4f%%", result.AppearProbability*100),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(locale, "bot.affix_value.value_probability"),
+				Value:  fmt.Sprintf("%.4f%%", result.ValueProbability*100),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(locale, "bot.affix_value.details"),
+				Value:  strings.Join(details, "\n"),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(locale, "bot.footer_version", result.GameVersion),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	return embed
+}
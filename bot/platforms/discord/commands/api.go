@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client"
+	"github.com/go-openapi/swag"
+)
+
+// responseError 后端返回的错误响应，消息已按请求语言本地化
+type responseError struct {
+	payload *models.ErrorResponse
+}
+
+func (e *responseError) Error() string {
+	return swag.StringValue(e.payload.Message)
+}
+
+// ErrorCode 返回后端的错误码，用于选择错误标题
+func (e *responseError) ErrorCode() string {
+	return e.payload.Code
+}
+
+// apiError 把后端返回的错误响应转换为responseError，连接失败等其他错误保持不变
+func apiError(err error) error {
+	if payload := client.ErrorResponse(err); payload != nil && payload.Message != nil {
+		return &responseError{payload: payload}
+	}
+	return err
+}
@@ -1,8 +1,10 @@
 package commands
 
 import (
-	"github.com/bwmarrin/discordgo"
+	"time"
+
 	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
+	"github.com/bwmarrin/discordgo"
 )
 
 // CreateHelpCommand 创建帮助命令
@@ -27,41 +29,40 @@ func handleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name: "📊 /affix - 词条概率计算",
-				Value: `计算模组出现特定词条的概率
-**参数：**
-• \`slots\` - 词条数量 (1-10)
-• \`targets\` - 目标词条ID，逗号分隔
-• \`show_combinations\` - 显示详细组合
-
-**示例：** \`/affix slots:4 targets:1,4,5\``,
+				Value: "计算模组出现特定词条的概率\n" +
+					"**参数：**\n" +
+					"• `targets` - 目标词条ID，逗号分隔\n" +
+					"• `slots` - 词条数量 (1-10)\n" +
+					"• `rarity` - 模组稀有度，任意表示随机掉落\n" +
+					"• `show_combinations` - 显示详细组合\n\n" +
+					"**示例：** `/affix slots:4 targets:1,4,5`",
 				Inline: false,
 			},
 			{
-				Name: "🎯 /strengthen single - 单词条强化",
-				Value: `计算单个词条强化到目标等级的概率
-**参数：**
-• \`affix_id\` - 词条ID (1-10)
-• \`current_level\` - 当前等级 (0-5)
-• \`target_level\` - 目标等级 (1-5)
-• \`slot_count\` - 词条数量
-• \`tries\` - 强化次数
-
-**示例：** \`/strengthen single affix_id:1 current_level:0 target_level:3 slot_count:4 tries:50\``,
+				Name: "📐 /affix_value - 词条数值概率",
+				Value: "计算词条出现且数值达到阈值或最高档位的概率\n" +
+					"**参数：**\n" +
+					"• `targets` - 目标词条，逗号分隔，支持名称或别名\n" +
+					"• `min_value` - 数值下限\n" +
+					"• `top_tier` - 要求最高档位\n" +
+					"• `level` - 词条等级 (1-5)\n" +
+					"• `slots` - 词条数量，不填则假定词条已出现\n\n" +
+					"**示例：** `/affix_value targets:5,6 top_tier:true slots:4`",
 				Inline: false,
 			},
 			{
-				Name: "🎯 /strengthen multi - 多词条强化",
-				Value: `计算多个词条同时强化的概率
-**参数：**
-• \`targets\` - 格式: ID:当前:目标
-• \`slot_count\` - 词条数量
-• \`tries\` - 强化次数
-
-**示例：** \`/strengthen multi targets:1:0:3,4:1:5 slot_count:4 tries:100\``,
+				Name: "🎯 /strengthen - 强化概率",
+				Value: "计算模组词条强化到目标等级的概率\n" +
+					"**参数：**\n" +
+					"• `target` - 目标等级，逗号或/分隔\n" +
+					"• `initial` - 初始等级，不填则按稀有度的掉落等级计算\n" +
+					"• `rarity` - 模组稀有度，默认金色\n" +
+					"• `order_independent` - 目标等级与词条位置无关\n\n" +
+					"**示例：** `/strengthen initial:1,1,1,1 target:5,5,1,1`",
 				Inline: false,
 			},
 			{
-				Name: "📖 词条ID对照表",
+				Name: "📖 词条ID对照表（也可直接输入名称、拼音或英文别名）",
 				Value: `1=异常伤害 | 2=弹匣容量 | 3=换弹速度
 4=对普通敌人伤害 | 5=对精英敌人伤害
 6=对上位者伤害 | 7=最大生命值
@@ -74,8 +75,8 @@ func handleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 			Text:    "OnceHuman工具集 - 更多功能开发中...",
 			IconURL: "https://i.imgur.com/AfFp7pu.png",
 		},
-		Timestamp: discordgo.NowTimestamp(),
+		Timestamp: time.Now().Format(time.RFC3339),
 	}
 
 	resp.SendEmbed(embed)
-}
\ No newline at end of file
+}
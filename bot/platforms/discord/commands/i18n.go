@@ -0,0 +1,179 @@
+package commands
+
+import "github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+
+// 机器人命令文本，词条和稀有度名称由目录提供
+func init() {
+	i18n.Register("zh-CN", map[string]string{
+		"bot.footer":              "OnceHuman工具集",
+		"bot.footer_version":      "OnceHuman工具集 · 游戏版本 %s",
+		"bot.option.game_version": "游戏版本，默认最新版本",
+
+		"bot.affix.description":              "计算模组词条概率",
+		"bot.affix.option.targets":           "目标词条，用逗号分隔，支持ID、中文名、拼音、英文别名或preset:预设名 (例如: 1,精英,preset:elite-dps)",
+		"bot.affix.option.slots":             "词条数量 (1-10)，指定稀有度时忽略",
+		"bot.affix.option.rarity":            "模组稀有度，任意表示按掉落权重计算随机掉落的概率",
+		"bot.affix.option.show_combinations": "是否显示详细组合",
+		"bot.affix.slots_or_rarity_required": "请指定词条数量或稀有度",
+		"bot.affix.title":                    "📊 词条概率计算结果",
+		"bot.affix.summary":                  "计算 %d 个词条位中出现指定词条的概率",
+		"bot.affix.summary_any":              "计算随机掉落的模组中出现指定词条的概率",
+		"bot.affix.targets":                  "📌 目标词条",
+		"bot.affix.probability":              "🎲 出现概率",
+		"bot.affix.exact_probability":        "📈 精确概率",
+		"bot.affix.valid_combinations":       "🔢 满足条件的组合数",
+		"bot.affix.total_combinations":       "🔢 总组合数",
+		"bot.affix.rarity_breakdown":         "💎 稀有度明细",
+		"bot.affix.rarity_line":              "• **%s** (掉落占比 %.0f%%, %d 词条): %.4f%%",
+		"bot.affix.combinations":             "📝 可能的组合",
+		"bot.affix.more_combinations":        "... 还有 %d 种组合",
+
+		"bot.affix_value.description":        "计算模组词条数值概率",
+		"bot.affix_value.option.targets":     "目标词条，用逗号分隔，支持ID、中文名、拼音、英文别名或preset:预设名 (例如: 精英,boss)",
+		"bot.affix_value.option.min_value":   "数值下限 (例如: 8 表示 ≥8%)",
+		"bot.affix_value.option.top_tier":    "是否要求最高档位",
+		"bot.affix_value.option.level":       "词条等级 (1-5，默认1)",
+		"bot.affix_value.option.slots":       "词条数量 (1-10)，不填则假定词条已出现",
+		"bot.affix_value.title":              "📐 词条数值概率计算结果",
+		"bot.affix_value.summary":            "Lv%d 词条数值满足要求的概率（假定词条已出现）",
+		"bot.affix_value.summary_slots":      "%d 个词条位中出现目标词条且 Lv%d 数值满足要求的概率",
+		"bot.affix_value.min_value":          "，≥%.2f%s",
+		"bot.affix_value.min_tier":           "，≥%d档",
+		"bot.affix_value.probability":        "🎲 综合概率",
+		"bot.affix_value.appear_probability": "📌 出现概率",
+		"bot.affix_value.value_probability":  "📈 数值概率",
+		"bot.affix_value.details":            "📋 词条详情",
+
+		"bot.strengthen.description":              "计算模组词条强化概率",
+		"bot.strengthen.option.target":            "目标等级，用逗号或/分隔 (例如: 5,5,1,1)",
+		"bot.strengthen.option.initial":           "初始等级，用逗号或/分隔，不填则按稀有度的掉落等级计算",
+		"bot.strengthen.option.rarity":            "模组稀有度，默认金色；任意表示按掉落权重计算随机掉落",
+		"bot.strengthen.option.order_independent": "目标等级是否与词条位置无关",
+		"bot.strengthen.levels_required":          "请填写词条等级",
+		"bot.strengthen.invalid_level":            "无效的等级: %s",
+		"bot.strengthen.title":                    "🎯 强化概率计算结果",
+		"bot.strengthen.summary":                  "从 %s 强化到 %s 的概率",
+		"bot.strengthen.summary_drop":             "掉落的模组强化到 %s 的概率",
+		"bot.strengthen.rarity":                   "稀有度: %s",
+		"bot.strengthen.probability":              "🎲 成功率",
+		"bot.strengthen.outcomes":                 "🔢 成功结果数 / 总结果数",
+		"bot.strengthen.rarity_breakdown":         "💎 稀有度明细",
+		"bot.strengthen.rarity_line":              "• **%s** (掉落占比 %.0f%%): %.4f%%",
+
+		"bot.preset.description":            "管理目标词条预设，计算时用preset:名称引用",
+		"bot.preset.list.description":       "查看可用的预设",
+		"bot.preset.save.description":       "保存或更新预设",
+		"bot.preset.delete.description":     "删除预设",
+		"bot.preset.option.name":            "预设名称，小写字母、数字和- (例如: my-dps)",
+		"bot.preset.option.delete_name":     "要删除的预设名称",
+		"bot.preset.option.targets":         "目标词条，用逗号分隔，支持ID、中文名、拼音、英文别名或preset:预设名",
+		"bot.preset.option.server":          "保存到服务器预设，服务器成员都可以使用，需要管理服务器权限",
+		"bot.preset.list.title":             "📚 目标词条预设",
+		"bot.preset.list.usage":             "在目标词条中输入 `preset:名称` 使用预设，同名时依次使用我的、服务器和内置预设",
+		"bot.preset.list.more":              "……",
+		"bot.preset.scope.user":             "我的预设",
+		"bot.preset.scope.guild":            "服务器预设",
+		"bot.preset.scope.builtin":          "内置预设",
+		"bot.preset.saved":                  "✅ 已保存预设 %s",
+		"bot.preset.deleted":                "🗑️ 已删除预设 %s",
+		"bot.preset.storage_disabled":       "未配置后端（API_BASE_URL），只能使用内置预设",
+		"bot.preset.storage_error":          "读写预设失败，请稍后重试",
+		"bot.preset.guild_only":             "服务器预设只能在服务器中修改",
+		"bot.preset.manage_server_required": "修改服务器预设需要管理服务器权限",
+
+		"bot.plugin.footer":       "OnceHuman工具集 · 插件 %s",
+		"bot.plugin.invalid_json": "选项 %s 需要填写JSON",
+		"bot.plugin.failed":       "插件 %s 运行失败",
+
+		"bot.rarity.any": "任意（随机掉落）",
+	})
+
+	i18n.Register("en", map[string]string{
+		"bot.footer":              "OnceHuman Tools",
+		"bot.footer_version":      "OnceHuman Tools · Game version %s",
+		"bot.option.game_version": "Game version, latest by default",
+
+		"bot.affix.description":              "Calculate mod affix probability",
+		"bot.affix.option.targets":           "Target affixes, comma separated: ID, name, pinyin, alias or preset:name (e.g. 1,elite,preset:elite-dps)",
+		"bot.affix.option.slots":             "Slot count (1-10), ignored when a rarity is given",
+		"bot.affix.option.rarity":            "Mod rarity; Any weighs every rarity by its drop rate",
+		"bot.affix.option.show_combinations": "Show matching combinations",
+		"bot.affix.slots_or_rarity_required": "Please give a slot count or a rarity",
+		"bot.affix.title":                    "📊 Affix Probability",
+		"bot.affix.summary":                  "Probability of the target affixes appearing in %d slots",
+		"bot.affix.summary_any":              "Probability of the target affixes appearing on a random mod drop",
+		"bot.affix.targets":                  "📌 Target Affixes",
+		"bot.affix.probability":              "🎲 Probability",
+		"bot.affix.exact_probability":        "📈 Exact Probability",
+		"bot.affix.valid_combinations":       "🔢 Matching Combinations",
+		"bot.affix.total_combinations":       "🔢 Total Combinations",
+		"bot.affix.rarity_breakdown":         "💎 By Rarity",
+		"bot.affix.rarity_line":              "• **%s** (%.0f%% of drops, %d slots): %.4f%%",
+		"bot.affix.combinations":             "📝 Possible Combinations",
+		"bot.affix.more_combinations":        "... and %d more",
+
+		"bot.affix_value.description":        "Calculate mod affix value probability",
+		"bot.affix_value.option.targets":     "Target affixes, comma separated: ID, name, pinyin, alias or preset:name (e.g. elite,boss)",
+		"bot.affix_value.option.min_value":   "Minimum value (e.g. 8 means ≥8%)",
+		"bot.affix_value.option.top_tier":    "Require the top tier",
+		"bot.affix_value.option.level":       "Affix level (1-5, default 1)",
+		"bot.affix_value.option.slots":       "Slot count (1-10); if omitted the affixes are assumed present",
+		"bot.affix_value.title":              "📐 Affix Value Probability",
+		"bot.affix_value.summary":            "Probability of Lv%d values meeting the requirements (affixes assumed present)",
+		"bot.affix_value.summary_slots":      "Probability of the target affixes appearing in %d slots with Lv%d values meeting the requirements",
+		"bot.affix_value.min_value":          ", ≥%.2f%s",
+		"bot.affix_value.min_tier":           ", tier ≥%d",
+		"bot.affix_value.probability":        "🎲 Overall Probability",
+		"bot.affix_value.appear_probability": "📌 Appear Probability",
+		"bot.affix_value.value_probability":  "📈 Value Probability",
+		"bot.affix_value.details":            "📋 Affix Details",
+
+		"bot.strengthen.description":              "Calculate mod affix enhancement probability",
+		"bot.strengthen.option.target":            "Target levels, separated by commas or / (e.g. 5,5,1,1)",
+		"bot.strengthen.option.initial":           "Initial levels, separated by commas or /; if omitted, drop levels of the rarity are used",
+		"bot.strengthen.option.rarity":            "Mod rarity, gold by default; Any weighs every rarity by its drop rate",
+		"bot.strengthen.option.order_independent": "Target levels may be reached by any affix slot",
+		"bot.strengthen.levels_required":          "Please give the affix levels",
+		"bot.strengthen.invalid_level":            "Invalid level: %s",
+		"bot.strengthen.title":                    "🎯 Enhancement Probability",
+		"bot.strengthen.summary":                  "Probability of enhancing %s to %s",
+		"bot.strengthen.summary_drop":             "Probability of a dropped mod reaching %s",
+		"bot.strengthen.rarity":                   "Rarity: %s",
+		"bot.strengthen.probability":              "🎲 Success Rate",
+		"bot.strengthen.outcomes":                 "🔢 Successful / Total Outcomes",
+		"bot.strengthen.rarity_breakdown":         "💎 By Rarity",
+		"bot.strengthen.rarity_line":              "• **%s** (%.0f%% of drops): %.4f%%",
+
+		"bot.preset.description":            "Manage target presets; use preset:name in target lists",
+		"bot.preset.list.description":       "List available presets",
+		"bot.preset.save.description":       "Save or update a preset",
+		"bot.preset.delete.description":     "Delete a preset",
+		"bot.preset.option.name":            "Preset name: lowercase letters, digits and - (e.g. my-dps)",
+		"bot.preset.option.delete_name":     "Name of the preset to delete",
+		"bot.preset.option.targets":         "Target affixes, comma separated: ID, name, pinyin, alias or preset:name",
+		"bot.preset.option.server":          "Save as a server preset shared with all members; requires Manage Server",
+		"bot.preset.list.title":             "📚 Target Presets",
+		"bot.preset.list.usage":             "Type `preset:name` in a target list to use a preset; yours win over server and built-in presets with the same name",
+		"bot.preset.list.more":              "...",
+		"bot.preset.scope.user":             "My Presets",
+		"bot.preset.scope.guild":            "Server Presets",
+		"bot.preset.scope.builtin":          "Built-in Presets",
+		"bot.preset.saved":                  "✅ Saved preset %s",
+		"bot.preset.deleted":                "🗑️ Deleted preset %s",
+		"bot.preset.storage_disabled":       "No backend is configured (API_BASE_URL); only built-in presets are available",
+		"bot.preset.storage_error":          "Failed to read or write presets, please retry later",
+		"bot.preset.guild_only":             "Server presets can only be changed in a server",
+		"bot.preset.manage_server_required": "Changing server presets requires the Manage Server permission",
+
+		"bot.plugin.footer":       "OnceHuman Tools · Plugin %s",
+		"bot.plugin.invalid_json": "Option %s must be JSON",
+		"bot.plugin.failed":       "Plugin %s failed",
+
+		"bot.rarity.any": "Any (random drop)",
+	})
+}
+
+// defaultText 获取默认语言的文本，用于命令注册
+func defaultText(key string) string {
+	return i18n.T(i18n.DefaultLocale, key)
+}
@@ -0,0 +1,327 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/calc"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client/tools"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
+	"github.com/bwmarrin/discordgo"
+	"github.com/go-openapi/swag"
+)
+
+// Discord对命令和选项的限制
+const (
+	maxOptions          = 25
+	maxOptionName       = 32
+	maxDescription      = 100
+	maxEmbedDescription = 4096
+)
+
+// pluginLocales 获取插件名称和描述的语言，默认语言的文本用于命令注册
+var pluginLocales = []string{i18n.DefaultLocale, "en"}
+
+// inputSchema 插件输入模式中生成命令选项用到的部分
+type inputSchema struct {
+	Type        string                  `json:"type"`
+	Description string                  `json:"description"`
+	Properties  map[string]*inputSchema `json:"properties"`
+	Required    []string                `json:"required"`
+	Enum        []interface{}           `json:"enum"`
+	Minimum     *float64                `json:"minimum"`
+	Maximum     *float64                `json:"maximum"`
+}
+
+// pluginOption 命令选项与输入属性的对应
+type pluginOption struct {
+	property string
+	schema   *inputSchema
+}
+
+// LoadPluginTools 从后端的工具列表获取插件工具，api为nil时没有插件；其他语言的名称和描述注册到i18n
+func LoadPluginTools(ctx context.Context, api *client.OncehumanTools) ([]*models.Tool, error) {
+	if api == nil {
+		return nil, nil
+	}
+
+	var plugins []*models.Tool
+	for _, locale := range pluginLocales {
+		lang := locale
+		resp, err := api.Tools.ListTools(tools.NewListToolsParamsWithContext(ctx).WithLang(&lang), nil)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		messages := make(map[string]string)
+		for _, tool := range resp.GetPayload().Tools {
+			if tool.Source != models.ToolSourcePlugin {
+				continue
+			}
+			if locale == i18n.DefaultLocale {
+				plugins = append(plugins, tool)
+				continue
+			}
+			id := swag.StringValue(tool.ID)
+			messages["tool."+id+".name"] = swag.StringValue(tool.Name)
+			if tool.Description != "" {
+				messages["tool."+id+".description"] = tool.Description
+			}
+		}
+		i18n.Register(locale, messages)
+	}
+	return plugins, nil
+}
+
+// CreatePluginCommand 为插件工具创建通用命令，通过后端的工具接口运行；选项按输入模式的顶层属性生成，必填属性在前，数组和对象属性填写JSON
+func CreatePluginCommand(api *client.OncehumanTools, tool *models.Tool) *discord.SlashCommand {
+	id := swag.StringValue(tool.ID)
+	var schema inputSchema
+	if tool.InputSchema != nil {
+		data, err := json.Marshal(tool.InputSchema)
+		if err == nil {
+			err = json.Unmarshal(data, &schema)
+		}
+		if err != nil {
+			slog.Warn("插件输入模式无效，命令不带选项", "plugin", id, "error", err)
+		}
+	}
+
+	options, mapping := pluginOptions(&schema)
+	if len(schema.Properties) > len(options) {
+		slog.Warn("插件输入属性过多或名称无效，部分属性不生成命令选项", "plugin", id,
+			"properties", len(schema.Properties), "options", len(options))
+	}
+
+	description := tool.Description
+	if description == "" {
+		description = swag.StringValue(tool.Name)
+	}
+	descriptions := discord.LocalizationsFunc(func(locale string) string {
+		return truncateRunes(i18n.TOr(locale, "tool."+id+".description", description), maxDescription)
+	})
+
+	return &discord.SlashCommand{
+		Command: &discordgo.ApplicationCommand{
+			Name:                     id,
+			Description:              truncateRunes(description, maxDescription),
+			DescriptionLocalizations: &descriptions,
+			Options:                  options,
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			handlePluginCommand(s, i, api, tool, mapping)
+		},
+	}
+}
+
+// pluginOptions 按输入模式生成命令选项，返回选项名称到输入属性的对应
+func pluginOptions(schema *inputSchema) ([]*discordgo.ApplicationCommandOption, map[string]pluginOption) {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	properties := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		properties = append(properties, name)
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		if required[properties[i]] != required[properties[j]] {
+			return required[properties[i]]
+		}
+		return properties[i] < properties[j]
+	})
+
+	var options []*discordgo.ApplicationCommandOption
+	mapping := make(map[string]pluginOption, len(properties))
+	for _, property := range properties {
+		name := optionName(property)
+		if name == "" || len(options) == maxOptions {
+			continue
+		}
+		if _, ok := mapping[name]; ok {
+			continue
+		}
+		prop := schema.Properties[property]
+		if prop == nil {
+			prop = &inputSchema{}
+		}
+		mapping[name] = pluginOption{property: property, schema: prop}
+		options = append(options, pluginCommandOption(name, prop, required[property]))
+	}
+	return options, mapping
+}
+
+// pluginCommandOption 按属性类型生成命令选项
+func pluginCommandOption(name string, prop *inputSchema, required bool) *discordgo.ApplicationCommandOption {
+	description := prop.Description
+	if description == "" {
+		description = name
+	}
+	option := &discordgo.ApplicationCommandOption{
+		Name:        name,
+		Description: truncateRunes(description, maxDescription),
+		Required:    required,
+	}
+
+	switch prop.Type {
+	case "integer", "number":
+		option.Type = discordgo.ApplicationCommandOptionNumber
+		if prop.Type == "integer" {
+			option.Type = discordgo.ApplicationCommandOptionInteger
+		}
+		option.MinValue = prop.Minimum
+		if prop.Maximum != nil {
+			option.MaxValue = *prop.Maximum
+		}
+	case "boolean":
+		option.Type = discordgo.ApplicationCommandOptionBoolean
+	case "array", "object":
+		option.Type = discordgo.ApplicationCommandOptionString
+		option.Description = truncateRunes(description+" (JSON)", maxDescription)
+	default:
+		option.Type = discordgo.ApplicationCommandOptionString
+		for _, value := range prop.Enum {
+			if s, ok := value.(string); ok && len(option.Choices) < maxOptions {
+				option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{Name: s, Value: s})
+			}
+		}
+	}
+	return option
+}
+
+// handlePluginCommand 处理插件命令，将选项转换为工具输入后通过后端运行插件
+func handlePluginCommand(s *discordgo.Session, i *discordgo.InteractionCreate, api *client.OncehumanTools, tool *models.Tool, mapping map[string]pluginOption) {
+	resp := discord.CreateResponse(s, i)
+	locale := resp.Locale()
+
+	if err := resp.Defer(); err != nil {
+		return
+	}
+
+	input := make(map[string]interface{})
+	for _, opt := range i.ApplicationCommandData().Options {
+		target, ok := mapping[opt.Name]
+		if !ok {
+			continue
+		}
+		value, err := pluginOptionValue(opt, target.schema)
+		if err != nil {
+			resp.SendError(calc.NewError(calc.ErrCodeInvalidInput, target.property, "bot.plugin.invalid_json", opt.Name))
+			return
+		}
+		input[target.property] = value
+	}
+
+	id := swag.StringValue(tool.ID)
+	params := tools.NewRunToolParamsWithContext(resp.Context()).WithID(id).WithBody(input).WithLang(&locale)
+	result, err := api.Tools.RunTool(params, nil)
+	if err != nil {
+		resp.SendError(pluginError(resp.Context(), id, err))
+		return
+	}
+
+	resp.SendEmbed(buildPluginResultEmbed(tool, result.GetPayload().Result, locale))
+}
+
+// pluginOptionValue 获取选项的值，数组和对象属性解析JSON，数组也可以填写逗号分隔的列表
+func pluginOptionValue(opt *discordgo.ApplicationCommandInteractionDataOption, prop *inputSchema) (interface{}, error) {
+	switch opt.Type {
+	case discordgo.ApplicationCommandOptionInteger:
+		return opt.IntValue(), nil
+	case discordgo.ApplicationCommandOptionNumber:
+		return opt.FloatValue(), nil
+	case discordgo.ApplicationCommandOptionBoolean:
+		return opt.BoolValue(), nil
+	}
+
+	text := opt.StringValue()
+	if prop.Type != "array" && prop.Type != "object" {
+		return text, nil
+	}
+	var value interface{}
+	err := json.Unmarshal([]byte(text), &value)
+	if err == nil || prop.Type != "array" {
+		return value, err
+	}
+
+	// 逗号分隔的列表，数字按数字传入
+	items := make([]interface{}, 0)
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		var number json.Number
+		if json.Unmarshal([]byte(item), &number) == nil {
+			items = append(items, number)
+		} else {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// pluginError 转换运行插件的错误：后端返回的错误消息已按语言本地化，直接显示；连接失败等其他错误记录日志
+func pluginError(ctx context.Context, id string, err error) error {
+	err = apiError(err)
+	var respErr *responseError
+	if errors.As(err, &respErr) {
+		return err
+	}
+	logging.FromContext(ctx).Error("运行插件失败", "plugin", id, "error", err)
+	return calc.NewError(calc.ErrCodeToolFailed, "", "bot.plugin.failed", id)
+}
+
+// buildPluginResultEmbed 构建插件结果嵌入消息，结果以JSON代码块输出
+func buildPluginResultEmbed(tool *models.Tool, result interface{}, locale string) *discordgo.MessageEmbed {
+	id := swag.StringValue(tool.ID)
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		data = []byte(err.Error())
+	}
+
+	const fence = "```"
+	body := truncateRunes(string(data), maxEmbedDescription-2*len(fence)-len("json\n\n"))
+	return &discordgo.MessageEmbed{
+		Title:       "🧩 " + i18n.TOr(locale, "tool."+id+".name", swag.StringValue(tool.Name)),
+		Description: fence + "json\n" + body + "\n" + fence,
+		Color:       0x5865F2,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(locale, "bot.plugin.footer", id),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+// optionName 将属性名转换为Discord选项名称：小写，驼峰和连字符转换为下划线，超长或含其他字符时返回空
+func optionName(property string) string {
+	var b strings.Builder
+	for i, r := range property {
+		switch {
+		case unicode.IsUpper(r) && r < unicode.MaxASCII:
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case r == '-':
+			b.WriteByte('_')
+		case r == '_' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			return ""
+		}
+	}
+	if b.Len() == 0 || b.Len() > maxOptionName {
+		return ""
+	}
+	return b.String()
+}
@@ -0,0 +1,518 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/models"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/calc"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/client"
+	presetclient "github.com/SpenserCai/OnceHumanTools/backend/pkg/client/preset"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
+	"github.com/bwmarrin/discordgo"
+	"github.com/go-openapi/swag"
+)
+
+// 预设的范围
+const (
+	presetScopeUser    = "user"
+	presetScopeGuild   = "guild"
+	presetScopeBuiltin = "builtin"
+)
+
+// 补全候选项的名称和值的最大长度
+const maxChoiceLength = 100
+
+// 预设列表每个范围最多显示的数量，避免超出嵌入字段的长度限制
+const maxListedPresets = 15
+
+// PresetStore 机器人的目标词条预设，用户和服务器预设通过后端的预设接口读写，api为nil时只有内置预设
+// 机器人的API key需要可以管理全部服务器（apikey create -guilds '*'），服务器管理权限由机器人检查
+type PresetStore struct {
+	api *client.OncehumanTools
+}
+
+// NewPresetStore 创建预设存储，api为nil表示未配置后端
+func NewPresetStore(api *client.OncehumanTools) *PresetStore {
+	return &PresetStore{api: api}
+}
+
+// namedPreset 交互中可以引用的预设
+type namedPreset struct {
+	scope   string // user、guild或builtin
+	name    string
+	title   string // 内置预设的本地化名称
+	targets []int
+}
+
+// presetOwners 预设的所有者，作为后端的X-Player-ID和X-Guild-ID：用户预设按Discord用户区分，
+// 服务器预设按Discord服务器区分，私信中没有服务器预设
+func presetOwners(i *discordgo.InteractionCreate) (player string, guild *string) {
+	player = "discord:" + discord.InteractionUserID(i)
+	if i.GuildID != "" {
+		guildID := "discord:" + i.GuildID
+		guild = &guildID
+	}
+	return player, guild
+}
+
+// saved 获取交互可用的保存预设，依次为用户和服务器预设
+func (p *PresetStore) saved(ctx context.Context, i *discordgo.InteractionCreate) ([]*models.Preset, error) {
+	player, guild := presetOwners(i)
+	params := presetclient.NewListPresetsParamsWithContext(ctx).WithXPlayerID(&player).WithXGuildID(guild)
+	ok, err := p.api.Preset.ListPresets(params, nil)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	var presets []*models.Preset
+	for _, preset := range ok.Payload.Presets {
+		if scope := swag.StringValue(preset.Scope); scope == presetScopeUser || scope == presetScopeGuild {
+			presets = append(presets, preset)
+		}
+	}
+	return presets, nil
+}
+
+// lookup 按用户、服务器的顺序查找保存的预设，未配置后端时返回nil，只能使用内置预设
+func (p *PresetStore) lookup(i *discordgo.InteractionCreate) calc.PresetLookup {
+	if p == nil || p.api == nil {
+		return nil
+	}
+	return func(ctx context.Context, name string) ([]int, bool, error) {
+		saved, err := p.saved(ctx, i)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, preset := range saved {
+			if swag.StringValue(preset.Name) == name {
+				return toInts(preset.TargetAffixIds), true, nil
+			}
+		}
+		return nil, false, nil
+	}
+}
+
+// ResolveTargets 解析目标词条，支持ID、中文名、拼音、拼音首字母、英文别名和preset:<名称>
+func (p *PresetStore) ResolveTargets(ctx context.Context, i *discordgo.InteractionCreate, str string) ([]int, error) {
+	return calc.ResolveTargets(ctx, str, p.lookup(i))
+}
+
+// list 获取交互可用的预设，依次为用户、服务器和内置预设
+func (p *PresetStore) list(ctx context.Context, i *discordgo.InteractionCreate, locale string) ([]namedPreset, error) {
+	var presets []namedPreset
+	if p != nil && p.api != nil {
+		saved, err := p.saved(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		for _, preset := range saved {
+			presets = append(presets, namedPreset{scope: swag.StringValue(preset.Scope), name: swag.StringValue(preset.Name), targets: toInts(preset.TargetAffixIds)})
+		}
+	}
+	builtin, _ := calc.Presets(calc.GameVersionLatest)
+	for _, preset := range builtin {
+		preset = preset.Localize(locale)
+		presets = append(presets, namedPreset{scope: presetScopeBuiltin, name: preset.ID, title: preset.Name, targets: preset.Targets})
+	}
+	return presets, nil
+}
+
+// CreatePresetCommand 创建预设命令组：查看、保存和删除目标词条预设
+func CreatePresetCommand(presets *PresetStore) *discord.SlashCommand {
+	nameOption := func(key string) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:                     discordgo.ApplicationCommandOptionString,
+			Name:                     "name",
+			Description:              defaultText(key),
+			DescriptionLocalizations: discord.Localizations(key),
+			Required:                 true,
+			MaxLength:                32,
+			Autocomplete:             true,
+		}
+	}
+	serverOption := &discordgo.ApplicationCommandOption{
+		Type:                     discordgo.ApplicationCommandOptionBoolean,
+		Name:                     "server",
+		Description:              defaultText("bot.preset.option.server"),
+		DescriptionLocalizations: discord.Localizations("bot.preset.option.server"),
+		Required:                 false,
+	}
+
+	return &discord.SlashCommand{
+		Command: &discordgo.ApplicationCommand{
+			Name:                     "preset",
+			Description:              defaultText("bot.preset.description"),
+			DescriptionLocalizations: localizationsPtr("bot.preset.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:                     discordgo.ApplicationCommandOptionSubCommand,
+					Name:                     "list",
+					Description:              defaultText("bot.preset.list.description"),
+					DescriptionLocalizations: discord.Localizations("bot.preset.list.description"),
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionSubCommand,
+					Name:                     "save",
+					Description:              defaultText("bot.preset.save.description"),
+					DescriptionLocalizations: discord.Localizations("bot.preset.save.description"),
+					Options: []*discordgo.ApplicationCommandOption{
+						nameOption("bot.preset.option.name"),
+						{
+							Type:                     discordgo.ApplicationCommandOptionString,
+							Name:                     "targets",
+							Description:              defaultText("bot.preset.option.targets"),
+							DescriptionLocalizations: discord.Localizations("bot.preset.option.targets"),
+							Required:                 true,
+							Autocomplete:             true,
+						},
+						serverOption,
+					},
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionSubCommand,
+					Name:                     "delete",
+					Description:              defaultText("bot.preset.delete.description"),
+					DescriptionLocalizations: discord.Localizations("bot.preset.delete.description"),
+					Options: []*discordgo.ApplicationCommandOption{
+						nameOption("bot.preset.option.delete_name"),
+						serverOption,
+					},
+				},
+			},
+		},
+		Handler:      presets.handleCommand,
+		Autocomplete: presets.autocomplete,
+	}
+}
+
+// handleCommand 处理预设命令，按子命令分发
+func (p *PresetStore) handleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	resp := discord.CreateResponse(s, i)
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch sub := options[0]; sub.Name {
+	case "list":
+		p.handleList(resp, i)
+	case "save":
+		p.handleSave(resp, i, sub.Options)
+	case "delete":
+		p.handleDelete(resp, i, sub.Options)
+	}
+}
+
+// handleList 列出用户、服务器和内置预设
+func (p *PresetStore) handleList(resp *discord.InteractionResponse, i *discordgo.InteractionCreate) {
+	locale := resp.Locale()
+	presets, err := p.list(resp.Context(), i, locale)
+	if err != nil {
+		p.storageError(resp, err)
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(locale, "bot.preset.list.title"),
+		Description: i18n.T(locale, "bot.preset.list.usage"),
+		Color:       0x00AAFF,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(locale, "bot.footer"),
+		},
+	}
+	for _, scope := range []string{presetScopeUser, presetScopeGuild, presetScopeBuiltin} {
+		var lines []string
+		for _, preset := range presets {
+			if preset.scope != scope {
+				continue
+			}
+			if len(lines) == maxListedPresets {
+				lines = append(lines, i18n.T(locale, "bot.preset.list.more"))
+				break
+			}
+			lines = append(lines, presetLine(preset, locale))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  i18n.T(locale, "bot.preset.scope."+scope),
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	resp.SendEmbed(embed)
+}
+
+// handleSave 保存用户预设，server为true时保存服务器预设，需要管理服务器权限
+func (p *PresetStore) handleSave(resp *discord.InteractionResponse, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	locale := resp.Locale()
+	ctx := resp.Context()
+	var name, targets string
+	var server bool
+	for _, opt := range options {
+		switch opt.Name {
+		case "name":
+			name = strings.ToLower(strings.TrimSpace(opt.StringValue()))
+		case "targets":
+			targets = opt.StringValue()
+		case "server":
+			server = opt.BoolValue()
+		}
+	}
+
+	scope, err := p.writableScope(i, server)
+	if err != nil {
+		resp.SendError(err)
+		return
+	}
+	ids, err := p.ResolveTargets(ctx, i, targets)
+	if err == nil {
+		ids, err = calc.ValidatePreset(name, ids)
+	}
+	if err != nil {
+		if !p.isServiceError(err) {
+			p.storageError(resp, err)
+			return
+		}
+		resp.SendError(err)
+		return
+	}
+
+	player, guild := presetOwners(i)
+	params := presetclient.NewSavePresetParamsWithContext(ctx).WithScope(scope).WithName(name).
+		WithXPlayerID(&player).WithXGuildID(guild).WithLang(&locale).
+		WithBody(&models.PresetInput{TargetAffixIds: toInt32s(ids)})
+	if _, err := p.api.Preset.SavePreset(params, nil); err != nil {
+		p.writeError(resp, apiError(err))
+		return
+	}
+
+	resp.SendEmbed(&discordgo.MessageEmbed{
+		Title:       i18n.T(locale, "bot.preset.saved", calc.PresetPrefix+name),
+		Description: presetLine(namedPreset{scope: scope, name: name, targets: ids}, locale),
+		Color:       0x00FF88,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(locale, "bot.preset.scope."+scope),
+		},
+	})
+}
+
+// handleDelete 删除用户预设，server为true时删除服务器预设，需要管理服务器权限
+func (p *PresetStore) handleDelete(resp *discord.InteractionResponse, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	locale := resp.Locale()
+	var name string
+	var server bool
+	for _, opt := range options {
+		switch opt.Name {
+		case "name":
+			name = strings.ToLower(strings.TrimSpace(opt.StringValue()))
+		case "server":
+			server = opt.BoolValue()
+		}
+	}
+
+	scope, err := p.writableScope(i, server)
+	if err != nil {
+		resp.SendError(err)
+		return
+	}
+	player, guild := presetOwners(i)
+	params := presetclient.NewDeletePresetParamsWithContext(resp.Context()).WithScope(scope).WithName(name).
+		WithXPlayerID(&player).WithXGuildID(guild).WithLang(&locale)
+	if _, err := p.api.Preset.DeletePreset(params, nil); err != nil {
+		p.writeError(resp, apiError(err))
+		return
+	}
+	resp.SendText(i18n.T(locale, "bot.preset.deleted", calc.PresetPrefix+name))
+}
+
+// writableScope 检查能否保存或删除预设并返回范围：需要配置后端，服务器预设只能在服务器中由有管理服务器权限的成员修改
+func (p *PresetStore) writableScope(i *discordgo.InteractionCreate, server bool) (string, error) {
+	if p.api == nil {
+		return "", i18n.NewMessage("bot.preset.storage_disabled")
+	}
+	if !server {
+		return presetScopeUser, nil
+	}
+	if i.GuildID == "" {
+		return "", i18n.NewMessage("bot.preset.guild_only")
+	}
+	if i.Member == nil || i.Member.Permissions&discordgo.PermissionManageServer == 0 {
+		return "", i18n.NewMessage("bot.preset.manage_server_required")
+	}
+	return presetScopeGuild, nil
+}
+
+// isServiceError 检查是否为可本地化的服务错误或后端返回的错误，其余错误来自与后端的连接
+func (p *PresetStore) isServiceError(err error) bool {
+	var serviceErr *calc.Error
+	var respErr *responseError
+	return errors.As(err, &serviceErr) || errors.As(err, &respErr)
+}
+
+// writeError 回复保存或删除预设的错误，后端返回的错误直接显示，其余错误按读写失败处理
+func (p *PresetStore) writeError(resp *discord.InteractionResponse, err error) {
+	if !p.isServiceError(err) {
+		p.storageError(resp, err)
+		return
+	}
+	resp.SendError(err)
+}
+
+// storageError 记录与后端通信的错误并回复通用的错误消息
+func (p *PresetStore) storageError(resp *discord.InteractionResponse, err error) {
+	logging.FromContext(resp.Context()).Error("读写预设失败", "error", err)
+	resp.SendError(i18n.NewMessage("bot.preset.storage_error"))
+}
+
+// autocomplete 补全预设名称和目标词条
+func (p *PresetStore) autocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	resp := discord.CreateResponse(s, i)
+	opt := focusedOption(i.ApplicationCommandData().Options)
+	if opt == nil {
+		return
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	switch opt.Name {
+	case "targets":
+		choices = p.targetChoices(resp.Context(), i, opt.StringValue(), resp.Locale())
+	case "name":
+		choices = p.nameChoices(resp.Context(), i, opt.StringValue(), resp.Locale())
+	}
+	if err := resp.SendChoices(choices); err != nil {
+		logging.FromContext(resp.Context()).Warn("返回补全失败", "error", err)
+	}
+}
+
+// nameChoices 补全保存的预设名称
+func (p *PresetStore) nameChoices(ctx context.Context, i *discordgo.InteractionCreate, input, locale string) []*discordgo.ApplicationCommandOptionChoice {
+	presets, err := p.list(ctx, i, locale)
+	if err != nil {
+		logging.FromContext(ctx).Warn("读取预设失败", "error", err)
+	}
+	query := strings.ToLower(strings.TrimSpace(input))
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, preset := range presets {
+		if preset.scope == presetScopeBuiltin || !strings.Contains(preset.name, query) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  fmt.Sprintf("%s (%s)", preset.name, i18n.T(locale, "bot.preset.scope."+preset.scope)),
+			Value: preset.name,
+		})
+	}
+	return choices
+}
+
+// targetChoices 补全以逗号分隔的目标词条中的最后一项，候选项为预设引用和匹配的词条
+func (p *PresetStore) targetChoices(ctx context.Context, i *discordgo.InteractionCreate, input, locale string) []*discordgo.ApplicationCommandOptionChoice {
+	prefix, token := "", input
+	if cut := strings.LastIndexAny(input, ",，、;；"); cut >= 0 {
+		_, size := utf8.DecodeRuneInString(input[cut:])
+		prefix, token = input[:cut+size], input[cut+size:]
+	}
+	token = strings.TrimSpace(token)
+	lowered := strings.ToLower(token)
+	query := strings.TrimPrefix(lowered, calc.PresetPrefix)
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	seen := make(map[string]bool)
+	add := func(value, label string) {
+		value = prefix + value
+		if seen[value] || len(value) > maxChoiceLength {
+			return
+		}
+		seen[value] = true
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  truncateRunes(prefix+label, maxChoiceLength),
+			Value: value,
+		})
+	}
+
+	presets, err := p.list(ctx, i, locale)
+	if err != nil {
+		logging.FromContext(ctx).Warn("读取预设失败", "error", err)
+	}
+	for _, preset := range presets {
+		if query != "" && !strings.Contains(preset.name, query) && !strings.Contains(strings.ToLower(preset.title), query) {
+			continue
+		}
+		label := preset.title
+		if label == "" {
+			label = i18n.T(locale, "bot.preset.scope."+preset.scope)
+		}
+		add(calc.PresetPrefix+preset.name, fmt.Sprintf("%s%s (%s)", calc.PresetPrefix, preset.name, label))
+	}
+
+	if !strings.HasPrefix(lowered, calc.PresetPrefix) {
+		for _, match := range calc.SearchAffixes(token, "", 25) {
+			affix := match.Affix.Localize(locale)
+			add(strconv.Itoa(affix.ID), fmt.Sprintf("%d %s", affix.ID, affix.Name))
+		}
+	}
+	return choices
+}
+
+// focusedOption 查找正在补全的选项，子命令的选项嵌套在子命令中
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, opt := range options {
+		if opt.Focused {
+			return opt
+		}
+		if found := focusedOption(opt.Options); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// presetLine 预设在列表中的一行：引用方式和目标词条名称
+func presetLine(preset namedPreset, locale string) string {
+	names := make([]string, 0, len(preset.targets))
+	for _, id := range preset.targets {
+		if name := calc.AffixName(id, locale); name != "" {
+			names = append(names, name)
+		}
+	}
+	line := fmt.Sprintf("`%s%s`", calc.PresetPrefix, preset.name)
+	if preset.title != "" {
+		line += " " + preset.title
+	}
+	return line + " — " + strings.Join(names, ", ")
+}
+
+// truncateRunes 按字符截断字符串
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max-1]) + "…"
+}
+
+// toInts 转换保存的int32词条ID
+func toInts(values []int32) []int {
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result
+}
+
+// toInt32s 转换词条ID以便保存
+func toInt32s(values []int) []int32 {
+	result := make([]int32, len(values))
+	for i, v := range values {
+		result[i] = int32(v)
+	}
+	return result
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/SpenserCai/OnceHumanTools/backend/internal/services"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/calc"
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
 	"github.com/SpenserCai/OnceHumanTools/bot/platforms/discord"
 	"github.com/bwmarrin/discordgo"
 )
@@ -14,84 +16,46 @@ import (
 func CreateStrengthenCommand() *discord.SlashCommand {
 	return &discord.SlashCommand{
 		Command: &discordgo.ApplicationCommand{
-			Name:        "strengthen",
-			Description: "计算词条强化概率",
+			Name:                     "strengthen",
+			Description:              defaultText("bot.strengthen.description"),
+			DescriptionLocalizations: localizationsPtr("bot.strengthen.description"),
 			Options: []*discordgo.ApplicationCommandOption{
 				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "single",
-					Description: "计算单个词条强化概率",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "affix_id",
-							Description: "词条ID (1-10)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    10,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "current_level",
-							Description: "当前等级 (0-5)",
-							Required:    true,
-							MinValue:    &[]float64{0}[0],
-							MaxValue:    5,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "target_level",
-							Description: "目标等级 (1-5)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    5,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "slot_count",
-							Description: "词条数量 (1-10)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    10,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "tries",
-							Description: "强化次数 (1-999)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    999,
-						},
-					},
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "target",
+					Description:              defaultText("bot.strengthen.option.target"),
+					DescriptionLocalizations: discord.Localizations("bot.strengthen.option.target"),
+					Required:                 true,
 				},
 				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "multi",
-					Description: "计算多个词条强化概率",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "targets",
-							Description: "目标格式: 词条ID:当前等级:目标等级，用逗号分隔 (例如: 1:0:3,4:1:5)",
-							Required:    true,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "slot_count",
-							Description: "词条数量 (1-10)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    10,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "tries",
-							Description: "强化次数 (1-999)",
-							Required:    true,
-							MinValue:    &[]float64{1}[0],
-							MaxValue:    999,
-						},
-					},
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "initial",
+					Description:              defaultText("bot.strengthen.option.initial"),
+					DescriptionLocalizations: discord.Localizations("bot.strengthen.option.initial"),
+					Required:                 false,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "rarity",
+					Description:              defaultText("bot.strengthen.option.rarity"),
+					DescriptionLocalizations: discord.Localizations("bot.strengthen.option.rarity"),
+					Required:                 false,
+					Choices:                  GetRarityChoices(),
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionBoolean,
+					Name:                     "order_independent",
+					Description:              defaultText("bot.strengthen.option.order_independent"),
+					DescriptionLocalizations: discord.Localizations("bot.strengthen.option.order_independent"),
+					Required:                 false,
+				},
+				{
+					Type:                     discordgo.ApplicationCommandOptionString,
+					Name:                     "game_version",
+					Description:              defaultText("bot.option.game_version"),
+					DescriptionLocalizations: discord.Localizations("bot.option.game_version"),
+					Required:                 false,
+					Choices:                  GetGameVersionChoices(),
 				},
 			},
 		},
@@ -102,273 +66,141 @@ func CreateStrengthenCommand() *discord.SlashCommand {
 // handleStrengthenCommand 处理强化概率计算命令
 func handleStrengthenCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	resp := discord.CreateResponse(s, i)
+	locale := resp.Locale()
 
-	// 延迟响应
 	if err := resp.Defer(); err != nil {
 		return
 	}
 
+	// 获取参数
 	options := i.ApplicationCommandData().Options
-	subCommand := options[0]
-
-	switch subCommand.Name {
-	case "single":
-		handleSingleStrengthen(resp, subCommand.Options)
-	case "multi":
-		handleMultiStrengthen(resp, subCommand.Options)
-	}
-}
-
-// handleSingleStrengthen 处理单个词条强化
-func handleSingleStrengthen(resp *discord.InteractionResponse, options []*discordgo.ApplicationCommandInteractionDataOption) {
-	// 解析参数
-	var affixID, currentLevel, targetLevel, slotCount, tries int
+	var targetStr, initialStr, rarity, gameVersion string
+	orderIndependent := false
 
 	for _, opt := range options {
 		switch opt.Name {
-		case "affix_id":
-			affixID = int(opt.IntValue())
-		case "current_level":
-			currentLevel = int(opt.IntValue())
-		case "target_level":
-			targetLevel = int(opt.IntValue())
-		case "slot_count":
-			slotCount = int(opt.IntValue())
-		case "tries":
-			tries = int(opt.IntValue())
+		case "target":
+			targetStr = opt.StringValue()
+		case "initial":
+			initialStr = opt.StringValue()
+		case "rarity":
+			rarity = opt.StringValue()
+		case "order_independent":
+			orderIndependent = opt.BoolValue()
+		case "game_version":
+			gameVersion = opt.StringValue()
 		}
 	}
 
-	// 验证参数
-	if targetLevel <= currentLevel {
-		resp.SendError(fmt.Errorf("目标等级必须大于当前等级"))
+	targetLevels, err := parseLevels(targetStr)
+	if err != nil {
+		resp.SendError(err)
 		return
 	}
-
-	// 创建目标
-	targets := []services.StrengthenTarget{
-		{
-			AffixID:      affixID,
-			CurrentLevel: currentLevel,
-			TargetLevel:  targetLevel,
-		},
-	}
-
-	// 计算概率
-	service := services.NewStrengthenProbabilityService()
-	result := service.CalculateStrengthenProbability(targets, slotCount, tries)
-
-	// 构建响应
-	embed := buildSingleStrengthenResultEmbed(result, slotCount, tries)
-	resp.SendEmbed(embed)
-}
-
-// handleMultiStrengthen 处理多个词条强化
-func handleMultiStrengthen(resp *discord.InteractionResponse, options []*discordgo.ApplicationCommandInteractionDataOption) {
-	// 解析参数
-	var targetsStr string
-	var slotCount, tries int
-
-	for _, opt := range options {
-		switch opt.Name {
-		case "targets":
-			targetsStr = opt.StringValue()
-		case "slot_count":
-			slotCount = int(opt.IntValue())
-		case "tries":
-			tries = int(opt.IntValue())
+	var initialLevels []int
+	if strings.TrimSpace(initialStr) != "" {
+		if initialLevels, err = parseLevels(initialStr); err != nil {
+			resp.SendError(err)
+			return
 		}
 	}
 
-	// 解析目标
-	targets, err := parseStrengthenTargets(targetsStr)
+	result, err := calc.StrengthenProbability(resp.Context(), calc.StrengthenProbabilityRequest{
+		GameVersion:      gameVersion,
+		InitialLevels:    initialLevels,
+		TargetLevels:     targetLevels,
+		Rarity:           rarity,
+		OrderIndependent: orderIndependent,
+	})
 	if err != nil {
-		resp.SendError(fmt.Errorf("解析目标失败: %v", err))
-		return
-	}
-
-	if len(targets) == 0 {
-		resp.SendError(fmt.Errorf("至少需要一个目标"))
+		resp.SendError(err)
 		return
 	}
 
-	// 计算概率
-	service := services.NewStrengthenProbabilityService()
-	result := service.CalculateStrengthenProbability(targets, slotCount, tries)
-
-	// 构建响应
-	embed := buildMultiStrengthenResultEmbed(result, slotCount, tries)
+	embed := buildStrengthenResultEmbed(result, initialLevels, targetLevels, locale)
 	resp.SendEmbed(embed)
 }
 
-// parseStrengthenTargets 解析强化目标
-func parseStrengthenTargets(str string) ([]services.StrengthenTarget, error) {
-	var targets []services.StrengthenTarget
-	parts := strings.Split(str, ",")
-
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		values := strings.Split(part, ":")
-		if len(values) != 3 {
-			return nil, fmt.Errorf("无效的格式: %s", part)
-		}
-
-		affixID, err := strconv.Atoi(values[0])
-		if err != nil || affixID < 1 || affixID > 10 {
-			return nil, fmt.Errorf("无效的词条ID: %s", values[0])
-		}
-
-		currentLevel, err := strconv.Atoi(values[1])
-		if err != nil || currentLevel < 0 || currentLevel > 5 {
-			return nil, fmt.Errorf("无效的当前等级: %s", values[1])
-		}
-
-		targetLevel, err := strconv.Atoi(values[2])
-		if err != nil || targetLevel < 1 || targetLevel > 5 || targetLevel <= currentLevel {
-			return nil, fmt.Errorf("无效的目标等级: %s", values[2])
-		}
-
-		targets = append(targets, services.StrengthenTarget{
-			AffixID:      affixID,
-			CurrentLevel: currentLevel,
-			TargetLevel:  targetLevel,
-		})
+// parseLevels 解析以逗号或/分隔的词条等级，如5,5,1,1或5/5/1/1
+func parseLevels(str string) ([]int, error) {
+	fields := strings.FieldsFunc(str, func(r rune) bool {
+		return r == ',' || r == '，' || r == '/' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, i18n.NewMessage("bot.strengthen.levels_required")
 	}
 
-	return targets, nil
-}
-
-// buildSingleStrengthenResultEmbed 构建单个词条强化结果
-func buildSingleStrengthenResultEmbed(result *services.StrengthenProbabilityResult, slotCount, tries int) *discordgo.MessageEmbed {
-	if len(result.Results) == 0 {
-		return &discordgo.MessageEmbed{
-			Title:       "❌ 错误",
-			Description: "计算失败",
-			Color:       0xFF0000,
+	levels := make([]int, 0, len(fields))
+	for _, field := range fields {
+		level, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, i18n.NewMessage("bot.strengthen.invalid_level", field)
 		}
+		levels = append(levels, level)
 	}
+	return levels, nil
+}
 
-	// 词条名称映射
-	affixNames := map[int]string{
-		1: "异常伤害", 2: "弹匣容量", 3: "换弹速度加成",
-		4: "对普通敌人伤害", 5: "对精英敌人伤害", 6: "对上位者伤害",
-		7: "最大生命值", 8: "头部受伤减免", 9: "枪械伤害减免", 10: "异常伤害减免",
+// formatLevels 按5/5/1/1的格式输出等级
+func formatLevels(levels []int) string {
+	parts := make([]string, len(levels))
+	for i, level := range levels {
+		parts[i] = strconv.Itoa(level)
 	}
+	return strings.Join(parts, "/")
+}
 
-	target := result.Results[0]
-	affixName := affixNames[target.AffixID]
-
-	// 选择颜色
+// buildStrengthenResultEmbed 构建强化概率结果嵌入消息
+func buildStrengthenResultEmbed(result *calc.StrengthenProbabilityResult, initialLevels, targetLevels []int, locale string) *discordgo.MessageEmbed {
 	color := 0x00FF88 // 绿色
-	if target.SuccessRate < 0.1 {
+	if result.ProbabilityPercent < 10 {
 		color = 0xFF0044 // 红色
-	} else if target.SuccessRate < 0.3 {
+	} else if result.ProbabilityPercent < 30 {
 		color = 0xFFAA00 // 橙色
 	}
 
-	// 构建路径描述
-	var pathDesc []string
-	for _, step := range target.Path {
-		pathDesc = append(pathDesc, fmt.Sprintf("Lv%d → Lv%d (%.2f%%)",
-			step.FromLevel, step.ToLevel, step.Probability*100))
+	description := i18n.T(locale, "bot.strengthen.summary_drop", formatLevels(targetLevels))
+	if len(initialLevels) > 0 {
+		description = i18n.T(locale, "bot.strengthen.summary", formatLevels(initialLevels), formatLevels(targetLevels))
+	}
+	if result.Rarity != "" {
+		description += "\n" + i18n.T(locale, "bot.strengthen.rarity", calc.RarityName(result.Rarity, locale))
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title:       "🎯 强化概率计算结果",
-		Description: fmt.Sprintf("词条: **%s**", affixName),
+		Title:       i18n.T(locale, "bot.strengthen.title"),
+		Description: description,
 		Color:       color,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name: "📊 基础信息",
-				Value: fmt.Sprintf("当前等级: Lv%d\n目标等级: Lv%d\n词条数量: %d\n强化次数: %d",
-					target.CurrentLevel, target.TargetLevel, slotCount, tries),
-				Inline: true,
-			},
-			{
-				Name:   "🎲 成功率",
-				Value:  fmt.Sprintf("**%.4f%%**", target.SuccessRate*100),
+				Name:   i18n.T(locale, "bot.strengthen.probability"),
+				Value:  fmt.Sprintf("**%.4f%%**", result.ProbabilityPercent),
 				Inline: true,
 			},
-			{
-				Name:   "📈 强化路径",
-				Value:  strings.Join(pathDesc, "\n"),
-				Inline: false,
-			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "OnceHuman工具集",
+			Text: i18n.T(locale, "bot.footer_version", result.GameVersion),
 		},
-		Timestamp: discordgo.NowTimestamp(),
+		Timestamp: time.Now().Format(time.RFC3339),
 	}
 
-	// 添加期望值
-	if target.ExpectedStrengthens > 0 {
+	if result.TotalOutcomes > 0 {
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "💰 期望强化次数",
-			Value:  fmt.Sprintf("%.2f 次", target.ExpectedStrengthens),
+			Name:   i18n.T(locale, "bot.strengthen.outcomes"),
+			Value:  fmt.Sprintf("%d / %d", result.SuccessfulOutcomes, result.TotalOutcomes),
 			Inline: true,
 		})
 	}
 
-	return embed
-}
-
-// buildMultiStrengthenResultEmbed 构建多个词条强化结果
-func buildMultiStrengthenResultEmbed(result *services.StrengthenProbabilityResult, slotCount, tries int) *discordgo.MessageEmbed {
-	// 词条名称映射
-	affixNames := map[int]string{
-		1: "异常伤害", 2: "弹匣容量", 3: "换弹速度加成",
-		4: "对普通敌人伤害", 5: "对精英敌人伤害", 6: "对上位者伤害",
-		7: "最大生命值", 8: "头部受伤减免", 9: "枪械伤害减免", 10: "异常伤害减免",
-	}
-
-	// 选择颜色
-	color := 0x00FF88 // 绿色
-	if result.TotalProbability < 0.1 {
-		color = 0xFF0044 // 红色
-	} else if result.TotalProbability < 0.3 {
-		color = 0xFFAA00 // 橙色
-	}
-
-	embed := &discordgo.MessageEmbed{
-		Title:       "🎯 多词条强化概率计算结果",
-		Description: fmt.Sprintf("同时达成所有目标的概率"),
-		Color:       color,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "📊 总体成功率",
-				Value:  fmt.Sprintf("**%.4f%%**", result.TotalProbability*100),
-				Inline: false,
-			},
-			{
-				Name:   "🎰 词条数量",
-				Value:  fmt.Sprintf("%d", slotCount),
-				Inline: true,
-			},
-			{
-				Name:   "🔄 强化次数",
-				Value:  fmt.Sprintf("%d", tries),
-				Inline: true,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "OnceHuman工具集",
-		},
-		Timestamp: discordgo.NowTimestamp(),
-	}
-
-	// 添加各词条详情
-	var details []string
-	for _, target := range result.Results {
-		affixName := affixNames[target.AffixID]
-		details = append(details, fmt.Sprintf("• **%s**: Lv%d → Lv%d (%.2f%%)",
-			affixName, target.CurrentLevel, target.TargetLevel, target.SuccessRate*100))
-	}
-
-	if len(details) > 0 {
+	if len(result.RarityBreakdown) > 0 {
+		var lines []string
+		for _, r := range result.RarityBreakdown {
+			lines = append(lines, i18n.T(locale, "bot.strengthen.rarity_line",
+				calc.RarityName(r.Rarity, locale), r.Weight*100, r.Probability*100))
+		}
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "📋 词条详情",
-			Value:  strings.Join(details, "\n"),
+			Name:   i18n.T(locale, "bot.strengthen.rarity_breakdown"),
+			Value:  strings.Join(lines, "\n"),
 			Inline: false,
 		})
 	}
@@ -0,0 +1,106 @@
+package discord
+
+import (
+	"errors"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/i18n"
+	"github.com/bwmarrin/discordgo"
+)
+
+// 英文客户端语言，用于命令和选项的本地化名称
+var englishLocales = []discordgo.Locale{discordgo.EnglishUS, discordgo.EnglishGB}
+
+func init() {
+	i18n.Register("zh-CN", map[string]string{
+		"bot.error": "❌ 错误",
+
+		"bot.error.out_of_range":         "❌ 参数超出范围",
+		"bot.error.invalid_count":        "❌ 数量不正确",
+		"bot.error.required":             "❌ 缺少参数",
+		"bot.error.unknown_rarity":       "❌ 未知的稀有度",
+		"bot.error.unknown_game_version": "❌ 未知的游戏版本",
+		"bot.error.affix_not_found":      "❌ 未找到词条",
+		"bot.error.affix_ambiguous":      "❌ 词条不明确",
+		"bot.error.preset_not_found":     "❌ 未找到预设",
+		"bot.error.invalid_preset_name":  "❌ 预设名称无效",
+		"bot.error.invalid_input":        "❌ 输入无效",
+		"bot.error.tool_failed":          "❌ 插件运行失败",
+		"bot.error.tool_timeout":         "⏱️ 插件运行超时",
+	})
+	i18n.Register("en", map[string]string{
+		"bot.error": "❌ Error",
+
+		"bot.error.out_of_range":         "❌ Out of Range",
+		"bot.error.invalid_count":        "❌ Wrong Count",
+		"bot.error.required":             "❌ Missing Option",
+		"bot.error.unknown_rarity":       "❌ Unknown Rarity",
+		"bot.error.unknown_game_version": "❌ Unknown Game Version",
+		"bot.error.affix_not_found":      "❌ Affix Not Found",
+		"bot.error.affix_ambiguous":      "❌ Ambiguous Affix",
+		"bot.error.preset_not_found":     "❌ Preset Not Found",
+		"bot.error.invalid_preset_name":  "❌ Invalid Preset Name",
+		"bot.error.invalid_input":        "❌ Invalid Input",
+		"bot.error.tool_failed":          "❌ Plugin Failed",
+		"bot.error.tool_timeout":         "⏱️ Plugin Timed Out",
+	})
+}
+
+// InteractionLocale 获取交互的语言，优先使用用户客户端语言，其次是服务器语言
+func InteractionLocale(i *discordgo.InteractionCreate) string {
+	var guildLocale string
+	if i.GuildLocale != nil {
+		guildLocale = string(*i.GuildLocale)
+	}
+	return i18n.Resolve(string(i.Locale), guildLocale)
+}
+
+// Localizations 为命令描述或选项名称生成Discord本地化表，默认语言的文本由调用方设置
+func Localizations(key string, args ...interface{}) map[discordgo.Locale]string {
+	return LocalizationsFunc(func(locale string) string {
+		return i18n.T(locale, key, args...)
+	})
+}
+
+// LocalizationsFunc 按语言调用fn生成Discord本地化表
+func LocalizationsFunc(fn func(locale string) string) map[discordgo.Locale]string {
+	localizations := make(map[discordgo.Locale]string, len(englishLocales))
+	for _, locale := range englishLocales {
+		localizations[locale] = fn(string(locale))
+	}
+	return localizations
+}
+
+// Locale 获取响应使用的语言
+func (r *InteractionResponse) Locale() string {
+	return InteractionLocale(r.interaction)
+}
+
+// localizer 可按语言输出的错误
+type localizer interface {
+	Localize(locale string) string
+}
+
+// coder 带错误码的错误
+type coder interface {
+	ErrorCode() string
+}
+
+// localizeError 按交互语言输出错误，支持可本地化的服务错误
+func (r *InteractionResponse) localizeError(err error) string {
+	var l localizer
+	if errors.As(err, &l) {
+		return l.Localize(r.Locale())
+	}
+	return err.Error()
+}
+
+// errorTitle 按错误码获取错误标题，未知错误码使用通用标题
+func (r *InteractionResponse) errorTitle(err error) string {
+	locale := r.Locale()
+	title := i18n.T(locale, "bot.error")
+	var c coder
+	if errors.As(err, &c) && c.ErrorCode() != "" {
+		return i18n.TOr(locale, "bot.error."+c.ErrorCode(), title)
+	}
+	return title
+}
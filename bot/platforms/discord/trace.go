@@ -0,0 +1,60 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/SpenserCai/OnceHumanTools/backend/pkg/logging"
+	"github.com/bwmarrin/discordgo"
+)
+
+// InteractionRequestID 获取交互的请求ID，由交互ID生成，同一交互的日志和计算使用相同的ID
+func InteractionRequestID(i *discordgo.InteractionCreate) string {
+	return "discord-" + i.ID
+}
+
+// InteractionContext 创建带有交互请求ID的上下文，传给后端服务以关联命令和计算日志
+func InteractionContext(i *discordgo.InteractionCreate) context.Context {
+	return logging.WithRequestID(context.Background(), InteractionRequestID(i))
+}
+
+// Context 获取响应对应交互的上下文
+func (r *InteractionResponse) Context() context.Context {
+	return InteractionContext(r.interaction)
+}
+
+// InteractionUserID 获取发起交互的用户ID，服务器内为成员，私信为用户
+func InteractionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// commandOptions 将命令选项格式化为name=value列表，用于日志
+func commandOptions(options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	parts := make([]string, 0, len(options))
+	for _, opt := range options {
+		parts = append(parts, fmt.Sprintf("%s=%v", opt.Name, opt.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// failedInteractions 发送过错误消息的交互ID，命令结束后用于统计错误数
+var failedInteractions sync.Map
+
+// markFailed 标记交互的命令执行失败
+func markFailed(i *discordgo.InteractionCreate) {
+	failedInteractions.Store(i.ID, struct{}{})
+}
+
+// takeFailed 获取并清除交互的失败标记
+func takeFailed(i *discordgo.InteractionCreate) bool {
+	_, failed := failedInteractions.LoadAndDelete(i.ID)
+	return failed
+}
@@ -29,6 +29,7 @@ var (
 	tlsCA          = flag.String("tls-ca", "", "TLS CA证书文件路径（可选）")
 	frontendPort   = flag.String("frontend-port", "3000", "前端服务端口")
 	launcherPort   = flag.String("port", "9000", "启动器端口（集成模式）")
+	stopTimeout    = flag.Duration("shutdown-timeout", 40*time.Second, "停止时等待子进程完成进行中的请求和任务的时间，超过后强制结束，应大于后端的 SHUTDOWN_DRAIN_DELAY 与 SHUTDOWN_GRACE_PERIOD 之和")
 )
 
 type Launcher struct {
@@ -37,6 +38,7 @@ type Launcher struct {
 	wg         sync.WaitGroup
 	backendCmd *exec.Cmd
 	botCmd     *exec.Cmd
+	server     *http.Server
 }
 
 func NewLauncher() *Launcher {
@@ -82,6 +84,7 @@ func (l *Launcher) runIntegrated() error {
 	}
 
 	// 启动集成Web服务器
+	l.server = l.newIntegratedServer()
 	go l.startIntegratedServer()
 
 	log.Printf("OnceHuman工具集已启动在 http://localhost:%s", *launcherPort)
@@ -166,6 +169,8 @@ func (l *Launcher) startBackend() error {
 	}
 
 	l.backendCmd = exec.CommandContext(l.ctx, backendPath, args...)
+	l.backendCmd.Cancel = terminate(l.backendCmd)
+	l.backendCmd.WaitDelay = *stopTimeout
 	l.backendCmd.Stdout = os.Stdout
 	l.backendCmd.Stderr = os.Stderr
 	l.backendCmd.Env = append(os.Environ(), fmt.Sprintf("PORT=%s", *backendPort))
@@ -177,9 +182,7 @@ func (l *Launcher) startBackend() error {
 	l.wg.Add(1)
 	go func() {
 		defer l.wg.Done()
-		if err := l.backendCmd.Wait(); err != nil {
-			log.Printf("后端进程退出: %v", err)
-		}
+		l.logExit("后端", l.backendCmd, l.backendCmd.Wait())
 	}()
 
 	return nil
@@ -200,6 +203,8 @@ func (l *Launcher) startBot() error {
 	}
 
 	l.botCmd = exec.CommandContext(l.ctx, botPath)
+	l.botCmd.Cancel = terminate(l.botCmd)
+	l.botCmd.WaitDelay = *stopTimeout
 	l.botCmd.Stdout = os.Stdout
 	l.botCmd.Stderr = os.Stderr
 	l.botCmd.Env = os.Environ()
@@ -211,17 +216,15 @@ func (l *Launcher) startBot() error {
 	l.wg.Add(1)
 	go func() {
 		defer l.wg.Done()
-		if err := l.botCmd.Wait(); err != nil {
-			log.Printf("机器人进程退出: %v", err)
-		}
+		l.logExit("机器人", l.botCmd, l.botCmd.Wait())
 	}()
 
 	return nil
 }
 
-// waitForBackend 等待后端服务启动
+// waitForBackend 等待后端服务就绪，就绪检查在目录加载且缓存等组件可用后返回200
 func (l *Launcher) waitForBackend() error {
-	backendURL := fmt.Sprintf("%s://localhost:%s/api/v1/health", *backendScheme, *backendPort)
+	backendURL := fmt.Sprintf("%s://localhost:%s/api/v1/health/ready", *backendScheme, *backendPort)
 
 	log.Printf("等待后端服务启动: %s", backendURL)
 
@@ -241,13 +244,36 @@ func (l *Launcher) waitForBackend() error {
 	return fmt.Errorf("后端服务启动超时")
 }
 
-// startIntegratedServer 启动集成Web服务器
-func (l *Launcher) startIntegratedServer() {
+// logExit 记录子进程退出，停止服务时正常退出不视为错误
+func (l *Launcher) logExit(name string, cmd *exec.Cmd, err error) {
+	if l.ctx.Err() != nil && cmd.ProcessState != nil && cmd.ProcessState.Success() {
+		log.Printf("%s进程已停止", name)
+		return
+	}
+	if err != nil {
+		log.Printf("%s进程退出: %v", name, err)
+	}
+}
+
+// terminate 停止子进程：发送SIGTERM让其完成进行中的请求和任务后退出，不支持信号的平台（Windows）直接结束进程
+func terminate(cmd *exec.Cmd) func() error {
+	return func() error {
+		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+			return cmd.Process.Kill()
+		}
+		return nil
+	}
+}
+
+// newIntegratedServer 创建集成Web服务器
+func (l *Launcher) newIntegratedServer() *http.Server {
 	mux := http.NewServeMux()
 
 	// 配置API代理
 	apiURL, _ := url.Parse(fmt.Sprintf("%s://localhost:%s", *backendScheme, *backendPort))
 	apiProxy := httputil.NewSingleHostReverseProxy(apiURL)
+	// 立即转发每次写入，任务进度等事件流不被代理缓冲
+	apiProxy.FlushInterval = -1
 
 	// API路由
 	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
@@ -263,6 +289,11 @@ func (l *Launcher) startIntegratedServer() {
 	staticPath, _ := filepath.Abs(*frontendPath)
 	fs := http.FileServer(http.Dir(staticPath))
 
+	// 分享链接/s/{id}始终由前端打开
+	mux.HandleFunc("/s/", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, filepath.Join(staticPath, "index.html"))
+	})
+
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		// 检查文件是否存在
 		path := filepath.Join(staticPath, r.URL.Path)
@@ -277,15 +308,18 @@ func (l *Launcher) startIntegratedServer() {
 		fs.ServeHTTP(w, r)
 	})
 
-	server := &http.Server{
+	return &http.Server{
 		Addr:    ":" + *launcherPort,
 		Handler: mux,
 	}
+}
 
+// startIntegratedServer 启动集成Web服务器
+func (l *Launcher) startIntegratedServer() {
 	log.Printf("集成服务器启动在端口 %s", *launcherPort)
 	log.Printf("API代理: %s://localhost:%s -> http://localhost:%s/api/", *backendScheme, *backendPort, *launcherPort)
 
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if err := l.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Printf("集成服务器错误: %v", err)
 	}
 }
@@ -298,28 +332,21 @@ func (l *Launcher) waitForShutdown() {
 	<-sigChan
 	log.Println("收到关闭信号，正在停止服务...")
 
-	// 取消上下文
+	// 取消上下文，子进程收到SIGTERM后完成进行中的请求和任务再退出
 	l.cancel()
 
-	// 等待所有子进程退出
-	done := make(chan struct{})
-	go func() {
-		l.wg.Wait()
-		close(done)
-	}()
+	// 等待所有子进程退出，超过shutdown-timeout仍未退出的子进程被强制结束
+	// 后端停止期间集成服务器继续转发请求
+	l.wg.Wait()
 
-	select {
-	case <-done:
-		log.Println("所有服务已停止")
-	case <-time.After(10 * time.Second):
-		log.Println("强制停止服务")
-		if l.backendCmd != nil && l.backendCmd.Process != nil {
-			l.backendCmd.Process.Kill()
-		}
-		if l.botCmd != nil && l.botCmd.Process != nil {
-			l.botCmd.Process.Kill()
+	if l.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := l.server.Shutdown(ctx); err != nil {
+			log.Printf("停止集成服务器失败: %v", err)
 		}
 	}
+	log.Println("所有服务已停止")
 }
 
 func main() {
@@ -0,0 +1,60 @@
+// 请求字段的中文名称
+const fieldNames = {
+  slotCount: '词条数量',
+  targetAffixIds: '目标词条',
+  rarity: '稀有度',
+  level: '词条等级',
+  requirements: '词条要求',
+  initialLevels: '初始等级',
+  targetLevels: '目标等级',
+  gameVersion: '游戏版本',
+  category: '词条分类',
+  affix: '词条',
+  id: '词条'
+}
+
+// 按错误码生成提示，details为后端返回的错误详情
+const formatters = {
+  out_of_range: (field, details) => `${field}必须在${details.min}-${details.max}之间`,
+  invalid_count: (field, details) =>
+    details.min === details.max
+      ? `${field}必须为${details.max}个`
+      : `${field}数量必须在${details.min}-${details.max}之间`,
+  required: (field) => `请填写${field}`,
+  duplicate: (field) => `${field}不能重复`,
+  unknown_rarity: (field, details) => withAllowed(`未知的${field}`, details),
+  unknown_game_version: (field, details) => withAllowed(`未知的${field}`, details),
+  unknown_category: (field, details) => withAllowed(`未知的${field}`, details),
+  affix_ambiguous: (field, details) => withAllowed('匹配到多个词条，请输入更准确的名称', details)
+}
+
+// 附加允许的取值
+const withAllowed = (message, details) => {
+  if (!details.allowed?.length) return message
+  return `${message}，可选: ${details.allowed.join('、')}`
+}
+
+// 解析后端错误，兼容ErrorResponse和RFC 7807问题详情
+export const parseApiError = (data) => {
+  if (!data || typeof data !== 'object') return null
+  if (data.type && data.status) {
+    return {
+      code: data.code,
+      message: data.detail,
+      details: { field: data.field, min: data.min, max: data.max, allowed: data.allowed }
+    }
+  }
+  return {
+    code: data.code,
+    message: data.message,
+    details: data.details || {}
+  }
+}
+
+// 生成错误提示，未知错误码使用后端返回的本地化消息
+export const formatApiError = (apiError) => {
+  const formatter = formatters[apiError.code]
+  if (!formatter) return apiError.message
+  const field = fieldNames[apiError.details.field] || apiError.details.field || '参数'
+  return formatter(field, apiError.details)
+}
@@ -1,5 +1,6 @@
 import axios from 'axios'
 import { ElMessage } from 'element-plus'
+import { parseApiError, formatApiError } from './errors'
 
 // 创建axios实例
 const request = axios.create({
@@ -28,8 +29,15 @@ request.interceptors.response.use(
     return response.data
   },
   error => {
-    const message = error.response?.data?.message || '网络错误'
-    ElMessage.error(message)
+    // 后端返回的错误带有错误码，按错误码提示并标记为已处理
+    const apiError = parseApiError(error.response?.data)
+    if (apiError?.message) {
+      error.apiError = apiError
+      error.handled = true
+      ElMessage.error(formatApiError(apiError))
+    } else {
+      ElMessage.error('网络错误')
+    }
     return Promise.reject(error)
   }
 )
@@ -44,19 +52,103 @@ export const api = {
   // 模组接口
   mod: {
     // 获取词条列表
-    getAffixList: () => request.get('/mod/affix/list'),
+    getAffixList: (params) => request.get('/mod/affix/list', { params }),
+    
+    // 获取词条详情
+    getAffix: (id, params) => request.get(`/mod/affix/${id}`, { params }),
+    
+    // 搜索词条（支持中文名、拼音、首字母和英文别名）
+    searchAffixes: (params) => request.get('/mod/affix/search', { params }),
+    
+    // 获取稀有度列表
+    getRarityList: (params) => request.get('/mod/rarity/list', { params }),
+    
+    // 获取游戏版本列表及变动
+    getGameVersions: () => request.get('/mod/versions'),
+    
+    // 比较两个游戏版本
+    diffGameVersions: (from, to) => request.get('/mod/versions/diff', { params: { from, to } }),
     
     // 计算词条概率
     calculateAffixProbability: (data) => request.post('/mod/affix/probability', data),
     
+    // 计算词条数值概率
+    calculateAffixValueProbability: (data) => request.post('/mod/affix/value/probability', data),
+    
     // 计算强化概率
     calculateStrengthenProbability: (data) => request.post('/mod/strengthen/probability', data)
   },
   
+  // 异步任务接口
+  jobs: {
+    // 提交计算任务，data为 { type, [type]: 计算参数 }
+    submit: (data) => request.post('/jobs', data),
+    
+    // 获取任务状态
+    get: (id) => request.get(`/jobs/${id}`),
+    
+    // 取消任务
+    cancel: (id) => request.delete(`/jobs/${id}`),
+    
+    // 获取任务结果
+    getResult: (id) => request.get(`/jobs/${id}/result`),
+    
+    // 订阅任务进度（Server-Sent Events）
+    events: (id) => new EventSource(`${request.defaults.baseURL}/jobs/${id}/events`)
+  },
+  
+  // 计算分享接口
+  share: {
+    // 创建分享，data为 { type, [type]: 计算参数 }
+    create: (data) => request.post('/share', data),
+    
+    // 获取分享的计算参数和结果
+    get: (id) => request.get(`/share/${id}`)
+  },
+  
+  // 模组背包接口
+  inventory: {
+    // 获取背包中的模组，params可包含 { type }
+    listMods: (params) => request.get('/inventory/mods', { params }),
+    
+    // 添加模组
+    createMod: (data) => request.post('/inventory/mods', data),
+    
+    // 更新模组
+    updateMod: (id, data) => request.put(`/inventory/mods/${id}`, data),
+    
+    // 删除模组
+    deleteMod: (id) => request.delete(`/inventory/mods/${id}`),
+    
+    // 分析背包，params为 { targetLevels: '5,5', affixIds, orderIndependent, type }
+    analyze: (params) => request.get('/inventory/analysis', { params })
+  },
+  
+  // 目标词条预设，计算时可在targets中以 preset:名称 引用
+  presets: {
+    // 获取内置、玩家和服务器预设
+    list: () => request.get('/presets'),
+    
+    // 保存预设，scope为user或guild，data为 { targetAffixIds, targets }
+    save: (scope, name, data) => request.put(`/presets/${scope}/${name}`, data),
+    
+    // 删除预设
+    remove: (scope, name) => request.delete(`/presets/${scope}/${name}`)
+  },
+  
   // 工具接口
   tools: {
     // 获取工具列表
-    getToolsList: () => request.get('/tools')
+    getToolsList: () => request.get('/tools'),
+    
+    // 运行工具，input符合工具的inputSchema
+    run: (id, input) => request.post(`/tools/${id}/run`, input)
+  },
+  
+  // 脚本接口
+  scripts: {
+    // 运行Starlark脚本，脚本需要定义main(input)
+    run: (source, input, gameVersion) => request.post('/scripts/run', { source, input, gameVersion })
   }
 }
 
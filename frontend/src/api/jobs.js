@@ -0,0 +1,58 @@
+import { ElMessage } from 'element-plus'
+import { api } from './index'
+import { parseApiError, formatApiError } from './errors'
+
+// 任务失败或取消时的错误，失败原因已提示并标记为已处理
+const jobError = (job) => {
+  const error = new Error(`任务${job.status}`)
+  error.handled = true
+  error.canceled = job.status === 'canceled'
+  const apiError = parseApiError(job.error)
+  if (apiError?.message) {
+    error.apiError = apiError
+    ElMessage.error(formatApiError(apiError))
+  } else if (!error.canceled) {
+    ElMessage.error('计算失败，请重试')
+  }
+  return error
+}
+
+// 以异步任务运行计算，onProgress接收任务状态（进度和概率估计），signal中止时取消任务
+// 返回对应计算类型的结果
+export const runJob = (type, params, { onProgress, signal } = {}) =>
+  new Promise((resolve, reject) => {
+    api.jobs.submit({ type, [type]: params }).then(job => {
+      onProgress?.(job)
+      const source = api.jobs.events(job.id)
+
+      signal?.addEventListener('abort', () => {
+        source.close()
+        api.jobs.cancel(job.id).catch(() => {})
+        reject(jobError({ ...job, status: 'canceled' }))
+      })
+
+      source.addEventListener('progress', event => {
+        onProgress?.(JSON.parse(event.data))
+      })
+
+      source.addEventListener('completed', event => {
+        source.close()
+        const done = JSON.parse(event.data)
+        onProgress?.(done)
+        if (done.status !== 'succeeded') {
+          reject(jobError(done))
+          return
+        }
+        api.jobs.getResult(done.id).then(result => resolve(result[type]), reject)
+      })
+
+      // 连接断开时浏览器会自动重连并收到最新状态，只有无法重连时才失败
+      source.onerror = () => {
+        if (source.readyState !== EventSource.CLOSED) return
+        const error = new Error('进度连接中断')
+        ElMessage.error(error.message)
+        error.handled = true
+        reject(error)
+      }
+    }, reject)
+  })
@@ -0,0 +1,32 @@
+import { ElMessage } from 'element-plus'
+import { api } from './index'
+
+// 计算类型对应的工具页面
+export const shareRoutes = {
+  affixProbability: 'AffixProbability',
+  affixValueProbability: 'AffixProbability',
+  strengthenProbability: 'StrengthenProbability'
+}
+
+// 创建计算分享并复制链接，返回分享
+export const createShareLink = async (type, params) => {
+  const share = await api.share.create({ type, [type]: params })
+  const url = `${window.location.origin}${share.path}`
+  try {
+    await navigator.clipboard.writeText(url)
+    ElMessage.success('分享链接已复制')
+  } catch (error) {
+    ElMessage.info(`分享链接：${url}`)
+  }
+  return share
+}
+
+// 读取分享，返回对应计算类型的参数和结果
+export const loadShare = async (id) => {
+  const share = await api.share.get(id)
+  return {
+    type: share.type,
+    params: share.request[share.type],
+    result: share.result[share.type]
+  }
+}
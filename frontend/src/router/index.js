@@ -32,6 +32,14 @@ const routes = [
       }
     ]
   },
+  {
+    path: '/s/:id',
+    name: 'Share',
+    component: () => import('@/views/Share.vue'),
+    meta: {
+      title: '计算分享'
+    }
+  },
   {
     path: '/about',
     name: 'About',
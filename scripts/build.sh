@@ -45,8 +45,15 @@ cd backend
 echo -e "${YELLOW}生成Swagger代码...${NC}"
 make generate-swagger
 echo -e "${YELLOW}编译后端可执行文件...${NC}"
+BUILDINFO=github.com/SpenserCai/OnceHumanTools/backend/internal/buildinfo
+VERSION=${VERSION:-$(git describe --tags --always --dirty 2>/dev/null || echo dev)}
+COMMIT=$(git rev-parse HEAD 2>/dev/null)
+BUILD_TIME=$(date -u +%Y-%m-%dT%H:%M:%SZ)
 GOOS=$TARGET_OS GOARCH=$TARGET_ARCH CGO_ENABLED=0 \
-    go build -ldflags="-s -w" -o ../$RELEASE_DIR/backend/server${EXE_EXT} cmd/server/main.go
+    go build -ldflags="-s -w -X $BUILDINFO.Version=$VERSION -X $BUILDINFO.Commit=$COMMIT -X $BUILDINFO.BuildTime=$BUILD_TIME" \
+    -o ../$RELEASE_DIR/backend/server${EXE_EXT} ./cmd/server
+GOOS=$TARGET_OS GOARCH=$TARGET_ARCH CGO_ENABLED=0 \
+    go build -ldflags="-s -w" -o ../$RELEASE_DIR/backend/apikey${EXE_EXT} ./cmd/apikey
 cp -r api ../$RELEASE_DIR/backend/
 cd ..
 echo -e "${GREEN}✓ 后端构建完成${NC}"